@@ -59,6 +59,11 @@ func (t *TenantService) TenantUpdate(ctx echo.Context, request gen.TenantUpdateR
 		}
 	}
 
+	if request.Body.PriorityAgingSeconds != nil {
+		agingSeconds := int32(*request.Body.PriorityAgingSeconds) // nolint: gosec
+		updateOpts.PriorityAgingSeconds = &agingSeconds
+	}
+
 	if request.Body.UiVersion != nil {
 		updateOpts.UIVersion = &dbsqlc.NullTenantMajorUIVersion{
 			Valid:                true,
@@ -0,0 +1,24 @@
+package approvalsv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *V1ApprovalsService) V1ApprovalApprove(ctx echo.Context, request gen.V1ApprovalApproveRequestObject) (gen.V1ApprovalApproveResponseObject, error) {
+	approval, apiErrors, err := t.decide(ctx, true, request.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if apiErrors != nil {
+		return gen.V1ApprovalApprove400JSONResponse(*apiErrors), nil
+	}
+
+	return gen.V1ApprovalApprove200JSONResponse(
+		transformers.ToV1Approval(approval),
+	), nil
+}
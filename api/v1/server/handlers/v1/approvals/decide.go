@@ -0,0 +1,83 @@
+package approvalsv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// decide records the decision of the authenticated user on an approval, and sends the decision to the
+// tasks controller so that the task which is waiting on the approval is queued or failed. If the approval
+// cannot be decided, it returns a non-nil *gen.APIErrors.
+func (t *V1ApprovalsService) decide(ctx echo.Context, approved bool, body *gen.V1ApprovalDecisionRequest) (*sqlcv1.V1Approval, *gen.APIErrors, error) {
+	user := ctx.Get("user").(*dbsqlc.User)
+	approval := ctx.Get("v1-approval").(*sqlcv1.V1Approval)
+
+	tenantId := sqlchelpers.UUIDToStr(approval.TenantID)
+
+	opts := v1.DecideApprovalOpts{
+		Approved:  approved,
+		UserId:    sqlchelpers.UUIDToStr(user.ID),
+		UserEmail: user.Email,
+	}
+
+	if body != nil {
+		opts.Comment = body.Comment
+
+		if body.Payload != nil {
+			payload, err := json.Marshal(*body.Payload)
+
+			if err != nil {
+				apiErrors := apierrors.NewAPIErrors("invalid payload")
+				return nil, &apiErrors, nil
+			}
+
+			opts.Payload = payload
+		}
+	}
+
+	decided, event, err := t.config.V1.Approvals().DecideApproval(
+		ctx.Request().Context(),
+		tenantId,
+		sqlchelpers.UUIDToStr(approval.ExternalID),
+		opts,
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		apiErrors := apierrors.NewAPIErrors("approval is not pending")
+		return nil, &apiErrors, nil
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg, err := tasktypes.NewInternalEventMessage(tenantId, time.Now(), *event)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create internal event message: %w", err)
+	}
+
+	// NOTE: the decision is committed before it is sent, but the same user can retry the same decision until the
+	// task has received it, so a failed send can be recovered by retrying the request
+	err = t.config.MessageQueueV1.SendMessage(ctx.Request().Context(), msgqueue.TASK_PROCESSING_QUEUE, msg)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not send approval decision: %w", err)
+	}
+
+	return decided, nil, nil
+}
@@ -0,0 +1,41 @@
+package approvalsv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *V1ApprovalsService) V1ApprovalList(ctx echo.Context, request gen.V1ApprovalListRequestObject) (gen.V1ApprovalListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	opts := v1.ListApprovalsOpts{
+		Offset: request.Params.Offset,
+		Limit:  request.Params.Limit,
+	}
+
+	if (opts.Offset != nil && *opts.Offset < 0) || (opts.Limit != nil && *opts.Limit < 1) {
+		return gen.V1ApprovalList400JSONResponse(apierrors.NewAPIErrors("offset must be at least 0 and limit must be at least 1")), nil
+	}
+
+	if request.Params.WorkflowRunId != nil {
+		workflowRunId := request.Params.WorkflowRunId.String()
+		opts.WorkflowRunId = &workflowRunId
+	}
+
+	approvals, err := t.config.V1.Approvals().ListPendingApprovals(ctx.Request().Context(), tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1ApprovalList200JSONResponse(
+		transformers.ToV1ApprovalList(approvals),
+	), nil
+}
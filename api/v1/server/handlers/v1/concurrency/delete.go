@@ -0,0 +1,36 @@
+package concurrencyv1
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *V1ConcurrencyService) V1ConcurrencyLimitDelete(ctx echo.Context, request gen.V1ConcurrencyLimitDeleteRequestObject) (gen.V1ConcurrencyLimitDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	limit, err := t.config.V1.Concurrency().DeleteConcurrencyKeyLimit(ctx.Request().Context(), tenantId, request.Body.WorkflowName, request.Body.Key)
+
+	switch {
+	case errors.Is(err, v1.ErrConcurrencyWorkflowNotFound):
+		return gen.V1ConcurrencyLimitDelete404JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+	case errors.Is(err, v1.ErrConcurrencyKeyLimitNotFound):
+		return gen.V1ConcurrencyLimitDelete404JSONResponse(apierrors.NewAPIErrors("concurrency limit not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	return gen.V1ConcurrencyLimitDelete200JSONResponse{
+		WorkflowName: request.Body.WorkflowName,
+		Key:          limit.Key,
+		MaxRuns:      int(limit.MaxRuns),
+		UpdatedAt:    limit.UpdatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package concurrencyv1
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+func (t *V1ConcurrencyService) V1ConcurrencyLimitList(ctx echo.Context, request gen.V1ConcurrencyLimitListRequestObject) (gen.V1ConcurrencyLimitListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	limits, err := t.config.V1.Concurrency().ListConcurrencyKeyLimits(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.V1ConcurrencyKeyLimit, 0, len(limits))
+
+	for _, limit := range limits {
+		rows = append(rows, gen.V1ConcurrencyKeyLimit{
+			WorkflowName: limit.WorkflowName,
+			Key:          limit.Key,
+			MaxRuns:      int(limit.MaxRuns),
+			UpdatedAt:    limit.UpdatedAt.Time,
+		})
+	}
+
+	return gen.V1ConcurrencyLimitList200JSONResponse{
+		Rows: rows,
+	}, nil
+}
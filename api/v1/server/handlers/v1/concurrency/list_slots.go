@@ -0,0 +1,77 @@
+package concurrencyv1
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *V1ConcurrencyService) V1ConcurrencySlotList(ctx echo.Context, request gen.V1ConcurrencySlotListRequestObject) (gen.V1ConcurrencySlotListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	opts := v1.ListConcurrencySlotKeysOpts{
+		Offset: request.Params.Offset,
+		Limit:  request.Params.Limit,
+	}
+
+	if request.Params.WorkflowIds != nil {
+		workflowIds := make([]pgtype.UUID, 0, len(*request.Params.WorkflowIds))
+
+		for _, id := range *request.Params.WorkflowIds {
+			workflowIds = append(workflowIds, sqlchelpers.UUIDFromStr(id.String()))
+		}
+
+		opts.WorkflowIds = workflowIds
+	}
+
+	if (opts.Offset != nil && *opts.Offset < 0) || (opts.Limit != nil && *opts.Limit < 1) {
+		return gen.V1ConcurrencySlotList400JSONResponse(apierrors.NewAPIErrors("offset must be at least 0 and limit must be at least 1")), nil
+	}
+
+	keys, err := t.config.V1.Concurrency().ListConcurrencySlotKeys(ctx.Request().Context(), tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.V1ConcurrencySlotKey, 0, len(keys))
+
+	for _, key := range keys {
+		row := gen.V1ConcurrencySlotKey{
+			WorkflowId:   uuid.MustParse(sqlchelpers.UUIDToStr(key.WorkflowID)),
+			WorkflowName: key.WorkflowName,
+			Strategy:     string(key.Strategy),
+			Expression:   key.Expression,
+			Key:          key.Key,
+			MaxRuns:      int(key.MaxRuns),
+			RunningCount: int(key.RunningCount),
+			QueuedCount:  int(key.QueuedCount),
+		}
+
+		if key.StepReadableId.Valid {
+			row.StepReadableId = &key.StepReadableId.String
+		}
+
+		if key.OldestQueuedWorkflowRunID.Valid {
+			runId := uuid.MustParse(sqlchelpers.UUIDToStr(key.OldestQueuedWorkflowRunID))
+			row.OldestQueuedRunId = &runId
+		}
+
+		if key.OldestQueuedAt.Valid {
+			row.OldestQueuedAt = &key.OldestQueuedAt.Time
+		}
+
+		rows = append(rows, row)
+	}
+
+	return gen.V1ConcurrencySlotList200JSONResponse{
+		Rows: rows,
+	}, nil
+}
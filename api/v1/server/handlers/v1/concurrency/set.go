@@ -0,0 +1,46 @@
+package concurrencyv1
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *V1ConcurrencyService) V1ConcurrencyLimitSet(ctx echo.Context, request gen.V1ConcurrencyLimitSetRequestObject) (gen.V1ConcurrencyLimitSetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if request.Body.MaxRuns < 1 {
+		return gen.V1ConcurrencyLimitSet400JSONResponse(apierrors.NewAPIErrors("maxRuns must be at least 1")), nil
+	}
+
+	if request.Body.WorkflowName == "" || request.Body.Key == "" {
+		return gen.V1ConcurrencyLimitSet400JSONResponse(apierrors.NewAPIErrors("workflowName and key are required")), nil
+	}
+
+	limit, err := t.config.V1.Concurrency().SetConcurrencyKeyLimit(ctx.Request().Context(), tenantId, v1.SetConcurrencyKeyLimitOpts{
+		WorkflowName: request.Body.WorkflowName,
+		Key:          request.Body.Key,
+		MaxRuns:      int32(request.Body.MaxRuns), // nolint: gosec
+	})
+
+	switch {
+	case errors.Is(err, v1.ErrConcurrencyWorkflowNotFound):
+		return gen.V1ConcurrencyLimitSet404JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	return gen.V1ConcurrencyLimitSet200JSONResponse{
+		WorkflowName: request.Body.WorkflowName,
+		Key:          limit.Key,
+		MaxRuns:      int(limit.MaxRuns),
+		UpdatedAt:    limit.UpdatedAt.Time,
+	}, nil
+}
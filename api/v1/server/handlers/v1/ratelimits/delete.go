@@ -0,0 +1,74 @@
+package ratelimitsv1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *V1RateLimitsService) V1RateLimitDelete(ctx echo.Context, request gen.V1RateLimitDeleteRequestObject) (gen.V1RateLimitDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	err := t.config.V1.RateLimits().DeleteRateLimit(ctx.Request().Context(), tenantId, request.Key)
+
+	switch {
+	case errors.Is(err, v1.ErrRateLimitNotFound):
+		return gen.V1RateLimitDelete404JSONResponse(apierrors.NewAPIErrors("rate limit not found")), nil
+	case errors.Is(err, v1.ErrRateLimitInUse):
+		return gen.V1RateLimitDelete400JSONResponse(apierrors.NewAPIErrors("rate limit is used by a workflow step or a queued task and can't be deleted")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	return gen.V1RateLimitDelete200JSONResponse{
+		Keys: []string{request.Key},
+	}, nil
+}
+
+func (t *V1RateLimitsService) V1RateLimitDeleteByPrefix(ctx echo.Context, request gen.V1RateLimitDeleteByPrefixRequestObject) (gen.V1RateLimitDeleteByPrefixResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if request.Body.Prefix == "" {
+		return gen.V1RateLimitDeleteByPrefix400JSONResponse(apierrors.NewAPIErrors("prefix is required")), nil
+	}
+
+	keys, err := t.config.V1.RateLimits().DeleteRateLimitsByPrefix(ctx.Request().Context(), tenantId, request.Body.Prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1RateLimitDeleteByPrefix200JSONResponse{
+		Keys: keys,
+	}, nil
+}
+
+func (t *V1RateLimitsService) V1RateLimitDeleteUnused(ctx echo.Context, request gen.V1RateLimitDeleteUnusedRequestObject) (gen.V1RateLimitDeleteUnusedResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if request.Body.UnusedForSeconds < 1 {
+		return gen.V1RateLimitDeleteUnused400JSONResponse(apierrors.NewAPIErrors("unusedForSeconds must be at least 1")), nil
+	}
+
+	unusedFor := time.Duration(request.Body.UnusedForSeconds) * time.Second
+
+	keys, err := t.config.V1.RateLimits().DeleteUnusedDynamicRateLimits(ctx.Request().Context(), tenantId, unusedFor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1RateLimitDeleteUnused200JSONResponse{
+		Keys: keys,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package ratelimitsv1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// maxUsageRange is the longest range of usage which can be requested at once, which bounds the number of buckets
+// in the time series
+const maxUsageRange = 31 * 24 * time.Hour
+
+func (t *V1RateLimitsService) V1RateLimitGetUsage(ctx echo.Context, request gen.V1RateLimitGetUsageRequestObject) (gen.V1RateLimitGetUsageResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	until := time.Now().UTC()
+	since := until.Add(-24 * time.Hour)
+
+	if request.Params.Since != nil {
+		since = request.Params.Since.UTC()
+	}
+
+	if request.Params.Until != nil {
+		until = request.Params.Until.UTC()
+	}
+
+	if !since.Before(until) {
+		return gen.V1RateLimitGetUsage400JSONResponse(
+			apierrors.NewAPIErrors("since must be before until"),
+		), nil
+	}
+
+	if until.Sub(since) > maxUsageRange {
+		return gen.V1RateLimitGetUsage400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("the range between since and until can be at most %d days", int(maxUsageRange.Hours()/24))),
+		), nil
+	}
+
+	bucketInterval := usageBucketInterval(until.Sub(since))
+	since = since.Truncate(bucketInterval)
+
+	rows, err := t.config.V1.OLAP().GetRateLimitUsage(ctx.Request().Context(), tenantId, request.Key, since, until, bucketInterval)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1RateLimitGetUsage200JSONResponse(toRateLimitUsage(request.Key, since, until, bucketInterval, rows)), nil
+}
+
+// usageBucketInterval picks a bucket interval which keeps the number of points in the time series reasonable
+func usageBucketInterval(rng time.Duration) time.Duration {
+	switch {
+	case rng < 61*time.Minute:
+		return time.Minute
+	case rng < 12*time.Hour:
+		return 5 * time.Minute
+	case rng < 48*time.Hour:
+		return 30 * time.Minute
+	case rng < 8*24*time.Hour:
+		return 8 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// toRateLimitUsage converts the usage rows to a time series with a point for every bucket between since and
+// until, filling in buckets without usage with zeros
+func toRateLimitUsage(key string, since, until time.Time, bucketInterval time.Duration, rows []*sqlcv1.GetRateLimitUsageOLAPRow) gen.V1RateLimitUsage {
+	res := gen.V1RateLimitUsage{
+		Key:     key,
+		Since:   since,
+		Until:   until,
+		Results: []gen.V1RateLimitUsagePoint{},
+	}
+
+	bucketToRow := make(map[time.Time]*sqlcv1.GetRateLimitUsageOLAPRow, len(rows))
+
+	for _, row := range rows {
+		if row == nil || !row.BucketStart.Valid {
+			continue
+		}
+
+		bucketToRow[row.BucketStart.Time.UTC()] = row
+	}
+
+	for t := since; !t.After(until); t = t.Add(bucketInterval) {
+		point := gen.V1RateLimitUsagePoint{
+			Time: t,
+		}
+
+		var rateLimitedAttempts int64
+
+		if row, ok := bucketToRow[t]; ok {
+			point.UnitsConsumed = int(row.UnitsConsumed)
+			point.TasksRateLimited = int(row.TasksRateLimited)
+			rateLimitedAttempts = row.RateLimitedAttempts
+		}
+
+		// tasks are only counted the first time they're rate limited, so the attempts tell whether the rate limit
+		// was exhausted in the bucket
+		if rateLimitedAttempts > 0 {
+			lastRateLimitedAt := t
+			res.LastRateLimitedAt = &lastRateLimitedAt
+		}
+
+		res.TotalUnitsConsumed += point.UnitsConsumed
+		res.TotalTasksRateLimited += point.TasksRateLimited
+		res.Results = append(res.Results, point)
+	}
+
+	return res
+}
@@ -0,0 +1,37 @@
+package ratelimitsv1
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *V1RateLimitsService) V1RateLimitReset(ctx echo.Context, request gen.V1RateLimitResetRequestObject) (gen.V1RateLimitResetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	rl, err := t.config.V1.RateLimits().ResetRateLimit(ctx.Request().Context(), tenantId, request.Key)
+
+	if errors.Is(err, v1.ErrRateLimitNotFound) {
+		return gen.V1RateLimitReset404JSONResponse(apierrors.NewAPIErrors("rate limit not found")), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1RateLimitReset200JSONResponse{
+		Key:        rl.Key,
+		TenantId:   sqlchelpers.UUIDToStr(rl.TenantId),
+		LastRefill: rl.LastRefill.Time,
+		LimitValue: int(rl.LimitValue),
+		Value:      int(rl.Value),
+		Window:     rl.Window,
+	}, nil
+}
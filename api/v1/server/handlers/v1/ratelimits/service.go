@@ -0,0 +1,16 @@
+package ratelimitsv1
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type V1RateLimitsService struct {
+	config *server.ServerConfig
+}
+
+func NewV1RateLimitsService(config *server.ServerConfig) *V1RateLimitsService {
+
+	return &V1RateLimitsService{
+		config: config,
+	}
+}
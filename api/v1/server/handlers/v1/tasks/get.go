@@ -1,6 +1,9 @@
 package tasks
 
 import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
@@ -55,6 +58,32 @@ func (t *TasksService) V1TaskGet(ctx echo.Context, request gen.V1TaskGetRequestO
 
 	result := transformers.ToTask(taskWithData, workflowRunExternalId, workflowVersion)
 
+	priorities, err := t.config.V1.Tasks().GetTaskPriorities(
+		ctx.Request().Context(),
+		task.TenantID.String(),
+		task.ID,
+		task.InsertedAt,
+	)
+
+	// the task may have been removed from the core tables, in which case we fall back to the priority
+	// stored in OLAP
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if err == nil {
+		priority := int(priorities.Priority)
+		effectivePriority := int(priorities.EffectivePriority)
+
+		result.Priority = &priority
+		result.EffectivePriority = &effectivePriority
+	} else if task.Priority.Valid {
+		priority := int(task.Priority.Int32)
+
+		result.Priority = &priority
+		result.EffectivePriority = &priority
+	}
+
 	return gen.V1TaskGet200JSONResponse(
 		result,
 	), nil
@@ -0,0 +1,52 @@
+package tasks
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+
+	transformers "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *TasksService) V1TaskGetSchedulingExplanation(ctx echo.Context, request gen.V1TaskGetSchedulingExplanationRequestObject) (gen.V1TaskGetSchedulingExplanationResponseObject, error) {
+	taskInterface := ctx.Get("task")
+
+	if taskInterface == nil {
+		return nil, echo.NewHTTPError(404, "Task not found")
+	}
+
+	task, ok := taskInterface.(*sqlcv1.V1TasksOlap)
+
+	if !ok {
+		return nil, echo.NewHTTPError(500, "Task type assertion failed")
+	}
+
+	state, err := t.config.V1.Tasks().GetTaskSchedulingState(
+		ctx.Request().Context(),
+		task.TenantID.String(),
+		task.ID,
+		task.InsertedAt,
+	)
+
+	// the task may have been removed from the core tables, in which case it is no longer being scheduled
+	if errors.Is(err, pgx.ErrNoRows) {
+		return gen.V1TaskGetSchedulingExplanation200JSONResponse(
+			gen.V1TaskSchedulingExplanation{
+				Reason:  gen.V1SchedulingReasonNOTQUEUED,
+				Message: "task is not queued",
+			},
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.V1TaskGetSchedulingExplanation200JSONResponse(
+		transformers.ToTaskSchedulingExplanation(state),
+	), nil
+}
@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *TasksService) V1TaskSignal(ctx echo.Context, request gen.V1TaskSignalRequestObject) (gen.V1TaskSignalResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if request.Body.Name == "" {
+		return gen.V1TaskSignal400JSONResponse(apierrors.NewAPIErrors("signal name is required")), nil
+	}
+
+	opts := v1.SendSignalOpts{
+		ExternalId: request.Body.ExternalId.String(),
+		Name:       request.Body.Name,
+	}
+
+	if request.Body.Payload != nil {
+		payload, err := json.Marshal(*request.Body.Payload)
+
+		if err != nil {
+			return gen.V1TaskSignal400JSONResponse(apierrors.NewAPIErrors("invalid payload")), nil
+		}
+
+		opts.Payload = payload
+	}
+
+	// the signal is stored, so we only need to notify the tasks controller if a task is already waiting on it
+	ids, err := t.config.V1.Signals().SendSignal(ctx.Request().Context(), tenantId, opts, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+		msg, err := tasktypes.NewInternalEventMessage(tenantId, time.Now(), events...)
+
+		if err != nil {
+			return fmt.Errorf("could not create internal event message: %w", err)
+		}
+
+		return t.config.MessageQueueV1.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return gen.V1TaskSignal404JSONResponse(apierrors.NewAPIErrors("task or workflow run not found")), nil
+	}
+
+	return gen.V1TaskSignal200JSONResponse(
+		transformers.ToCancelledOrReplayedTaskResponse(ids),
+	), nil
+}
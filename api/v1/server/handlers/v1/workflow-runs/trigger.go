@@ -58,6 +58,11 @@ func (t *V1WorkflowRunsService) V1WorkflowRunCreate(ctx echo.Context, request ge
 		Priority:           priority,
 	}
 
+	if request.Body.IdempotencyKey != nil {
+		grpcReq.IdempotencyKey = request.Body.IdempotencyKey
+		grpcReq.IdempotencyKeyRetention = request.Body.IdempotencyKeyRetention
+	}
+
 	resp, err := t.proxyTrigger.Do(
 		ctx.Request().Context(),
 		tenant,
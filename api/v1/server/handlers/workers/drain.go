@@ -0,0 +1,64 @@
+package workers
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	transformersv1 "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+func (t *WorkerService) WorkerDrain(ctx echo.Context, request gen.WorkerDrainRequestObject) (gen.WorkerDrainResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	worker := ctx.Get("worker").(*dbsqlc.GetWorkerByIdRow)
+
+	if tenant.Version != dbsqlc.TenantMajorEngineVersionV1 {
+		return gen.WorkerDrain400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("draining workers is not supported in engine version %s", string(tenant.Version))),
+		), nil
+	}
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	workerId := sqlchelpers.UUIDToStr(worker.Worker.ID)
+
+	drained, err := t.config.V1.Workers().DrainWorker(ctx.Request().Context(), tenantId, workerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inFlight, err := t.config.V1.Workers().ListInFlightTasks(ctx.Request().Context(), tenantId, workerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkerDrain200JSONResponse(transformersv1.ToWorkerDrainStatus(drained.ID, drained.DrainingAt, inFlight)), nil
+}
+
+func (t *WorkerService) WorkerDrainStatus(ctx echo.Context, request gen.WorkerDrainStatusRequestObject) (gen.WorkerDrainStatusResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	worker := ctx.Get("worker").(*dbsqlc.GetWorkerByIdRow)
+
+	if tenant.Version != dbsqlc.TenantMajorEngineVersionV1 {
+		return gen.WorkerDrainStatus400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("draining workers is not supported in engine version %s", string(tenant.Version))),
+		), nil
+	}
+
+	inFlight, err := t.config.V1.Workers().ListInFlightTasks(
+		ctx.Request().Context(),
+		sqlchelpers.UUIDToStr(tenant.ID),
+		sqlchelpers.UUIDToStr(worker.Worker.ID),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkerDrainStatus200JSONResponse(transformersv1.ToWorkerDrainStatus(worker.Worker.ID, worker.Worker.DrainingAt, inFlight)), nil
+}
@@ -194,6 +194,14 @@ const (
 	TenantVersionV1 TenantVersion = "V1"
 )
 
+// Defines values for V1ApprovalStatus.
+const (
+	V1ApprovalStatusAPPROVED V1ApprovalStatus = "APPROVED"
+	V1ApprovalStatusEXPIRED  V1ApprovalStatus = "EXPIRED"
+	V1ApprovalStatusPENDING  V1ApprovalStatus = "PENDING"
+	V1ApprovalStatusREJECTED V1ApprovalStatus = "REJECTED"
+)
+
 // Defines values for V1CELDebugResponseStatus.
 const (
 	V1CELDebugResponseStatusERROR   V1CELDebugResponseStatus = "ERROR"
@@ -208,6 +216,22 @@ const (
 	V1LogLineLevelWARN  V1LogLineLevel = "WARN"
 )
 
+// Defines values for V1SchedulingReason.
+const (
+	V1SchedulingReasonCONCURRENCYLIMITED      V1SchedulingReason = "CONCURRENCY_LIMITED"
+	V1SchedulingReasonGANGWAITING             V1SchedulingReason = "GANG_WAITING"
+	V1SchedulingReasonLABELMISMATCH           V1SchedulingReason = "LABEL_MISMATCH"
+	V1SchedulingReasonNOSLOTS                 V1SchedulingReason = "NO_SLOTS"
+	V1SchedulingReasonNOTQUEUED               V1SchedulingReason = "NOT_QUEUED"
+	V1SchedulingReasonNOWORKERS               V1SchedulingReason = "NO_WORKERS"
+	V1SchedulingReasonPENDING                 V1SchedulingReason = "PENDING"
+	V1SchedulingReasonRATELIMITED             V1SchedulingReason = "RATE_LIMITED"
+	V1SchedulingReasonRESOURCESUNAVAILABLE    V1SchedulingReason = "RESOURCES_UNAVAILABLE"
+	V1SchedulingReasonRETRYBACKOFF            V1SchedulingReason = "RETRY_BACKOFF"
+	V1SchedulingReasonRUNNING                 V1SchedulingReason = "RUNNING"
+	V1SchedulingReasonSTICKYWORKERUNAVAILABLE V1SchedulingReason = "STICKY_WORKER_UNAVAILABLE"
+)
+
 // Defines values for V1TaskEventType.
 const (
 	V1TaskEventTypeACKNOWLEDGED       V1TaskEventType = "ACKNOWLEDGED"
@@ -216,6 +240,7 @@ const (
 	V1TaskEventTypeCREATED            V1TaskEventType = "CREATED"
 	V1TaskEventTypeFAILED             V1TaskEventType = "FAILED"
 	V1TaskEventTypeFINISHED           V1TaskEventType = "FINISHED"
+	V1TaskEventTypePREEMPTED          V1TaskEventType = "PREEMPTED"
 	V1TaskEventTypeQUEUED             V1TaskEventType = "QUEUED"
 	V1TaskEventTypeRATELIMITERROR     V1TaskEventType = "RATE_LIMIT_ERROR"
 	V1TaskEventTypeREASSIGNED         V1TaskEventType = "REASSIGNED"
@@ -250,6 +275,7 @@ const (
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
+	DRAINING WorkerStatus = "DRAINING"
 	INACTIVE WorkerStatus = "INACTIVE"
 	PAUSED   WorkerStatus = "PAUSED"
 )
@@ -291,13 +317,13 @@ const (
 
 // Defines values for WorkflowRunStatus.
 const (
-	BACKOFF   WorkflowRunStatus = "BACKOFF"
-	CANCELLED WorkflowRunStatus = "CANCELLED"
-	FAILED    WorkflowRunStatus = "FAILED"
-	PENDING   WorkflowRunStatus = "PENDING"
-	QUEUED    WorkflowRunStatus = "QUEUED"
-	RUNNING   WorkflowRunStatus = "RUNNING"
-	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+	WorkflowRunStatusBACKOFF   WorkflowRunStatus = "BACKOFF"
+	WorkflowRunStatusCANCELLED WorkflowRunStatus = "CANCELLED"
+	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
+	WorkflowRunStatusPENDING   WorkflowRunStatus = "PENDING"
+	WorkflowRunStatusQUEUED    WorkflowRunStatus = "QUEUED"
+	WorkflowRunStatusRUNNING   WorkflowRunStatus = "RUNNING"
+	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
 // APIError defines model for APIError.
@@ -969,6 +995,9 @@ type Tenant struct {
 	// Name The name of the tenant.
 	Name string `json:"name"`
 
+	// PriorityAgingSeconds The number of seconds a queued task waits before its priority is raised by 1. Priority aging is disabled when this is not set.
+	PriorityAgingSeconds *int `json:"priorityAgingSeconds,omitempty"`
+
 	// Slug The slug of the tenant.
 	Slug      string           `json:"slug"`
 	UiVersion *TenantUIVersion `json:"uiVersion,omitempty"`
@@ -1135,9 +1164,12 @@ type UpdateTenantRequest struct {
 	MaxAlertingFrequency *string `json:"maxAlertingFrequency,omitempty" validate:"omitnil,duration"`
 
 	// Name The name of the tenant.
-	Name      *string          `json:"name,omitempty"`
-	UiVersion *TenantUIVersion `json:"uiVersion,omitempty"`
-	Version   *TenantVersion   `json:"version,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// PriorityAgingSeconds The number of seconds a queued task waits before its priority is raised by 1. Set to 0 to disable priority aging.
+	PriorityAgingSeconds *int             `json:"priorityAgingSeconds,omitempty" validate:"omitnil,min=0"`
+	UiVersion            *TenantUIVersion `json:"uiVersion,omitempty"`
+	Version              *TenantVersion   `json:"version,omitempty"`
 }
 
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
@@ -1210,6 +1242,55 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// V1Approval defines model for V1Approval.
+type V1Approval struct {
+	// Comment The comment left with the decision.
+	Comment *string `json:"comment,omitempty"`
+
+	// DecidedAt The time at which the approval was approved or rejected.
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+
+	// DecidedBy The id of the user who approved or rejected the task.
+	DecidedBy *openapi_types.UUID `json:"decidedBy,omitempty"`
+
+	// DecidedByEmail The email of the user who approved or rejected the task.
+	DecidedByEmail *string `json:"decidedByEmail,omitempty"`
+
+	// ExpiresAt The time at which the approval expires, if it has an expiry.
+	ExpiresAt *time.Time      `json:"expiresAt,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Payload The payload passed to the task with the decision.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// ReadableDataKey The key under which the decision is made available to the task.
+	ReadableDataKey string           `json:"readableDataKey"`
+	Status          V1ApprovalStatus `json:"status"`
+
+	// TaskExternalId The external id of the task which is waiting on the approval.
+	TaskExternalId openapi_types.UUID `json:"taskExternalId"`
+
+	// WorkflowRunId The external id of the workflow run of the task.
+	WorkflowRunId openapi_types.UUID `json:"workflowRunId"`
+}
+
+// V1ApprovalDecisionRequest defines model for V1ApprovalDecisionRequest.
+type V1ApprovalDecisionRequest struct {
+	// Comment A comment explaining the decision.
+	Comment *string `json:"comment,omitempty"`
+
+	// Payload A payload which is passed to the task along with the decision.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// V1ApprovalList defines model for V1ApprovalList.
+type V1ApprovalList struct {
+	Rows *[]V1Approval `json:"rows,omitempty"`
+}
+
+// V1ApprovalStatus defines model for V1ApprovalStatus.
+type V1ApprovalStatus string
+
 // V1CELDebugRequest defines model for V1CELDebugRequest.
 type V1CELDebugRequest struct {
 	// AdditionalMetadata Additional metadata, which simulates metadata that could be sent with an event or a workflow run
@@ -1253,6 +1334,67 @@ type V1CancelledTasks struct {
 	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
 }
 
+// V1ConcurrencyKeyLimit defines model for V1ConcurrencyKeyLimit.
+type V1ConcurrencyKeyLimit struct {
+	// Key The concurrency key which the limit applies to.
+	Key string `json:"key"`
+
+	// MaxRuns The maximum number of concurrent runs for the key, which overrides the max runs of the workflow version.
+	MaxRuns int `json:"maxRuns"`
+
+	// UpdatedAt The time the limit was last changed.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+}
+
+// V1ConcurrencyKeyLimitList defines model for V1ConcurrencyKeyLimitList.
+type V1ConcurrencyKeyLimitList struct {
+	Rows []V1ConcurrencyKeyLimit `json:"rows"`
+}
+
+// V1ConcurrencySlotKey defines model for V1ConcurrencySlotKey.
+type V1ConcurrencySlotKey struct {
+	// Expression The expression which the key was evaluated from.
+	Expression string `json:"expression"`
+
+	// Key The concurrency key.
+	Key string `json:"key"`
+
+	// MaxRuns The maximum number of concurrent runs for the key, including per-key concurrency limits.
+	MaxRuns int `json:"maxRuns"`
+
+	// OldestQueuedAt The time the oldest run waiting for a slot for the key was created.
+	OldestQueuedAt *time.Time `json:"oldestQueuedAt,omitempty"`
+
+	// OldestQueuedRunId The id of the oldest workflow run waiting for a slot for the key. For standalone tasks, this is the id of the task.
+	OldestQueuedRunId *openapi_types.UUID `json:"oldestQueuedRunId,omitempty"`
+
+	// QueuedCount The number of runs which are waiting for a slot for the key.
+	QueuedCount int `json:"queuedCount"`
+
+	// RunningCount The number of runs which hold a slot for the key.
+	RunningCount int `json:"runningCount"`
+
+	// StepReadableId The readable id of the step, if the strategy is set on a step rather than on the workflow.
+	StepReadableId *string `json:"stepReadableId,omitempty"`
+
+	// Strategy The concurrency strategy, for example GROUP_ROUND_ROBIN or CANCEL_IN_PROGRESS.
+	Strategy string `json:"strategy"`
+
+	// WorkflowId The id of the workflow.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+}
+
+// V1ConcurrencySlotKeyList defines model for V1ConcurrencySlotKeyList.
+type V1ConcurrencySlotKeyList struct {
+	Rows []V1ConcurrencySlotKey `json:"rows"`
+}
+
 // V1CreateFilterRequest defines model for V1CreateFilterRequest.
 type V1CreateFilterRequest struct {
 	// Expression The expression for the filter
@@ -1274,6 +1416,33 @@ type V1DagChildren struct {
 	DagId    *openapi_types.UUID `json:"dagId,omitempty"`
 }
 
+// V1DeleteConcurrencyKeyLimitRequest defines model for V1DeleteConcurrencyKeyLimitRequest.
+type V1DeleteConcurrencyKeyLimitRequest struct {
+	// Key The concurrency key.
+	Key string `json:"key"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+}
+
+// V1DeleteRateLimitsRequest defines model for V1DeleteRateLimitsRequest.
+type V1DeleteRateLimitsRequest struct {
+	// Prefix The prefix of the rate limit keys to delete.
+	Prefix string `json:"prefix"`
+}
+
+// V1DeleteUnusedRateLimitsRequest defines model for V1DeleteUnusedRateLimitsRequest.
+type V1DeleteUnusedRateLimitsRequest struct {
+	// UnusedForSeconds Dynamic rate limits which haven't been used for this many seconds are deleted.
+	UnusedForSeconds int `json:"unusedForSeconds"`
+}
+
+// V1DeletedRateLimits defines model for V1DeletedRateLimits.
+type V1DeletedRateLimits struct {
+	// Keys The keys of the deleted rate limits.
+	Keys []string `json:"keys"`
+}
+
 // V1Event defines model for V1Event.
 type V1Event struct {
 	// AdditionalMetadata Additional metadata for the event.
@@ -1387,6 +1556,36 @@ type V1LogLineList struct {
 	Rows       *[]V1LogLine        `json:"rows,omitempty"`
 }
 
+// V1RateLimitUsage defines model for V1RateLimitUsage.
+type V1RateLimitUsage struct {
+	// Key The rate limit key.
+	Key string `json:"key"`
+
+	// LastRateLimitedAt The start of the latest bucket in which the rate limit was exhausted, if it was exhausted between since and until.
+	LastRateLimitedAt *time.Time              `json:"lastRateLimitedAt,omitempty"`
+	Results           []V1RateLimitUsagePoint `json:"results"`
+	Since             time.Time               `json:"since"`
+
+	// TotalTasksRateLimited The number of tasks which were rate limited by the key between since and until, counted once per day.
+	TotalTasksRateLimited int `json:"totalTasksRateLimited"`
+
+	// TotalUnitsConsumed The units consumed from the rate limit between since and until.
+	TotalUnitsConsumed int       `json:"totalUnitsConsumed"`
+	Until              time.Time `json:"until"`
+}
+
+// V1RateLimitUsagePoint defines model for V1RateLimitUsagePoint.
+type V1RateLimitUsagePoint struct {
+	// TasksRateLimited The number of tasks which were first rate limited by the key in the bucket. A task which is rate limited on several days is counted once per day.
+	TasksRateLimited int `json:"tasksRateLimited"`
+
+	// Time The start of the bucket.
+	Time time.Time `json:"time"`
+
+	// UnitsConsumed The units consumed from the rate limit by tasks which were assigned in the bucket.
+	UnitsConsumed int `json:"unitsConsumed"`
+}
+
 // V1ReplayTaskRequest defines model for V1ReplayTaskRequest.
 type V1ReplayTaskRequest struct {
 	// ExternalIds A list of external IDs, which can refer to either task or workflow run external IDs
@@ -1400,10 +1599,64 @@ type V1ReplayedTasks struct {
 	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
 }
 
+// V1SchedulingReason defines model for V1SchedulingReason.
+type V1SchedulingReason string
+
+// V1SetConcurrencyKeyLimitRequest defines model for V1SetConcurrencyKeyLimitRequest.
+type V1SetConcurrencyKeyLimitRequest struct {
+	// Key The concurrency key, as evaluated from the concurrency expression.
+	Key string `json:"key"`
+
+	// MaxRuns The maximum number of concurrent runs for the key.
+	MaxRuns int `json:"maxRuns"`
+
+	// WorkflowName The name of the workflow.
+	WorkflowName string `json:"workflowName"`
+}
+
+// V1TaskError The structured error of a failed task run.
+type V1TaskError struct {
+	// Details Arbitrary details attached to the error.
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// Message The error message.
+	Message string `json:"message"`
+
+	// Retryable Whether the error was reported as retryable.
+	Retryable bool `json:"retryable"`
+
+	// StackTrace The stack trace of the error, if available.
+	StackTrace *string `json:"stackTrace,omitempty"`
+
+	// Type The type of the error.
+	Type string `json:"type"`
+}
+
+// V1SignalTaskRequest defines model for V1SignalTaskRequest.
+type V1SignalTaskRequest struct {
+	// ExternalId The external id of the task or workflow run to signal
+	ExternalId openapi_types.UUID `json:"externalId"`
+
+	// Name The name of the signal
+	Name string `json:"name"`
+
+	// Payload The payload which is passed to the task along with the signal
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// V1SignalledTasks defines model for V1SignalledTasks.
+type V1SignalledTasks struct {
+	// Ids The list of task external ids that were signalled
+	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
+}
+
 // V1TaskEvent defines model for V1TaskEvent.
 type V1TaskEvent struct {
 	// Attempt The attempt number of the task.
-	Attempt      *int            `json:"attempt,omitempty"`
+	Attempt *int `json:"attempt,omitempty"`
+
+	// ErrorDetails The structured error of a failed task run.
+	ErrorDetails *V1TaskError    `json:"errorDetails,omitempty"`
 	ErrorMessage *string         `json:"errorMessage,omitempty"`
 	EventType    V1TaskEventType `json:"eventType"`
 	Id           int             `json:"id"`
@@ -1457,6 +1710,22 @@ type V1TaskRunMetric struct {
 // V1TaskRunMetrics defines model for V1TaskRunMetrics.
 type V1TaskRunMetrics = []V1TaskRunMetric
 
+// V1TaskSchedulingExplanation defines model for V1TaskSchedulingExplanation.
+type V1TaskSchedulingExplanation struct {
+	// ConcurrencyKey The concurrency key which is blocking the task, if the task is waiting on a concurrency slot.
+	ConcurrencyKey *string `json:"concurrencyKey,omitempty"`
+
+	// EvaluatedAt The time at which the scheduler recorded the reason, if the reason was recorded by the scheduler.
+	EvaluatedAt *time.Time `json:"evaluatedAt,omitempty"`
+
+	// Message A human-readable explanation of the reason.
+	Message string             `json:"message"`
+	Reason  V1SchedulingReason `json:"reason"`
+
+	// RetryAfter The time after which the task will be retried, if the task is waiting to be retried.
+	RetryAfter *time.Time `json:"retryAfter,omitempty"`
+}
+
 // V1TaskStatus defines model for V1TaskStatus.
 type V1TaskStatus string
 
@@ -1483,6 +1752,12 @@ type V1TaskSummary struct {
 	// Duration The duration of the task run, in milliseconds.
 	Duration *int `json:"duration,omitempty"`
 
+	// EffectivePriority The effective priority of the task, which is higher than its priority when the task has been aged while queued.
+	EffectivePriority *int `json:"effectivePriority,omitempty"`
+
+	// ErrorDetails The structured error of a failed task run.
+	ErrorDetails *V1TaskError `json:"errorDetails,omitempty"`
+
 	// ErrorMessage The error message of the task run (for the latest run)
 	ErrorMessage *string `json:"errorMessage,omitempty"`
 
@@ -1502,6 +1777,9 @@ type V1TaskSummary struct {
 	// ParentTaskExternalId The external ID of the parent task.
 	ParentTaskExternalId *openapi_types.UUID `json:"parentTaskExternalId,omitempty"`
 
+	// Priority The priority of the task.
+	Priority *int `json:"priority,omitempty"`
+
 	// RetryCount The number of retries of the task.
 	RetryCount *int `json:"retryCount,omitempty"`
 
@@ -1598,7 +1876,13 @@ type V1TaskTimingList struct {
 // V1TriggerWorkflowRunRequest defines model for V1TriggerWorkflowRunRequest.
 type V1TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Input              map[string]interface{}  `json:"input"`
+
+	// IdempotencyKey Triggers with the same idempotency key for a workflow return the original run instead of creating a new one.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+
+	// IdempotencyKeyRetention How long the idempotency key is retained, as a duration string (e.g. "24h"). Defaults to 24h.
+	IdempotencyKeyRetention *string                `json:"idempotencyKeyRetention,omitempty"`
+	Input                   map[string]interface{} `json:"input"`
 
 	// Priority The priority of the workflow run.
 	Priority *int `json:"priority,omitempty"`
@@ -1633,6 +1917,9 @@ type V1WorkflowRun struct {
 	// Duration The duration of the task run, in milliseconds.
 	Duration *int `json:"duration,omitempty"`
 
+	// ErrorDetails The structured error of a failed task run.
+	ErrorDetails *V1TaskError `json:"errorDetails,omitempty"`
+
 	// ErrorMessage The error message of the task run (for the latest run)
 	ErrorMessage *string `json:"errorMessage,omitempty"`
 
@@ -1797,6 +2084,21 @@ type Worker struct {
 // WorkerStatus The status of the worker.
 type WorkerStatus string
 
+// WorkerDrainStatus defines model for WorkerDrainStatus.
+type WorkerDrainStatus struct {
+	// DrainingAt The time the worker started draining.
+	DrainingAt *time.Time `json:"drainingAt,omitempty"`
+
+	// InFlightTaskIds The ids of the tasks which are still running on the worker.
+	InFlightTaskIds []openapi_types.UUID `json:"inFlightTaskIds"`
+
+	// IsDraining Whether the worker is draining and cannot accept new runs.
+	IsDraining bool `json:"isDraining"`
+
+	// WorkerId The id of the worker.
+	WorkerId openapi_types.UUID `json:"workerId"`
+}
+
 // WorkerLabel defines model for WorkerLabel.
 type WorkerLabel struct {
 	// Key The key of the label.
@@ -2085,6 +2387,30 @@ type V1TaskEventListParams struct {
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// V1ApprovalListParams defines parameters for V1ApprovalList.
+type V1ApprovalListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// WorkflowRunId The workflow run id to filter by
+	WorkflowRunId *openapi_types.UUID `form:"workflowRunId,omitempty" json:"workflowRunId,omitempty"`
+}
+
+// V1ConcurrencySlotListParams defines parameters for V1ConcurrencySlotList.
+type V1ConcurrencySlotListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// WorkflowIds The workflow ids to filter by
+	WorkflowIds *[]openapi_types.UUID `form:"workflowIds,omitempty" json:"workflowIds,omitempty"`
+}
+
 // V1EventListParams defines parameters for V1EventList.
 type V1EventListParams struct {
 	// Offset The number to skip
@@ -2133,6 +2459,15 @@ type V1FilterListParams struct {
 	Scopes *[]string `form:"scopes,omitempty" json:"scopes,omitempty"`
 }
 
+// V1RateLimitGetUsageParams defines parameters for V1RateLimitGetUsage.
+type V1RateLimitGetUsageParams struct {
+	// Since The start of the time range, defaults to 24 hours ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until The end of the time range, defaults to now. The range can be at most 31 days.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // V1TaskListStatusMetricsParams defines parameters for V1TaskListStatusMetrics.
 type V1TaskListStatusMetricsParams struct {
 	// Since The start time to get metrics for
@@ -2490,21 +2825,42 @@ type WorkflowVersionGetParams struct {
 // AlertEmailGroupUpdateJSONRequestBody defines body for AlertEmailGroupUpdate for application/json ContentType.
 type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 
+// V1ApprovalApproveJSONRequestBody defines body for V1ApprovalApprove for application/json ContentType.
+type V1ApprovalApproveJSONRequestBody = V1ApprovalDecisionRequest
+
+// V1ApprovalRejectJSONRequestBody defines body for V1ApprovalReject for application/json ContentType.
+type V1ApprovalRejectJSONRequestBody = V1ApprovalDecisionRequest
+
 // V1CelDebugJSONRequestBody defines body for V1CelDebug for application/json ContentType.
 type V1CelDebugJSONRequestBody = V1CELDebugRequest
 
+// V1ConcurrencyLimitSetJSONRequestBody defines body for V1ConcurrencyLimitSet for application/json ContentType.
+type V1ConcurrencyLimitSetJSONRequestBody = V1SetConcurrencyKeyLimitRequest
+
+// V1ConcurrencyLimitDeleteJSONRequestBody defines body for V1ConcurrencyLimitDelete for application/json ContentType.
+type V1ConcurrencyLimitDeleteJSONRequestBody = V1DeleteConcurrencyKeyLimitRequest
+
 // V1FilterCreateJSONRequestBody defines body for V1FilterCreate for application/json ContentType.
 type V1FilterCreateJSONRequestBody = V1CreateFilterRequest
 
 // V1FilterUpdateJSONRequestBody defines body for V1FilterUpdate for application/json ContentType.
 type V1FilterUpdateJSONRequestBody = V1UpdateFilterRequest
 
+// V1RateLimitDeleteByPrefixJSONRequestBody defines body for V1RateLimitDeleteByPrefix for application/json ContentType.
+type V1RateLimitDeleteByPrefixJSONRequestBody = V1DeleteRateLimitsRequest
+
+// V1RateLimitDeleteUnusedJSONRequestBody defines body for V1RateLimitDeleteUnused for application/json ContentType.
+type V1RateLimitDeleteUnusedJSONRequestBody = V1DeleteUnusedRateLimitsRequest
+
 // V1TaskCancelJSONRequestBody defines body for V1TaskCancel for application/json ContentType.
 type V1TaskCancelJSONRequestBody = V1CancelTaskRequest
 
 // V1TaskReplayJSONRequestBody defines body for V1TaskReplay for application/json ContentType.
 type V1TaskReplayJSONRequestBody = V1ReplayTaskRequest
 
+// V1TaskSignalJSONRequestBody defines body for V1TaskSignal for application/json ContentType.
+type V1TaskSignalJSONRequestBody = V1SignalTaskRequest
+
 // V1WorkflowRunCreateJSONRequestBody defines body for V1WorkflowRunCreate for application/json ContentType.
 type V1WorkflowRunCreateJSONRequestBody = V1TriggerWorkflowRunRequest
 
@@ -2636,12 +2992,36 @@ type ServerInterface interface {
 	// List log lines
 	// (GET /api/v1/stable/tasks/{task}/logs)
 	V1LogLineList(ctx echo.Context, task openapi_types.UUID) error
+	// Get a task's scheduling explanation
+	// (GET /api/v1/stable/tasks/{task}/scheduling-explanation)
+	V1TaskGetSchedulingExplanation(ctx echo.Context, task openapi_types.UUID) error
 	// List events for a task
 	// (GET /api/v1/stable/tasks/{task}/task-events)
 	V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error
+	// List pending approvals
+	// (GET /api/v1/stable/tenants/{tenant}/approvals)
+	V1ApprovalList(ctx echo.Context, tenant openapi_types.UUID, params V1ApprovalListParams) error
+	// Approve a task
+	// (POST /api/v1/stable/tenants/{tenant}/approvals/{v1-approval}/approve)
+	V1ApprovalApprove(ctx echo.Context, tenant openapi_types.UUID, v1Approval openapi_types.UUID) error
+	// Reject a task
+	// (POST /api/v1/stable/tenants/{tenant}/approvals/{v1-approval}/reject)
+	V1ApprovalReject(ctx echo.Context, tenant openapi_types.UUID, v1Approval openapi_types.UUID) error
 	// Debug a CEL expression
 	// (POST /api/v1/stable/tenants/{tenant}/cel/debug)
 	V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error
+	// List concurrency limits
+	// (GET /api/v1/stable/tenants/{tenant}/concurrency-limits)
+	V1ConcurrencyLimitList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Set concurrency limit
+	// (POST /api/v1/stable/tenants/{tenant}/concurrency-limits)
+	V1ConcurrencyLimitSet(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete concurrency limit
+	// (POST /api/v1/stable/tenants/{tenant}/concurrency-limits/delete)
+	V1ConcurrencyLimitDelete(ctx echo.Context, tenant openapi_types.UUID) error
+	// List concurrency slots
+	// (GET /api/v1/stable/tenants/{tenant}/concurrency-slots)
+	V1ConcurrencySlotList(ctx echo.Context, tenant openapi_types.UUID, params V1ConcurrencySlotListParams) error
 	// List events
 	// (GET /api/v1/stable/tenants/{tenant}/events)
 	V1EventList(ctx echo.Context, tenant openapi_types.UUID, params V1EventListParams) error
@@ -2663,6 +3043,21 @@ type ServerInterface interface {
 
 	// (PATCH /api/v1/stable/tenants/{tenant}/filters/{v1-filter})
 	V1FilterUpdate(ctx echo.Context, tenant openapi_types.UUID, v1Filter openapi_types.UUID) error
+	// Delete rate limits by prefix
+	// (POST /api/v1/stable/tenants/{tenant}/rate-limits/delete)
+	V1RateLimitDeleteByPrefix(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete unused dynamic rate limits
+	// (POST /api/v1/stable/tenants/{tenant}/rate-limits/delete-unused)
+	V1RateLimitDeleteUnused(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete rate limit
+	// (DELETE /api/v1/stable/tenants/{tenant}/rate-limits/{key})
+	V1RateLimitDelete(ctx echo.Context, tenant openapi_types.UUID, key string) error
+	// Reset rate limit
+	// (POST /api/v1/stable/tenants/{tenant}/rate-limits/{key}/reset)
+	V1RateLimitReset(ctx echo.Context, tenant openapi_types.UUID, key string) error
+	// Get rate limit usage
+	// (GET /api/v1/stable/tenants/{tenant}/rate-limits/{key}/usage)
+	V1RateLimitGetUsage(ctx echo.Context, tenant openapi_types.UUID, key string, params V1RateLimitGetUsageParams) error
 	// Get task metrics
 	// (GET /api/v1/stable/tenants/{tenant}/task-metrics)
 	V1TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V1TaskListStatusMetricsParams) error
@@ -2675,6 +3070,9 @@ type ServerInterface interface {
 	// Replay tasks
 	// (POST /api/v1/stable/tenants/{tenant}/tasks/replay)
 	V1TaskReplay(ctx echo.Context, tenant openapi_types.UUID) error
+	// Signal a task
+	// (POST /api/v1/stable/tenants/{tenant}/tasks/signal)
+	V1TaskSignal(ctx echo.Context, tenant openapi_types.UUID) error
 	// List workflow runs
 	// (GET /api/v1/stable/tenants/{tenant}/workflow-runs)
 	V1WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunListParams) error
@@ -2924,6 +3322,12 @@ type ServerInterface interface {
 	// Update worker
 	// (PATCH /api/v1/workers/{worker})
 	WorkerUpdate(ctx echo.Context, worker openapi_types.UUID) error
+	// Get worker drain status
+	// (GET /api/v1/workers/{worker}/drain)
+	WorkerDrainStatus(ctx echo.Context, worker openapi_types.UUID) error
+	// Drain worker
+	// (POST /api/v1/workers/{worker}/drain)
+	WorkerDrain(ctx echo.Context, worker openapi_types.UUID) error
 	// Delete workflow
 	// (DELETE /api/v1/workflows/{workflow})
 	WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error
@@ -3260,6 +3664,26 @@ func (w *ServerInterfaceWrapper) V1LogLineList(ctx echo.Context) error {
 	return err
 }
 
+// V1TaskGetSchedulingExplanation converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskGetSchedulingExplanation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "task" -------------
+	var task openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "task", runtime.ParamLocationPath, ctx.Param("task"), &task)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TaskGetSchedulingExplanation(ctx, task)
+	return err
+}
+
 // V1TaskEventList converts echo context to params.
 func (w *ServerInterfaceWrapper) V1TaskEventList(ctx echo.Context) error {
 	var err error
@@ -3296,28 +3720,8 @@ func (w *ServerInterfaceWrapper) V1TaskEventList(ctx echo.Context) error {
 	return err
 }
 
-// V1CelDebug converts echo context to params.
-func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1CelDebug(ctx, tenant)
-	return err
-}
-
-// V1EventList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1EventList(ctx echo.Context) error {
+// V1ApprovalList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ApprovalList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3332,7 +3736,7 @@ func (w *ServerInterfaceWrapper) V1EventList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1EventListParams
+	var params V1ApprovalListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -3347,69 +3751,72 @@ func (w *ServerInterfaceWrapper) V1EventList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "keys" -------------
+	// ------------- Optional query parameter "workflowRunId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	err = runtime.BindQueryParameter("form", true, false, "workflowRunId", ctx.QueryParams(), &params.WorkflowRunId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowRunId: %s", err))
 	}
 
-	// ------------- Optional query parameter "since" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1ApprovalList(ctx, tenant, params)
+	return err
+}
 
-	// ------------- Optional query parameter "until" -------------
+// V1ApprovalApprove converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ApprovalApprove(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowIds" -------------
+	// ------------- Path parameter "v1-approval" -------------
+	var v1Approval openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-approval", runtime.ParamLocationPath, ctx.Param("v1-approval"), &v1Approval)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-approval: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowRunStatuses" -------------
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowRunStatuses", ctx.QueryParams(), &params.WorkflowRunStatuses)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowRunStatuses: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1ApprovalApprove(ctx, tenant, v1Approval)
+	return err
+}
 
-	// ------------- Optional query parameter "eventIds" -------------
+// V1ApprovalReject converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ApprovalReject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	// ------------- Path parameter "v1-approval" -------------
+	var v1Approval openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-approval", runtime.ParamLocationPath, ctx.Param("v1-approval"), &v1Approval)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-approval: %s", err))
 	}
 
-	// ------------- Optional query parameter "scopes" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1EventList(ctx, tenant, params)
+	err = w.Handler.V1ApprovalReject(ctx, tenant, v1Approval)
 	return err
 }
 
-// V1EventKeyList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
+// V1CelDebug converts echo context to params.
+func (w *ServerInterfaceWrapper) V1CelDebug(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3424,12 +3831,12 @@ func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1EventKeyList(ctx, tenant)
+	err = w.Handler.V1CelDebug(ctx, tenant)
 	return err
 }
 
-// V1FilterList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
+// V1ConcurrencyLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ConcurrencyLimitList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3443,43 +3850,13 @@ func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params V1FilterListParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "workflowIds" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
-	}
-
-	// ------------- Optional query parameter "scopes" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterList(ctx, tenant, params)
+	err = w.Handler.V1ConcurrencyLimitList(ctx, tenant)
 	return err
 }
 
-// V1FilterCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterCreate(ctx echo.Context) error {
+// V1ConcurrencyLimitSet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ConcurrencyLimitSet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3494,12 +3871,12 @@ func (w *ServerInterfaceWrapper) V1FilterCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterCreate(ctx, tenant)
+	err = w.Handler.V1ConcurrencyLimitSet(ctx, tenant)
 	return err
 }
 
-// V1FilterDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterDelete(ctx echo.Context) error {
+// V1ConcurrencyLimitDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ConcurrencyLimitDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3509,25 +3886,17 @@ func (w *ServerInterfaceWrapper) V1FilterDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "v1-filter" -------------
-	var v1Filter openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterDelete(ctx, tenant, v1Filter)
+	err = w.Handler.V1ConcurrencyLimitDelete(ctx, tenant)
 	return err
 }
 
-// V1FilterGet converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterGet(ctx echo.Context) error {
+// V1ConcurrencySlotList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1ConcurrencySlotList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3537,53 +3906,40 @@ func (w *ServerInterfaceWrapper) V1FilterGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "v1-filter" -------------
-	var v1Filter openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterGet(ctx, tenant, v1Filter)
-	return err
-}
-
-// V1FilterUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) V1FilterUpdate(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1ConcurrencySlotListParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Path parameter "v1-filter" -------------
-	var v1Filter openapi_types.UUID
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "workflowIds" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1FilterUpdate(ctx, tenant, v1Filter)
+	err = w.Handler.V1ConcurrencySlotList(ctx, tenant, params)
 	return err
 }
 
-// V1TaskListStatusMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) V1TaskListStatusMetrics(ctx echo.Context) error {
+// V1EventList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3598,105 +3954,84 @@ func (w *ServerInterfaceWrapper) V1TaskListStatusMetrics(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1TaskListStatusMetricsParams
-	// ------------- Required query parameter "since" -------------
+	var params V1EventListParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "until" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflow_ids" -------------
+	// ------------- Optional query parameter "keys" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
 	}
 
-	// ------------- Optional query parameter "parent_task_external_id" -------------
+	// ------------- Optional query parameter "since" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "parent_task_external_id", ctx.QueryParams(), &params.ParentTaskExternalId)
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parent_task_external_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
 	}
 
-	// ------------- Optional query parameter "triggering_event_external_id" -------------
+	// ------------- Optional query parameter "until" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "triggering_event_external_id", ctx.QueryParams(), &params.TriggeringEventExternalId)
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter triggering_event_external_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1TaskListStatusMetrics(ctx, tenant, params)
-	return err
-}
-
-// V1TaskGetPointMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) V1TaskGetPointMetrics(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Optional query parameter "workflowIds" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params V1TaskGetPointMetricsParams
-	// ------------- Optional query parameter "createdAfter" -------------
+	// ------------- Optional query parameter "workflowRunStatuses" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	err = runtime.BindQueryParameter("form", true, false, "workflowRunStatuses", ctx.QueryParams(), &params.WorkflowRunStatuses)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowRunStatuses: %s", err))
 	}
 
-	// ------------- Optional query parameter "finishedBefore" -------------
+	// ------------- Optional query parameter "eventIds" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
+	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1TaskGetPointMetrics(ctx, tenant, params)
-	return err
-}
-
-// V1TaskCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) V1TaskCancel(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Optional query parameter "additionalMetadata" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "scopes" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1TaskCancel(ctx, tenant)
+	err = w.Handler.V1EventList(ctx, tenant, params)
 	return err
 }
 
-// V1TaskReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) V1TaskReplay(ctx echo.Context) error {
+// V1EventKeyList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1EventKeyList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3711,12 +4046,12 @@ func (w *ServerInterfaceWrapper) V1TaskReplay(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1TaskReplay(ctx, tenant)
+	err = w.Handler.V1EventKeyList(ctx, tenant)
 	return err
 }
 
-// V1WorkflowRunList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
+// V1FilterList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3731,7 +4066,7 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1WorkflowRunListParams
+	var params V1FilterListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -3746,83 +4081,103 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "statuses" -------------
+	// ------------- Optional query parameter "workflowIds" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
 	}
 
-	// ------------- Required query parameter "since" -------------
+	// ------------- Optional query parameter "scopes" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
+	err = runtime.BindQueryParameter("form", true, false, "scopes", ctx.QueryParams(), &params.Scopes)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scopes: %s", err))
 	}
 
-	// ------------- Optional query parameter "until" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterList(ctx, tenant, params)
+	return err
+}
 
-	// ------------- Optional query parameter "additional_metadata" -------------
+// V1FilterCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "additional_metadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additional_metadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflow_ids" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "worker_id" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterCreate(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "worker_id", ctx.QueryParams(), &params.WorkerId)
+// V1FilterDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Required query parameter "only_tasks" -------------
+	// ------------- Path parameter "v1-filter" -------------
+	var v1Filter openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, true, "only_tasks", ctx.QueryParams(), &params.OnlyTasks)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter only_tasks: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
 	}
 
-	// ------------- Optional query parameter "parent_task_external_id" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "parent_task_external_id", ctx.QueryParams(), &params.ParentTaskExternalId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parent_task_external_id: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "triggering_event_external_id" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterDelete(ctx, tenant, v1Filter)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "triggering_event_external_id", ctx.QueryParams(), &params.TriggeringEventExternalId)
+// V1FilterGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter triggering_event_external_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "include_payloads" -------------
+	// ------------- Path parameter "v1-filter" -------------
+	var v1Filter openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "include_payloads", ctx.QueryParams(), &params.IncludePayloads)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_payloads: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunList(ctx, tenant, params)
+	err = w.Handler.V1FilterGet(ctx, tenant, v1Filter)
 	return err
 }
 
-// V1WorkflowRunDisplayNamesList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunDisplayNamesList(ctx echo.Context) error {
+// V1FilterUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1FilterUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3832,26 +4187,45 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunDisplayNamesList(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "v1-filter" -------------
+	var v1Filter openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-filter", runtime.ParamLocationPath, ctx.Param("v1-filter"), &v1Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-filter: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params V1WorkflowRunDisplayNamesListParams
-	// ------------- Required query parameter "external_ids" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1FilterUpdate(ctx, tenant, v1Filter)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "external_ids", ctx.QueryParams(), &params.ExternalIds)
+// V1RateLimitDeleteByPrefix converts echo context to params.
+func (w *ServerInterfaceWrapper) V1RateLimitDeleteByPrefix(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter external_ids: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunDisplayNamesList(ctx, tenant, params)
+	err = w.Handler.V1RateLimitDeleteByPrefix(ctx, tenant)
 	return err
 }
 
-// V1WorkflowRunCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunCreate(ctx echo.Context) error {
+// V1RateLimitDeleteUnused converts echo context to params.
+func (w *ServerInterfaceWrapper) V1RateLimitDeleteUnused(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3866,19 +4240,27 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunCreate(ctx, tenant)
+	err = w.Handler.V1RateLimitDeleteUnused(ctx, tenant)
 	return err
 }
 
-// V1WorkflowRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunGet(ctx echo.Context) error {
+// V1RateLimitDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) V1RateLimitDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "v1-workflow-run" -------------
-	var v1WorkflowRun openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "key", runtime.ParamLocationPath, ctx.Param("key"), &key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3886,19 +4268,27 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunGet(ctx, v1WorkflowRun)
+	err = w.Handler.V1RateLimitDelete(ctx, tenant, key)
 	return err
 }
 
-// V1WorkflowRunGetStatus converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunGetStatus(ctx echo.Context) error {
+// V1RateLimitReset converts echo context to params.
+func (w *ServerInterfaceWrapper) V1RateLimitReset(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "v1-workflow-run" -------------
-	var v1WorkflowRun openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "key", runtime.ParamLocationPath, ctx.Param("key"), &key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3906,19 +4296,27 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunGetStatus(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunGetStatus(ctx, v1WorkflowRun)
+	err = w.Handler.V1RateLimitReset(ctx, tenant, key)
 	return err
 }
 
-// V1WorkflowRunTaskEventsList converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunTaskEventsList(ctx echo.Context) error {
+// V1RateLimitGetUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) V1RateLimitGetUsage(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "v1-workflow-run" -------------
-	var v1WorkflowRun openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "key", runtime.ParamLocationPath, ctx.Param("key"), &key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3926,35 +4324,35 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunTaskEventsList(ctx echo.Context) e
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1WorkflowRunTaskEventsListParams
-	// ------------- Optional query parameter "offset" -------------
+	var params V1RateLimitGetUsageParams
+	// ------------- Optional query parameter "since" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "until" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunTaskEventsList(ctx, v1WorkflowRun, params)
+	err = w.Handler.V1RateLimitGetUsage(ctx, tenant, key, params)
 	return err
 }
 
-// V1WorkflowRunGetTimings converts echo context to params.
-func (w *ServerInterfaceWrapper) V1WorkflowRunGetTimings(ctx echo.Context) error {
+// V1TaskListStatusMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskListStatusMetrics(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "v1-workflow-run" -------------
-	var v1WorkflowRun openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3962,64 +4360,56 @@ func (w *ServerInterfaceWrapper) V1WorkflowRunGetTimings(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1WorkflowRunGetTimingsParams
-	// ------------- Optional query parameter "depth" -------------
+	var params V1TaskListStatusMetricsParams
+	// ------------- Required query parameter "since" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "depth", ctx.QueryParams(), &params.Depth)
+	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter depth: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1WorkflowRunGetTimings(ctx, v1WorkflowRun, params)
-	return err
-}
-
-// StepRunListArchives converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunListArchives(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Optional query parameter "until" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "workflow_ids" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params StepRunListArchivesParams
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "parent_task_external_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "parent_task_external_id", ctx.QueryParams(), &params.ParentTaskExternalId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parent_task_external_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "triggering_event_external_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "triggering_event_external_id", ctx.QueryParams(), &params.TriggeringEventExternalId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter triggering_event_external_id: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunListArchives(ctx, stepRun, params)
+	err = w.Handler.V1TaskListStatusMetrics(ctx, tenant, params)
 	return err
 }
 
-// StepRunListEvents converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunListEvents(ctx echo.Context) error {
+// V1TaskGetPointMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskGetPointMetrics(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4027,105 +4417,88 @@ func (w *ServerInterfaceWrapper) StepRunListEvents(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params StepRunListEventsParams
-	// ------------- Optional query parameter "offset" -------------
+	var params V1TaskGetPointMetricsParams
+	// ------------- Optional query parameter "createdAfter" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "finishedBefore" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunListEvents(ctx, stepRun, params)
+	err = w.Handler.V1TaskGetPointMetrics(ctx, tenant, params)
 	return err
 }
 
-// LogLineList converts echo context to params.
-func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
+// V1TaskCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskCancel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LogLineListParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TaskCancel(ctx, tenant)
+	return err
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// V1TaskReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "levels" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "search" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1TaskReplay(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderByField" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderByDirection" -------------
+// V1TaskSignal converts echo context to params.
+func (w *ServerInterfaceWrapper) V1TaskSignal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LogLineList(ctx, stepRun, params)
-	return err
-}
-
-// TenantCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantCreate(ctx echo.Context) error {
-	var err error
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantCreate(ctx)
+	err = w.Handler.V1TaskSignal(ctx, tenant)
 	return err
 }
 
-// TenantGet converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGet(ctx echo.Context) error {
+// V1WorkflowRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4139,53 +4512,99 @@ func (w *ServerInterfaceWrapper) TenantGet(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGet(ctx, tenant)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkflowRunListParams
+	// ------------- Optional query parameter "offset" -------------
 
-// TenantUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantUpdate(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "statuses" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantUpdate(ctx, tenant)
-	return err
-}
+	// ------------- Required query parameter "since" -------------
 
-// AlertEmailGroupList converts echo context to params.
-func (w *ServerInterfaceWrapper) AlertEmailGroupList(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, true, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "additional_metadata" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "additional_metadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additional_metadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflow_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflow_ids", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "worker_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "worker_id", ctx.QueryParams(), &params.WorkerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	// ------------- Required query parameter "only_tasks" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "only_tasks", ctx.QueryParams(), &params.OnlyTasks)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter only_tasks: %s", err))
+	}
+
+	// ------------- Optional query parameter "parent_task_external_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent_task_external_id", ctx.QueryParams(), &params.ParentTaskExternalId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parent_task_external_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "triggering_event_external_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "triggering_event_external_id", ctx.QueryParams(), &params.TriggeringEventExternalId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter triggering_event_external_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_payloads" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_payloads", ctx.QueryParams(), &params.IncludePayloads)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_payloads: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AlertEmailGroupList(ctx, tenant)
+	err = w.Handler.V1WorkflowRunList(ctx, tenant, params)
 	return err
 }
 
-// AlertEmailGroupCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) AlertEmailGroupCreate(ctx echo.Context) error {
+// V1WorkflowRunDisplayNamesList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunDisplayNamesList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4199,13 +4618,22 @@ func (w *ServerInterfaceWrapper) AlertEmailGroupCreate(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkflowRunDisplayNamesListParams
+	// ------------- Required query parameter "external_ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "external_ids", ctx.QueryParams(), &params.ExternalIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter external_ids: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AlertEmailGroupCreate(ctx, tenant)
+	err = w.Handler.V1WorkflowRunDisplayNamesList(ctx, tenant, params)
 	return err
 }
 
-// TenantAlertingSettingsGet converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantAlertingSettingsGet(ctx echo.Context) error {
+// V1WorkflowRunCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4220,19 +4648,19 @@ func (w *ServerInterfaceWrapper) TenantAlertingSettingsGet(ctx echo.Context) err
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantAlertingSettingsGet(ctx, tenant)
+	err = w.Handler.V1WorkflowRunCreate(ctx, tenant)
 	return err
 }
 
-// ApiTokenList converts echo context to params.
-func (w *ServerInterfaceWrapper) ApiTokenList(ctx echo.Context) error {
+// V1WorkflowRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunGet(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "v1-workflow-run" -------------
+	var v1WorkflowRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4240,19 +4668,19 @@ func (w *ServerInterfaceWrapper) ApiTokenList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ApiTokenList(ctx, tenant)
+	err = w.Handler.V1WorkflowRunGet(ctx, v1WorkflowRun)
 	return err
 }
 
-// ApiTokenCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
+// V1WorkflowRunGetStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunGetStatus(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "v1-workflow-run" -------------
+	var v1WorkflowRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4260,19 +4688,19 @@ func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ApiTokenCreate(ctx, tenant)
+	err = w.Handler.V1WorkflowRunGetStatus(ctx, v1WorkflowRun)
 	return err
 }
 
-// EventList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
+// V1WorkflowRunTaskEventsList converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunTaskEventsList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "v1-workflow-run" -------------
+	var v1WorkflowRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4280,7 +4708,7 @@ func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params EventListParams
+	var params V1WorkflowRunTaskEventsListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -4295,189 +4723,191 @@ func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "keys" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRunTaskEventsList(ctx, v1WorkflowRun, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+// V1WorkflowRunGetTimings converts echo context to params.
+func (w *ServerInterfaceWrapper) V1WorkflowRunGetTimings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "v1-workflow-run" -------------
+	var v1WorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "v1-workflow-run", runtime.ParamLocationPath, ctx.Param("v1-workflow-run"), &v1WorkflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter v1-workflow-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflows" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "statuses" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1WorkflowRunGetTimingsParams
+	// ------------- Optional query parameter "depth" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	err = runtime.BindQueryParameter("form", true, false, "depth", ctx.QueryParams(), &params.Depth)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter depth: %s", err))
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1WorkflowRunGetTimings(ctx, v1WorkflowRun, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+// StepRunListArchives converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListArchives(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListArchivesParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
-
-	// ------------- Optional query parameter "additionalMetadata" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
-
-	// ------------- Optional query parameter "eventIds" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventList(ctx, tenant, params)
+	err = w.Handler.StepRunListArchives(ctx, stepRun, params)
 	return err
 }
 
-// EventCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
+// StepRunListEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListEvents(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventCreate(ctx, tenant)
-	return err
-}
-
-// EventCreateBulk converts echo context to params.
-func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListEventsParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventCreateBulk(ctx, tenant)
+	err = w.Handler.StepRunListEvents(ctx, stepRun, params)
 	return err
 }
 
-// EventUpdateCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
+// LogLineList converts echo context to params.
+func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateCancel(ctx, tenant)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogLineListParams
+	// ------------- Optional query parameter "offset" -------------
 
-// EventKeyList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "levels" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventKeyList(ctx, tenant)
-	return err
-}
+	// ------------- Optional query parameter "search" -------------
 
-// EventUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "orderByDirection" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateReplay(ctx, tenant)
+	err = w.Handler.LogLineList(ctx, stepRun, params)
 	return err
 }
 
-// TenantInviteList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
+// TenantCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantCreate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteList(ctx, tenant)
+	err = w.Handler.TenantCreate(ctx)
 	return err
 }
 
-// TenantInviteCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
+// TenantGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4492,12 +4922,12 @@ func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteCreate(ctx, tenant)
+	err = w.Handler.TenantGet(ctx, tenant)
 	return err
 }
 
-// TenantInviteDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
+// TenantUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4507,25 +4937,17 @@ func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
+	err = w.Handler.TenantUpdate(ctx, tenant)
 	return err
 }
 
-// TenantInviteUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
+// AlertEmailGroupList converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertEmailGroupList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4535,25 +4957,17 @@ func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
+	err = w.Handler.AlertEmailGroupList(ctx, tenant)
 	return err
 }
 
-// TenantMemberList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
+// AlertEmailGroupCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AlertEmailGroupCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4568,12 +4982,12 @@ func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberList(ctx, tenant)
+	err = w.Handler.AlertEmailGroupCreate(ctx, tenant)
 	return err
 }
 
-// TenantMemberDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
+// TenantAlertingSettingsGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantAlertingSettingsGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4583,25 +4997,17 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "member" -------------
-	var member openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberDelete(ctx, tenant, member)
+	err = w.Handler.TenantAlertingSettingsGet(ctx, tenant)
 	return err
 }
 
-// TenantGetPrometheusMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetPrometheusMetrics(ctx echo.Context) error {
+// ApiTokenList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4616,12 +5022,12 @@ func (w *ServerInterfaceWrapper) TenantGetPrometheusMetrics(ctx echo.Context) er
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetPrometheusMetrics(ctx, tenant)
+	err = w.Handler.ApiTokenList(ctx, tenant)
 	return err
 }
 
-// TenantGetQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
+// ApiTokenCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4635,29 +5041,13 @@ func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TenantGetQueueMetricsParams
-	// ------------- Optional query parameter "workflows" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
-	}
-
-	// ------------- Optional query parameter "additionalMetadata" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
+	err = w.Handler.ApiTokenCreate(ctx, tenant)
 	return err
 }
 
-// RateLimitList converts echo context to params.
-func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
+// EventList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4672,7 +5062,7 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RateLimitListParams
+	var params EventListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -4687,6 +5077,27 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
+	// ------------- Optional query parameter "keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
@@ -4708,33 +5119,27 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RateLimitList(ctx, tenant, params)
-	return err
-}
+	// ------------- Optional query parameter "additionalMetadata" -------------
 
-// TenantResourcePolicyGet converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "eventIds" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantResourcePolicyGet(ctx, tenant)
+	err = w.Handler.EventList(ctx, tenant, params)
 	return err
 }
 
-// SlackWebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+// EventCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4749,12 +5154,12 @@ func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SlackWebhookList(ctx, tenant)
+	err = w.Handler.EventCreate(ctx, tenant)
 	return err
 }
 
-// UserUpdateSlackOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+// EventCreateBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4764,15 +5169,17 @@ func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	err = w.Handler.EventCreateBulk(ctx, tenant)
 	return err
 }
 
-// SnsList converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+// EventUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4787,12 +5194,12 @@ func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsList(ctx, tenant)
+	err = w.Handler.EventUpdateCancel(ctx, tenant)
 	return err
 }
 
-// SnsCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+// EventKeyList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4807,12 +5214,12 @@ func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsCreate(ctx, tenant)
+	err = w.Handler.EventKeyList(ctx, tenant)
 	return err
 }
 
-// TenantGetStepRunQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
+// EventUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4827,12 +5234,12 @@ func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context)
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	err = w.Handler.EventUpdateReplay(ctx, tenant)
 	return err
 }
 
-// StepRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+// TenantInviteList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4842,25 +5249,17 @@ func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	err = w.Handler.TenantInviteList(ctx, tenant)
 	return err
 }
 
-// StepRunUpdateCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
+// TenantInviteCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4870,25 +5269,17 @@ func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateCancel(ctx, tenant, stepRun)
+	err = w.Handler.TenantInviteCreate(ctx, tenant)
 	return err
 }
 
-// StepRunUpdateRerun converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
+// TenantInviteDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4898,12 +5289,12 @@ func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4911,12 +5302,12 @@ func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
+	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
 	return err
 }
 
-// StepRunGetSchema converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
+// TenantInviteUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4926,12 +5317,12 @@ func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4939,12 +5330,12 @@ func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
+	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
 	return err
 }
 
-// WebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
+// TenantMemberList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4959,12 +5350,12 @@ func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookList(ctx, tenant)
+	err = w.Handler.TenantMemberList(ctx, tenant)
 	return err
 }
 
-// WebhookCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
+// TenantMemberDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4974,17 +5365,25 @@ func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "member" -------------
+	var member openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookCreate(ctx, tenant)
+	err = w.Handler.TenantMemberDelete(ctx, tenant, member)
 	return err
 }
 
-// WorkerList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+// TenantGetPrometheusMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetPrometheusMetrics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -4999,12 +5398,12 @@ func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerList(ctx, tenant)
+	err = w.Handler.TenantGetPrometheusMetrics(ctx, tenant)
 	return err
 }
 
-// WorkflowRunUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
+// TenantGetQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5018,13 +5417,29 @@ func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TenantGetQueueMetricsParams
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunUpdateReplay(ctx, tenant)
+	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
 	return err
 }
 
-// WorkflowRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+// RateLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5034,53 +5449,54 @@ func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RateLimitListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-// WorkflowRunGetInput converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Optional query parameter "search" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Optional query parameter "orderByField" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "orderByDirection" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetInput(ctx, tenant, workflowRun)
+	err = w.Handler.RateLimitList(ctx, tenant, params)
 	return err
 }
 
-// WorkflowRunGetShape converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
+// TenantResourcePolicyGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5090,25 +5506,17 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetShape(ctx, tenant, workflowRun)
+	err = w.Handler.TenantResourcePolicyGet(ctx, tenant)
 	return err
 }
 
-// WorkflowRunListStepRunEvents converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context) error {
+// SlackWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5118,34 +5526,17 @@ func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListStepRunEventsParams
-	// ------------- Optional query parameter "lastId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "lastId", ctx.QueryParams(), &params.LastId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params)
+	err = w.Handler.SlackWebhookList(ctx, tenant)
 	return err
 }
 
-// WorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+// UserUpdateSlackOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5155,40 +5546,35 @@ func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowListParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	return err
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// SnsList converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowList(ctx, tenant, params)
+	err = w.Handler.SnsList(ctx, tenant)
 	return err
 }
 
-// WorkflowRunCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
+// SnsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5203,12 +5589,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunCancel(ctx, tenant)
+	err = w.Handler.SnsCreate(ctx, tenant)
 	return err
 }
 
-// CronWorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
+// TenantGetStepRunQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5222,71 +5608,69 @@ func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CronWorkflowListParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	return err
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// StepRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowId" -------------
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowName" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflowName", ctx.QueryParams(), &params.WorkflowName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowName: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "cronName" -------------
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "cronName", ctx.QueryParams(), &params.CronName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cronName: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	return err
+}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+// StepRunUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CronWorkflowList(ctx, tenant, params)
+	err = w.Handler.StepRunUpdateCancel(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowCronDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
+// StepRunUpdateRerun converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5296,12 +5680,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "cron-workflow" -------------
-	var cronWorkflow openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -5309,12 +5693,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowCronDelete(ctx, tenant, cronWorkflow)
+	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowCronGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
+// StepRunGetSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5324,12 +5708,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "cron-workflow" -------------
-	var cronWorkflow openapi_types.UUID
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -5337,12 +5721,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowCronGet(ctx, tenant, cronWorkflow)
+	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowRunList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
+// WebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5356,120 +5740,157 @@ func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListParams
-	// ------------- Optional query parameter "offset" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookList(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+// WebhookCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "eventId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookCreate(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
+// WorkerList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerList(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+// WorkflowRunUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "parentStepRunId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "statuses" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunUpdateReplay(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+// WorkflowRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "kinds" -------------
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "kinds", ctx.QueryParams(), &params.Kinds)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kinds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "createdAfter" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+// WorkflowRunGetInput converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "createdBefore" -------------
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "finishedAfter" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "finishedAfter", ctx.QueryParams(), &params.FinishedAfter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedAfter: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "finishedBefore" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetInput(ctx, tenant, workflowRun)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
+// WorkflowRunGetShape converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunList(ctx, tenant, params)
+	err = w.Handler.WorkflowRunGetShape(ctx, tenant, workflowRun)
 	return err
 }
 
-// WorkflowRunGetMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
+// WorkflowRunListStepRunEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5479,68 +5900,97 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunGetMetricsParams
-	// ------------- Optional query parameter "eventId" -------------
+	var params WorkflowRunListStepRunEventsParams
+	// ------------- Optional query parameter "lastId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
+	err = runtime.BindQueryParameter("form", true, false, "lastId", ctx.QueryParams(), &params.LastId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastId: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+// WorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "parentStepRunId" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowListParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "createdAfter" -------------
+	// ------------- Optional query parameter "name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Optional query parameter "createdBefore" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowList(ctx, tenant, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+// WorkflowRunCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetMetrics(ctx, tenant, params)
+	err = w.Handler.WorkflowRunCancel(ctx, tenant)
 	return err
 }
 
-// WorkflowScheduledList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
+// CronWorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5555,7 +6005,7 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowScheduledListParams
+	var params CronWorkflowListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -5570,20 +6020,6 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderByDirection" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
-
 	// ------------- Optional query parameter "workflowId" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
@@ -5591,18 +6027,18 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
 	}
 
-	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+	// ------------- Optional query parameter "workflowName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	err = runtime.BindQueryParameter("form", true, false, "workflowName", ctx.QueryParams(), &params.WorkflowName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowName: %s", err))
 	}
 
-	// ------------- Optional query parameter "parentStepRunId" -------------
+	// ------------- Optional query parameter "cronName" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	err = runtime.BindQueryParameter("form", true, false, "cronName", ctx.QueryParams(), &params.CronName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cronName: %s", err))
 	}
 
 	// ------------- Optional query parameter "additionalMetadata" -------------
@@ -5612,48 +6048,27 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
 	}
 
-	// ------------- Optional query parameter "statuses" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowScheduledList(ctx, tenant, params)
-	return err
-}
-
-// WorkflowScheduledDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowScheduledDelete(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// ------------- Optional query parameter "orderByField" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
 	}
 
-	// ------------- Path parameter "scheduled-workflow-run" -------------
-	var scheduledWorkflowRun openapi_types.UUID
+	// ------------- Optional query parameter "orderByDirection" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun)
+	err = w.Handler.CronWorkflowList(ctx, tenant, params)
 	return err
 }
 
-// WorkflowScheduledGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
+// WorkflowCronDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5663,12 +6078,12 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "scheduled-workflow-run" -------------
-	var scheduledWorkflowRun openapi_types.UUID
+	// ------------- Path parameter "cron-workflow" -------------
+	var cronWorkflow openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -5676,12 +6091,12 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun)
+	err = w.Handler.WorkflowCronDelete(ctx, tenant, cronWorkflow)
 	return err
 }
 
-// CronWorkflowTriggerCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) error {
+// WorkflowCronGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5691,12 +6106,12 @@ func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow" -------------
-	var workflow string
+	// ------------- Path parameter "cron-workflow" -------------
+	var cronWorkflow openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -5704,12 +6119,12 @@ func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) err
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CronWorkflowTriggerCreate(ctx, tenant, workflow)
+	err = w.Handler.WorkflowCronGet(ctx, tenant, cronWorkflow)
 	return err
 }
 
-// ScheduledWorkflowRunCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduledWorkflowRunCreate(ctx echo.Context) error {
+// WorkflowRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -5719,40 +6134,407 @@ func (w *ServerInterfaceWrapper) ScheduledWorkflowRunCreate(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow" -------------
-	var workflow string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduledWorkflowRunCreate(ctx, tenant, workflow)
-	return err
-}
-
-// WorkflowGetWorkersCount converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowGetWorkersCount(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "tenant" -------------
-	var tenant openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunListParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "eventId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentStepRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// ------------- Optional query parameter "kinds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kinds", ctx.QueryParams(), &params.Kinds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kinds: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "finishedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finishedAfter", ctx.QueryParams(), &params.FinishedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "finishedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunGetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunGetMetricsParams
+	// ------------- Optional query parameter "eventId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentStepRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetMetrics(ctx, tenant, params)
+	return err
+}
+
+// WorkflowScheduledList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowScheduledListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentStepRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowScheduledDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow-run" -------------
+	var scheduledWorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun)
+	return err
+}
+
+// WorkflowScheduledGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow-run" -------------
+	var scheduledWorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun)
+	return err
+}
+
+// CronWorkflowTriggerCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CronWorkflowTriggerCreate(ctx, tenant, workflow)
+	return err
+}
+
+// ScheduledWorkflowRunCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduledWorkflowRunCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduledWorkflowRunCreate(ctx, tenant, workflow)
+	return err
+}
+
+// WorkflowGetWorkersCount converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGetWorkersCount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -5999,6 +6781,46 @@ func (w *ServerInterfaceWrapper) WorkerUpdate(ctx echo.Context) error {
 	return err
 }
 
+// WorkerDrainStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerDrainStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerDrainStatus(ctx, worker)
+	return err
+}
+
+// WorkerDrain converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerDrain(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerDrain(ctx, worker)
+	return err
+}
+
 // WorkflowDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
 	var err error
@@ -6198,8 +7020,16 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/stable/dags/tasks", wrapper.V1DagListTasks)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task", wrapper.V1TaskGet)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/logs", wrapper.V1LogLineList)
+	router.GET(baseURL+"/api/v1/stable/tasks/:task/scheduling-explanation", wrapper.V1TaskGetSchedulingExplanation)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/task-events", wrapper.V1TaskEventList)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/approvals", wrapper.V1ApprovalList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/approvals/:v1-approval/approve", wrapper.V1ApprovalApprove)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/approvals/:v1-approval/reject", wrapper.V1ApprovalReject)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/cel/debug", wrapper.V1CelDebug)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/concurrency-limits", wrapper.V1ConcurrencyLimitList)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/concurrency-limits", wrapper.V1ConcurrencyLimitSet)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/concurrency-limits/delete", wrapper.V1ConcurrencyLimitDelete)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/concurrency-slots", wrapper.V1ConcurrencySlotList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events", wrapper.V1EventList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/events/keys", wrapper.V1EventKeyList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/filters", wrapper.V1FilterList)
@@ -6207,10 +7037,16 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/v1/stable/tenants/:tenant/filters/:v1-filter", wrapper.V1FilterDelete)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/filters/:v1-filter", wrapper.V1FilterGet)
 	router.PATCH(baseURL+"/api/v1/stable/tenants/:tenant/filters/:v1-filter", wrapper.V1FilterUpdate)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/rate-limits/delete", wrapper.V1RateLimitDeleteByPrefix)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/rate-limits/delete-unused", wrapper.V1RateLimitDeleteUnused)
+	router.DELETE(baseURL+"/api/v1/stable/tenants/:tenant/rate-limits/:key", wrapper.V1RateLimitDelete)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/rate-limits/:key/reset", wrapper.V1RateLimitReset)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/rate-limits/:key/usage", wrapper.V1RateLimitGetUsage)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/task-metrics", wrapper.V1TaskListStatusMetrics)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/task-point-metrics", wrapper.V1TaskGetPointMetrics)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/tasks/cancel", wrapper.V1TaskCancel)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/tasks/replay", wrapper.V1TaskReplay)
+	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/tasks/signal", wrapper.V1TaskSignal)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs", wrapper.V1WorkflowRunList)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/display-names", wrapper.V1WorkflowRunDisplayNamesList)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/workflow-runs/trigger", wrapper.V1WorkflowRunCreate)
@@ -6294,6 +7130,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/webhook-workers/:webhook/requests", wrapper.WebhookRequestsList)
 	router.GET(baseURL+"/api/v1/workers/:worker", wrapper.WorkerGet)
 	router.PATCH(baseURL+"/api/v1/workers/:worker", wrapper.WorkerUpdate)
+	router.GET(baseURL+"/api/v1/workers/:worker/drain", wrapper.WorkerDrainStatus)
+	router.POST(baseURL+"/api/v1/workers/:worker/drain", wrapper.WorkerDrain)
 	router.DELETE(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowDelete)
 	router.GET(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowGet)
 	router.PATCH(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowUpdate)
@@ -6303,673 +7141,1006 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 }
 
-type LivenessGetRequestObject struct {
+type LivenessGetRequestObject struct {
+}
+
+type LivenessGetResponseObject interface {
+	VisitLivenessGetResponse(w http.ResponseWriter) error
+}
+
+type LivenessGet200Response struct {
+}
+
+func (response LivenessGet200Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type LivenessGet500Response struct {
+}
+
+func (response LivenessGet500Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ReadinessGetRequestObject struct {
+}
+
+type ReadinessGetResponseObject interface {
+	VisitReadinessGetResponse(w http.ResponseWriter) error
+}
+
+type ReadinessGet200Response struct {
+}
+
+func (response ReadinessGet200Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReadinessGet500Response struct {
+}
+
+func (response ReadinessGet500Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AlertEmailGroupDeleteRequestObject struct {
+	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
+}
+
+type AlertEmailGroupDeleteResponseObject interface {
+	VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupDelete204Response struct {
+}
+
+func (response AlertEmailGroupDelete204Response) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AlertEmailGroupDelete400JSONResponse APIErrors
+
+func (response AlertEmailGroupDelete400JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupDelete403JSONResponse APIError
+
+func (response AlertEmailGroupDelete403JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupUpdateRequestObject struct {
+	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
+	Body            *AlertEmailGroupUpdateJSONRequestBody
+}
+
+type AlertEmailGroupUpdateResponseObject interface {
+	VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupUpdate200JSONResponse TenantAlertEmailGroup
+
+func (response AlertEmailGroupUpdate200JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupUpdate400JSONResponse APIErrors
+
+func (response AlertEmailGroupUpdate400JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupUpdate403JSONResponse APIError
+
+func (response AlertEmailGroupUpdate403JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRevokeRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+}
+
+type ApiTokenUpdateRevokeResponseObject interface {
+	VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenUpdateRevoke204Response struct {
+}
+
+func (response ApiTokenUpdateRevoke204Response) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ApiTokenUpdateRevoke400JSONResponse APIErrors
+
+func (response ApiTokenUpdateRevoke400JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRevoke403JSONResponse APIErrors
+
+func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloudMetadataGetRequestObject struct {
+}
+
+type CloudMetadataGetResponseObject interface {
+	VisitCloudMetadataGetResponse(w http.ResponseWriter) error
+}
+
+type CloudMetadataGet200JSONResponse APIErrors
+
+func (response CloudMetadataGet200JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloudMetadataGet400JSONResponse APIErrors
+
+func (response CloudMetadataGet400JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventGetRequestObject struct {
+	Event openapi_types.UUID `json:"event"`
+}
+
+type EventGetResponseObject interface {
+	VisitEventGetResponse(w http.ResponseWriter) error
+}
+
+type EventGet200JSONResponse Event
+
+func (response EventGet200JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGetResponseObject interface {
-	VisitLivenessGetResponse(w http.ResponseWriter) error
+type EventGet400JSONResponse APIErrors
+
+func (response EventGet400JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGet200Response struct {
+type EventGet403JSONResponse APIErrors
+
+func (response EventGet403JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response LivenessGet200Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+type EventDataGetRequestObject struct {
+	Event openapi_types.UUID `json:"event"`
+}
+
+type EventDataGetResponseObject interface {
+	VisitEventDataGetResponse(w http.ResponseWriter) error
+}
+
+type EventDataGet200JSONResponse EventData
+
+func (response EventDataGet200JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGet500Response struct {
+type EventDataGet400JSONResponse APIErrors
+
+func (response EventDataGet400JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response LivenessGet500Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+type EventDataGet403JSONResponse APIErrors
+
+func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGetRequestObject struct {
+type MetadataGetRequestObject struct {
 }
 
-type ReadinessGetResponseObject interface {
-	VisitReadinessGetResponse(w http.ResponseWriter) error
+type MetadataGetResponseObject interface {
+	VisitMetadataGetResponse(w http.ResponseWriter) error
 }
 
-type ReadinessGet200Response struct {
+type MetadataGet200JSONResponse APIMeta
+
+func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ReadinessGet200Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+type MetadataGet400JSONResponse APIErrors
+
+func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrationsRequestObject struct {
+}
+
+type MetadataListIntegrationsResponseObject interface {
+	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
+}
+
+type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+
+func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGet500Response struct {
+type MetadataListIntegrations400JSONResponse APIErrors
+
+func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ReadinessGet500Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+type MonitoringPostRunProbeRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type MonitoringPostRunProbeResponseObject interface {
+	VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error
+}
+
+type MonitoringPostRunProbe200Response struct {
+}
+
+func (response MonitoringPostRunProbe200Response) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
 	return nil
 }
 
-type AlertEmailGroupDeleteRequestObject struct {
-	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
+type MonitoringPostRunProbe403JSONResponse APIErrors
+
+func (response MonitoringPostRunProbe403JSONResponse) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupDeleteResponseObject interface {
-	VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error
+type SlackWebhookDeleteRequestObject struct {
+	Slack openapi_types.UUID `json:"slack"`
 }
 
-type AlertEmailGroupDelete204Response struct {
+type SlackWebhookDeleteResponseObject interface {
+	VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error
 }
 
-func (response AlertEmailGroupDelete204Response) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+type SlackWebhookDelete204Response struct {
+}
+
+func (response SlackWebhookDelete204Response) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type AlertEmailGroupDelete400JSONResponse APIErrors
+type SlackWebhookDelete400JSONResponse APIErrors
 
-func (response AlertEmailGroupDelete400JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+func (response SlackWebhookDelete400JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupDelete403JSONResponse APIError
+type SlackWebhookDelete401JSONResponse APIErrors
 
-func (response AlertEmailGroupDelete403JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+func (response SlackWebhookDelete401JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdateRequestObject struct {
-	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
-	Body            *AlertEmailGroupUpdateJSONRequestBody
+type SlackWebhookDelete405JSONResponse APIErrors
+
+func (response SlackWebhookDelete405JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdateResponseObject interface {
-	VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error
+type SnsDeleteRequestObject struct {
+	Sns openapi_types.UUID `json:"sns"`
 }
 
-type AlertEmailGroupUpdate200JSONResponse TenantAlertEmailGroup
+type SnsDeleteResponseObject interface {
+	VisitSnsDeleteResponse(w http.ResponseWriter) error
+}
 
-func (response AlertEmailGroupUpdate200JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+type SnsDelete204Response struct {
+}
+
+func (response SnsDelete204Response) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SnsDelete400JSONResponse APIErrors
+
+func (response SnsDelete400JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdate400JSONResponse APIErrors
+type SnsDelete401JSONResponse APIErrors
 
-func (response AlertEmailGroupUpdate400JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+func (response SnsDelete401JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdate403JSONResponse APIError
+type SnsDelete405JSONResponse APIErrors
 
-func (response AlertEmailGroupUpdate403JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+func (response SnsDelete405JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevokeRequestObject struct {
-	ApiToken openapi_types.UUID `json:"api-token"`
+type SnsUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Event  string             `json:"event"`
 }
 
-type ApiTokenUpdateRevokeResponseObject interface {
-	VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error
+type SnsUpdateResponseObject interface {
+	VisitSnsUpdateResponse(w http.ResponseWriter) error
 }
 
-type ApiTokenUpdateRevoke204Response struct {
+type SnsUpdate200Response struct {
 }
 
-func (response ApiTokenUpdateRevoke204Response) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+func (response SnsUpdate200Response) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
 	return nil
 }
 
-type ApiTokenUpdateRevoke400JSONResponse APIErrors
+type SnsUpdate400JSONResponse APIErrors
+
+func (response SnsUpdate400JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdate401JSONResponse APIErrors
+
+func (response SnsUpdate401JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdate405JSONResponse APIErrors
+
+func (response SnsUpdate405JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DagListTasksRequestObject struct {
+	Params V1DagListTasksParams
+}
+
+type V1DagListTasksResponseObject interface {
+	VisitV1DagListTasksResponse(w http.ResponseWriter) error
+}
+
+type V1DagListTasks200JSONResponse []V1DagChildren
+
+func (response V1DagListTasks200JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1DagListTasks400JSONResponse APIErrors
 
-func (response ApiTokenUpdateRevoke400JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+func (response V1DagListTasks400JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevoke403JSONResponse APIErrors
+type V1DagListTasks403JSONResponse APIErrors
 
-func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+func (response V1DagListTasks403JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CloudMetadataGetRequestObject struct {
+type V1DagListTasks501JSONResponse APIErrors
+
+func (response V1DagListTasks501JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CloudMetadataGetResponseObject interface {
-	VisitCloudMetadataGetResponse(w http.ResponseWriter) error
+type V1TaskGetRequestObject struct {
+	Task   openapi_types.UUID `json:"task"`
+	Params V1TaskGetParams
 }
 
-type CloudMetadataGet200JSONResponse APIErrors
+type V1TaskGetResponseObject interface {
+	VisitV1TaskGetResponse(w http.ResponseWriter) error
+}
 
-func (response CloudMetadataGet200JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+type V1TaskGet200JSONResponse V1TaskSummary
+
+func (response V1TaskGet200JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CloudMetadataGet400JSONResponse APIErrors
+type V1TaskGet400JSONResponse APIErrors
 
-func (response CloudMetadataGet400JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+func (response V1TaskGet400JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventGetRequestObject struct {
-	Event openapi_types.UUID `json:"event"`
-}
-
-type EventGetResponseObject interface {
-	VisitEventGetResponse(w http.ResponseWriter) error
-}
-
-type EventGet200JSONResponse Event
+type V1TaskGet403JSONResponse APIErrors
 
-func (response EventGet200JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+func (response V1TaskGet403JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventGet400JSONResponse APIErrors
+type V1TaskGet404JSONResponse APIErrors
 
-func (response EventGet400JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+func (response V1TaskGet404JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventGet403JSONResponse APIErrors
+type V1TaskGet501JSONResponse APIErrors
 
-func (response EventGet403JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+func (response V1TaskGet501JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(501)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGetRequestObject struct {
-	Event openapi_types.UUID `json:"event"`
+type V1LogLineListRequestObject struct {
+	Task openapi_types.UUID `json:"task"`
 }
 
-type EventDataGetResponseObject interface {
-	VisitEventDataGetResponse(w http.ResponseWriter) error
+type V1LogLineListResponseObject interface {
+	VisitV1LogLineListResponse(w http.ResponseWriter) error
 }
 
-type EventDataGet200JSONResponse EventData
+type V1LogLineList200JSONResponse V1LogLineList
 
-func (response EventDataGet200JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response V1LogLineList200JSONResponse) VisitV1LogLineListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGet400JSONResponse APIErrors
+type V1LogLineList400JSONResponse APIErrors
 
-func (response EventDataGet400JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response V1LogLineList400JSONResponse) VisitV1LogLineListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGet403JSONResponse APIErrors
+type V1LogLineList403JSONResponse APIErrors
 
-func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response V1LogLineList403JSONResponse) VisitV1LogLineListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGetRequestObject struct {
+type V1TaskGetSchedulingExplanationRequestObject struct {
+	Task openapi_types.UUID `json:"task"`
 }
 
-type MetadataGetResponseObject interface {
-	VisitMetadataGetResponse(w http.ResponseWriter) error
+type V1TaskGetSchedulingExplanationResponseObject interface {
+	VisitV1TaskGetSchedulingExplanationResponse(w http.ResponseWriter) error
 }
 
-type MetadataGet200JSONResponse APIMeta
+type V1TaskGetSchedulingExplanation200JSONResponse V1TaskSchedulingExplanation
 
-func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSchedulingExplanation200JSONResponse) VisitV1TaskGetSchedulingExplanationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGet400JSONResponse APIErrors
+type V1TaskGetSchedulingExplanation400JSONResponse APIErrors
 
-func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSchedulingExplanation400JSONResponse) VisitV1TaskGetSchedulingExplanationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataListIntegrationsRequestObject struct {
-}
-
-type MetadataListIntegrationsResponseObject interface {
-	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
-}
-
-type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+type V1TaskGetSchedulingExplanation403JSONResponse APIErrors
 
-func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSchedulingExplanation403JSONResponse) VisitV1TaskGetSchedulingExplanationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataListIntegrations400JSONResponse APIErrors
+type V1TaskGetSchedulingExplanation404JSONResponse APIErrors
 
-func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+func (response V1TaskGetSchedulingExplanation404JSONResponse) VisitV1TaskGetSchedulingExplanationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MonitoringPostRunProbeRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-}
-
-type MonitoringPostRunProbeResponseObject interface {
-	VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error
-}
-
-type MonitoringPostRunProbe200Response struct {
+type V1TaskEventListRequestObject struct {
+	Task   openapi_types.UUID `json:"task"`
+	Params V1TaskEventListParams
 }
 
-func (response MonitoringPostRunProbe200Response) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type V1TaskEventListResponseObject interface {
+	VisitV1TaskEventListResponse(w http.ResponseWriter) error
 }
 
-type MonitoringPostRunProbe403JSONResponse APIErrors
+type V1TaskEventList200JSONResponse V1TaskEventList
 
-func (response MonitoringPostRunProbe403JSONResponse) VisitMonitoringPostRunProbeResponse(w http.ResponseWriter) error {
+func (response V1TaskEventList200JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDeleteRequestObject struct {
-	Slack openapi_types.UUID `json:"slack"`
-}
-
-type SlackWebhookDeleteResponseObject interface {
-	VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error
-}
-
-type SlackWebhookDelete204Response struct {
-}
-
-func (response SlackWebhookDelete204Response) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type SlackWebhookDelete400JSONResponse APIErrors
+type V1TaskEventList400JSONResponse APIErrors
 
-func (response SlackWebhookDelete400JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1TaskEventList400JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDelete401JSONResponse APIErrors
+type V1TaskEventList403JSONResponse APIErrors
 
-func (response SlackWebhookDelete401JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1TaskEventList403JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDelete405JSONResponse APIErrors
+type V1TaskEventList404JSONResponse APIErrors
 
-func (response SlackWebhookDelete405JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response V1TaskEventList404JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDeleteRequestObject struct {
-	Sns openapi_types.UUID `json:"sns"`
-}
+type V1TaskEventList501JSONResponse APIErrors
 
-type SnsDeleteResponseObject interface {
-	VisitSnsDeleteResponse(w http.ResponseWriter) error
+func (response V1TaskEventList501JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDelete204Response struct {
+type V1ApprovalListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1ApprovalListParams
 }
 
-func (response SnsDelete204Response) VisitSnsDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type V1ApprovalListResponseObject interface {
+	VisitV1ApprovalListResponse(w http.ResponseWriter) error
 }
 
-type SnsDelete400JSONResponse APIErrors
+type V1ApprovalList200JSONResponse V1ApprovalList
 
-func (response SnsDelete400JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+func (response V1ApprovalList200JSONResponse) VisitV1ApprovalListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDelete401JSONResponse APIErrors
+type V1ApprovalList400JSONResponse APIErrors
 
-func (response SnsDelete401JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+func (response V1ApprovalList400JSONResponse) VisitV1ApprovalListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDelete405JSONResponse APIErrors
+type V1ApprovalList403JSONResponse APIErrors
 
-func (response SnsDelete405JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+func (response V1ApprovalList403JSONResponse) VisitV1ApprovalListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Event  string             `json:"event"`
+type V1ApprovalApproveRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	V1Approval openapi_types.UUID `json:"v1-approval"`
+	Body       *V1ApprovalApproveJSONRequestBody
 }
 
-type SnsUpdateResponseObject interface {
-	VisitSnsUpdateResponse(w http.ResponseWriter) error
+type V1ApprovalApproveResponseObject interface {
+	VisitV1ApprovalApproveResponse(w http.ResponseWriter) error
 }
 
-type SnsUpdate200Response struct {
-}
+type V1ApprovalApprove200JSONResponse V1Approval
 
-func (response SnsUpdate200Response) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response V1ApprovalApprove200JSONResponse) VisitV1ApprovalApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdate400JSONResponse APIErrors
+type V1ApprovalApprove400JSONResponse APIErrors
 
-func (response SnsUpdate400JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response V1ApprovalApprove400JSONResponse) VisitV1ApprovalApproveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdate401JSONResponse APIErrors
+type V1ApprovalApprove403JSONResponse APIErrors
 
-func (response SnsUpdate401JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response V1ApprovalApprove403JSONResponse) VisitV1ApprovalApproveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdate405JSONResponse APIErrors
+type V1ApprovalApprove404JSONResponse APIErrors
 
-func (response SnsUpdate405JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response V1ApprovalApprove404JSONResponse) VisitV1ApprovalApproveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DagListTasksRequestObject struct {
-	Params V1DagListTasksParams
+type V1ApprovalRejectRequestObject struct {
+	Tenant     openapi_types.UUID `json:"tenant"`
+	V1Approval openapi_types.UUID `json:"v1-approval"`
+	Body       *V1ApprovalRejectJSONRequestBody
 }
 
-type V1DagListTasksResponseObject interface {
-	VisitV1DagListTasksResponse(w http.ResponseWriter) error
+type V1ApprovalRejectResponseObject interface {
+	VisitV1ApprovalRejectResponse(w http.ResponseWriter) error
 }
 
-type V1DagListTasks200JSONResponse []V1DagChildren
+type V1ApprovalReject200JSONResponse V1Approval
 
-func (response V1DagListTasks200JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
+func (response V1ApprovalReject200JSONResponse) VisitV1ApprovalRejectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DagListTasks400JSONResponse APIErrors
+type V1ApprovalReject400JSONResponse APIErrors
 
-func (response V1DagListTasks400JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
+func (response V1ApprovalReject400JSONResponse) VisitV1ApprovalRejectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DagListTasks403JSONResponse APIErrors
+type V1ApprovalReject403JSONResponse APIErrors
 
-func (response V1DagListTasks403JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
+func (response V1ApprovalReject403JSONResponse) VisitV1ApprovalRejectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1DagListTasks501JSONResponse APIErrors
+type V1ApprovalReject404JSONResponse APIErrors
 
-func (response V1DagListTasks501JSONResponse) VisitV1DagListTasksResponse(w http.ResponseWriter) error {
+func (response V1ApprovalReject404JSONResponse) VisitV1ApprovalRejectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGetRequestObject struct {
-	Task   openapi_types.UUID `json:"task"`
-	Params V1TaskGetParams
+type V1CelDebugRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1CelDebugJSONRequestBody
 }
 
-type V1TaskGetResponseObject interface {
-	VisitV1TaskGetResponse(w http.ResponseWriter) error
+type V1CelDebugResponseObject interface {
+	VisitV1CelDebugResponse(w http.ResponseWriter) error
 }
 
-type V1TaskGet200JSONResponse V1TaskSummary
+type V1CelDebug200JSONResponse V1CELDebugResponse
 
-func (response V1TaskGet200JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
+func (response V1CelDebug200JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGet400JSONResponse APIErrors
+type V1CelDebug400JSONResponse APIErrors
 
-func (response V1TaskGet400JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
+func (response V1CelDebug400JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGet403JSONResponse APIErrors
+type V1CelDebug403JSONResponse APIErrors
+
+func (response V1CelDebug403JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1ConcurrencyLimitListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type V1ConcurrencyLimitListResponseObject interface {
+	VisitV1ConcurrencyLimitListResponse(w http.ResponseWriter) error
+}
+
+type V1ConcurrencyLimitList200JSONResponse V1ConcurrencyKeyLimitList
 
-func (response V1TaskGet403JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitList200JSONResponse) VisitV1ConcurrencyLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGet404JSONResponse APIErrors
+type V1ConcurrencyLimitList400JSONResponse APIErrors
 
-func (response V1TaskGet404JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitList400JSONResponse) VisitV1ConcurrencyLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGet501JSONResponse APIErrors
+type V1ConcurrencyLimitList403JSONResponse APIErrors
 
-func (response V1TaskGet501JSONResponse) VisitV1TaskGetResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitList403JSONResponse) VisitV1ConcurrencyLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1LogLineListRequestObject struct {
-	Task openapi_types.UUID `json:"task"`
+type V1ConcurrencyLimitSetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1ConcurrencyLimitSetJSONRequestBody
 }
 
-type V1LogLineListResponseObject interface {
-	VisitV1LogLineListResponse(w http.ResponseWriter) error
+type V1ConcurrencyLimitSetResponseObject interface {
+	VisitV1ConcurrencyLimitSetResponse(w http.ResponseWriter) error
 }
 
-type V1LogLineList200JSONResponse V1LogLineList
+type V1ConcurrencyLimitSet200JSONResponse V1ConcurrencyKeyLimit
 
-func (response V1LogLineList200JSONResponse) VisitV1LogLineListResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitSet200JSONResponse) VisitV1ConcurrencyLimitSetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1LogLineList400JSONResponse APIErrors
+type V1ConcurrencyLimitSet400JSONResponse APIErrors
 
-func (response V1LogLineList400JSONResponse) VisitV1LogLineListResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitSet400JSONResponse) VisitV1ConcurrencyLimitSetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1LogLineList403JSONResponse APIErrors
+type V1ConcurrencyLimitSet403JSONResponse APIErrors
 
-func (response V1LogLineList403JSONResponse) VisitV1LogLineListResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitSet403JSONResponse) VisitV1ConcurrencyLimitSetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventListRequestObject struct {
-	Task   openapi_types.UUID `json:"task"`
-	Params V1TaskEventListParams
+type V1ConcurrencyLimitSet404JSONResponse APIErrors
+
+func (response V1ConcurrencyLimitSet404JSONResponse) VisitV1ConcurrencyLimitSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventListResponseObject interface {
-	VisitV1TaskEventListResponse(w http.ResponseWriter) error
+type V1ConcurrencyLimitDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1ConcurrencyLimitDeleteJSONRequestBody
 }
 
-type V1TaskEventList200JSONResponse V1TaskEventList
+type V1ConcurrencyLimitDeleteResponseObject interface {
+	VisitV1ConcurrencyLimitDeleteResponse(w http.ResponseWriter) error
+}
 
-func (response V1TaskEventList200JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
+type V1ConcurrencyLimitDelete200JSONResponse V1ConcurrencyKeyLimit
+
+func (response V1ConcurrencyLimitDelete200JSONResponse) VisitV1ConcurrencyLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventList400JSONResponse APIErrors
+type V1ConcurrencyLimitDelete400JSONResponse APIErrors
 
-func (response V1TaskEventList400JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitDelete400JSONResponse) VisitV1ConcurrencyLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventList403JSONResponse APIErrors
+type V1ConcurrencyLimitDelete403JSONResponse APIErrors
 
-func (response V1TaskEventList403JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitDelete403JSONResponse) VisitV1ConcurrencyLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventList404JSONResponse APIErrors
+type V1ConcurrencyLimitDelete404JSONResponse APIErrors
 
-func (response V1TaskEventList404JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencyLimitDelete404JSONResponse) VisitV1ConcurrencyLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskEventList501JSONResponse APIErrors
-
-func (response V1TaskEventList501JSONResponse) VisitV1TaskEventListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type V1CelDebugRequestObject struct {
+type V1ConcurrencySlotListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *V1CelDebugJSONRequestBody
+	Params V1ConcurrencySlotListParams
 }
 
-type V1CelDebugResponseObject interface {
-	VisitV1CelDebugResponse(w http.ResponseWriter) error
+type V1ConcurrencySlotListResponseObject interface {
+	VisitV1ConcurrencySlotListResponse(w http.ResponseWriter) error
 }
 
-type V1CelDebug200JSONResponse V1CELDebugResponse
+type V1ConcurrencySlotList200JSONResponse V1ConcurrencySlotKeyList
 
-func (response V1CelDebug200JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencySlotList200JSONResponse) VisitV1ConcurrencySlotListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1CelDebug400JSONResponse APIErrors
+type V1ConcurrencySlotList400JSONResponse APIErrors
 
-func (response V1CelDebug400JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencySlotList400JSONResponse) VisitV1ConcurrencySlotListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1CelDebug403JSONResponse APIErrors
+type V1ConcurrencySlotList403JSONResponse APIErrors
 
-func (response V1CelDebug403JSONResponse) VisitV1CelDebugResponse(w http.ResponseWriter) error {
+func (response V1ConcurrencySlotList403JSONResponse) VisitV1ConcurrencySlotListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
@@ -7255,6 +8426,205 @@ func (response V1FilterUpdate404JSONResponse) VisitV1FilterUpdateResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type V1RateLimitDeleteByPrefixRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1RateLimitDeleteByPrefixJSONRequestBody
+}
+
+type V1RateLimitDeleteByPrefixResponseObject interface {
+	VisitV1RateLimitDeleteByPrefixResponse(w http.ResponseWriter) error
+}
+
+type V1RateLimitDeleteByPrefix200JSONResponse V1DeletedRateLimits
+
+func (response V1RateLimitDeleteByPrefix200JSONResponse) VisitV1RateLimitDeleteByPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDeleteByPrefix400JSONResponse APIErrors
+
+func (response V1RateLimitDeleteByPrefix400JSONResponse) VisitV1RateLimitDeleteByPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDeleteByPrefix403JSONResponse APIErrors
+
+func (response V1RateLimitDeleteByPrefix403JSONResponse) VisitV1RateLimitDeleteByPrefixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDeleteUnusedRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1RateLimitDeleteUnusedJSONRequestBody
+}
+
+type V1RateLimitDeleteUnusedResponseObject interface {
+	VisitV1RateLimitDeleteUnusedResponse(w http.ResponseWriter) error
+}
+
+type V1RateLimitDeleteUnused200JSONResponse V1DeletedRateLimits
+
+func (response V1RateLimitDeleteUnused200JSONResponse) VisitV1RateLimitDeleteUnusedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDeleteUnused400JSONResponse APIErrors
+
+func (response V1RateLimitDeleteUnused400JSONResponse) VisitV1RateLimitDeleteUnusedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDeleteUnused403JSONResponse APIErrors
+
+func (response V1RateLimitDeleteUnused403JSONResponse) VisitV1RateLimitDeleteUnusedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Key    string             `json:"key"`
+}
+
+type V1RateLimitDeleteResponseObject interface {
+	VisitV1RateLimitDeleteResponse(w http.ResponseWriter) error
+}
+
+type V1RateLimitDelete200JSONResponse V1DeletedRateLimits
+
+func (response V1RateLimitDelete200JSONResponse) VisitV1RateLimitDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDelete400JSONResponse APIErrors
+
+func (response V1RateLimitDelete400JSONResponse) VisitV1RateLimitDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDelete403JSONResponse APIErrors
+
+func (response V1RateLimitDelete403JSONResponse) VisitV1RateLimitDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitDelete404JSONResponse APIErrors
+
+func (response V1RateLimitDelete404JSONResponse) VisitV1RateLimitDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitResetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Key    string             `json:"key"`
+}
+
+type V1RateLimitResetResponseObject interface {
+	VisitV1RateLimitResetResponse(w http.ResponseWriter) error
+}
+
+type V1RateLimitReset200JSONResponse RateLimit
+
+func (response V1RateLimitReset200JSONResponse) VisitV1RateLimitResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitReset400JSONResponse APIErrors
+
+func (response V1RateLimitReset400JSONResponse) VisitV1RateLimitResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitReset403JSONResponse APIErrors
+
+func (response V1RateLimitReset403JSONResponse) VisitV1RateLimitResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitReset404JSONResponse APIErrors
+
+func (response V1RateLimitReset404JSONResponse) VisitV1RateLimitResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitGetUsageRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Key    string             `json:"key"`
+	Params V1RateLimitGetUsageParams
+}
+
+type V1RateLimitGetUsageResponseObject interface {
+	VisitV1RateLimitGetUsageResponse(w http.ResponseWriter) error
+}
+
+type V1RateLimitGetUsage200JSONResponse V1RateLimitUsage
+
+func (response V1RateLimitGetUsage200JSONResponse) VisitV1RateLimitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitGetUsage400JSONResponse APIErrors
+
+func (response V1RateLimitGetUsage400JSONResponse) VisitV1RateLimitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1RateLimitGetUsage403JSONResponse APIErrors
+
+func (response V1RateLimitGetUsage403JSONResponse) VisitV1RateLimitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type V1TaskListStatusMetricsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params V1TaskListStatusMetricsParams
@@ -7408,51 +8778,96 @@ type V1TaskReplayResponseObject interface {
 	VisitV1TaskReplayResponse(w http.ResponseWriter) error
 }
 
-type V1TaskReplay200JSONResponse V1ReplayedTasks
+type V1TaskReplay200JSONResponse V1ReplayedTasks
+
+func (response V1TaskReplay200JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskReplay400JSONResponse APIErrors
+
+func (response V1TaskReplay400JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskReplay403JSONResponse APIErrors
+
+func (response V1TaskReplay403JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskReplay404JSONResponse APIErrors
+
+func (response V1TaskReplay404JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskReplay501JSONResponse APIErrors
+
+func (response V1TaskReplay501JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskSignalRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *V1TaskSignalJSONRequestBody
+}
+
+type V1TaskSignalResponseObject interface {
+	VisitV1TaskSignalResponse(w http.ResponseWriter) error
+}
+
+type V1TaskSignal200JSONResponse V1SignalledTasks
 
-func (response V1TaskReplay200JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1TaskSignal200JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay400JSONResponse APIErrors
+type V1TaskSignal400JSONResponse APIErrors
 
-func (response V1TaskReplay400JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1TaskSignal400JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay403JSONResponse APIErrors
+type V1TaskSignal403JSONResponse APIErrors
 
-func (response V1TaskReplay403JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1TaskSignal403JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay404JSONResponse APIErrors
+type V1TaskSignal404JSONResponse APIErrors
 
-func (response V1TaskReplay404JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1TaskSignal404JSONResponse) VisitV1TaskSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskReplay501JSONResponse APIErrors
-
-func (response V1TaskReplay501JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
 type V1WorkflowRunListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Params V1WorkflowRunListParams
@@ -10657,6 +12072,76 @@ func (response WorkerUpdate403JSONResponse) VisitWorkerUpdateResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
+type WorkerDrainStatusRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
+}
+
+type WorkerDrainStatusResponseObject interface {
+	VisitWorkerDrainStatusResponse(w http.ResponseWriter) error
+}
+
+type WorkerDrainStatus200JSONResponse WorkerDrainStatus
+
+func (response WorkerDrainStatus200JSONResponse) VisitWorkerDrainStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerDrainStatus400JSONResponse APIErrors
+
+func (response WorkerDrainStatus400JSONResponse) VisitWorkerDrainStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerDrainStatus403JSONResponse APIErrors
+
+func (response WorkerDrainStatus403JSONResponse) VisitWorkerDrainStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerDrainRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
+}
+
+type WorkerDrainResponseObject interface {
+	VisitWorkerDrainResponse(w http.ResponseWriter) error
+}
+
+type WorkerDrain200JSONResponse WorkerDrainStatus
+
+func (response WorkerDrain200JSONResponse) VisitWorkerDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerDrain400JSONResponse APIErrors
+
+func (response WorkerDrain400JSONResponse) VisitWorkerDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerDrain403JSONResponse APIErrors
+
+func (response WorkerDrain403JSONResponse) VisitWorkerDrainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type WorkflowDeleteRequestObject struct {
 	Workflow openapi_types.UUID `json:"workflow"`
 }
@@ -10960,10 +12445,26 @@ type StrictServerInterface interface {
 
 	V1LogLineList(ctx echo.Context, request V1LogLineListRequestObject) (V1LogLineListResponseObject, error)
 
+	V1TaskGetSchedulingExplanation(ctx echo.Context, request V1TaskGetSchedulingExplanationRequestObject) (V1TaskGetSchedulingExplanationResponseObject, error)
+
 	V1TaskEventList(ctx echo.Context, request V1TaskEventListRequestObject) (V1TaskEventListResponseObject, error)
 
+	V1ApprovalList(ctx echo.Context, request V1ApprovalListRequestObject) (V1ApprovalListResponseObject, error)
+
+	V1ApprovalApprove(ctx echo.Context, request V1ApprovalApproveRequestObject) (V1ApprovalApproveResponseObject, error)
+
+	V1ApprovalReject(ctx echo.Context, request V1ApprovalRejectRequestObject) (V1ApprovalRejectResponseObject, error)
+
 	V1CelDebug(ctx echo.Context, request V1CelDebugRequestObject) (V1CelDebugResponseObject, error)
 
+	V1ConcurrencyLimitList(ctx echo.Context, request V1ConcurrencyLimitListRequestObject) (V1ConcurrencyLimitListResponseObject, error)
+
+	V1ConcurrencyLimitSet(ctx echo.Context, request V1ConcurrencyLimitSetRequestObject) (V1ConcurrencyLimitSetResponseObject, error)
+
+	V1ConcurrencyLimitDelete(ctx echo.Context, request V1ConcurrencyLimitDeleteRequestObject) (V1ConcurrencyLimitDeleteResponseObject, error)
+
+	V1ConcurrencySlotList(ctx echo.Context, request V1ConcurrencySlotListRequestObject) (V1ConcurrencySlotListResponseObject, error)
+
 	V1EventList(ctx echo.Context, request V1EventListRequestObject) (V1EventListResponseObject, error)
 
 	V1EventKeyList(ctx echo.Context, request V1EventKeyListRequestObject) (V1EventKeyListResponseObject, error)
@@ -10978,6 +12479,16 @@ type StrictServerInterface interface {
 
 	V1FilterUpdate(ctx echo.Context, request V1FilterUpdateRequestObject) (V1FilterUpdateResponseObject, error)
 
+	V1RateLimitDeleteByPrefix(ctx echo.Context, request V1RateLimitDeleteByPrefixRequestObject) (V1RateLimitDeleteByPrefixResponseObject, error)
+
+	V1RateLimitDeleteUnused(ctx echo.Context, request V1RateLimitDeleteUnusedRequestObject) (V1RateLimitDeleteUnusedResponseObject, error)
+
+	V1RateLimitDelete(ctx echo.Context, request V1RateLimitDeleteRequestObject) (V1RateLimitDeleteResponseObject, error)
+
+	V1RateLimitReset(ctx echo.Context, request V1RateLimitResetRequestObject) (V1RateLimitResetResponseObject, error)
+
+	V1RateLimitGetUsage(ctx echo.Context, request V1RateLimitGetUsageRequestObject) (V1RateLimitGetUsageResponseObject, error)
+
 	V1TaskListStatusMetrics(ctx echo.Context, request V1TaskListStatusMetricsRequestObject) (V1TaskListStatusMetricsResponseObject, error)
 
 	V1TaskGetPointMetrics(ctx echo.Context, request V1TaskGetPointMetricsRequestObject) (V1TaskGetPointMetricsResponseObject, error)
@@ -10986,6 +12497,8 @@ type StrictServerInterface interface {
 
 	V1TaskReplay(ctx echo.Context, request V1TaskReplayRequestObject) (V1TaskReplayResponseObject, error)
 
+	V1TaskSignal(ctx echo.Context, request V1TaskSignalRequestObject) (V1TaskSignalResponseObject, error)
+
 	V1WorkflowRunList(ctx echo.Context, request V1WorkflowRunListRequestObject) (V1WorkflowRunListResponseObject, error)
 
 	V1WorkflowRunDisplayNamesList(ctx echo.Context, request V1WorkflowRunDisplayNamesListRequestObject) (V1WorkflowRunDisplayNamesListResponseObject, error)
@@ -11152,6 +12665,10 @@ type StrictServerInterface interface {
 
 	WorkerUpdate(ctx echo.Context, request WorkerUpdateRequestObject) (WorkerUpdateResponseObject, error)
 
+	WorkerDrainStatus(ctx echo.Context, request WorkerDrainStatusRequestObject) (WorkerDrainStatusResponseObject, error)
+
+	WorkerDrain(ctx echo.Context, request WorkerDrainRequestObject) (WorkerDrainResponseObject, error)
+
 	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
 
 	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
@@ -11397,200 +12914,404 @@ func (sh *strictHandler) MonitoringPostRunProbe(ctx echo.Context, tenant openapi
 	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MonitoringPostRunProbe(ctx, request.(MonitoringPostRunProbeRequestObject))
+		return sh.ssi.MonitoringPostRunProbe(ctx, request.(MonitoringPostRunProbeRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MonitoringPostRunProbeResponseObject); ok {
+		return validResponse.VisitMonitoringPostRunProbeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SlackWebhookDelete operation
+func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
+	var request SlackWebhookDeleteRequestObject
+
+	request.Slack = slack
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SlackWebhookDelete(ctx, request.(SlackWebhookDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SlackWebhookDeleteResponseObject); ok {
+		return validResponse.VisitSlackWebhookDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SnsDelete operation
+func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
+	var request SnsDeleteRequestObject
+
+	request.Sns = sns
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
+		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SnsUpdate operation
+func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
+	var request SnsUpdateRequestObject
+
+	request.Tenant = tenant
+	request.Event = event
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
+		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1DagListTasks operation
+func (sh *strictHandler) V1DagListTasks(ctx echo.Context, params V1DagListTasksParams) error {
+	var request V1DagListTasksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1DagListTasks(ctx, request.(V1DagListTasksRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1DagListTasksResponseObject); ok {
+		return validResponse.VisitV1DagListTasksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskGet operation
+func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID, params V1TaskGetParams) error {
+	var request V1TaskGetRequestObject
+
+	request.Task = task
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskGet(ctx, request.(V1TaskGetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskGetResponseObject); ok {
+		return validResponse.VisitV1TaskGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1LogLineList operation
+func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID) error {
+	var request V1LogLineListRequestObject
+
+	request.Task = task
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1LogLineListResponseObject); ok {
+		return validResponse.VisitV1LogLineListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskGetSchedulingExplanation operation
+func (sh *strictHandler) V1TaskGetSchedulingExplanation(ctx echo.Context, task openapi_types.UUID) error {
+	var request V1TaskGetSchedulingExplanationRequestObject
+
+	request.Task = task
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskGetSchedulingExplanation(ctx, request.(V1TaskGetSchedulingExplanationRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskGetSchedulingExplanationResponseObject); ok {
+		return validResponse.VisitV1TaskGetSchedulingExplanationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1TaskEventList operation
+func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error {
+	var request V1TaskEventListRequestObject
+
+	request.Task = task
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskEventList(ctx, request.(V1TaskEventListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MonitoringPostRunProbeResponseObject); ok {
-		return validResponse.VisitMonitoringPostRunProbeResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1TaskEventListResponseObject); ok {
+		return validResponse.VisitV1TaskEventListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SlackWebhookDelete operation
-func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
-	var request SlackWebhookDeleteRequestObject
+// V1ApprovalList operation
+func (sh *strictHandler) V1ApprovalList(ctx echo.Context, tenant openapi_types.UUID, params V1ApprovalListParams) error {
+	var request V1ApprovalListRequestObject
 
-	request.Slack = slack
+	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SlackWebhookDelete(ctx, request.(SlackWebhookDeleteRequestObject))
+		return sh.ssi.V1ApprovalList(ctx, request.(V1ApprovalListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SlackWebhookDeleteResponseObject); ok {
-		return validResponse.VisitSlackWebhookDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ApprovalListResponseObject); ok {
+		return validResponse.VisitV1ApprovalListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsDelete operation
-func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
-	var request SnsDeleteRequestObject
+// V1ApprovalApprove operation
+func (sh *strictHandler) V1ApprovalApprove(ctx echo.Context, tenant openapi_types.UUID, v1Approval openapi_types.UUID) error {
+	var request V1ApprovalApproveRequestObject
 
-	request.Sns = sns
+	request.Tenant = tenant
+	request.V1Approval = v1Approval
+
+	var body V1ApprovalApproveJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+		return sh.ssi.V1ApprovalApprove(ctx, request.(V1ApprovalApproveRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
-		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ApprovalApproveResponseObject); ok {
+		return validResponse.VisitV1ApprovalApproveResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsUpdate operation
-func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
-	var request SnsUpdateRequestObject
+// V1ApprovalReject operation
+func (sh *strictHandler) V1ApprovalReject(ctx echo.Context, tenant openapi_types.UUID, v1Approval openapi_types.UUID) error {
+	var request V1ApprovalRejectRequestObject
 
 	request.Tenant = tenant
-	request.Event = event
+	request.V1Approval = v1Approval
+
+	var body V1ApprovalRejectJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+		return sh.ssi.V1ApprovalReject(ctx, request.(V1ApprovalRejectRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
-		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ApprovalRejectResponseObject); ok {
+		return validResponse.VisitV1ApprovalRejectResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1DagListTasks operation
-func (sh *strictHandler) V1DagListTasks(ctx echo.Context, params V1DagListTasksParams) error {
-	var request V1DagListTasksRequestObject
+// V1CelDebug operation
+func (sh *strictHandler) V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1CelDebugRequestObject
 
-	request.Params = params
+	request.Tenant = tenant
+
+	var body V1CelDebugJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1DagListTasks(ctx, request.(V1DagListTasksRequestObject))
+		return sh.ssi.V1CelDebug(ctx, request.(V1CelDebugRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1DagListTasksResponseObject); ok {
-		return validResponse.VisitV1DagListTasksResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1CelDebugResponseObject); ok {
+		return validResponse.VisitV1CelDebugResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskGet operation
-func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID, params V1TaskGetParams) error {
-	var request V1TaskGetRequestObject
+// V1ConcurrencyLimitList operation
+func (sh *strictHandler) V1ConcurrencyLimitList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1ConcurrencyLimitListRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskGet(ctx, request.(V1TaskGetRequestObject))
+		return sh.ssi.V1ConcurrencyLimitList(ctx, request.(V1ConcurrencyLimitListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskGetResponseObject); ok {
-		return validResponse.VisitV1TaskGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ConcurrencyLimitListResponseObject); ok {
+		return validResponse.VisitV1ConcurrencyLimitListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1LogLineList operation
-func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID) error {
-	var request V1LogLineListRequestObject
+// V1ConcurrencyLimitSet operation
+func (sh *strictHandler) V1ConcurrencyLimitSet(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1ConcurrencyLimitSetRequestObject
 
-	request.Task = task
+	request.Tenant = tenant
+
+	var body V1ConcurrencyLimitSetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
+		return sh.ssi.V1ConcurrencyLimitSet(ctx, request.(V1ConcurrencyLimitSetRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1LogLineListResponseObject); ok {
-		return validResponse.VisitV1LogLineListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ConcurrencyLimitSetResponseObject); ok {
+		return validResponse.VisitV1ConcurrencyLimitSetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1TaskEventList operation
-func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error {
-	var request V1TaskEventListRequestObject
+// V1ConcurrencyLimitDelete operation
+func (sh *strictHandler) V1ConcurrencyLimitDelete(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1ConcurrencyLimitDeleteRequestObject
 
-	request.Task = task
-	request.Params = params
+	request.Tenant = tenant
+
+	var body V1ConcurrencyLimitDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1TaskEventList(ctx, request.(V1TaskEventListRequestObject))
+		return sh.ssi.V1ConcurrencyLimitDelete(ctx, request.(V1ConcurrencyLimitDeleteRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1TaskEventListResponseObject); ok {
-		return validResponse.VisitV1TaskEventListResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ConcurrencyLimitDeleteResponseObject); ok {
+		return validResponse.VisitV1ConcurrencyLimitDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// V1CelDebug operation
-func (sh *strictHandler) V1CelDebug(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request V1CelDebugRequestObject
+// V1ConcurrencySlotList operation
+func (sh *strictHandler) V1ConcurrencySlotList(ctx echo.Context, tenant openapi_types.UUID, params V1ConcurrencySlotListParams) error {
+	var request V1ConcurrencySlotListRequestObject
 
 	request.Tenant = tenant
-
-	var body V1CelDebugJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.V1CelDebug(ctx, request.(V1CelDebugRequestObject))
+		return sh.ssi.V1ConcurrencySlotList(ctx, request.(V1ConcurrencySlotListRequestObject))
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(V1CelDebugResponseObject); ok {
-		return validResponse.VisitV1CelDebugResponse(ctx.Response())
+	} else if validResponse, ok := response.(V1ConcurrencySlotListResponseObject); ok {
+		return validResponse.VisitV1ConcurrencySlotListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -11768,6 +13489,132 @@ func (sh *strictHandler) V1FilterUpdate(ctx echo.Context, tenant openapi_types.U
 	return nil
 }
 
+// V1RateLimitDeleteByPrefix operation
+func (sh *strictHandler) V1RateLimitDeleteByPrefix(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1RateLimitDeleteByPrefixRequestObject
+
+	request.Tenant = tenant
+
+	var body V1RateLimitDeleteByPrefixJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1RateLimitDeleteByPrefix(ctx, request.(V1RateLimitDeleteByPrefixRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1RateLimitDeleteByPrefixResponseObject); ok {
+		return validResponse.VisitV1RateLimitDeleteByPrefixResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1RateLimitDeleteUnused operation
+func (sh *strictHandler) V1RateLimitDeleteUnused(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1RateLimitDeleteUnusedRequestObject
+
+	request.Tenant = tenant
+
+	var body V1RateLimitDeleteUnusedJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1RateLimitDeleteUnused(ctx, request.(V1RateLimitDeleteUnusedRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1RateLimitDeleteUnusedResponseObject); ok {
+		return validResponse.VisitV1RateLimitDeleteUnusedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1RateLimitDelete operation
+func (sh *strictHandler) V1RateLimitDelete(ctx echo.Context, tenant openapi_types.UUID, key string) error {
+	var request V1RateLimitDeleteRequestObject
+
+	request.Tenant = tenant
+	request.Key = key
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1RateLimitDelete(ctx, request.(V1RateLimitDeleteRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1RateLimitDeleteResponseObject); ok {
+		return validResponse.VisitV1RateLimitDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1RateLimitReset operation
+func (sh *strictHandler) V1RateLimitReset(ctx echo.Context, tenant openapi_types.UUID, key string) error {
+	var request V1RateLimitResetRequestObject
+
+	request.Tenant = tenant
+	request.Key = key
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1RateLimitReset(ctx, request.(V1RateLimitResetRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1RateLimitResetResponseObject); ok {
+		return validResponse.VisitV1RateLimitResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// V1RateLimitGetUsage operation
+func (sh *strictHandler) V1RateLimitGetUsage(ctx echo.Context, tenant openapi_types.UUID, key string, params V1RateLimitGetUsageParams) error {
+	var request V1RateLimitGetUsageRequestObject
+
+	request.Tenant = tenant
+	request.Key = key
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1RateLimitGetUsage(ctx, request.(V1RateLimitGetUsageRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1RateLimitGetUsageResponseObject); ok {
+		return validResponse.VisitV1RateLimitGetUsageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1TaskListStatusMetrics operation
 func (sh *strictHandler) V1TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V1TaskListStatusMetricsParams) error {
 	var request V1TaskListStatusMetricsRequestObject
@@ -11870,6 +13717,34 @@ func (sh *strictHandler) V1TaskReplay(ctx echo.Context, tenant openapi_types.UUI
 	return nil
 }
 
+// V1TaskSignal operation
+func (sh *strictHandler) V1TaskSignal(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request V1TaskSignalRequestObject
+
+	request.Tenant = tenant
+
+	var body V1TaskSignalJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1TaskSignal(ctx, request.(V1TaskSignalRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1TaskSignalResponseObject); ok {
+		return validResponse.VisitV1TaskSignalResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1WorkflowRunList operation
 func (sh *strictHandler) V1WorkflowRunList(ctx echo.Context, tenant openapi_types.UUID, params V1WorkflowRunListParams) error {
 	var request V1WorkflowRunListRequestObject
@@ -13842,6 +15717,50 @@ func (sh *strictHandler) WorkerUpdate(ctx echo.Context, worker openapi_types.UUI
 	return nil
 }
 
+// WorkerDrainStatus operation
+func (sh *strictHandler) WorkerDrainStatus(ctx echo.Context, worker openapi_types.UUID) error {
+	var request WorkerDrainStatusRequestObject
+
+	request.Worker = worker
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerDrainStatus(ctx, request.(WorkerDrainStatusRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerDrainStatusResponseObject); ok {
+		return validResponse.VisitWorkerDrainStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkerDrain operation
+func (sh *strictHandler) WorkerDrain(ctx echo.Context, worker openapi_types.UUID) error {
+	var request WorkerDrainRequestObject
+
+	request.Worker = worker
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerDrain(ctx, request.(WorkerDrainRequestObject))
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerDrainResponseObject); ok {
+		return validResponse.VisitWorkerDrainResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowDelete operation
 func (sh *strictHandler) WorkflowDelete(ctx echo.Context, workflow openapi_types.UUID) error {
 	var request WorkflowDeleteRequestObject
@@ -13991,281 +15910,342 @@ func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_t
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+y9e2/bONYw/lUI/37AuwvYuXU6zzwB3j/SxO14myZZO2mxzzxFlpYYmxNZ8ohUUm/R",
-	"7/6CN4mSSInyLXYjYLGTWrwcHp4bDw/P+d7xotk8ClFISef0e4d4UzSD/M+zm0E/jqOY/T2PozmKKUb8",
-	"ixf5iP3XR8SL8ZziKOycdiDwEkKjGfgdUm+KKECsN+CNux30Dc7mAeqcHv9ydNTtPETxDNLOaSfBIf31",
-	"l063Qxdz1Dnt4JCiCYo7P7r54cuzaf8GD1EM6BQTMac+Xecsa/iEJEwzRAicoGxWQmMcTvikkUfuAxw+",
-	"mqZkvwMaATpFwI+8ZIZCCg0AdAF+AJgC9A0TSnLgTDCdJuMDL5odTgWeej56Un+bIHrAKPDL0DAY+CdA",
-	"p5BqkwNMACQk8jCkyAfPmE45PHA+D7AHx0FuOzohnBkQ8aPbidFfCY6R3zn9Izf117RxNP4TeZTBqGiF",
-	"lIkFpb9jimb8j/8/Rg+d087/d5jR3qEkvMOU6n6k08A4hosSSHJcCzSfEIVlWGAQRM/nUxhO0A0k5DmK",
-	"DYh9niI6RTGIYhBGFCQExQR4MAQe78g2H8dgrvpruKRxglJwxlEUIBgyeMS0MYIU3aIQhrTJpLwbCNEz",
-	"oLwvcZ5xED5hKhbuOBnmPUDEv4qfObVjAnBIKAw95Dz7CE/CZN5gcoInIUjmGSs1mjKhUwfSYmRxxpr+",
-	"6HbmEaHTaOLY60a2Zh0XQRSezecDC1fesO+M3cDggq8mIYj3YVzPqIgCksznUUxzjHh88uaXt7/+1289",
-	"9kfh/9jv/310fGJkVBv9n0mc5HmAr8tEFQx0CRfyARuUgOgBMMyikGKPCzod4j86Y0iw1+l2JlE0CRDj",
-	"xZTHS2KsxMw2sAdMA8RQif2CNAmZAKvgWkk56RBMGspOIAq55NboqkxIXBwaccO+MISIITIYy9K9VpxK",
-	"masWUyHDbjIiLYiyOf49ItRCgRGhv0cTcHYzAFPWSodxSumcnB4eSvo/kF8YcZrUD5zjj2hRP88jWuSm",
-	"mU8f7zPShWPPRw/O5DtEJEpiD5nFuJCJ/pll9RTPkKYUYzkWeIZEitOc1O6cHJ2c9I5Pesdvbo/fnh79",
-	"evrLbwe//fbbm7e/9Y7enh4ddTRzxYcU9dgEJlRhi0DAvqAbDZguwCG4uxMCgg2tAzQenxz/8tvRf/VO",
-	"fvkV9X55A9/24Mlbv/fL8X/9euwfew8P/83mn8FvlyicMCZ/86sBnGTuL4umABIKZP9N4KrAD5hNku2q",
-	"DrqFN26jR2QSD9/mOEbEtOQvUyTYnxErZd2BbH3gvMEzRKEPBUnW6IwcBVvlym1BrqSwHeT39+Tt2zoc",
-	"prB1U/GSIsOIRM9DcypshCH6K0FCmOTxKQwCgdnVqHOGQzuxdjvfehGc4x47LExQ2EPfaAx7FE44FE8w",
-	"wGxfOqfpirtJgv3OjxIhCXhN632XBI/CBus/oZBal4ye1FnIyV41DFlruYoZvv7ods6ZHgocABr4eZAa",
-	"b0d24Eo4tzXZHqcFMQj5kqLQS+IYhd7iEs8wHdEYUjRZCO2dzFiH87Or8/7l/eDq/mZ4/WHYH4063c7F",
-	"8Prm/qr/pT+67XQ7/7zr3/Wzf34YXt/d3A+v764u7ofX7wZX2h5nUIrNUOLBjlHBGIPQzJB+EmeHuucp",
-	"9qacN4XMwARwcjzoLE/E0QzTEAddNRFHqFlAnAnxIGzileQDH9/EGEWkkXkUElTGGlUit4yxHFjVYIhR",
-	"7HCcx1H4JYofH4Lo+TbGkwmKrfsIfR8zKGDwSRPMpYG9OAr73+YxIkTalCXCYU2u5AaU1Xo4T6hx5HmM",
-	"oxhTTtspg+GQvjkR24NnjN7fcPYSfx+XHR0lEcZm65oWp8FZWtXXFIPV0sSMswLRpW2A0iopBXJe17Y5",
-	"Q4Z5LM5QbgM8msxM1v8RLazds23SN6M8hvqqNG06Tmnfyo4o4kVzi/LmnzhwfEDwgAOKGET1nCAMZo61",
-	"bPNGVyPt/GPdRRrNsXcW29hxBv8ThUCZIIBRDPjb2fDq72r1o6sR4GOsIsZSXTzD4f897s7gt/978vbX",
-	"slJOgbVzvXCLnAUopv0ZxMGHOErmdvnNmhCTsAwwoWyNooU6fMek43wyXWL5Pn5CXT5jee0S1LqV15hh",
-	"YnDjXvNPalvZWgGNpB9nLXur1tXtxFGA6qwhsZpPaDZG8ZC1N+KjIwerw4odH+EEh+gzipVAr4dJNXY2",
-	"xYW3bR045EggQTKxiJAgmax/0q70KHNtwQBIcCN83Q1SjJmdF3xB5h3MNDhxVUDZrzda65y3L6/QjZys",
-	"eYfKnp1UjTeaa4Uj3wzRaeTXHyA0dH0SXTQirVRzS9sc3Y6gtIFvnONZwlPz2WoxqQaShIzD2I+vKWim",
-	"gQqz52CVlJHRQboHtXR6iU1yZg4nOEw9kVW7eJO2TA1oLjKfm5wkdb5x8piaaEc7Zl3035/dXbLj09nN",
-	"wHJg0ga4jn0Uv1u8V/dNaphQGZyo5JPJRuJW5zbNzRWtxRX4mqZ3OPVitMhqZXAHF3nhX7y7kzd71oUo",
-	"+h8m4SiZzWC8qIOMb9WXcrcKlhS2arqQr2rDL6DJP9vkJAD+9o/R9RUYLygif683mlNzmU//cTUaUGPs",
-	"APOnyynzvQJ0V6CsAFFKkAscI0+BpKQIJF5H3Onb5YdNAjmInhGCsTc1aiMbvZfvFbg3zni9xK3DhJm1",
-	"jFvThiBOQlI8RVrCGR4gdhhatGoy7hyFPltpzcCyWZOR/0pQUg+xaNVk3DgJQweIZbMmI5PE8xDy64FO",
-	"G7qPnlI5qXIaG05o/NuBfgRdgsdW0Fh2sa55ov8RjQ2CvCoCh8tzLQZHarE/o/HBhu5OSmMSiubu0mtE",
-	"0dyE2EpTmOIZihJqXr78WLf0p1XN4CfN/FXHL750k137j2g8TMIK6SZux9xuvNJOaSiYvckQQWI5mD3g",
-	"EJNps6n/FBRZtaOMaEVLy+6tQHQxIklgdvsSCmPabDGEQpoQh/Uw/STaSvoeJmEzEmeb35zKvUcUV7NA",
-	"k+VqRmkdyJpiLvRc/dgoBlEEku6CnWtG6TYp0+Omf3UxuPrQ6XaGd1dX4q/R3fl5v3/Rv+h0O+/PBpf8",
-	"D3GnJf5+d3b+8fr9e6O1wsw4c6SLa3xcsaths+Uk/EaH2K90tmo8prf2RvuRQZx3fpMXhjcPTe0lqAab",
-	"nMhEZnyZAfQev6DxNIoeX3yRGizrWmI0ucQhahS2w5Qp/8wMCSZZlEoNogkIcIiaxGiI2F7jHGw42aDW",
-	"SLH1Fi0MPokCtvR4lizgOJ3ha4aqS/SEgrzj5t0dEzSDq/fXnW7ny9nwqtPt9IfD66FZpmjjpIcnp/3P",
-	"QWASJPL7y589FVmZpYf4uML5Mz9CwxOo7FxxBjUgQI/i+N4RMRP0fs5p96TbCdE39a833U6YzPg/SOf0",
-	"+Ih7gXOcletsCvaSLcBcUGE68YnTsUqDxRgZib6VR37jNnK2LmOMWkRhoB9iWVPu2QkwoeJmJHtZcORy",
-	"ijNIrH+yE+wnRGPsGeRxmMxu3I7YnI7VQfvAtt5/Op2qxVhYhKzxI7Z1wKHbcVqMKA/VB53aQIQM1Nws",
-	"XR0hJvk/hBTxyJ8yKp18tjET/wEbwCiiA0joED3gwHIhykMXZWyjPhiPa4x5R8SjdzYQAMon+gyDxKJ+",
-	"5PWM7uMQV5wE8Jh56fKVu/6MQz96Nm/7OnzKNYh+sq9DSRPDOmbQR66LEN/MU4hvfBlsL3GoRWJlaBbR",
-	"3Q9R7CHfNeJCOydo+6XWm0KVo7SvOl3vgDLMeMyoDtPPKyjE4hgllSiwqbCmodI4GvJQSEfaebZwT8TB",
-	"s9Gz+ApMUXe6A6LJCXUZj8QK3oSNuQwkSjOfQekAXYz8rOaRdCO6+tlawlIc3Sj+Efvr9cQVD9E8gIuf",
-	"KoRXLElzzBDrynL08LLr05q/PTqqWW8BbtuqbY4Trbu70C54ulzhU9DFjMs5s1ewlTlS1RhiykYt+DgM",
-	"A04QoXexxda6G14CGgGCQp+HFMpjLgE02sylu01BJCH+i1kDPgopfsAoTq1JaQDJdy4i8lF/HjZGQRRO",
-	"FMQ1srK7ycBLN9dmZTDlyJsiPwmQRmmrBk9vOPi526EiyNtdMzaJl84G/6qhx1+fp5c/U2B/jM5/71/c",
-	"sR9N5k8682YD43Y0xK28+izObRvhbI1JbH0RcMMkPNfdno2vTwQA29alGgAuSxw5mapfSh1eMlQwI4rK",
-	"KMEy7e7A8c8gTpziBa2M2ChosDyK7Yio47jagzpCMzifRjEaBRFd8/kwd/YyX+ILhwgJIuEmkj3cLx2W",
-	"PKvJ+13bsthnECdqYfXGiX5RW79QHAQqgsF9pSXRZHDdyCbuoBcYPENLVz+PFs6ejGr026vyfdMUhiEK",
-	"bGDKzwD7ZvcYYYODZzG62fEgRriyvidQU/B3BUtOspLNDGe21bNvKyyddbevmw++yqJ3wtp3s8cVIlJ0",
-	"5+miq5GhUb9QNLeJO3O4zRQHfozyEQM1h/0NhcjMYVx6K10LSYygD8cBsm2u+p5mTRBysJZMVorcssxg",
-	"pwBtFTlyUJEmcgPF1VnF1m8gUuuM9udR7hpSs5PXFM/FifCLzQlSSwO57uQ8SkJqBhdZoVzGf5v1qcBQ",
-	"8cCbC0hziGeS4Xdp+/WzXZRQG4hLciS/Xzx7oCh2R+ba4+NEl4qdWcHIcg0NZW1t4sRB1jRZcdqlYsXM",
-	"4rGE5Tkpp5QC05VVxsBJ1J3F3hQ/ob2US83P2jslYiJ2kDJ3quD6GNF4USFFN8aP2ullOyxRcVDQkKDw",
-	"aD502uh9F871eQY03u3KNpb3dp6dCuwuXt/cQYukM5Cc4kGH9cjLMd6D0Q16Qsrl59p7pPo40d17HBM6",
-	"QsJIdqe9S9i0V8NoZXHKyAFYmDnFrIYmPXxQ7G8FMe/KU7EcmdYScibSleto2Beu9fur6/sv18OP/WGn",
-	"m/04PLvt318OPg1uM9f74OrD/e3gU//i/vqOu69Go8GHK+Gcvz0b3vK/zs4/Xl1/uexffBA+/cHVYPR7",
-	"3r0/7N8O/yXc/7qnnw19fXd7P+y/H/Zln2Ffm0Sfe3R5zVpe9s9G6ZiD/sX9u3/d3434Utia3l9ef7kf",
-	"3l3di+xGH/v/utcvHCxNJKBGL5qJYzSkavGkcoHDwe3g/OyyarSqmxL5171Aw6f+VQHxDW5S5N+idVUA",
-	"fZZCtZjcFcUy9UTfkiDki0oSGQHeWvkLZrwXOTBmhIQhDBYUe+R6Tq8TWjFq5oCYQgKiOUU+kIfMdBDz",
-	"HBtPLGdLLLFyZoqVMkukL5sa5vCoTX3H15SNbpKXxpQz2801s6FHffaUM8Y174CyMO+FKTXPJOoJgu8M",
-	"+a3Hj/yqcDgZIcr+Q7YnIES2if63OWa7zN+4cGCqxxe9xDQEPPPslPy5DoAxAnA+jyPoTXE4EWkqOYKr",
-	"5lcpcwSR8Mi9JaEQS1b5QMvw8FC/SlxonqH3EAdJjBxA4VEkOiD6PQLhD6PNcwaQiKXa73iyoGAYyp3l",
-	"9zzFHGDV4X/wmyKy99xnEnoLa5wveFBNAKQqdlVS1Xr9/HZJYATYLhcGaVDeZrJP/UhTklbeT6mEtDIZ",
-	"+TaTtC6X4qruukIylO2yRX22Y020qLpu4SPkMkVa9XWN4lC5ubK90vN+1NDOzqgSScrNNIjY0zL8L0ZQ",
-	"7ilmGOvVtb4jKBY9bpJxgL0qUuDjVWRp02HemU2X+7fMpg/lPqkTzvWXK35KO7v4NLjqdDuf+p/e9YcV",
-	"x5HqJ0Tcv07sgVkm70sJ5/wtVB0mcnBoDoqquZuMVwwsTRGgKF/HYnpuF3/cs1Nxp9vpfxbnRP18y87P",
-	"Z6OP8s/z4fWVFlNXgfecvWMy+WA8q3iQw78D/obBLJzF0yEagWcY8xQXJUNI9DY/cGn2Vsn8TGk9L4/E",
-	"2PYlmuFfLX1CSg/1rJtSj9u7o7oNa/7caIYoitWjI6VDxVjgb/gAHYBj4MNFFxyDZ4Qe2X9nUUinf18y",
-	"bCBFj/ERkl3kKkTdRAH2DCmMhG1edVxN8/yLpgaDoYHIzbNfXVC7BM6+OulxchWmVmGUuRg0afT5qNPt",
-	"fD6uECZNO4noti0EW1vj9+94nYTXmD1XX3nNY6O1JK61mkI6IPb932PfZOveeFn3xgbdDhspWdDA9fwi",
-	"nmMLB3/hsRb2p1XkBibE9G5fZzERsAEwAXPeGsDQBx4Mw4gCyAuu8EpuKudccbON0BHTmbTWJwN9P0aE",
-	"6L6ZnDWpDvtlFw378DskU5OGmEIy1Yf8P6QwndQZwiAThdBGoqYYOJ9Cap3wM4rxA65DL/cwMfn1JJvL",
-	"Ynw5GMxcNIXEXvLPOAdMa/wBgugW7218TOYBXOSYSO1fY2dOHrtfLQSWr4loZYIQPduRyPkePWdYU5al",
-	"GfYlTIW05uIPHupWBUgKRCX+VoOhlB0prQip48mG8stogsPlM/4vx98rFQDYOYyrNc7rcD1EE0xohXTf",
-	"RXS7aVeLYNjB3VJVyVw3TTfJyRTPyb46GkuO1y1q801oGTGZads+H5/3Ly/QOJmsu/5QV9qyBM+SAFJE",
-	"slTx/MbIi5LAB2PEr/SE9QFDmWE8igHMWdumdPIoVyCqjK7z/iXI2vCzxRMMEkb9xjDQgKL4Bi6CCFo4",
-	"UDQBc9GmvD6oPjHrA0Qh+yFGTzhKSE+GNcoxOlUvgcsT80/l+Wjp5ZZ8WF3ti9DwpmatowxbUoU0EtfA",
-	"BXrZboBVCSe+AbxSm8itbdiJLGzW9NSEJEH6BqSww9novHo2TxhNyEMSGA1Bt9j0MhZUmHopsNUapG0d",
-	"w/KEkH3LLTFdFy+UIdxcPDqLV+GzJxn8fCyqFd5C8lhRWo+iOISBTAZi9TDJZmBwQRQpejAEMXqQB3cs",
-	"DHJIHhn/5ghT76y7ptaaH0XxcP2WMny8F22NBziFtwD5rKnBo4l9YvPaC3RxNKTLxj4RQu8ZxSjLRb8x",
-	"VPwQi+AyRyy0qrJipRTV+EudDooyTLdgKsSnEo62YbTXKHXV24z3CGK8A3DHTvFsEpKMiQgtYij3ueEj",
-	"WxEAqS6N3JIKVDyc5Xu20h5a0smIFyAcITmVZxY0F3Byrr02LL6uNbxDrOeUtNhImeF8OHHNWGUA9hUV",
-	"oHFiDL3OoS5ixwjAcAH+Mbq+6hEUYxjg/3AnqVjZwVIsVDEZd4PRSNk7UQw8SNEkivF/9NoI5bBUhMKq",
-	"l+yEwtlcunTTYjIiqEvUIXWM+9+pYj4yYQLPUGMrJ6FpPzUZ9yBnKiEdBYwXhRkdOZUz060GjIlfm1ce",
-	"kgOvVHvIOO/XTATswClRCSOLJi0jtwSuYJZ6KpNMJYLm0l2XJMHtVjifB9hjDO6UQsIhvYNGgCmxp1PH",
-	"SXiwId1lTzloJ6ufpMRPW4in4hFgnCYm/kulK85mT/dEez8qpcX71L5f2oatshy3pfM140Wpfm7AlGHb",
-	"DyN5HQq2Ygsa2OB1Y69TyFXntLKZ7RlZ6CS9ExpQnYhd0lJ9PrbWjICUotncYgbKj5o0KZaMMDw030oR",
-	"ikBVdKhGUrH6wsvVrig+Ijc5y2i8APz9qQummxfDKKBjhXIY2Ui7wAmVhSs+H4u0s61TralTTeBtMz61",
-	"WI69YZcaA93mtmgu99iCzDKPe88/Vbzm58fD28UcuW1MP22+ZPYA51wWlVYpojFGpH757MuFuO2yJrNk",
-	"bZycT+K9Pnc+NEsToI5zzZKOiSYCOH1qfc8yXJsPR+mW7YQ4zIi+jisUQW44JUDDHABqrNzb/+J7f3Oy",
-	"gGIOgFH/6vb+Vl9MuoZ7od1KCQvOh/2z20Lm4Y+DmxtLQoCcIHX0jbo/XiY4FAH+TZJkoqbEkmVyKs6f",
-	"hFRcmzfN6ZsHoZ7jq4LaBRLsnHcT4ZCKYPbyDkiCMwrQLGOC+X0QnqElU2bLRoaUDE7LMGhbcZPadGd1",
-	"1DgeFLidlIQ2fHqVSZCcbmh1kjPfylZlYSlA2BQj2dIM5J6DTZOLqSTIsm2cX3+6uezflpJsVOQOyV/P",
-	"LJeAVzud57VxNs2q9zHcbJNexhL212o16RdcdjNSteIDEXcPe81dWM0xNbsASXHyDAmQvdzPqX7eLHKL",
-	"EjJsgTZiktWSMAwnvxaH6gIcghkOAkyQF4U+cTNk6wJFCrOAv6UvuyBFhLLf/l5fHMgJ/Wx41c0d/3Vh",
-	"OhUol1Qvg87Uj3MUwjk+uIrCqyQI4DhA/xjxt5Rpqx6ezaOYTyoj1cqN55CdYzoTTKfJ+MCLZodTSL0p",
-	"oj0fPam/D+EcHz4dHxIUP6H4MIJcR3/rhXKszukDDAhaMUo6mY3m8DlE/nklO2oOZdG8zJhVKfXKA4pv",
-	"DSloj/ZEZArltnbqXXC+7xGdU9lZa0Bt4FDnkNTdwKEbSuxeNFSzNKKWpO5lRbmqe2G5jVzj7A4e+8oT",
-	"+iAkKG6u8rDs1vTG3/WCIV8pcpuVuupITl13KkeMOt6cR+EDnhjfl+YvP5wvg11KgCxBfIWQXGdwcqVC",
-	"yjPJh2GGiVZJ+a47sHWrqStcNCpY1qCvUj2jldErsKvu4smzQj7XvPD25G6FzFvwtWjQb9b1U+1lXZdV",
-	"vFQ1cjHmLZ7Je+4Null9NKdTi93LPuWMCVUeFVIUP8AgMA+5NUN05SoAm7EkGgpOEQDQEFlMi4iO7uh6",
-	"bQaNwYW+hrNia7T8REbLcpFjug2wUkkWIXwLKvYip6iXUbpfCyrkJfUooyae2bKROpWqb23adGtJT/K1",
-	"AQ2BzvKrjZTM5RR0e7b6ZaVqXf8grlBmLkvP8vlY5BZoX00sHRBmvgbQS+muPeS/0sXc3DGrbIzWOds6",
-	"Z38u52zrPy2fela0onb7FLA3RmhDB1eNR8lgrkon00omK/Zz9mrm58n7l3LuntSVpBsZmja8QFSlFyvc",
-	"0NdXLsurVUYlU1jviNRfXbD276PYAI867T2p8mR1gXm8YaqSC67C1UONBDhkfY8Xa72v5VcLnRxOFLoV",
-	"ZOWtzZsD+e31a+LbNpBdXJ+yCtiXOjLp1lGDM5MF4+s6P+W89nqM89kHmQPXGJ0hi9yKJGVrTVHtlmZG",
-	"FmuVCc7MydxiSwoT1TeJg0YprGTCGDauCZc5lIjX6fbEVetaJEFejCzKWXxLn//K7DhMS4DBAwgjCuZx",
-	"9IR95HcBBDEM/WimOvFizGMEJihEsTom6NruZGMYb45mfzcJcLm92TYpp3DWIpsJTnu2lK2G7ebFj1Os",
-	"Xq6LlTHlofgeWvaN+wtg6GdZsmMx1HJH6lla7t55tRJ0WSg/tZ3PI99Ctb/f3t6ovCte5KcUHEvku7+W",
-	"uYfiuQyfOTfxV0eEV5OQRGWNHlU0r1o7PyM3UsDStPMp3TqlMj/0bzvdzs31iP/n7pZbITYNKSIUSVX4",
-	"IhE+LJnD04MhmKOY0dVBo5JO8Alifli0v+HPPa0tT4u+IS+hCHhRKDOeBwvLlSMmc35yNb4VZ1SXVdGG",
-	"hOBJiHyQdeKenbu7wQWQ7LP9E1sAxygg1eneeRvOUjnnrFADbqQoBCobx7RlAST0dwRjOkaQVp29c1vF",
-	"s/fzfGMQTFXv/Kn35OjkpHd80jt+c3v89vTo19Nffjv47bff3rz9rXf09vToyP1BIRTMzMyDPqFwHHBn",
-	"1g5COoPf7IQ/g9/wLJmtjwE2b3fY7Y0YeSjNWU9sryZZGxH0JfI8R/EyBDzMz2Wq4p2EbEsG4UPkxg1D",
-	"rQMvAxjZNAFBMzifRjECrJFkxCUXMlJjjfh8plclzunMsqmVSjg7vx187vOnoumfN2d3I0vMu0uglUBW",
-	"GmQlNJP19bjUlUKiFoCsd0eJ3nd11ufd8NIwfFNjlLc3GhKasCzp0cqsR+pdMOu67gQIFWVBRDmQmsmr",
-	"E8xU4OHlH81Zze4UyGGe+Qs1QWA4SeSljLNYGF18JELxiM5aovbyc06zYSQlUv8bjaGxAfEf7cOWFsch",
-	"0s2/68szUYT2X7e/cxf/7b9u+qPz4eDm1uxDyThZG2bUv3z/+/VIvJX5dHZ1Jh7afem/+/36+qN1IFVU",
-	"qeCG02nTHAiW/uJwz9xtkHZeJK1QiefN6cr/jMYWwcq+mAByos9/ROO11jZtoputmFNJig3mEZwsv9bU",
-	"fweNxr9rJUCXFcg7hmZyQrvOUMis9Fsa9EJVtb8vmZtbWGamIkQTRLXvaWHdwg18qF6NiUwFE0RFElov",
-	"6womrG+q6zTX7IG1CNaIxpCiSW0uMg3Cy1y/5jZsZqbmi3oUsxm9Oak/+qupi6vpGrFatUWDC1OyiBTA",
-	"wYURh6r3RxzmDtvv767ObwdczF7cDc/eXTLT6uLsQ6WAZIMo/dmIgvnsBvZS381KeaUg1i3rc64/3Jwh",
-	"srX18S1nko+oKh6V1w40UWzKY49oYYnrUMMzsnQLeVXnHAjIHHn4AXvZJOBvc0gI8sEThjIQ6O9mrrAi",
-	"okHQj7nkIY0TZBi/7g5Nj55JD87HR0dH1mgY4zD5+JWGoSiNFvRnNFZizFWPW1I9rhweLjTitp1LYm55",
-	"an4ZEHIBHesMztDv3Y0RGvbkou8WDQa/1XqVQyYamiTWoItVsqNlA+nhFBrYX6uFyY6c8LTAC3elMEzC",
-	"69hH8bvFBY6RRwvlC89G50xN90fnlXo6G+U9RkFO7+vvnjJazkkxTTLWTDJSASWt7G5ldyu7X0p2W+b4",
-	"CUV7RUTaEqKZjzagaGaPcbOcV+o7W/Prj/hb8uqMRSsm5cueq6/9FfoaBrTI9GJOo+LjHrmobgmR2qh1",
-	"1FNKtXPTv7oQGXayXDuGNEr5pDtpfp53Z+cfr9+/r9WSfNqlzs15gWInxtu8OCnGZEThjSb5S7CyBiNv",
-	"ivwkqMgnaOm8sjr6Unxo5ihgajabiCot1kiV3Pu2DbJjVfZzUrsIq5OAp6xqQkdqqHPRsc4KLTQvzZ8x",
-	"hDE7V1UiNMV0xo+SuYzfFI82T69WtdhbODGhN7DVrmrq8g/X/DpNunUFhFX0I4XCecwOMg9muWBkacGX",
-	"99jCjXUT8gBo44xcjtzLK8d1T0vMK2xuGRTwZpC8KA17X2bgFD/rNe6FuWVGX2aB3ctbiOZoFk8f11BQ",
-	"uf5mqwoMzZotsmzuCsNlQ/RbD57w4QEmAb2pfKYqG1mfqzpdEmRXdy90IRfFvoiqcwCVSNPgFs9QZEnz",
-	"Syj2Hhe2IA/2DRB59eF226fxdAPWIoVK4vZsMi5APGv3wq7+/8ZZe5yPU2pZavNyA32t5xi+9eu8Y2lC",
-	"QzuxJ9tCuAhMyC5XCrWAYsSDpc7tuU1n8FtNi+dmRrMtwamIsk+YHGMHgJmAcIxgjOKzRGS/4Rjl4pn/",
-	"nG3KlNI5Pz5E0SNGqjlmuyp+UnfQpx35HDPrC+f4I5IhL1hGuRhCr0U3cHYz4NmyKXcW5X9NKatzfHB0",
-	"cMQJU7ww7Zx23hwcHxzJx6J8afxBaICfkLzXLs/7Qd1bs1YhIgSkjgq2i1ClTe1cyu8f+LpUNDif5eTo",
-	"qDzw7wgGdMoF91vT96uIpnPmdqZz+sfXboeoTK4MwqyhCoz4Q47vTZH32PnK+vO1xgj6i/rFsma4arVD",
-	"1WCdy+XAARoB6HloTgGN4cMD9mpXn0Jbu/yn40MYMN4LJz1e57jHby7J4Xf+s/7bDwFjgKjBXL/gvxMA",
-	"0zo1rLus5sy7lzB2xlr0WQN+ty9G4LQYwxmiXLn9URFVUpoByMxknVPx+DnlrtJSOjr3C4e0kIsrn25/",
-	"fC3t/S9lbI3S4rrBAgiU+rkiPyXk/eh2fhFU4kUhlSUUZK0zNujhn0Roj2wdNdqqH8dRLCt2F4MmZjBg",
-	"WEA+iGIwhr56CyHAeLN2MExQvI/iMfZ9JMzdjL4FnVSRmaJ4WcTwa7fzrRdL3cw/yBqIXQNhfOXnLOoZ",
-	"8poJ+34VEhcj/BwkzunhXSRk51qIQWBHbFoBceljmjKZVGKLRiBROM9j44dZRK9lIcYlmGDPiQEBaCsG",
-	"HMWAoJbNiQFdQc5xj0aPKGRaUf3NteE8IgajYYieokcEYMgsMMBby/CgdMaCmJjjW9ZKeRBYdxcpkQ5v",
-	"kQkK1p1SdzFfnqRzDt3PTdSkCVVL0mEbeyt3TpFx9lsVJadbnqNgL4gS/1A/ytqt3VJ6KHWc4IMAHBIK",
-	"Qw+ViPicfVbxDHYjePO45YCAJEzfJe4MgdVY7QLB+gWx3PpP2pXOt54aohfNRXSF1Gjafgv/6+F3/t8f",
-	"VfvNpFRaLDm/odwNKzayVhKJatQ244R/3aoQWt9my3wqNcpbZD19kmJNYIPvWCvbciSuYSYjb4HiCqkm",
-	"6OerncIP68Qa35ZUqtXQ/EUqwF473V9wEm5pf7dof4aW1uFW7b09xS3TLDWhqVQl7okiX4cKZ2Mccoe2",
-	"2CVi3fFLTNgBKAC51rYNZq0H+YYb2202l9xxbcqGm6/ScuRWt0uEkG4934jCJpT3P7fJUYhpxKT54XfB",
-	"8T8O53E0RvbDpbrIAzC7K6YR4H5djq/8k3E7w6dT30SEDpPwhs/r7puyKb1Ucm1Z61UQlEyvIOiJ4/dg",
-	"q1rhKqIAJnQaxfg/DIpIJVoRiSDEs8CSm5PyQv5A+O0B3x7wXsrzQbatZsWRIzMSQO/x8Dv/j4MXH4xY",
-	"Q/X6vkQ5/KvMWOPutM+NaSUeDuJOeufzONkl0+Z4O2DchRkJi4nfbmdikQiJ55ODQRA9s+lNNwJFqlWi",
-	"l/9eZWIJostzTEgOv5OQOHHL1UiX+mV+CUkDNskPZmcUqbl3jk0KyGgZZQcZpUSwKatcjSoZJSQGNlGG",
-	"i+ZtMpsubF51JC6xSOO7sRezP7p2R8AjWizrCdBgOHn7NgfE8TpsoHkcsX8gv9VhO8SatkMkT9oO4Hyu",
-	"qL2s1kSbAj9SOA7QoQ8n5DDN92w9NBJ+auTtAJ1CCsYoiMKJ/ow9zS0MJ+Uj5efjC8gLxNzKomf17jJV",
-	"ECfLCCLyAHOW+StB8SLjGR9O7rFfreY29STBSe4U4H2pg48z9a6tat0FnKTV/oxJmirkEJtS3f7xWV+3",
-	"l7Dbebst4cdOoXg2D9AMhbRkG3DnRVo6WF2dQ/JolDC84eF39p+a6yWR3n68EHxTFCBsAkdXu6giaFP6",
-	"DNAtq/x8uUSLUFAFF3VYSo9vNunHLyTyb+R641h97fz5izj7bH7WW71iHrMUHqJEZAXaERGR8XNJRNjP",
-	"DNRFhBwG0aTOVgmiCQhwiFSqHQlHUaJcRpNLHIoiDDsuVTbL9joiGihl+XSrvbvLa8aU+jTSv4wmq1M+",
-	"+/9e9l7OfsOjVYixEn9aAGYfyL9bkVWLRoA84rlFqUYPDwTldar+xObXX4wJtqqn49nnwHhhmZJ/bjjj",
-	"5tV6ttdLXNK3pner2nMyziRhVlfzvIXmJvRQcOijcTKxOwr7okgmAhCc9y/1cqBwAnFIssIysjChDyk8",
-	"MMjDcxRc8Kn25Vpz/VH1n4/P+5ccCTVB9ByThIlCXqiQiQkz8rcaS6+Dr3KN1Yg6WWJVirr8Glq7Rr8N",
-	"GCeTEotpPH/ev7SzvBOvO9g1wgmZFz1pOcUiPzezbXbxnuBnsm+65Wy7yqH4iBZclIiEp/ZpWbuO0aFb",
-	"G5vIn8fWe27Po5BgH8WKxLijO/J4WgMfwAfKEy9gosoxm6AkWIRaGJBTkRytKSxj9BDFqBaYJKQ4WAMw",
-	"okY32yQdGhiXK11r9wHFupQG+LI34Jad3bCr3n1dudzVYAapN8X8+sNDMYU4zJ76Vq0zTUGFlqDkUsVZ",
-	"58WlWyJXOV4wdYdjIK5MTBDLLFUvui3jBYCGUuRRqJ9LLK7UctZM40IMGczVNI9o0RPFM+YQxwT8zUdc",
-	"8DHuWwAI/n36778XxVblRazbzREvIO8kD0VL13WJwvQrwbvZM6r7+bT1QNV5oFLecAwdb2CgHXI17Gil",
-	"Cd3uZKl9RIt9MdY2/pRC4aIpI3B0t8xgYgYgrcd1MoSQpC7MIFvWcoJQfO2hZVcPLbe5rGu+k5qutXEr",
-	"pygZotzkF3MerJ7FuZlFQpIxQRR4MPQxf1Gv6HqtNkrVisEdQT5nIwELZUZ4GR5IlWcHM2vRko96q+aN",
-	"xtoNxLoSMa1Mz8t0hZdMoAv8Vkn0rsWDLOp2AwhC9CwHtopm0fZ1u4g5CgQ6XNzE3EuckrIoSy18h9v0",
-	"DEvyqGM9WdFBA7i9+NrWxddVdteVY/iUP1PedOd5dyvu8PvTcU/87fKQA9ZJisYpynbLjJPcivkLNF+t",
-	"xQBeirW9DX5xFA3q1UorFl5SLLiyflcjTKb6K4JOUwMeYErMoadiNtfg053m51fOxZOItsrdmnZiCR1b",
-	"ZLTKhIj1anPPn3bl1GaaTvAlGW4TRwCxSUsfAV4gzaKzfFCZFVv5sH9a3sHY5xG0s6wOTIVZICWjevIE",
-	"4iQEsmd1hkZxT3uJCRV3tarszL7KNF7pjTvRGB9PRM4ahYaaKAgHQBsFIvCHgaHfEJp1hUEUfbPc+xv6",
-	"aVHfGg+wfKH4Mi8SRUEYTsr/h+iPKy1AywIyrP29an3PW2+U2LLHnuLahgdkpEXtspdGlmeVoiEOJ/ei",
-	"PM5mIN98sPYwCZXYaP4MSxdV7ZPJ3XkPxfdmlmoDt3Bpd7U2j3BIHZXbDIcJRezMq/6KEXz0o+cw1XcN",
-	"dN0HRG/Y5Puu6bhWUWF+WhS+9Ap3ulqV1ZOjk+PeEfvf7dHRKf/f/1ikkioT/CDM/XVoIQ5pGgSogxox",
-	"+FYAVlXxfccHbw7u5mVjjtSWkI6cT1r5uKPyMb87a5eS5NDjpSztD0pEqcv0ebtJ3okmr/sWkKOAmyo1",
-	"9RZEypAIeAppW30QwicNkC/SjtRe/6nmbc6J9uFbSUYVJMPaJVOM5gFcVNWKYN8rJZNo8qolk0BBE8kU",
-	"K6RtUzIJMF0FUyxbt3KplUsluVSQC2uUS8pz1YuTsC7ONf9Cpi7SVauO3Ya77v4bPSJfLTm9S9naCyfu",
-	"DYZxgBHh77qRE3gbdE0HkDYBZV1+6TPDK6nH3pN8uOQASPZc6n5W+V5qKddzOWJ6T3zmz7z2bOrpr4/z",
-	"RvGGXeRp+e8I4NALEp8nRSRMKUdhsNB/T/P0mQRSGCzuVQM7I5RLidfcLOSSNjrg7IUuGQypJa23DQ6v",
-	"WLd062AQzw8BnHBV+yzpIoq5haKTQXoJD0MfRAllf87hIoigTwAOZcVaYecegAtRkZ3z6b8ZPfwb4AeQ",
-	"hARxNW5avpzpXg3aqSShreWvaxpq31rVu5ZjJmdRaoatsh3BkP2+Rgv30MeEmdI9Rtl19q5sy4YFvD0T",
-	"JXYjuNoGvhCDXbFx9toe1kQrKdagF0iRt/YSfRJ1dkNAk6Uvkt53s/LKTAKt6GpFV1PRJY2Q2mJA8p1T",
-	"waypEE3tk6djiToNKTWuRR27/O5D4XCbHkZdtvACQaTR86cchbRx0sXnSAUGWgOD5/n5+9NxT/+lLnV2",
-	"juSYqY/12n40ShWuTJ/yvx2fE8X/dsAcTlC1DHB8+ZCDQZwAJ7ynOQBaX97evjtYgstazb1Hae0dGbpb",
-	"IuglWPxQJpGqK06ble3L8/1BLRePVJaqJXlZn16z2X9O1tad0S1L7+jt3HmUBKJYDncrmyyXHQpxynFV",
-	"mjLuRWSNczLxrPCP8Ge4Hx3StNPOPo3Xkz0mE6vG65CfV6IuleetFaqtnVSUXRTPcDipt5Zku8bS6wOi",
-	"t3KKvT37GGWQj+Z0KgKfRHA08FSFLkt1NdZh5woaiM1pJcneS5Iq/ly3eEFzKVPUnz8OYexN8ROqs4Jk",
-	"Kwkm624UISOK5jKo6UwN7CA+1Hj2Ir4S3jbAaTeLrMh9l3ve1lnZi7DOlOsKoZ1lIZVjf43502rI7Kch",
-	"kzcVJZEVC9fLpMZFnlzkUV9lBW6l0SuRRm3Np59RFmmMv3lJtES1RQVU2R3dsOBiK4ZeNuo7QE8ocAog",
-	"Fi1zM1cxg6ID1us9RoFvzT2CmOIFfDYNjorEI7xDU0BGopcx4BbycMoo9qvWzz+/W4i1NJz8Wu9rwYOY",
-	"3scx8mTy1AooLrRmy0CS9d+skmqrjr5w1VGzGpCBRhUvpHkEBJGRRJbwxlv+87ke+LLuwBwxuJio7q2f",
-	"CE16mVAcAWGj4BuJ1J+bxpeIutFqSMpHbjKepkjkJopOQ+dqXcYiNEbesFcSeNOcoGn4q5zBeuWz11VM",
-	"HCleJeNsqX27pw1BjH6ExEEDfRMauJSPw5XZcjn2q/N/hmI2HE6q+Wp/8oBuKOpUIKCJcpvHDJEUi1ea",
-	"L5Bks9Vzq+s5ySdLsF6FvjuEASOMcNJDM4iD3iSOknnlxSkz7tQpUJIXHwPwAYAcoMi6Z6xJn7X4wBq0",
-	"9bwUT5gQ0zBzlXUTWt7J3yZWUGsjPeZ89CnPVccYr/5JhX5yK+DGTdeVUN7oaHe8WfZeQgMaaKjla+PZ",
-	"z8ht69WSh7KwWI2GZLunugDVpfrNp0YuOJyMZJ89qSuxJTWpIWYFHanvSctKhmOdAU1r46M57tHoEdWk",
-	"DAJnNwMg2lVzzdkc37JmrT1JDnlc0c2A44MM5SwN+UTFR7U+9KLxyChSoFZjhvTHVYoLhhm1uxF7ayNy",
-	"BCha18zCTbowipO2/LXmZ7MZMzVksCqF4xAtpRUlr0tOlwXNtEnpdjo8gReXdwhOkKW/GyajU5XYXZKF",
-	"ZTCl4cuDi0bVoZcAUIVEDy6WBDF7g7ZCYj8XCIdJKN5RSsfXi4R68P18mUAPPvUOhHnocOhBHhXEkuUT",
-	"RAvwBIMEmbMKprUG/mDsdnzKmx53uuxfJ+JfJ0y8V2cf/LTe5IPZMkR6N8eK7bzxYDt5Bzd5VljqpV0b",
-	"XRPaYy41o4Ujd3UXMh/XYoO0RwCOAI6LGrewTN/4IuE9ghKa+HyR6PHao6tP/ns7sw4lf0rzFH3zEPKR",
-	"pcy42JsGfF5/MDkcJ8GjPZzuXRI8SvIgmUwglUKB9XnFgoEtv6FwIC8pHUhz8dC+vtgx+cDZVBcSZM1S",
-	"wq0wkXBkaOlFcyauTWqIsJJXX7dIIMDdoJAHhg0VCMkCtti/nrPDMjt7bDDVufohGv+JPOpYFAllOUpa",
-	"IbWzQkqWAtmIfOJuNEcfq/DNOfhZP6JFe62XORuXOq1zZLcndtOJHUjf7zr5wK1MF2mmml994S6BgF1R",
-	"zetxq+WqdrUK89UoTBw+YYqaBlirXuagsQH/2upKFSum4WOpKDGF7TY2zBQ+ndHihmKmxQSVtN66v7Uo",
-	"aYESt+BogdsXjYgW4C4TCC0Jo2VLc/RzyjfrCdWUfK5+6Il//xBMHCCKyux8wX8n6cHOhZVFn72Np8nz",
-	"VTVsvRQd+65ba7lXUMguc2+OkQQRZuRqy4qQ38faN63NOGF/3rXuCyds9untcnr3xR7fOnKugG9vOFc+",
-	"im3MuVWab4ZmY858jc5oqpeZxT/xr+0ZTVGjho+lzmgK260xaDqjZbS4HltQjnf4XfzhYAQCKIEAD3E0",
-	"q3v2Jqjh5zAF5bJtsInPW+XdXzbCu8vYgK+Da3coe+SVJVlkyqS5jVmbvJjH0QzRKUpIb8akt1efij/r",
-	"AmSX9D65LsvSTdr1k5zsp1CxFH2jh/MA4gIxFEdqoj3LWG558aV5kXGAYV/WxYt/JShBzmzIWzfmwH+y",
-	"XnvEfPv9SmefHl5s/iSRo73lXmOCJxQTHIWtTNwlmZjuTlkiKs5ZVibGkKIev/x1CVtircVVcV3c0hBS",
-	"dMkatm9Ed7nS2jreE9ZicpOvBlM624GXg0VYtpUiOs9rDQLjNHZuI+MK/iMdN5m4ZagGl+LXZSWu7NGb",
-	"RwH2FvXpk1QHIDq4JE9SYT03vEebOunQhJbl3K2F3WjdrlvPQEYC6D1WJ00asSbgGY2nUfRYvojgn7+I",
-	"r+1FhMiXpOOkyemhgOpdYoctVe+7C2FCp1GM/4N8MfHb7Uz8CdFpJMo6wyCIns2VA8UGcTtQsICuz/jH",
-	"lRjxkFAYUys7jthXoceuzxI6BfywUmTIO4JicX/JAbpmCOU995Ez3xydGPCgcw9HmVQrOaxMEfTlfWsQ",
-	"CYKp8XjyDUdeEmO64PjxougRIzYoT/D/VacHjtL8jIoQ2A4sTQd1OexGV6MiARYEckhaOSzl8NVooKOq",
-	"gSQuYrmVxTsni8uMkEriq9EKqfMKA5sYrI0U5gjI81dlxrz10Wx+UueI3+Kutgy9Qwxt5TxHjq7UqLLm",
-	"VG8bV1ayDOa+3Vxt3l1gQkwzn0FamzG3M+2lyi5cqqR7s+5rZlOF0ErWzYqBgvFCMJSxPPGe+PG6u1ql",
-	"dAu1hJeUD61E2LkiwrqIWEvhYCc5UZvf5oxSNJvLRE28rUNd831LbNNKkKpgUkz4UxspQgQRBLt3QHjh",
-	"S7w6RtkWQ8eIdazIg8ETBrnyMG/esvAuZuaIk1BuVc1DKBzOEx4PIS53Tcv9sROWSpuXo0K+8A1/CYGS",
-	"ranSFyCayWCBOuHyAdGRGLYVLS9nHTTLOGfxNMjh2gPFLh8o1C5tRGrIu/jecxQ/Vj3ezMI6rYESbYxE",
-	"FqIuUPGFI5UhpKruDUNGGkYvOgK1Ha0Tf9du5TTyXz5tjxzExkKv/vYtxz8CG1sqV2WY2W+UdEdtbcu5",
-	"u3f9pjPeMs56IZWr3fNMQwrhXR17m+mGV68sM0y0VeFWPmqqJ0D5PAYCx8teUilEi+Nl82yten0sQ9JW",
-	"rahVm7pVS92q4YXUuIlyFcheLpGrCW7ngo+aBylHMO3xdCcTvOb3qPzIsPqA2kTgfNf/WXc7nuOEWg0s",
-	"yXSfL8sLrG8GTcfgHpsJcruWfa/cXp7bXwvn/dL1L4W7eZpanp8P+RVHrYtaXIQIhtaBPqjh6wEfvWXu",
-	"l2fuLDfCjVamRcC4ijc7jyO+3a1De0sO7S867kOXrATZJjU1GdYnccgUztGG7IgRH7uVN3tjTIgNay2K",
-	"n8iiSCPiHcrY5yrYB0F660YMtkYV6/PnWOKCvK9KX7QyYO0AXkJCweCCJ5CdIhBAtYO25CeQ0IFvzX7y",
-	"5sSU/WQLkXtNSt7okqeNrdnRG/slZIn7db6bLCRONxO8pZtF8yrTMfnoASYB7ZwedXOiYhuJmdK53y4z",
-	"+UjkZxovAJ/APKn8ZH8lvg2zq73sWb+9tc5Eb+mYjiV0AQRjSL1p6bKnymJ69bVz9XsSgQzXYGAZo16+",
-	"KnnVBXWD9vaoJumSIJtt3NyQQy+OwnqLhLUCf0bjDCga48mkNnziPI7CV22m7E3WyHRjsc+mnSCamsQH",
-	"NcmBbQe3DZx12cxNwbuqM6WMU3KKbzId69B8qv3Me1yRiXO8AA8y2+faEoLqUoS4JwUdLzaXF1QzCrac",
-	"GTSHjBUs9FbtGqz0kp7bkLnOlO7hd/afnvrVrexMWRE7X3wwwtnzIjTp6m1g5TC6/TI0jvVijJvYZh0t",
-	"1m8xo6nZXUWeIL7+6FZdJq7IXPscnrTDnLUh1dmqzX1w7DdS1muQD276m9OAqxdfv1qoj01oT8m7fErm",
-	"N0cNjsi8/RbPx7t4eJ/DmCHNcl9dAEs0/qJ7MLcEn+G1uRE2eTO8WbjOjI8yAKGQJgQ5lW5SbZc50o54",
-	"X3m4dAHuEYe+E1S8YWOQPuLQr4dm7z0oFM8QgA8M0FLE5DMk6gGjvoTOydHJce+I/e/26OiU/+9/rB4q",
-	"3v2MTWAmXh9S1GNQdFyrgjKIx+ghitEmQX7HZ1gnzBVYfsAhJtPlYVb9t4rndQG9VkxvziNYdr+9Wn9g",
-	"0XZsjzUbiZHcjCOQh0W6pAKGQILGFF2e/fXcwI7Rz/tczLI1w1szfPtmeGtbtrbli7x7ICsWf+UCqE1S",
-	"Xq/fN1CINdPzDFQ/CZh6rPEapi2X8R+OVOfWi7jLXsTNnYtSAtircInWmGqNqb0xprJlZKJ6Lb5Zp6r6",
-	"KYOnXtotl6UvS5jW67Beq8RiAWzWLjn8nv7ZK+VxqY1KMoPc0GbZ89gkAw6seYuNqN7ZcCXz7rbxSsV4",
-	"JQuemgUkWGijJnJpLQy417WI9or7NqmOW1W873FNm5UjboZBmqrhR/ZCqLJaKQQhera/E3J/JnQrOuxP",
-	"cuX6FyvVuRkqQdtqHVXDNjSpe2Ld/K0mt2wW5KnnhLbD34rF7Rd33LmEmlLQVVH5Zp5oarI450c2y2Nl",
-	"EUiJ7G4PlkyJYRK2UnibUljtgLYBTeSv1W7YYiGq5uaoLoFf5UmzFb9O4lcaJHU28dpFrsjS3vOiJKQ1",
-	"ITq8jcp5pcoLwCeIAzgOEJe+mrgxn8Y/ICqywJNzPuPei9661GR7npowt1lLHr0FqQjyab3hljv6HJKW",
-	"S1iYZ/+EoJgcekkco2rOJuJ0IBoC1q3EvXcExR8QPZeDbZDu2EwN6YxD3Ba6eflCN8hLYkwXXIx7UfSI",
-	"0VnCZNcfX5moKjxuy5ObIne+/QYynmA6TcaHHgyCMfQereR8Hs3mAaJI0PQ1mx8Y9RGbSJT5+MCHvma4",
-	"PFfDFwj8zdFJzX2CJ+f1y/NOEfRlTbsgEpthrKGYivUfBWTmcKcWmJ/DEX2EwtguCkbs63KI412bY43D",
-	"s3mccegaIiyKJgHaDL3xoX9yehPoWzO9ZYj76egNh0+YIpfCl8oaFh240e2kvtkIt7zvQM61QS2uT+QU",
-	"PxFgojYmv8DWXnRWqzz3awF7GeXdGk6IOdo7hJ6H5tTueTvj30nqYZOTlKhN33zRp7MZf5IYXExUX5ix",
-	"gvrEyk3010YBpOQlsF3ae3f6ihHPolhRsY19b0Zfok9nU/XP2OBroC+x8pa+aqrTMyQtQV9BNMGhnawu",
-	"owkBOASQ68aDCgPjkg+0GVriKpiNv6UKsk7n6CCaTJAPcNgen3fq+JxX64xqXM/JQTSJElrDDFFC3bgh",
-	"Sl7e1yNpNNqxekotkdYYo5x6XMl2hmZjFJMpnjc4Ammd3I5BQoV8yrrJZ0QbJXDzpM3PQzqK2jPRMmci",
-	"HYP1JDmHhDxHcUUkghCTUpIC1b5KpN6oMTdnY5xPYThJJ9olY8PjkPkpolpxvkfiXJBVntIdmChGEybI",
-	"4qpDn2hBKi2SNE5nU2yjwNglhlHIa6+59sJOVyTkavOQAHqPG7lhGLGRd/iCoUbUNLxxeEIxkSBUlu6V",
-	"7VT8CkHxk8FGHIQP0QdEP8tB11q4RIM0y+hwfHB0cGTKGaGFjfyRdv3qUJPktmKxhVC5CnL+gkCMaBKH",
-	"OeQV7GwmpZIwxOEkm+JbTw3Zi+biiWo2m9q0ZzSeRtFjT0YRHX6XPzi8x2OaQrYuRxmJ392f2smB7FE8",
-	"6URbDuJxfLum4Gv1wsvrheJ7OZ1MraE7ssVXJ+Y4lHh2OSSrpqroXzXHSLuHuCbW2Fm+WU/wm4BexL5J",
-	"1DDMDOWENqmb5g2V2Em3q2XPHWJP7hMobVFTHk15k//xw6GOt8HaEBTm+DBVRghWBZwadPz+hJs2DvyT",
-	"K269YaWI0tJrHWY0VweQcrOaUSH1phW+rkpCFq32hpY34ErgCMjpDZuukBhIFMq294jFkdcEZC2nmTlN",
-	"MsQqzFbQJsWXGU6ZSdLwcadUCA3ORTv5vKFJVo8UwPZ11fZfV5mOQxrFLPm4oVtnYblzQgOT6zW88lny",
-	"ZU/LWy/NW/oTolUYy8Xsc+euZnbgTjDY5upqC2S4PnQWVleey7ZtHDpJhKJ52MoDq4G4GnPWmIlO6fXZ",
-	"JuXz6KeM95TedFg1ZYN0+rvAz4aUliIh5RrqDS1fbcgM2CSOkjnPE5qBoDbKCgrv9BEtOrU5HDYsJFbM",
-	"3a0uldr03TtoTSyVL7yR4FJ5ZayxISolQtNML0sleNlJyXVrYJcDMHjg3m2SMOpAfpdzVQApIjTlKUzA",
-	"A6LeFPm2bNKZ4N9xQ0qSwZJZY14sV4wGb6MkMW1qmDY1zAZSwzQSzVI2EIdbrZwmdxLLMrZmj1wwP4Nc",
-	"3rCUUwFTq5mCrbzbKRMwI8VlTcBi4N8YwRjFaeBf1xgKyCPJhDxI4qBz2un8+Prj/wUAAP//t+QksLXF",
-	"AgA=",
+	"H4sIAEFB02oC/+19a3PbSK7oX2H53qrdrbL8SjI7O1Xng2IrGW0c2yvZkztnN+VDS22Ja4rU4cOOdyr/",
+	"/TbQDzbJbrKpl6WYVVMT2+wHGg2gATQa+GNvFM7mYUCCJN775Y+9eDQlMxd/7F71e1EURvDzPArnJEo8",
+	"gl9G4ZjAv2MSjyJvnnhhsPfLnuuM0jgJZ86vbkJHSRwCvR1svL9HvrmzuU+7Hb89Otrfuw+jmZvQXqkX",
+	"JD+9pQ2S5zn9ukd/JRMS7X3fzw9fnk353aHDOcnUi9mc6nR73azhI+EwzUgcuxOSzRonkRdMcNJwFN/6",
+	"XvCgmxL+7iQhnYo4tGE6o2hzNQDsO96941EMfPNiilcVnImXTNO7A4r1wynDU2dMHsXPOojuPeKPy9AA",
+	"DPiJzusmyuQO/cGN43DkuQkZO090QoTHnc99b+Te+bnt2AvcmQYRdN6I/G/qRYRO/c/c1F9l4/Du32SU",
+	"AIyCVuIysRD5dy8hM/zh/0bknnb/P4cZ7R1ywjuUVPddTuNGkftcAomPa4DmM0ncMiyu74dPp1M3mJAr",
+	"iqKnMNIg9onuw5REDsVkECZOGpModkZu4IywI2y+Fzlz0V/BZRKlRIJzF4Y+cQOAh00bEbof1yRwg6TJ",
+	"pNjNCciTk2Df2HrGfvBIUR43mMzDHk6IX9mfkdopRXlBnLjBiFjPPvQmQTpvMHlMOzjpPGOlRlOmydSC",
+	"tIAsutCUdpmHcTINJ5a9rnhr6Pjsh0F3Pu8buPIKvgO7Of0zXA1dI/YBrgcqSpw4nc/DKMkx4vHJm7fv",
+	"fvrrzx34ofA/+Pvfjo5PtIxqov8ux0meB3BdOqoA0DlcVGzAoLETUrFBR6EIoZID2ykQ/3Pvzo29Ef3T",
+	"JAwn9C+UFyWPl8RYiZlNYPfhBIhcIfYL0iQAAVbBtZxy5BAgDXknh/4Gi1ToqkxIKA61uIEvgBA2RAZj",
+	"WbrXilMuc8ViKmTYVUakBVE2936l3wwUSL/8Gk4cOogzhVYqjNMkmce/HB5y+j/gX4A4dccPnegTea6f",
+	"54E2UqeZTx9uM9J170ZjymO25DsgcZhGI6IX40wmjruG1SfejCiHYsTHcp7cmIvTnNTeOzk6OaFc1jl+",
+	"4xy/++Xop1/e/nzw888/v3n3c+eI/n60p6grY9q7AxPoUOUZBII3ZnSjAENP5MC5uWECAoZWAbq7Ozl+",
+	"+/PRXzsnb38inbdv3Hcd9+TduPP2+K8/HY+PR/f3f4P5Z+63cxJMgMnf/KQBJ52PF0WT78ZUNLP+68BV",
+	"gR88mCTbVRV0A29chw9EJx6+zemYsW7JX6gUQ94FYk2gu8NbH1hv8IySI23gWpwZOQo2ypXrglyRsB3k",
+	"9/fk3bs6HErY9qV4kcjQInE0IvOE6QgDOg5hwiSPT6YQMMwuR50zLzAT6/7et05IBU0HjIUJCTrkWxK5",
+	"ncSdIBSPru/BvtAOYsX7aUqJ5nuJkBi8uvW+T/0HpoP1HulmGZdMHoUtZKWvaoas1VzZDF/pn0/hHPIt",
+	"AOqP8yA13o7M4EqR25psj9WCAEJcUhiM0igiwej53Jt5yZDuJD0sn9npnc6gw2n34rR3ftu/uL0aXH4c",
+	"9IZDCtHZ4PLq9qL3pTe8pr/946Z308t+/Ti4vLm6pf+7OKP/f9+/UPY4g5JthhAPZowyxugHeoYcp1Fm",
+	"1D1NvdEUeZPJDCopkRwP9hYn4pCiJfD8fTERIlQvILpMPDCdeCn5gOPrGKOItJiSeEzKWEuEyC1jLAdW",
+	"NRhsFDMcp1EYfAmjh3tqQlxH3mRCIuM+uuOxB1C4/mdFMJcGHtEhe9/mdMdjrlOWCAeaXPANKB/rwTxN",
+	"tCPPIy+MvARpWzIY1Q3fnLDt8WZA72+QvdjPx2VHR0mEwWz7usUpcJZW9VVisFqa6HFWIDrZxhGniqRA",
+	"5HVlmzNk6MdChrIb4EGnZkJ/+sHYPdsmdTPKY4iv4qSV45T2reyIikfh3HB44ycEDgd07j0/IQBRPScw",
+	"hRmxlm3e8GKo2D/GXUzCuTfqRiZ2nLn/oeJLqCAOUIzz5+7g4i9i9XQaB8dYRozJs5hS938d71N6/6+T",
+	"dz+VD2UJrJnrmVuk69MV9mau53+MwnRult/QJNYJS9+jeitdI2shjO8IfCaWlukCyx97j2QfZyyvnYNa",
+	"t/IaNYwNrt1r/CS2FdYKHhumBq1kb8W66LJCn9RpQ2w1n8nsjoptaK/Fxx4frA4rZnwEEy8gv9GN5QK9",
+	"HibR2FoVZ962VeAQkRD76cQgQuiX1U+6zz3KeFoAAKnXCF83fYkxvfMCF6TfwewEj20PoOyvV0rrnLcv",
+	"f6BrOVnxDpU9O/IYbzTXEiYf7ToNx/UGhIKuz6yLQqSVx9zCOgf9C5M7Y+0cTxyems9GjUk04CSkHcZs",
+	"vkrQdAMVZs/ByikjowO5B7V0eu7p5MzcpXJGeiKrdvFKtpQKNIrMpyaWpMo3Vh5THe0oZtZZ70P35hzM",
+	"J0qdBoNJGeAyGpPo/fMHcd8khgmEwklKPplsJNQ6N6luLqktLsHXibzDqRejRVYrg9s/ywv/4t0dv9kz",
+	"LkTQ/yANhuls5kbPdZDhVn0pd6tgSaaryoV8FRt+5ur8s00sAefPfx9eXjh3zwmJ/1KvNEt1Gaf/tBwN",
+	"iDG2gPnlcsp8LwDdFigrQOQS5Izu1kiAJKSIG8NNEWyVWX6YJJCF6BkSNxpNtaeRid7L9wrojdNeL6F2",
+	"mIJaC9wqGzpRGsRFK9IQznBPNd/6oVmrJuPOSTCGldYMzJs1GZlq32k9xKxVk3Fp08ACYt6sychxOhoR",
+	"Mq4HWja0H11SeVzlNNZYaPjtQDVBF+CxJU4ss1hXPNF/D+80grwqAgfluRKDw0+xf4d3B2u6OymNGSdk",
+	"bi+9hrS1DrGVqjBcBYVpol8+/1i39Mdl1eBHRf0V5hcuXafX0p2kQq5CurHbMbsbL9lJhoKZmwyIGxsM",
+	"s3tqi8TTZlP/m1Fk1Y4C0bKWht1bgugiEqe+3u0bJ26UNFsM7ZKkscV64HxibTl90z80I3HY/OZUPnog",
+	"UTULNFmuopTWgawczIWey5uNbBBBIHIXzFwzlNskVI+r3sVZ/+Ij7Ty4ubhgPw1vTk97vbPeGf35Q7d/",
+	"jj+wOy328/vu6afLDx+02gqocfpIF9v4uGJXzWbzSfBGJzZf6WxUeZS39lr9ESDOO7/jF4Y3D03tJagC",
+	"G59IR2a4TN8dPXwhd9MwfHjxRSqwrGqJ4eTcC0ijsB04TPEzKBIgWcSR6ocTiLolTWI0WGyvdg4Yjjeo",
+	"VVJMvVkLjU+igC01niULOJYzfM1QdU71MD/vuHl/A4Kmf/Hhkv7zpTu4oP/0BoPLgV6mKONI48lq/3MQ",
+	"6AQJ//7ytqcgK730YB+XsD/zIzS0QHnnChtUgwA1ioMyB8ZMJLdzpN0TqueRb+K3N/S3dIa/UDQdH6EX",
+	"OMdZuc66YC/ewpkzKpQTn1iZVQos2shI+rk08hu7kbN1aWPUwsT1VSMWmqJnB2762M1I9rLgyMaK00is",
+	"f4AFS0/VyBtp5DGd/crOxEY6Fob2gWm9/7CyqtlYHgtZQxPbOODAzpxmI3Kj+mCvNhAhAzU3y76KEJ38",
+	"H1BGwcifMiqtfLYQLkS3lw6gFdEQmjgg955vuBDF0EUe26gOhnGNEXYkGL2zhgBQnOg3108Nxw+/nlF9",
+	"HOyKM3YwZp67fPmuP3nBOHzSb/sqfMo1iH40r0NIE806Zu6Y2C6CfdNPwb7hMmAv6WhZJFaGZhbdTTdn",
+	"RMa2EReKnaDsl1ivhCpHaV9Vut6CwzDjMe1xKD8vcSAWxygdiQybAmsKKrWjkRE4aRV7tnBPhOCZ6Jl9",
+	"dXRRd6oDoomFuohHYglvwtpcBhylmc+gZEAXIz+reURuxL5qW3NYiqNrxT+Bn15PXPGAzH33+YcK4WVL",
+	"UhwzsXFlOXp42fUpzd/BE9PK9RbgNq3a5DhRutsL7YKnyxY+AV0EXI7MXsFW+khVbYgpjFrwcWgGpPp2",
+	"chMZdK2bwTmEm8VUG8SQQm7mwqvB9Vy6mw6INPD+F7SBMbxUu/eoTiK0Sa4A8XcuLPJRfR52R/wwmAiI",
+	"a2Tl/joDL+1cm5XBlEOKv3HqE4XSlg2eXnPwM/0LC/K2PxmbxEtng39V0DNenacXnynAD8PTX3tnN/BH",
+	"nfojZ15vYNyWhriVV5/FuW0inK0xia0uAo5S2qnq9mx8fcIA2PRZqgBgs8Shlar6pdThJUMFM6KojBIs",
+	"0+4WmH8acWIVL2hkxEZBg+VRTCaiiuNqD+qQrms+DSMy9MNkxfZhzvbSX+Izh0hM50Y3Ee9hf+mwoK3G",
+	"73dNy4LP4LDjC6tXTtSL2vqFer4vIhjsV1oSTRrXDW9iD3qBwTO07Kv2aMH2BKpRb6/K901TNwiIbwKT",
+	"f4bX2Vr3WAyDO09sdL3jgY1wYXxPIKbAdwULTrKUzuzOTKuHb0ssHbqb142DL7PordD27fRxgQiJ7jxd",
+	"7CtkqD1fICjJIO704TZTzx9HJB8xUGPsrylEZu5GpbfStZDQY2AM0fmmzRXfZdYEJgdryWSpyC3DDGYK",
+	"UFaRIwcRacI3kF2dVWz9GiK1uklvHuauIRU9eUXxXEiEX0xOkFoayHWPT8M0SPTgEiOUi/hvsz4VGCoa",
+	"vLmANIt4Jh5+J9uvnu0o3ZpAXJAj8X6xe5+QyB6ZK4+PY10qdmYJJcs2NBTamsSJhaxpsmLZpWLFoPEY",
+	"wvKsDidJgXJllTFwHHXdiPLnI9lJudTc1t4qEROCIaXvVMH1EUmi5wopujZ+VKyXzbBEhaGgIEHgUW90",
+	"muh9G+z6PANq73Z5G8N7u5GZCswu3rG+gxJJpyE5wYMW6+GXY9gD6IY8EuHys+09FH2s6O6DF8W0C1OS",
+	"7Wnv3G3aq2G0MrMycgAWZpaYVdCkhg+y/a0g5m15KpYj01pCzkS6cB0Nesy1fntxefvlcvCpNwDHvPjj",
+	"oHvduz3vf+5fZ673/sXH2+v+Z/r18gbdV8Nh/+MFc85fdwfX+FP39NPF5Zfz3tlH5tPvX/SHv+bd+4Pe",
+	"9eB35v5XPf0wNB34dtD7MOjxPoOeMok69/D8Elqe0+9yzD79+v7325shLgXW9OH88svt4ObilmU3+tT7",
+	"/Va9cDA04YBqvWg6jlGQqsST8gUO+tf90+551WhVNyX8p1uGhs+9iwLiG9yk8J9Z66oA+iyFajG5K/2Z",
+	"pZ7oGRKEfBFJIkMHWwt/wQx7xQfajJBu4PrPiTeKL+fJZZpUjJo5IKZu7IRz8HpwI1MOop9j7YnlTIkl",
+	"lBuYLuX5yZCMwmAc1z6fY80cV7z+S9z4wXlyvSR27giVndR8pz/KpDsYbebFtOHds3N84FyJDyBoJvB5",
+	"7MUsSecTy9MHWTxjzBAbk0QfPrZcSo2lUmLIJ1kNk4/U5uzDNWWj6wS9NlfOZpPkrOk1ojlXjnbNW3DK",
+	"6fdCl1NoEnYYp+4N8Lrme35VyHoJ/BNvTrKxNBk9yIZHJ8bHOQhM9fisF5sm5uyK74wcl7K9O6ewu1R/",
+	"pWyNefYQwVXzi1w/jEgw5HBBKNiSRSLTMjwYo1iJC8Wl9YEiOo2IBSgY/qICol6AxPiiWz8nBJji+ObL",
+	"qSya2Q34zuIFVTF5WXXcovtNENkHdPYEo2djgLJzL5o4biKCbjlVrfaCwiwJtACb5UJfRhOuJ23Wd5lL",
+	"tfJiTWTS5VnUN5lddrHcXHX3LJyhTLdE4rMZa6xF1T0RjpBLcWk8r2sODpFULNsrNWFJDe1szVHCSbnZ",
+	"CcL2tAz/ixGUfW4cYL261je0Detxld753qiKFHC8ivRyKsxbs+l8/xbZ9AHfJ2GaXX65QPOye/a5D28G",
+	"P/c+v+8NKuyo6rdPqN3H5ogynduohHN8xFWHiRwcimelau4m4xUjYiUCBOWrWJQOB/bDLZjz8ADzN2bg",
+	"qoY5GP7d4Sf+4+ng8kIJBqzAe07f0al8bjSreEmE3x18fKEXzuzNEz3UntwIc3OUFCHWW29aNXtkpX9f",
+	"tZonU2xs8xL18C+X90HSQz3rSuqxezBVt2HN30nRlVLlhb+WEmcoG8v5s3dADpxjZ+w+79N/ngh5gH9n",
+	"YZBM/7JgvINEj/b1lFnkCkRdhVSCa3IvMd28ylyVBQpYU43C0EDk5tmvLhqfA2deHXeV2QpTozDKXAyK",
+	"NPoNHiD+dlwhTJp2YmF5G4gSNz48uMECD68x7a+68ppXUivJuGtUhVRAzPu/w07V1r3xsu6NNbod1lJr",
+	"Yct95kOC+twR/I97y7PG6EfXaA/NEQXJ3I+WzBi9hHvcIKa+YCSM+eFbfOVSiT+uliMsnAYQO8fWVICM",
+	"IZcj3DK4WA4H6+yJjIBFitZCF+sM71rHEz1WoXCD6oDKqczCo1H2Q8GHX914qjsGqYCcqkP+KS5Mxw9G",
+	"pnWyMnVDVvHNOZ26iXFCukEQZ1uDXnSjgZB+5M15qcQcDHpRQXuZCzJq53BlBcbCBdG6b9Uo68E70Zyk",
+	"EPvX2GOVx+5XA4HlK1YamYDSrhmJKIsocUusCfVZD/sC+pCsiPkdAxGrAJFAVOJvORhKuatkvU4VTyaU",
+	"n4dUoC5ej2Ex/l6qPMPWYVyscV6H6wGZUP29QrpvI7rtVAiDYNjC3RI142w3TbU74qk3j3fVm1ryLm/w",
+	"NF/HKcMm023bb8ddsBYemTO2GDc4m5HAlJmPfXR8cp8IjxXk/h15cd74Ucpo04/jmjd3mZ4/5YYMBQ3d",
+	"iOwXMPQiau8A/E1cinzu9wZjw8uxFQUh1M7HTACqoh8s/5xWQtRrciNpBZnppnIBzPOeong5alm8SObz",
+	"Rm4x5y5VSV2jTMSPKBsBB6FEQyVJZuQvnuxAzYLKwgFpMCZq4T8xKhgOmN7KfaRbhfaXAsXB4u9CM8bM",
+	"Xi3AkL1v9FQMXOMLRsK/KyTN8IGgU2jBuATPAC98LHb6YKUPxBtAl3sZqkC8NEAVrus8Hsu5gYtkUflC",
+	"ItupM04URqXFKFG7Up5S1vJdD3Pc1wpUI290JWfIbdewiAvvHq0Y5XvlsvWnfKNTWjmGrB6tl7hDG4na",
+	"vboaXP7Gg23/3jtl4b69/0eljCFY9rfj0975GblLJ6sulrjPtyL2ZqlPZWWc1bXBKJFRmPpj545gGA/b",
+	"FBC0WA6Fynk3xyc6UUZy1SzLrEcX5mRt0J8I9zOgDGrfrEDNwqsq4cuaCEorr8+VREiNcRA39A8RefTC",
+	"NO7wNxh8jL2qtCWawxo+lecrCRORBab6/kHBm5hVz+UZZZgyQMlnQxrBB59ELiQ4TFkVGtwALCvLCoFo",
+	"diJ746N7Fwvv7YTULOxwNjqe3ljdIo7vU1/rF7E9lYpYEKdT6RVOhbw0jGHIdwDfckuU68KqXozpMZQc",
+	"SwabMyLTifG52DUVfhV1gMW5UH2rJA6y/lksSHFEGZbijjvrPeafAkELJYNVwlQ7q9dRK03mJni4fksB",
+	"Hx9YW4OwPRXP7KCp5hbTM3m6BboQDcrhHzOh90QikhXOWRsqvrNFZEWosb5Ts2y0o6w3KoSFzKOgR/le",
+	"RZYz95t4Em2TAlbOlvALFe6YozMLaqMWQBRRC4IJPrgtwZZFrYo73fVBBCn60euyoKjBHBjkMULf47h5",
+	"gpALKweJaF1v1xZy+bDsowLV6vIMcqhMESvRZXSUVhdOYEydnxsOMuFwO6VUvrzy9FfOhYxykY7dWCoC",
+	"9DyOwpmWfm35Yu3E7wUjP8WiVXT1HViBCkHpulN9LOvTyVlsWz3Fs8YorYXBdI9qGKbJUSBCBHJ1xp4h",
+	"VFgqzKXMSuLw5I6RasAOnA/0F3p8BmNQ9JnOT88q8YwmyY2/IqcGu8WUz45rKnnFnBbh0rpmMVU1w5pO",
+	"N6XItJ4DH5Yulp5kX+h5FD+UGCZ4fSt1YZbIyeW3WFR14AZ5hfQDaNhI9bwoWu6zQuQsV5zDXgzS/12c",
+	"0f+/71+AbsJe2d32L26pufRxQNWog/r0d1XEqi5hRT6FdZ0cupRwEsn7eeOgeLzkyC9P+7aCfNXHjTgf",
+	"ljltUJYxfbBCS7Y+bgSHFU09CzeG6uIzDaNkGBmFcwON4Cd9iCUb78C5Ae8ITBKndzF7dQWa6RivS3ir",
+	"GJykitFmlyiyIhkacsQqnVw5smYIyRGxfsvP3MmpkkGqmDFNk1uq3qCQBWTLdsnYndhmIdcBS3ySEI2K",
+	"ZSTWJrqLgu3jlYqjyoHr1dqvFbiQWfnNKbnnEIT9zcBh+E3ArBTHoPPGGFiEszRdBJ+yCvCbACJuLMBP",
+	"sSHVZ4xhVWfPFPHeSAFfnvbuIwn+lDh3hAROKrgceX/mBs9ZCFZE+ErHfKnG1LKFlZagq1qzslwtpcbG",
+	"Owhp23Eo1bUeNHinWy6AYYL4FdXvtjqDwvsMANXpcwcvMZ8dKFrdiUnkub73H9QH2coOFjqtKiZLuSef",
+	"e2ApXkagsISR9x+1tGxZe6QsUGX5UGNhNheP7UUtbva0lAT2xs121ULn+WbRzoprbqX6Z5LJ0F7InFRy",
+	"FAi8zM9oeSgiM10rwOiOxuaF2/nAS5Vu1877NRMBWxDGIYSRwbdXRm4JXMYs9VTGmYo93ZW7zkkCTTp0",
+	"9Y2Awa0y8Da5FlWIXU5Npz1Yk5porthiJqsfpEJ6W8e8QqOJZF23/xXV3rLZ5Z4o6fe4tPggbxwWNher",
+	"jLRNnfmK8iKOflRgyrDthj26igO2YgsamLt1Y68r4KN80pks5IwsVJLeihNQ3NHZBUgYS+66CZ1ublAD",
+	"+UdFmhQr7mrydG6khq8vCuJWI6lYvPblSv8Wc3Dq/Mb0u4Pp+2ww3byWcAEdS1QTzkbaBk6orPv727E0",
+	"sW/E1ls6hPLOD3OpUTG+megxV6rcVIhPSZy7dPRAknzZykItUvJt6qYxHVcEXOb+SG2/5AncGLFHz118",
+	"qURJx/PtmYgFjDRBdR6XV6GnU8KpJAWIGmTWhPQPGEyg4LJOr8ELLI47NMoy5Am7jHkKDGjaZ6wGgbPw",
+	"d0oQ8AreUEsVALwJvCQ+pTSazoi57EASgyMR2+D9aXFfKzZNcyMPnxasesWsOrYTYiTtSkz4z+hDbxLo",
+	"aKHEXMmyu3oPeVCNe8tLyDJeOnC6hejWXDd65mDCVKrH0Y3GW88GFOCZXLw53uaAWHNguhqaei7jjapW",
+	"3iSgHfIoqj9IOKhpkUaKG2kgCiyR2MZUNY2pYnhbT0hVxMdec0QVL/pEW5Rz9WZRuDJZ75D9Aolz4Mfz",
+	"7vve+e3n/vBz9/r0V8zI2z/99DtvfHtz0f2t26eNznsQvyuT+/Jw3uHlzeC0Nyw0+9i9+Hj7pdu/5qls",
+	"Ly9ObwaD3sXp77nO14Pfb0U6WTUh7cXl9S2v3qdXgoYkWcfN075TisJBFlabZTaKxT3V6iJu6u5CXvBO",
+	"LFumXjINqTh0baM9m72mKEofSImAsy0f8WD3iFDOtthteoNnAcWZjGHUynsKXEPVpqwplDQWg69Z8AHs",
+	"PXOwN+2cjpIUnLcs7hvykggfJcLPfbr5pY+p6aZPZhPdeUnkUluRNwEz3QXhKzYOp9HaoJX2by4q/UBv",
+	"MFATFVzd1U/w2UAs2xekDoA8CrEjOx+YQs5HD9eROzLrWaMHJ4EG8jYM5mFOePHeSn/p82xyvcGX3Gj1",
+	"sUL4VbWxM6ToaRzJw3B32tz5UnhIVqyEdJZRTb1KwohWdP1cUcwBr7euORotBpbNFyweYV3KpNKrDmm2",
+	"SFyPOfhyxp7TGmuZQhurOBVmK+DlabMqEeI6qlnNOU8o531ROYJNre5ZhusaCt0Kd07GL1XyViXINVeE",
+	"aFgCQoyVK/1QLPegrxVRLAEx7F1c316ri5FruGXeuVK9ilM67XWh8PSn/tUV/nQ16PU+X10bFVrFTrGM",
+	"87BPB9/QJ8Re+pCmhKM8jy3M38iXot4M5EGo5/6q8BqGBDMXoheFpQcs7wAnPq0wzYpn6DOucvfFAn4k",
+	"3khTncNqGRqlrrnbsYgay0sPVPbTwITPUWU9LKv3byrJ6d+8VRXkKUDYFCPZ0jTkzmGTNnkPXhJnUryI",
+	"CdWKbfLkiRoNd344ehAvlOEkkpHuqNzmH5m7+bB0P9SH6Ujr1z5JQcxrTkMWhBFU9+JhGuiLkCCxX7lu",
+	"yptxf6IcoEkmA4M63XWm6cwNOvItAMnQL2OKEBSDnm1TO0vjchH6kazUaMLcfZJLYMCTJPg+BJQxvWls",
+	"3EZqZWStbJFVjCkQNazqNZPym255smW+mtPLz1fnvetSzaCKUkj5yOTF6okrt+WmBA/LxkeqFmIJQys1",
+	"INTYbrPFLVoxl7N9xFtNGHjNtXEWkJiR6yJPrcZ5Nd8urY5mC5QRRdpI/XD8a3EoeLrmzCjDeTzi2GDT",
+	"3d/TnfYeiSiHZLDcRbMs3aMy334mq6feRL4wyqWSlGGeCCAkdsEIacqZ6B6iEozF/qzd9Kx7LF/Ao/Nn",
+	"mdGaXavSv/2luuymNYHB8KKbPYXVpSqoICrO1zwPlfjjnATu3Du4CIOL1EcnB4QWq6063gzcLJjhjyWv",
+	"Kjeeu+DT2pt4yTS9O6D7cjh1E7olSWdMHsXPh3Siw8fjw5hEjyQ6DF3UpL91Aj7W3i/3rh+TJRMnprPh",
+	"3H0KyPi0UuAoIWyseVn0VNVALQ/IvjWkoB3aE1ba+bpJWqDs/GKdjS8/jfltTa9IymLI8Gpz9e6cXDFb",
+	"a07nvewZfREbQa0frXNxkrlGpVjWZb0YQaxwdotYw0rfXD+ISdRcOfB4t6ZvFWxDIw8wVhCf0oIr++7k",
+	"+O3PR3/tnLz9iXTevnHfddyTd+PO2+O//nQ8Ph7d3/+NrACdVj5YEagtXLDCmXEaBvfeRJufPx+2aR3G",
+	"bvSXKkHlCxBfIb2RNTg8T7RpJp7+QjNR/STmeFI19E7VL/eZc1YkHtKce/K82s/cBaV0ZJlzN88KuUBW",
+	"fiuhfcic34KvRdNnvU7f6qu7VdkPpVS+Evj9qtfNMOa1N+MR+mu8mxmTeTI1WAjwKaeU8IidJ0pUET3b",
+	"DbFhG1Nol4qpX59GslAuiobIglMks3tsgypfl0KjuTxbgVXdKi0/kNKy2Js3VQc4WOZ8ZsK3cMSe5Q7q",
+	"RQ7dr4Uj5CXPUaAmrAzc6DjlR9/KTtPVF40a01M2TMz3EmzGWIlPAgGjdJNvxdWUmSRJI3bGUiMV1ubz",
+	"DJHUAGMPu9krlWBCu0FJhDDQB5bk4RsQ+pPeFfgrnRYDqViepTx4HsbGuF4APncsXCG9hmwq58/kYHLg",
+	"/Gvv5O30X3t/OXDOyL0LV2jgiqd/O6j0Q5Ww2sx0L7LhKkP+GgX5ZaXBfjtmJV/atDQLPwPUX5gqrLv6",
+	"RA+VFxnN3f9CP/vhrwBa33rrW7c1uFr3d9nYXFJ53W7ja2d0/4Z+xRpHnsZKUFLbLmwpYOvMTMjca3m3",
+	"Xs7LJj14qn6iHKSK6C6EQaWBvSeXZ4iJp269/1dN0wHtP4SRBh5hZGOCIJsge2woT/OCh3b52E7+xGx1",
+	"ieVqnd7lNBd7OZwIdAvIylub1yQK8fs1AcULS/kKQ1eZsgrYl7JUVcWqgalqwPiqzNbcZYn6KL77kZdu",
+	"14YPfSF30zB8YGUny9hcS2XBnB3F5uclK7V6aRoZKgmJvrRBo6KEvAQYjKvDZQ4lLHOouRThqhZJlWdq",
+	"PRtMKvwmfQC83hmcEk7/3oGinlA2BAovUcPbiaglFc5EJxENNyEBidwsD6A87U7WhvHmaB5vJwEutjeb",
+	"JmUJZy2yQXCaC35s9J1EXvxYBUTnupirEjGCunUN+4auBshbILOjR2yoxaxxuiXTcNxotRz0z6yn1J1P",
+	"w7GBan+9vr4SpUOgEmIWgcuQb59e5dZl+VVw5tzEXy0RXk1CHJU156igedHaOu+glgIWpp3PcuvEkfmx",
+	"Bw9fri6H+M/NNWohphOShdDGVfG1MXN/8arM8Pqf9ge6apLhdH9Pvg40v4IupIYvTku+kVGaKEHwiW9I",
+	"FAE6Dlqu2uSC+TT7MkdD1gmdQjc31L7h7LN5i41iivgGNIl34dgGWSrn12XHgB0pMoEK4+i2DBLr/Eqo",
+	"DX1H+a6mPkO2VViPBEtmuc5U9M5bvSdHJyedY/rfG+f43S9HP/3y9ueDn3/++c27nztH9Pcj+wxULmNm",
+	"UA96FBN3PjqzthDShs//l2SA9esdZn0jIiM6xxCKNBgXzNrwggtqeoOGBDzIz6Wh4QienM1IP7gP7bhh",
+	"oHSAY80PTSdBTHvNpyE8coe6FYwRF1zIUIw1xPl0T/esK3JlU4sjoXt63f+th7nF5I9X3Rv2CvJs0O3j",
+	"A46vC4a6MbzJMDd2SBkzD/JjkwnXArz1ninW+6ZOEb0ZnGuGb6qXYnutToFDnkWuF2QPYwqeh4iVjKwt",
+	"a8P5m/sqHdGviT/+g+9Npuh07ZtSNUBSBsXprJZ5iRMwskT2U6XkSYF8V5y2x4vP+FKr8xhw/FBJKFCD",
+	"ii+Vh2A6uqMRgRAw8iQzq5azGqgPu+uKpKw+6aWcPbfm8r6ZCY0d0PapbMCck3nuaNdVZ2mFe03DGYGf",
+	"6iavzoJdgYeXfxlvNPUkkIP8gZOH1XeDScovAq2PouHZp5gpO6wzd8vrczbolXF+Cvag8ry2QTx+MA9b",
+	"WhxCpJocl+ddfAF49fv1r3itdP37VW94OuhfXev9dtmRoQwz7J1/+JXaLXgsfe5edNlr+i+9979eXn4y",
+	"DoShw2UBrNKmPuZT/sUiLAKY98qFNP/14opl1oVMPtBeL5P+Hd4ZRDV80QFkRZ9/D+90wnYj+qARc4k7",
+	"MawVviy8VukzdrUHTPW1HE+srK+QVVoBv9dqJieUKzSBzEpfuUYBkWFuBpnIr1bEW+4yF0xy2dE+RmE6",
+	"1wSMBOIpLdMKaKe4lOxsAn2lUqVcB+hzskIStqFSGq0KWQqE57l+S6VNUy/HSinX35zUu5uysmL51exr",
+	"sVq1Rf0z3et0CWD/TItD0fuTF+QcPB9uLqgSj2L27GbA0+zBRcnXmkHE+dmIgnF2DXuJ7/pDeal49Q2f",
+	"53h+2DngeGtjVg1kkk+kKvQc87zqKFbyGJT/0Zv1YnggS/sSi7x+45yMvHtvlE3i/Jmnmnv0XB639hc9",
+	"VxgR0SBGLfvrldI6iVKiGb/u3lYN9pLOmuOjoyNj8JZ2mHzMVMPwp0YLooe6EGO257ihHs3SL0HYibhp",
+	"hyabm3tqXgaEXBDRKgOC1FgPbVSQuQLS++cGg18rvcphOg1VEmOgzzIlHLKB1BAeBeyv1cJkSyw8JdjH",
+	"/lCgHS6jMYneP59RZI2keBI+uOEpHNPUKqo8p7NRPnjEz5376hPHjJZzUkyRjDWTDEUQUyu7W9ndyu6X",
+	"kt2GOX5A0V4RBbmAaMbR+rSTOa7SYK/UdzbW2x1i2ojqVIRLesWzzBQrTzixggENMr2YrLD4jo8var+E",
+	"SGXUOuop5R/LstlnCcg0+RHzmchk0jKRar7ulMRpF7Kb8wLFTIzXeXFSjAMKgytF8pdghQY8AV1F0mBD",
+	"56WPoy/FN6WWAqZms+NTLGJnjI7KPWVdIztWlWiMaxdhdBJgLsomdCSGOmUd67TQQvPS/BlDaNNuVmU4",
+	"FUyn/ciZS/tN8GjzvKlViwXPrwa9vikLfFOXf7Dix5TcrcsgrKIfLhROIzBk7vVyQcvSjC9vPQM31k2I",
+	"QffaGVGO3PIrx1VPG+tX2FwzKOBNI3mJfGqxyMASP6tV7pm6pUdfpoHd8luI5mhmL3WN8nSVN1tVYCja",
+	"rDH1re2GqLcemNsFH2NXZ2jkjYyvq60uCbKruxe6kIN8uVHurDODKtLpXlMbKTTk8o8Tb/TwbAosgm/w",
+	"Ah6vPuxu+xSebsBasXLPVp04ygaIJ+Ve2Nb/3zhBl7U5JZYlNi830Nd6jsGtX+UdSxMa2oo92RTCWWBC",
+	"drlSKFgeEQzQOzUnLacKZ02Lp2ZKsylzOXvZkYIcAwNgxiC8I/QkjropS3SFGEXxjH/ONmWaJHM0H8Lw",
+	"wSOiuQe7yv4k7qBpU/YEOOvrzj3IA4JRIR6PctGE+7NuDiU+LImRoLMo/1dJWXvHB0cHR0iY7FUz/dOb",
+	"A/pH/kAZl4aPkH3vkfB77fK8H8W9NbQKSBw70lEBu+iKXNJ75/z7R1yXeIGAs5wcHWkyhxDXT6YouN/p",
+	"vl+EiZwztzN0A79CoW6e3hogzBqKwIh/8vEpZkYPe1+hP64V8pY/1y8WmnlVqx2IBqtcLgIHqU94BB49",
+	"Ie7vIQt+zeoltLXLfzw+dH3gvWDSoXLB8zt4cxkf/oF/Vv/2ncHok0Sjrp/h3yGjiyimDd0d7M4uQ0sY",
+	"60KLHjTAu302AtJiRJkiwcPtnxVRJaUZHJ6EkDbDB/eSu0pL2VO5nzmkmVxc2rr9/rW092/L2BpCUfk4",
+	"vk99H2pbwcLHuUrkJeTR/XrLqISqcQkvseTO5743Qowe/pun0c/WUXNaYe6MmEmYYtDEzPUBC1AvNHLu",
+	"3LF4f8PAeLNyMHRQfAijO288Jkzdzeib0UkVmQmKv8YmINW/dSJ+NuMH1pe2LBPGV7SzqPwsbxrT75ch",
+	"cTbCj0HiSA/vQyY7V0IMDDts0wqIkw+4ymRSiS0qOVOB8zw2vutF9EoWol2CDvacGGCAtmLAUgwwalmf",
+	"GFAPyLnXScIHEsCpKH7G03AexhqlYUAeaQvHDUADc7A1Dw+SMxbExNy7hlbCgwDdbaSEHN4gEwSsW3Xc",
+	"Rbg8TucI3Y9N1HETquakAxt7zXdOkHH2typKllueo+CRH6bjQ9WUNWu7pWxmwpzAQTB1oMsqreeJ+BQ+",
+	"i3gGsxK8ftwiIE4ayLewW0NgNVo7Q7B6Qcy3/rNypfOtI4bohHMWXcFPNGW/mf/18A/893vVfoOUwlYH",
+	"pQ1FNyzbyFpJhEMYlRP8ulEhtLrN5jl8ag5vluD4kYs1hg3csVa25UhcwUxG3gzFFVKN0c9XM4Uf1ok1",
+	"3BYp1Wpo/kwKsNdO92dIwi3tbxftz8jCZ7jx9N7cwc1TezWhKXkk7shBvoojHMY4RIc226XYuOMQOEMN",
+	"IN/JtTZtMLTu5xuubbdhLr7jypQNN1+kgsmtbpsIQW49bkRhE8r7n9vkMPCSEKT54R+M478fzqPwjpiN",
+	"S5kIXEnznYQO+nVZXvBcmgIzw8upr+g8gzS4wnntfVOmQ09Krg2fehUExVN6MHpC/B5s9FQAV76bJlOK",
+	"7v8AFKFI7sOSj7BngSU3J8RJ0tbMb+/g9jgfuDzvZ9uqPzhyZBb77ujh8A/8x8KL7wyhoUjzUKIc/Mqz",
+	"JNk77XNjGokHQdxK73weJ9uk2hxvBoybICNhNvG7zUzMkm9hDkN6yoVPML3uRqBItUL04t+rVCxGdHmO",
+	"AV8f/Z8Vt1wMValf5pcgbsAm+cHMjMJP7q1jkwIyWkbZQkYpEaxklYthJaMEsYZNhOKieJv0qgvMK0zi",
+	"Eos0vht7Mf1j3+wIgMjNBT0BCgwn797lgDhehQ5E1R74BYpttGfY1rCmyYjEQgEOBUZQe/lYY20K/AjJ",
+	"+cjhmDY5lDnGjUZjjFYjy1SVTN3EuSNYuUd5xi7zWbuTskn52/GZi7Wgrnl9w3p3mah9lWUEYbmnkWUo",
+	"PUTPGc/QOW+9cfUxt64nCVZypwDvSxk+1tS7sgKVdNtlYU9tkqYKOQRTits/nPV1ewkh+Ot4c1aoB+9J",
+	"Z7RPSTdA54WsNi6uzunvWgmDDenRT/+puV5iJRXunhnfFAUITGDpamcFQ02HPgC64SM/XxnVIBREbVUV",
+	"ltLjm3X68QvFIxq53hCrr50/3zLbZ/2zXqvFMUFTuA9TlhVoS0RExs8lEWG2GRIbEXLoh5M6XYU2oYdH",
+	"QESqHQ5HUaKch1QlCVjhjy2XKutlexURDQ5l/nSrvbvLn4yS+hTSpxhenvL54yGMv/42990sBYuWF3rQ",
+	"xoMccs/ibJ1ycSGS2/Iks/vIJzyFBFXtR/CajDYUuV5neCUYOx7wFiZCB+R7CRbphNA6zMdGKk7toQS9",
+	"p0D+qpkOz1otWpqdvBlVOCpVtGfxC5/FhvPwT7Fpx1Z9UML/O9nzWvOFsFLEzHhWyhplu3Ba7lck4UtC",
+	"J37w5gYdPLy/j0leBVdf5P30VpuPr3o6FI7UrDFMiZ8bzrh+yZTt9QIxPa2l3loCOZVIJ2GWF3bYQrlV",
+	"oOuLwkfXrzMP8GqfBGNMXS/65IuC7vPMu8zjCVn5ZYEPimkvYZn5DzSCsssHtJaT23hF0UxWZqBh1dmX",
+	"EJ6rB0HNVgw+aAoLS8lqBib/kNgOqC1UTHMU3MAcLLFUaxkWxGAZQ8qbDf43syhsJgMP/3g87ojfxN8r",
+	"gsXY/ITK5yKUB45yyAjRx0p18RZgB1JUp1gxMmDUMCYjDzMR0G8zd0wbizcWwEqyLDQ9rHg6E3iIjJ8x",
+	"GlUpmSFngXrwniiGaJK7fB07K3oznBrAUrZ19x5uZvt0xumj5r0mHWOGBcEoWc3dZz905XWgoLCNPtjM",
+	"FlArGjnLtf7xzWvFF3kzPLs6/yOXYeOfX7/n7tIzGVjQUeuF836OL5cS1RHBhCIVb0bh+8KC+p7Fij55",
+	"yTRM4SIf2kVppVBlU7YytZWpLyxTGW+0MnV3ZKqUVpsQqSPiH47JXToxS88eVETDas3Oae8cHLB0blRU",
+	"3YkLTyBk9XMHs5OjOqqTjaeE8htMtSvvINYheSgKEQk1EgcxGaPiDzWxgXn1yN+w3MnAF8UJauQP4dQz",
+	"1qyhNXfV8GGK1RKLKexPPyxp6So5KjvoBqq55WAuiqgDlqVa44f11bv/qDIYRVQWsKeF7jdWv4c2/Ti4",
+	"vLm6pf+7OKP/f9+/ECkZPRJL1Sqiv8ZUKqCOhiUxpVOJZ1rTypVC8a1dcSSu1ymlYOUTURDTwD9V3vSW",
+	"YwsOKg2KFJZVMrxWsO6+KaSfP62vrBGn1seiZOXnd407hbLnjMziYT5ixBeJhXepkkNhEN8XXFiqeMi8",
+	"WBGZ++6IxCXmzyXfFQk3xfNpCuOBA0m/lYq+rs+y84mavvA3uKsZYVp1tMamhJ6BfCUeRDA9QQp6G/kw",
+	"JMmrVkGGudp/QjjUmkAFQse7DrJx/aMMeK1EizkflZbQmkKbvHSVAqAq7ANkXnmfGovUhbShw+wtol4g",
+	"8yderlkr2nfiUAg1J41tRSHq8jaiq3Eezx9PejEUrEiAjQU+t12GqXlMWzm2HXKMrr5MVFXCjcuPzcu3",
+	"2A9tjD3IlfCIsqssqkC/gypIOejzCmLOGqQWHf4hV8mV6XJ0JHb3yYSiuP8M/TG4OrJPIGZRO6wWjJCs",
+	"vI0d+UFiR7xx3CRwpF94wliCZ01vGDdmugNx4yGxuOXOmL813M2GO8PQOoSwRTAxeyicj/fD3jqx1yyg",
+	"ePeF3XYHFZdm7MpHv3iGWggyaLenFVi1+QOxhEX962pKzLEHIUOcxPAxejhCAh877n2C0UQe/cCqkOqg",
+	"jD2WDkmDnIoCpk1huSN0VFILTBoknr8CYD6wraGbpEKD3qc4DkceXlqgHqO82VeDHK3Ops0/p7dfl7qY",
+	"mL1ZYp73EYkSeAgly3HUBG+yMpFkAUrmr3oMZSarFie3hK/y7hlOPi9yWFoDHcS8kuSLbgsFMyvdnOWS",
+	"5GEn/LwwPHcuV7bWLqQsBeU0VNx04EKOOHPXi2Lnz9SuZC/REDDnf375n78UxVZlshS77A7xiB5kVvKQ",
+	"tbRdF7ZeDt71anL2j0LaV6J1GpvkDcv0rg0UtEM8hi21NHa2W2lqQnN/5ZeROVw0ZQREd8sMOmZwuPa4",
+	"SoZgktSGGXjLWk5gB19rtGyr0bK8/8XqrFamKCmiqPKzOXMVTNeXbyrTSOL0Di4IR24w9rDqjaDrleoo",
+	"VSt2biBzHLARgwXjkMvwuIkIpoIrI72Vs2H1RmHtBmJdiJhWpudlusBLJtAZfheJHTmNCAvZhCAqNrBR",
+	"NLO2rzsqE1HA0GETmSliZricHEH3zQeBc/KoYz2ELsd77YXhC8WAS4aX/Cl5057n7bU4fCfDfrZJtuzW",
+	"SYrdCT/QHvqcW9nbZHn1r3/dIjGxm9GflqJBhBW0YuElxYIt6+8rhAlHf0ViSKnAYwoqbXpINttHkuw+",
+	"P79yLp6ESXu4G0tDLXDGFhmtsmhx/bG54+nXc8emLPn7kgy3DhOAbdLCJsALlEK2lg+i+nErH3bvlLdQ",
+	"9iEGr2kIs+8reSjh5UUYs6BlTHQZi3vvOV269+3AGeTailcaaSzuDaVzD0MEQ1Q8WCYo8a6ecsudTL0I",
+	"gQe0PzhT5/o3GzChEvH8/vkKIWkjn4nETFwjpdjeicDMbLdlbMqLBD+zRYyzVTQKfVZotlVzSoHFKkff",
+	"PXMCUNQe5GKO9eX8C2WR00kDEAe1kqewi9I5dR+FM2f8TJnUG6Egyh7BCpEzdR9J8CdIwEECJnvY1RMV",
+	"HV44PnA+AV1n0ilOPJBy5J5AJB+TVPYyyTnLYBGjwgszCTCPVmLL5q3gexK5owc+WBgg/SYsA4N3n1Vm",
+	"4CPmXkfPcmBDAI6VbLxhiG8lI0OEvXxEsuERcGw7+GYKMlTpFLaGS6Kdkph8Sa3grBKc5n1fu/T8g0oO",
+	"O99sBtU69LGRy0SrIB4LwbOzBm1eF9JDxj5UlBRbpITYlulQrSW4yXdjCtVZPBJTdmkjIuiQDkUqc6jd",
+	"g16iiiGQIVjggMmkIUXDfEp1InZYRtiBVeRlB1FOWIG0mRIf5ZV4GYYSqlLwDEi8w/7q3ZY7chMsco6J",
+	"J/etrNlqWYPstHlRk8buhNRVNvNmVD2hKnr2tpRJEIpAugBhMboFppKvSVUrC+0ytewKuxerEjQUiBuE",
+	"spU1Jlmjj6sD96F8DAx7GLnBhOxTDeTeTX2W0uzkrTOltETPiUlIAeBldOgIJ0cnx50j+O/66OgX/O+/",
+	"1/wkCStnBuM6kAOROge/gbYMurKbODN6Wjpvjp2x+4xH10KLWfBJ03q1TMkLjBGaVbVQyI9xe2t05i4l",
+	"S/hZh+TFejYz2JdRXCNuOXSiXik+v+c9cwHehkI3EL/IHnF95tPtqtxkAgzFAGV7ijEVDTWyyALQhWRT",
+	"M2hW9T6yGLSNYeHBWGYcqwkN5+WFX6ac8NzF5Gi8fJRSGdkANGt/C+1vRetbbL1WYssqNbP3HPhSk/aY",
+	"TCCXWZay11ATmTWk499i9zVBvv7SSYM0EGKjeQ1VVVS19Y63p5gp7s1MngZ2xYvsj7V56AWJ5eFGCTyl",
+	"ZypV+sVPEXEfxuFTIM+7BmcdHfMKJt/1kw5PFfH+X6mJxS+4FtVleffuPYsDWsUphJDK7AAqqCHAtwSw",
+	"9EDz4ikZv8fBt00DB2rLkdoC0hH5pJWPWyof87uzcikZH7KEqWa37il+l7XpdfKONXndz4MQBaiqVN8p",
+	"M68T1ZRHAmkbTSwosuNe43bWvguSyXSlw6z10rZlKMULpbxkWLlkwmTRz1UXTvC9UjKxJq9aMjEUNJFM",
+	"kUDaJiUTA9NWMEW8dSuXWrlEyndWObmwcrkUe5PArdCYhuCRg2T70AwYihXq2QdSgZxbLi+Cm8+7j/XC",
+	"8AKBd/RiCMrwHpmjJ3TGacRKPar3Vq6XQGYoOZvMqhpTueWA8GKJ9+lgcRLCSOj8U40krNQROJ42NQfm",
+	"3mLrfdUJ8REFFkI02/SYUsGGZSiD0lq7i5lDUQDdStCXlqD5PPeMkhrW9rYSY0LkdMBRX5PHJ58BsC6T",
+	"z5cs41+bzmf7c5DGPCujVd69jWVwxEstN4LKM1gqjliBt8YbNt9NmoCyquu1riYL5EPnkSdmtAAkSwd5",
+	"O6vMB7nQDVo5I9SOXP0BEPK1qk0eKxKt+abvy5TQcxgFACVjPx0T56z7MYaTER+IKH8XWq1WING2t6KB",
+	"mRE4OHdh6BM3sLggVa9HbXD2QnelKpR1l6YWWXo3dHmqEc/3vjvBo/aJ0wX9EdQElQzkI2PQ7sM0gR95",
+	"4dmYfuHl35mqeeCcKYFK/wP08D/w1ojyKsFjXLd8PtOtGHSvkoTWf80xZJpR01RirXNgiyxzTGKW0ygV",
+	"xVbojljpbZUa7uHYi8Ej0AHKrtN3eVu0tLE9iBKzElytA5+xwS5gnJ3WhxXRWi78gkjhwUccfRx1ZkVA",
+	"kaXVh9VOlufQk0ArulrR1VR0cSXE7Gy8Zg2KxXCZWlMhmtqUjsccdQpSapx7KnbxClfgcJNOPlW2wBNw",
+	"P26U3jFHIW3IdTHdYoGBVsDgeX6GdIvqX77XBKblSA4d+VRHkd4AuBXgBy4vD/EvOgoQxb/2qDWAQeMV",
+	"MsAys1sOBmYBTrCnPsGTurydzau2AJe1J/cWn9zFy0BLht4vEfQCLH7Ii+RUcXrCHhbQZppbwVouHooq",
+	"PAvysjq9orP/mKytOqNblt7SK7LTMPXH7G7MC/SayxZFaua4SpbEehFZg6HvFkUFMcEbCyVg/gx70wEY",
+	"CAuJWPs0Xk91jEysaq9DflyJulAdq1aotnpSUXYllCeDSb22xNs1ll60/zWfYmdtH60MGpM5xH6F8o2H",
+	"M5p6/jgipgsu7NBQ+q1fkLDNaSXJzkuSKv5ctXghcy5TxI/fD92Ikv8jqdOCeCsOJiZD04mQIf3Ag5q6",
+	"YmAL8SHGM3pPBbxtgNPiGtk6ZRLfd77nVlIp/8SuLdW5+dhKyXVV8ZVl9leYX8gn2H6QTVWiSbJwvUyy",
+	"sctydd5t5FFPVD1tpdErkUb2tlYri3ZHFimMv35J5IeTukgY2oTyR1DSjcru6POQ6usBsfUGtWLoZaO+",
+	"fUppvlUAMWuZm7mKGQQdQK8PHvHHxhRKBA5eB2dT4KjIn4QdmgIyZL20AbcuhlOG0bhq/fj5/TNbS8PJ",
+	"L9W+Bjyw6ceUwEe8OGQFFGdKs0Ugyfqv95BSpUFb037Zkq9SCitnAcVw82OABxpVJHrACIiYRxIZwhuv",
+	"8c+nauDLqgNz2OBsoronyyw06WVCcRiEjYJvOFJ/bBpfIOpGEpt85MbjaYpErqNoGTpX6zJmoTH8hr2S",
+	"wJvWPJThr3wG45XPZqLlXpbiRbHBlto3a20wYhyHhBka5Bs7gUtphWyZLVdDvLq+YcBmwxTtVXy1O3UO",
+	"1xR1yhDQ5HCbR4DIxGOvNF+giGB7zi1/znE+WYD1Ks67Q9cHwggmHQqX53cmUZjOKy9OQbkTViAnLxzD",
+	"wQEcPkCRdbvQpActPkKDXXnIsv6TUIeYhgn4jJvQ8k7+NrGCWhudY9amT3muOsZ49U8qVMutgBu7s66E",
+	"8kam3fF62XuBE1BDQy1fa20/Lbet9pQ8jEmS1IUWxbh7oosjulS/+VTIhTYe8j4fSXtMahGzxBmp7knL",
+	"ShqzToOmlfHR3Osk4QOpSRnk0AU4rF0113Tn3jU0a/XJ+BDjiq76iI94wGdpyCciPqr1oReVR6BIhlqF",
+	"GeQfF1cYwe8hqd2O2FsdEREgaF1RC9fpwihO2vLXip/NZszUkMGqDhyLaKkYn7HkQqZMyemyoJk2Kd1W",
+	"hydgoXSL4ARo1zwZHZLBJ/Jskywsg0mGL/fPYtusYUxWNAZQhET3zxYEMXuDtkRiPxsIB2nA3lFyx9eL",
+	"hHrgfr5MoAdOvQVhHiocapBHBbFk+QTJs/Po+inRZxWUJVP+Cex2/As2PaYf6G8n7LcTEO/V2Qc/rzb5",
+	"YLYMlt5N5h+spnNs3N9M3sF12goLvbRro2sCc8ylorQgcpd3IeO4Bh2kNQEQAYiLGrcwT9/4IuE9jBKa",
+	"+HwJ6/Hao6tP/raZWQecP7l6Sr6NCBmXi5QwA4XtTQM+rzdMDu9S/8EcTveefuXkEWcyIa4UCtDnFQsG",
+	"WH5D4RC/pHSIm4uH9vXFlskHZFNVSMQrlhJ29dWYI0NJL5pTcU1Sg4WVvPryawwB9goFNxjWVOcoC9iC",
+	"354yYxlsjzWmOhd/CO/+TU1Ay9puJMtR0gqprRVSvKLRWuQTutEsfazMN2fhZ/1EnttrvczZuJC1jshu",
+	"LXadxe5w3+8q+cCu2mDc7Gh+9fUHGQK25WhejVstV3ywPTBfzYHpBY9Ud2saYC166YPG+vi1PStFrJiC",
+	"j4WixAS229gwXfh0RotriplmE1TSeuv+VqKkGUrsgqMZbl80IpqBu0ggNCeMli310c+Sb1YTqsn5XPyh",
+	"w37/zpjYpzxXZucz/HssDTsbVmZ9djaeJs9X1bB1JDp2/Wyt5V5GIdvMvTlGYkSYkaspK0J+H2vftDbj",
+	"hN1517ornLDep7eLnbsv9vjWknMZfDvDufxRbGPOrTr5ZgSCFpvaaKKXnsU/49fWRhPUqOBjIRtNYLtV",
+	"BnU2WkaLq9EF+XiHf7AfLJRAyh+srXMfhbO6Z2+MGn4MVZAv2wQb+7xR3n27Ft5dRAd8HVy7RdkjLwzJ",
+	"IiWT5jZmZfJiTnkeqg6ncWcG0ntUn4o/6+LwLvI+uS7L0pXs+plP9kMcsQn5lhzOfdcrEENxpCanZxnL",
+	"LS++NC8CB2j2ZVW8SNGbEms2xNaNOfAf0GuHmG+3X+ns0sOL9VsSOdpb7DWm80hJlbZuZeI2yUS5O2WJ",
+	"KDhnUZlIZRjp4OWvTdgStGZXxXVxSwMX7h1pw/aN6DZXWlvFe8JaTK7z1aCksy14OViEZVMpovO81iAw",
+	"TmHnNjKu4D9ScZOJW0C1c87+uqjE5T0685Au6rk+fZLo4LAONsmTRFjPFfZoUycd6tCymLu1sBut23Xj",
+	"Gchi3x09VCdNGkIT54ncTcPwoXwRgZ+/sK/tRQTLl6TipIn1UED1NrHDhqr33QRumkzDyPsPBE7CxO82",
+	"MzE19aYhK+tMlfPwSV85kG0Q6oGMBdTzDD8uxYhQjTRKjOw4hK/sHLvsUjQ5aKwUGfImJhG7v0SALgGh",
+	"2HMXOfPN0YkGDyr3IMr4sZLDypS4Y37f6oeMYGo8nrjhZJRGXvKM+BlRNvQIDIoJ/r+q9IAozc8oCAF2",
+	"YGE6qMthN7wYFgmwIJCDuJXDXA5fDPsqqhpI4iKWW1m8dbK4zAhSEl8Ml0idVxhYx2BtpDAiIM9flRnz",
+	"Vkez+UmtI36Lu9oy9BYxtJHzLDm68kTlNac6m7iy4mUwd+3mav3uAh1imvkMZG3G3M60lyrbcKki92bV",
+	"18y6CqGVrJsVA3XunhlDacsT74gfb39bq5RuoJbwgvKhlQhbV0RYFRErKRxsJSdq89t0k4TM5jxRE7a1",
+	"qGu+a4ltWglSFUzqxfjUhosQRgT+9hkIL3yJV8com2LoiEDHijwYmDDIloexecvC25iZI4L8zbhVNQ+h",
+	"vGCeYjwEu9zVLff7VmgqbV6OCvmCG/4SAiVbU6UvgDXjwQJ1wgW8AGzYVrS8nHbQLOOcwdPAh2sNim02",
+	"KMQurUVq8Lv4DkSNVj3ezMI6jYESbYxEFqLOUPEFkQoIqap7A8iQYfSsoyO2o3Xib9utnEL+i6ft4YOY",
+	"WOjV377l+IdhY0PlqjQzjxsl3RFb23Lu9l2/qYy3iLOeSeVq9zyckEx4V8feZmfDqz8sM0y0VeGWNjXF",
+	"E6B8HgOG40UvqQSimXnZPFurWh9Lk7RVKWrVpm5VUrcqeIlr3ES5CmQvl8hVB7d1wUfFg5QjmNY83coE",
+	"r/k9Kj8yrDZQmwicP9Rf627Hc5xQewJzMt3ly/IC6+tBUzG4w2oC365F3yu3l+fm18J5v3T9S+H9PE0t",
+	"zs+HeMVR66JmFyGMoVWgD2r4uo+jt8z98syd5Ua4Usq0MBiX8WbncYTb3Tq0N+TQ/qLiPrDJSpBtUlOV",
+	"YXUSJ566c7ImPWKIY7fyZmeUCbZhrUbxA2kUMiLeoox9roK978tbt1ija1SxPj7HYhfkPVH6opUBKwfw",
+	"3KVb1j/DBLJwb+aKHTQlP6EN+mNj9pM3J7rsJxuI3GtS8kaVPG1szZbe2C8gS+yv8+1kYWx1M4Et7TSa",
+	"V5mOaUzu3dSnsBzt50TFJhIzybnfLTL5kOVnunt2cAL9pPyT+ZX4JtSu9rJn9frWKhO9yTEtS+hSUXIH",
+	"Yealy54qjenV185V70kYMmyDgXmMevmq5FUX1PXb26OapEuMbDZxc0MlRxQG9RoJtHL+Hd5lQFGamExq",
+	"wydOab9XrabsTNZIubHeGKal1CBV4oOa5MAmw20Nti7M3BS8izpVSjslUnyT6aBD86l2M+9xRSZOqtbe",
+	"82yfK0sIqkqR2D4p6N3z+vKCKkrBhjOD5pCxhIbeHrsaLb10zq1JXYdD9/AP+Kcj/mpXdqZ8EFtffADh",
+	"7HgRGrl6E1g5jG6+DI1lvRjtJrZZR4v1W/RoanZXkScICPqvuExckrl2OTxpizlrTUdne2zugmO/0WG9",
+	"Avlgd34jDdh68dWrhfrYhNZK3mYrGW+OGpjI2H6D9vE2Gu+UlAFphvvqAlis8RfVg7kh+DSvzbWw8Zvh",
+	"9cLV1T7KgCTDSUoPRpvSTaLtIibtEPty49IGuAcvGFtBhQ0bg/SJ9qqHZuc9KIk3ozbePQBaipiES23+",
+	"gFFdAtWPTo47R/Df9dHRL/jffxs9VNi9CxPoiRde9XQAij3bqqAA8R2hA5B1gvweZ1glzBVYvvcCL54u",
+	"DrPov1E8rwrolWJ6fR7Bsvvt1foDi7pja9asJUZyPY5ADIu0SQXsOhw0OOjy7K/mBraMft7lYpatGt6q",
+	"4ZtXw1vdstUtX+TdQ7xk8VcUQG2S8vrzfQ2FWLNzHkAdpz4cjzVeQ9lyEf/hUHRuvYjb7EVcn10kCWCn",
+	"wiVaZapVpnZGmcqWkYnqlfhmJUhWDC69tBsuS1+WMK3XYbVaiUEDWK9ecviH/LFTyuNSG5WkB7mhzrLj",
+	"sUkaHBjzFmtRvbXhSvrdbeOVivFKBjw1C0gw0EZN5NJKGHCnaxHtFPet8zhuj+Jdj2tarxyxUwxkqobv",
+	"2QuhymqlrhOQJ/M7IftnQtesw+4kV65/sVKdm6EStI3WUdVsQ5O6J8bN32hyy2ZBnmpOaDP8rVjcfHHH",
+	"rUuoyQVdFZWv54mmIotzfmS9PBYaAZfI9vpgSZWAx9+tFN6gFBY7oGxAE/lr1Bs2WIiquTqqSuBXaWm2",
+	"4tdK/HKFpE4nXrnIZVnaOyOKlqQmRAfbiJxXoryA++h6vntHBTJIX0Xc6K1xOhLLAh+f4ow7L3rrUpPt",
+	"eGrC3GYtaHozUmHk03rDDXf0OSQtlrAwz/5pTPftcJRGEanm7JhZB6yhA91K3HtD/0hbnvLB1kh3MFND",
+	"OkOI20I3L1/ohlAa8pJnFOOjMHzwSDcF2fXPryCqCo/b8uQmyB23X0PGEy+ZpneHIzrfnTt6MJLzaQg3",
+	"qlDeCijjEuZ3tOcRTMTKfHzEoS8Bl6di+AKBvzk6qblPGPF5x+V5p8Qd85p2fsg2Q1tDUYr17wVk5nAn",
+	"FpifwxJ9ceJGZlEwhK+LIQ67NscawrN+nCF0DREWhhOfrIfecOgfnN4Y+lZMbxnifjh684JHLyE2hS+F",
+	"Nsw6oNJtdXzDCNfYt8/nWuMprk5kFT8BMSd8Y/ILbPVF62MVc78WsJdR3rXGQszR3qFL92OemD1vXfwe",
+	"Sw8bn6REbermsz576/EnscHZRPWFGSuoj61cR39tFIAkL4bt0t7b01dEMItiRcU2+N6MvlifvXXVP4PB",
+	"V0BfbOUtfdVUpwckLUBffjjxAjNZnYeTmA5HyQqaH1QoGOc40HpoCY9gGH9DFWSt7GiKuQmlBS9ozeet",
+	"Mp/zxzpQja2dTHc0TJMaZqAt7LghTF/e18NpNNyyekotkdYoo0g9tmQ7I/BGJZ568wYmkNLJzgxiR8jn",
+	"rBt/RrRWAtdP2tweUlHU2kSL2EQqButJcu7G8VMYVUQiMDHJJakj2leJ1Csx5vp0jNOpG0zkRNukbIwQ",
+	"srFEVCvOd0icM7LKU7oFE0VkAoIsqjL6WIu4UiORcTrrYhsBxjYxjEBee821E3q6ICFbnSf23dHDWm4Y",
+	"hjDyFl8w1IiahjcOjxQWDkJl6V7eTsSv0HEeNTpiP7gPaY/f+KArLVyiQJpldDg+ODo40uWMUMJG/im7",
+	"frWoSXJdsdhCqFwFOX8hcM2eRkEOeQU9G6RUGgQU4myKbx0xZCecsyeq2Wxi057I3ZTSQIdHER3+wf9g",
+	"8R4PTgreuhxlxP5u/9SOD2SO4pETbTiIx/LtmoCvPRde/lwovpdTydQYusNbfLVijkOOZxsjWTQVRf+q",
+	"OYbrPbFtYo2t5ZvVBL8x6FnsG0cNYGbAJzRJXZk3lGNHblfLnlvEnugTKG1RUx6VvIk/fLeo463RNhiF",
+	"WT5M5RGCVQGnmjN+d8JNGwf+8RW33rBSRGnptQ4ozdUBpKhWAxUmo2mFr6uSkFmrnaHlNbgSEAG5c8N0",
+	"VnAMpAJlm3vEYslrDLKW0/ScxhliGWarOE0Ox5Hr1Zu02Ipn5gG9Q7DnvuMFIz8dg12GVwhu/BA7T1Nv",
+	"NHXcCEpJe6AhcsstDNQ91nP2GczEE/K0R1UBIc1OLXXTWo7SnV15FC16kGndzbhtCp/E8LTQTai2iK9J",
+	"MQMbcIgbx94kIJiazUsOnAG6QuKm3HRQxU4tIzVlJBG/i/QhhFt7NpXy9SCRr/BoKj4atEqaJV82WWXp",
+	"aeCy28qXd00STkkA24e/m3/4q/PUKRSz4Lu7/Trj354TGngDXsMD1AUfnba89dK8pb5uXYaxbDwS9tzV",
+	"zEWxFQy2ejdFHhm2OTiYQyDPZZv2W1hJhKLnopUHRt/FcsxZoyZaVX6BTcqXeJGM9ygv4Y0nZYNKL9vA",
+	"z5psy5n3ZslSeIsXwtMDNonCdI4prDMQxEYZQcFOn8jzXm16oTULiSXLSoh4h7ayxBZqEwuVsmgkuETK",
+	"M2PYosjW0zQJ2UK5x7ZScl1r2OXA6d/jxWucAnWQ8T5ylU/XGSeSpzwq6EkCqbBMhQ4ywb/lihQngwUT",
+	"mr1YGjMF3kb5y9qsZW3WsjVkLWskmrlsiC0CLnInuZVY5mGfO+SC+RHk8pqlnIjlXU4VbOXdVqmAGSku",
+	"qgIWY9LviBuRSMak72uj1DHImcmDNPIpUHvfv37//3Gxnb24OgMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
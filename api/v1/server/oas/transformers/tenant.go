@@ -10,7 +10,7 @@ import (
 
 func ToTenant(tenant *dbsqlc.Tenant) *gen.Tenant {
 	uiVersion := gen.TenantUIVersion(tenant.UiVersion)
-	return &gen.Tenant{
+	res := &gen.Tenant{
 		Metadata:          *toAPIMetadata(sqlchelpers.UUIDToStr(tenant.ID), tenant.CreatedAt.Time, tenant.UpdatedAt.Time),
 		Name:              tenant.Name,
 		Slug:              tenant.Slug,
@@ -19,6 +19,13 @@ func ToTenant(tenant *dbsqlc.Tenant) *gen.Tenant {
 		Version:           gen.TenantVersion(tenant.Version),
 		UiVersion:         &uiVersion,
 	}
+
+	if tenant.PriorityAgingSeconds.Valid {
+		agingSeconds := int(tenant.PriorityAgingSeconds.Int32)
+		res.PriorityAgingSeconds = &agingSeconds
+	}
+
+	return res
 }
 
 func ToTenantAlertingSettings(alerting *dbsqlc.TenantAlertingSettings) *gen.TenantAlertingSettings {
@@ -0,0 +1,64 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func ToV1Approval(approval *sqlcv1.V1Approval) gen.V1Approval {
+	res := gen.V1Approval{
+		Metadata: gen.APIResourceMeta{
+			CreatedAt: approval.CreatedAt.Time,
+			UpdatedAt: approval.CreatedAt.Time,
+			Id:        sqlchelpers.UUIDToStr(approval.ExternalID),
+		},
+		TaskExternalId:  uuid.MustParse(sqlchelpers.UUIDToStr(approval.TaskExternalID)),
+		WorkflowRunId:   uuid.MustParse(sqlchelpers.UUIDToStr(approval.WorkflowRunID)),
+		ReadableDataKey: approval.ReadableDataKey,
+		Status:          gen.V1ApprovalStatus(approval.Status),
+	}
+
+	if approval.ExpiresAt.Valid {
+		res.ExpiresAt = &approval.ExpiresAt.Time
+	}
+
+	if approval.DecidedAt.Valid {
+		res.DecidedAt = &approval.DecidedAt.Time
+		res.Metadata.UpdatedAt = approval.DecidedAt.Time
+	}
+
+	if approval.DecidedBy.Valid {
+		decidedBy := uuid.MustParse(sqlchelpers.UUIDToStr(approval.DecidedBy))
+		res.DecidedBy = &decidedBy
+	}
+
+	if approval.DecidedByEmail.Valid {
+		res.DecidedByEmail = &approval.DecidedByEmail.String
+	}
+
+	if approval.Comment.Valid {
+		res.Comment = &approval.Comment.String
+	}
+
+	if approval.Payload != nil {
+		payload := jsonToMap(approval.Payload)
+		res.Payload = &payload
+	}
+
+	return res
+}
+
+func ToV1ApprovalList(approvals []*sqlcv1.V1Approval) gen.V1ApprovalList {
+	rows := make([]gen.V1Approval, len(approvals))
+
+	for i, approval := range approvals {
+		rows[i] = ToV1Approval(approval)
+	}
+
+	return gen.V1ApprovalList{
+		Rows: &rows,
+	}
+}
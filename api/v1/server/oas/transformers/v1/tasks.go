@@ -22,6 +22,43 @@ func jsonToMap(jsonBytes []byte) map[string]interface{} {
 	return result
 }
 
+// taskErrorDetails is the structured error of a failed task, as it is reported by the SDKs.
+type taskErrorDetails struct {
+	Type       string                 `json:"type"`
+	Message    string                 `json:"message"`
+	StackTrace string                 `json:"stack_trace"`
+	Retryable  bool                   `json:"retryable"`
+	Details    map[string]interface{} `json:"details"`
+}
+
+func toTaskErrorDetails(errorDetails []byte) *gen.V1TaskError {
+	if len(errorDetails) == 0 {
+		return nil
+	}
+
+	var details taskErrorDetails
+
+	if err := json.Unmarshal(errorDetails, &details); err != nil {
+		return nil
+	}
+
+	res := &gen.V1TaskError{
+		Type:      details.Type,
+		Message:   details.Message,
+		Retryable: details.Retryable,
+	}
+
+	if details.StackTrace != "" {
+		res.StackTrace = &details.StackTrace
+	}
+
+	if len(details.Details) > 0 {
+		res.Details = &details.Details
+	}
+
+	return res
+}
+
 func ToTaskSummary(task *sqlcv1.PopulateTaskRunDataRow) gen.V1TaskSummary {
 	workflowVersionID := uuid.MustParse(sqlchelpers.UUIDToStr(task.WorkflowVersionID))
 	additionalMetadata := jsonToMap(task.AdditionalMetadata)
@@ -65,6 +102,7 @@ func ToTaskSummary(task *sqlcv1.PopulateTaskRunDataRow) gen.V1TaskSummary {
 		FinishedAt:            finishedAt,
 		AdditionalMetadata:    &additionalMetadata,
 		ErrorMessage:          &task.ErrorMessage.String,
+		ErrorDetails:          toTaskErrorDetails(task.ErrorDetails),
 		Status:                gen.V1TaskStatus(task.Status),
 		TenantId:              uuid.MustParse(sqlchelpers.UUIDToStr(task.TenantID)),
 		WorkflowId:            uuid.MustParse(sqlchelpers.UUIDToStr(task.WorkflowID)),
@@ -158,6 +196,7 @@ func ToTaskRunEventMany(
 		toReturn[i] = gen.V1TaskEvent{
 			Id:           int(event.ID),
 			ErrorMessage: &event.ErrorMessage.String,
+			ErrorDetails: toTaskErrorDetails(event.ErrorDetails),
 			EventType:    gen.V1TaskEventType(event.EventType),
 			Message:      event.AdditionalEventMessage.String,
 			Timestamp:    event.EventTimestamp.Time,
@@ -189,6 +228,7 @@ func ToWorkflowRunTaskRunEventsMany(
 
 		toReturn[i] = gen.V1TaskEvent{
 			ErrorMessage:    &event.ErrorMessage.String,
+			ErrorDetails:    toTaskErrorDetails(event.ErrorDetails),
 			EventType:       gen.V1TaskEventType(event.EventType),
 			Id:              int(event.ID),
 			Message:         event.AdditionalEventMessage.String,
@@ -309,6 +349,7 @@ func ToTask(taskWithData *sqlcv1.PopulateSingleTaskRunDataRow, workflowRunExtern
 		TenantId:              uuid.MustParse(sqlchelpers.UUIDToStr(taskWithData.TenantID)),
 		WorkflowId:            uuid.MustParse(sqlchelpers.UUIDToStr(taskWithData.WorkflowID)),
 		ErrorMessage:          &taskWithData.ErrorMessage.String,
+		ErrorDetails:          toTaskErrorDetails(taskWithData.ErrorDetails),
 		WorkflowRunExternalId: uuid.MustParse(sqlchelpers.UUIDToStr(workflowRunExternalId)),
 		TaskExternalId:        uuid.MustParse(sqlchelpers.UUIDToStr(taskWithData.ExternalID)),
 		Type:                  gen.V1WorkflowTypeTASK,
@@ -354,6 +395,7 @@ func ToWorkflowRunDetails(
 		DisplayName:          workflowRun.DisplayName,
 		Duration:             &duration,
 		ErrorMessage:         &workflowRun.ErrorMessage,
+		ErrorDetails:         toTaskErrorDetails(workflowRun.ErrorDetails),
 		FinishedAt:           &workflowRun.FinishedAt.Time,
 		ParentTaskExternalId: &parentTaskExternalId,
 		Metadata: gen.APIResourceMeta{
@@ -401,6 +443,7 @@ func ToWorkflowRunDetails(
 
 		parsedTaskEvents[i] = gen.V1TaskEvent{
 			ErrorMessage:    &event.ErrorMessage.String,
+			ErrorDetails:    toTaskErrorDetails(event.ErrorDetails),
 			EventType:       gen.V1TaskEventType(event.EventType),
 			Id:              int(event.ID),
 			Message:         event.AdditionalEventMessage.String,
@@ -494,3 +537,36 @@ func ToCancelledOrReplayedTaskResponse(ids []string) gen.V1ReplayedTasks {
 		Ids: &idUuids,
 	}
 }
+
+func ToTaskSchedulingExplanation(state *sqlcv1.GetTaskSchedulingStateRow) gen.V1TaskSchedulingExplanation {
+	res := gen.V1TaskSchedulingExplanation{
+		Reason:  gen.V1SchedulingReasonNOTQUEUED,
+		Message: "task is not queued",
+	}
+
+	switch {
+	case state.IsRunning:
+		res.Reason = gen.V1SchedulingReasonRUNNING
+		res.Message = "task has been assigned to a worker"
+	case state.IsQueued && state.SchedulingReason.Valid:
+		res.Reason = gen.V1SchedulingReason(state.SchedulingReason.V1SchedulingReason)
+		res.Message = state.SchedulingMessage.String
+
+		if state.SchedulingEvaluatedAt.Valid {
+			res.EvaluatedAt = &state.SchedulingEvaluatedAt.Time
+		}
+	case state.IsQueued:
+		res.Reason = gen.V1SchedulingReasonPENDING
+		res.Message = "task is queued and has not been evaluated by the scheduler yet"
+	case state.IsConcurrencyLimited:
+		res.Reason = gen.V1SchedulingReasonCONCURRENCYLIMITED
+		res.Message = "task is waiting for a concurrency slot"
+		res.ConcurrencyKey = &state.ConcurrencyKey
+	case state.RetryAfter.Valid:
+		res.Reason = gen.V1SchedulingReasonRETRYBACKOFF
+		res.Message = "task is waiting to be retried"
+		res.RetryAfter = &state.RetryAfter.Time
+	}
+
+	return res
+}
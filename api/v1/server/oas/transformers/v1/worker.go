@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
@@ -72,6 +73,10 @@ func ToWorkerSqlc(worker *sqlcv1.Worker, remainingSlots *int, webhookUrl *string
 		status = gen.PAUSED
 	}
 
+	if worker.DrainingAt.Valid {
+		status = gen.DRAINING
+	}
+
 	if worker.LastHeartbeatAt.Time.Add(5 * time.Second).Before(time.Now()) {
 		status = gen.INACTIVE
 	}
@@ -111,3 +116,23 @@ func ToWorkerSqlc(worker *sqlcv1.Worker, remainingSlots *int, webhookUrl *string
 
 	return res
 }
+
+func ToWorkerDrainStatus(workerId pgtype.UUID, drainingAt pgtype.Timestamp, inFlight []*sqlcv1.ListInFlightTasksForWorkerRow) gen.WorkerDrainStatus {
+	inFlightTaskIds := make([]uuid.UUID, len(inFlight))
+
+	for i, task := range inFlight {
+		inFlightTaskIds[i] = uuid.MustParse(sqlchelpers.UUIDToStr(task.ExternalID))
+	}
+
+	res := gen.WorkerDrainStatus{
+		WorkerId:        uuid.MustParse(sqlchelpers.UUIDToStr(workerId)),
+		IsDraining:      drainingAt.Valid,
+		InFlightTaskIds: inFlightTaskIds,
+	}
+
+	if drainingAt.Valid {
+		res.DrainingAt = &drainingAt.Time
+	}
+
+	return res
+}
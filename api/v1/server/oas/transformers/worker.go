@@ -107,6 +107,10 @@ func ToWorkerSqlc(worker *dbsqlc.Worker, remainingSlots *int, webhookUrl *string
 		status = gen.PAUSED
 	}
 
+	if worker.DrainingAt.Valid {
+		status = gen.DRAINING
+	}
+
 	if worker.LastHeartbeatAt.Time.Add(5 * time.Second).Before(time.Now()) {
 		status = gen.INACTIVE
 	}
@@ -26,9 +26,12 @@ import (
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/tenants"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/users"
+	approvalsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/approvals"
 	celv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/cel"
+	concurrencyv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/concurrency"
 	eventsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/events"
 	filtersv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/filters"
+	ratelimitsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/ratelimits"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/tasks"
 	workflowrunsv1 "github.com/hatchet-dev/hatchet/api/v1/server/handlers/v1/workflow-runs"
 	webhookworker "github.com/hatchet-dev/hatchet/api/v1/server/handlers/webhook-worker"
@@ -65,6 +68,9 @@ type apiService struct {
 	*eventsv1.V1EventsService
 	*filtersv1.V1FiltersService
 	*celv1.V1CELService
+	*ratelimitsv1.V1RateLimitsService
+	*concurrencyv1.V1ConcurrencyService
+	*approvalsv1.V1ApprovalsService
 }
 
 func newAPIService(config *server.ServerConfig) *apiService {
@@ -90,6 +96,9 @@ func newAPIService(config *server.ServerConfig) *apiService {
 		V1EventsService:       eventsv1.NewV1EventsService(config),
 		V1FiltersService:      filtersv1.NewV1FiltersService(config),
 		V1CELService:          celv1.NewV1CELService(config),
+		V1RateLimitsService:   ratelimitsv1.NewV1RateLimitsService(config),
+		V1ConcurrencyService:  concurrencyv1.NewV1ConcurrencyService(config),
+		V1ApprovalsService:    approvalsv1.NewV1ApprovalsService(config),
 	}
 }
 
@@ -416,6 +425,19 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T, middlewares []
 
 		return filter, sqlchelpers.UUIDToStr(filter.TenantID), nil
 	})
+	populatorMW.RegisterGetter("v1-approval", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		approval, err := t.config.V1.Approvals().GetApproval(
+			context.Background(),
+			parentId,
+			id,
+		)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return approval, sqlchelpers.UUIDToStr(approval.TenantID), nil
+	})
 
 	authnMW := authn.NewAuthN(t.config)
 	authzMW := authz.NewAuthZ(t.config)
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/shared"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/scheduling/v1/simulator"
+)
+
+var (
+	simulateTrace    string
+	simulateSpeed    float64
+	simulateTimeout  time.Duration
+	simulateOutput   string
+	simulateLogLevel string
+)
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "replay a JSON trace against the scheduler and report queue latency percentiles.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runSimulate()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [simulate] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+
+	simulateCmd.PersistentFlags().StringVar(
+		&simulateTrace,
+		"trace",
+		"",
+		"path to the JSON trace file",
+	)
+
+	simulateCmd.MarkPersistentFlagRequired("trace") // nolint: errcheck
+
+	simulateCmd.PersistentFlags().Float64Var(
+		&simulateSpeed,
+		"speed",
+		1,
+		"simulated seconds per wall-clock second. The scheduler's internal intervals are not scaled, so high speeds overstate latency.",
+	)
+
+	simulateCmd.PersistentFlags().DurationVar(
+		&simulateTimeout,
+		"timeout",
+		0,
+		"wall-clock time after which the simulation is stopped and an incomplete report is written (0 for no timeout)",
+	)
+
+	simulateCmd.PersistentFlags().StringVarP(
+		&simulateOutput,
+		"output",
+		"o",
+		"text",
+		"the output format, one of text or json",
+	)
+
+	simulateCmd.PersistentFlags().StringVar(
+		&simulateLogLevel,
+		"log-level",
+		"error",
+		"the log level of the scheduler",
+	)
+}
+
+func runSimulate() error {
+	if simulateOutput != "text" && simulateOutput != "json" {
+		return fmt.Errorf("invalid output format %q, must be one of text or json", simulateOutput)
+	}
+
+	trace, err := simulator.ReadTrace(simulateTrace)
+
+	if err != nil {
+		return fmt.Errorf("could not read trace: %w", err)
+	}
+
+	l := logger.NewStdErr(&shared.LoggerConfigFile{
+		Level:  simulateLogLevel,
+		Format: "console",
+	}, "simulator")
+
+	sim, err := simulator.New(trace, simulateSpeed, &l)
+
+	if err != nil {
+		return err
+	}
+
+	// interrupting the simulation writes the report for the tasks which have been scheduled so far
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if simulateTimeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, simulateTimeout)
+		defer cancelTimeout()
+	}
+
+	report, err := sim.Run(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if simulateOutput == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(report)
+	}
+
+	return report.Print(os.Stdout)
+}
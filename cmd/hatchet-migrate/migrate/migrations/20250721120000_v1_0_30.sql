@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Step" ADD COLUMN "slotWeight" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE v1_task_runtime ADD COLUMN slot_weight INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_task_runtime DROP COLUMN slot_weight;
+ALTER TABLE "Step" DROP COLUMN "slotWeight";
+-- +goose StatementEnd
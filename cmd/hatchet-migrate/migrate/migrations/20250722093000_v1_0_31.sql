@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_worker_resource (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    capacity INTEGER NOT NULL,
+
+    CONSTRAINT v1_worker_resource_pkey PRIMARY KEY (worker_id, key),
+    CONSTRAINT v1_worker_resource_worker_id_fkey FOREIGN KEY (worker_id) REFERENCES "Worker" ("id") ON DELETE CASCADE
+);
+
+CREATE TABLE v1_step_resource_request (
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+
+    CONSTRAINT v1_step_resource_request_pkey PRIMARY KEY (step_id, key)
+);
+
+CREATE TABLE v1_task_runtime_resource (
+    task_id bigint NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+
+    CONSTRAINT v1_task_runtime_resource_pkey PRIMARY KEY (task_id, task_inserted_at, retry_count, key)
+);
+
+CREATE INDEX v1_task_runtime_resource_tenantId_workerId_idx ON v1_task_runtime_resource (tenant_id ASC, worker_id ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_task_runtime_resource;
+DROP TABLE v1_step_resource_request;
+DROP TABLE v1_worker_resource;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Tenant" ADD COLUMN "priorityAgingSeconds" INTEGER;
+ALTER TABLE "WorkflowVersion" ADD COLUMN "priorityAgingSeconds" INTEGER;
+ALTER TABLE v1_queue_item ADD COLUMN inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_queue_item DROP COLUMN inserted_at;
+ALTER TABLE "WorkflowVersion" DROP COLUMN "priorityAgingSeconds";
+ALTER TABLE "Tenant" DROP COLUMN "priorityAgingSeconds";
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_scheduling_reason AS ENUM (
+    'NO_WORKERS',
+    'NO_SLOTS',
+    'LABEL_MISMATCH',
+    'STICKY_WORKER_UNAVAILABLE',
+    'RATE_LIMITED',
+    'RESOURCES_UNAVAILABLE'
+);
+
+ALTER TABLE v1_queue_item ADD COLUMN scheduling_reason v1_scheduling_reason;
+ALTER TABLE v1_queue_item ADD COLUMN scheduling_message TEXT;
+ALTER TABLE v1_queue_item ADD COLUMN scheduling_evaluated_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_queue_item DROP COLUMN scheduling_evaluated_at;
+ALTER TABLE v1_queue_item DROP COLUMN scheduling_message;
+ALTER TABLE v1_queue_item DROP COLUMN scheduling_reason;
+
+DROP TYPE v1_scheduling_reason;
+-- +goose StatementEnd
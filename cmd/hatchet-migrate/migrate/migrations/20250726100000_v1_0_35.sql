@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Worker" ADD COLUMN "drainingAt" TIMESTAMP(3);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "Worker" DROP COLUMN "drainingAt";
+-- +goose StatementEnd
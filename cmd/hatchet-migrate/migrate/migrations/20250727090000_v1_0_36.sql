@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "preemptible" BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE v1_task_runtime ADD COLUMN IF NOT EXISTS preemptible BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS v1_task_runtime_tenantId_preemptible_idx ON v1_task_runtime (tenant_id ASC, worker_id ASC) WHERE preemptible;
+
+ALTER TYPE v1_event_type_olap ADD VALUE IF NOT EXISTS 'PREEMPTED';
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP INDEX CONCURRENTLY IF EXISTS v1_task_runtime_tenantId_preemptible_idx;
+
+ALTER TABLE v1_task_runtime DROP COLUMN IF EXISTS preemptible;
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "preemptible";
+
+-- Note: Removing the enum value 'PREEMPTED' from v1_event_type_olap is not supported by PostgreSQL.
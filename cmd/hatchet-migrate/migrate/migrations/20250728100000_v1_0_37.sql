@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "gangName" TEXT;
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "gangTimeout" TEXT;
+
+ALTER TYPE v1_scheduling_reason ADD VALUE IF NOT EXISTS 'GANG_WAITING';
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "gangTimeout";
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "gangName";
+
+-- Note: Removing the enum value 'GANG_WAITING' from v1_scheduling_reason is not supported by PostgreSQL.
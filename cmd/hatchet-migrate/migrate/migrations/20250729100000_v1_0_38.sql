@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "placementStrategy" TEXT;
+
+-- +goose Down
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "placementStrategy";
@@ -0,0 +1,195 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'SLIDING_WINDOW', 'TOKEN_BUCKET');
+
+ALTER TABLE "RateLimit"
+    ADD COLUMN IF NOT EXISTS "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW',
+    ADD COLUMN IF NOT EXISTS "burst" INTEGER,
+    ADD COLUMN IF NOT EXISTS "refillRate" DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS "previousUsage" INTEGER NOT NULL DEFAULT 0;
+
+-- get_token_bucket_capacity returns the capacity of a token bucket, which defaults to the limit
+CREATE OR REPLACE FUNCTION get_token_bucket_capacity(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+BEGIN
+    RETURN COALESCE(rate_limit."burst", rate_limit."limitValue");
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- get_token_bucket_refill_rate returns the tokens added to a token bucket per second, which defaults to the
+-- limit per window
+CREATE OR REPLACE FUNCTION get_token_bucket_refill_rate(rate_limit "RateLimit")
+RETURNS DOUBLE PRECISION AS $$
+BEGIN
+    RETURN COALESCE(
+        rate_limit."refillRate",
+        rate_limit."limitValue" / GREATEST(EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL), 0.001)
+    );
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- get_refill_value returns the stored value of the rate limit after refilling it. For fixed and sliding
+-- windows, the value is refilled to the limit at the end of the window. For token buckets, tokens are added
+-- at the refill rate up to the capacity.
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+    capacity INTEGER;
+    tokens DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        capacity := get_token_bucket_capacity(rate_limit);
+
+        IF rate_limit."value" >= capacity THEN
+            RETURN capacity;
+        END IF;
+
+        tokens := FLOOR(EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) * get_token_bucket_refill_rate(rate_limit));
+
+        RETURN LEAST(capacity::DOUBLE PRECISION, rate_limit."value" + tokens)::INTEGER;
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_refill_last_refill returns the last refill time of the rate limit after refilling it. Token buckets
+-- only advance the last refill time by the tokens which were added, so partial tokens aren't lost.
+CREATE OR REPLACE FUNCTION get_refill_last_refill(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+DECLARE
+    capacity INTEGER;
+    refill_rate DOUBLE PRECISION;
+    tokens DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        capacity := get_token_bucket_capacity(rate_limit);
+
+        -- a full bucket starts refilling when tokens are next used
+        IF rate_limit."value" >= capacity THEN
+            RETURN rate_limit."lastRefill";
+        END IF;
+
+        refill_rate := get_token_bucket_refill_rate(rate_limit);
+        tokens := FLOOR(EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) * refill_rate);
+
+        IF tokens <= 0 THEN
+            RETURN rate_limit."lastRefill";
+        ELSIF rate_limit."value" + tokens >= capacity THEN
+            RETURN CURRENT_TIMESTAMP;
+        END IF;
+
+        RETURN rate_limit."lastRefill" + make_interval(secs => tokens / refill_rate);
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        RETURN CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN rate_limit."lastRefill";
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_refill_previous_usage returns the units used in the previous window of a sliding window after
+-- refilling it. If more than a full window has passed since the last refill, nothing was used in the
+-- previous window.
+CREATE OR REPLACE FUNCTION get_refill_previous_usage(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+BEGIN
+    IF rate_limit."algorithm" <> 'SLIDING_WINDOW' THEN
+        RETURN 0;
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") < (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        RETURN rate_limit."previousUsage";
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= 2 * rate_limit."window"::INTERVAL THEN
+        RETURN 0;
+    END IF;
+
+    RETURN GREATEST(rate_limit."limitValue" - rate_limit."value", 0);
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_available_value returns the units which can be used right now. For sliding windows, the usage of the
+-- previous window is weighted by how much of it overlaps with a window ending now.
+CREATE OR REPLACE FUNCTION get_available_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    available INTEGER;
+    window_start TIMESTAMP(3);
+    previous_weight DOUBLE PRECISION;
+BEGIN
+    available := get_refill_value(rate_limit);
+
+    IF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        window_start := get_refill_last_refill(rate_limit);
+        previous_weight := GREATEST(
+            1 - EXTRACT(EPOCH FROM (NOW() - window_start)) / GREATEST(EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL), 0.001),
+            0
+        );
+
+        available := available - CEIL(get_refill_previous_usage(rate_limit) * previous_weight)::INTEGER;
+    END IF;
+
+    RETURN available;
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_next_refill_at returns when more units next become available for a rate limit which was just refilled
+CREATE OR REPLACE FUNCTION get_next_refill_at(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        RETURN rate_limit."lastRefill" + make_interval(secs => 1 / get_token_bucket_refill_rate(rate_limit));
+    ELSIF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        -- the previous window's usage decays continuously, so we refill once per unit of previous usage
+        RETURN LEAST(
+            rate_limit."lastRefill" + rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds',
+            NOW() + make_interval(secs => EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL) / GREATEST(rate_limit."previousUsage", 1))
+        );
+    END IF;
+
+    RETURN rate_limit."lastRefill" + rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+BEGIN
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP FUNCTION IF EXISTS get_next_refill_at("RateLimit");
+DROP FUNCTION IF EXISTS get_available_value("RateLimit");
+DROP FUNCTION IF EXISTS get_refill_previous_usage("RateLimit");
+DROP FUNCTION IF EXISTS get_refill_last_refill("RateLimit");
+DROP FUNCTION IF EXISTS get_token_bucket_refill_rate("RateLimit");
+DROP FUNCTION IF EXISTS get_token_bucket_capacity("RateLimit");
+
+ALTER TABLE "RateLimit"
+    DROP COLUMN IF EXISTS "algorithm",
+    DROP COLUMN IF EXISTS "burst",
+    DROP COLUMN IF EXISTS "refillRate",
+    DROP COLUMN IF EXISTS "previousUsage";
+
+DROP TYPE IF EXISTS "RateLimitAlgorithm";
+-- +goose StatementEnd
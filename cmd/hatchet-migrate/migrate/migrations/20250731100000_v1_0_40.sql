@@ -0,0 +1,204 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TYPE "RateLimitAlgorithm" ADD VALUE IF NOT EXISTS 'SEMAPHORE';
+
+CREATE TABLE IF NOT EXISTS v1_task_runtime_semaphore (
+    task_id bigint NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+
+    CONSTRAINT v1_task_runtime_semaphore_pkey PRIMARY KEY (task_id, task_inserted_at, retry_count, key)
+);
+
+CREATE INDEX IF NOT EXISTS v1_task_runtime_semaphore_tenantId_key_idx ON v1_task_runtime_semaphore (tenant_id ASC, key ASC);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+    capacity INTEGER;
+    tokens DOUBLE PRECISION;
+BEGIN
+    -- semaphores are only refilled when the tasks holding them are released
+    IF rate_limit."algorithm" = 'SEMAPHORE' THEN
+        RETURN rate_limit."value";
+    END IF;
+
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        capacity := get_token_bucket_capacity(rate_limit);
+
+        IF rate_limit."value" >= capacity THEN
+            RETURN capacity;
+        END IF;
+
+        tokens := FLOOR(EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) * get_token_bucket_refill_rate(rate_limit));
+
+        RETURN LEAST(capacity::DOUBLE PRECISION, rate_limit."value" + tokens)::INTEGER;
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_refill_last_refill(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+DECLARE
+    capacity INTEGER;
+    refill_rate DOUBLE PRECISION;
+    tokens DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" = 'SEMAPHORE' THEN
+        RETURN rate_limit."lastRefill";
+    END IF;
+
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        capacity := get_token_bucket_capacity(rate_limit);
+
+        -- a full bucket starts refilling when tokens are next used
+        IF rate_limit."value" >= capacity THEN
+            RETURN rate_limit."lastRefill";
+        END IF;
+
+        refill_rate := get_token_bucket_refill_rate(rate_limit);
+        tokens := FLOOR(EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) * refill_rate);
+
+        IF tokens <= 0 THEN
+            RETURN rate_limit."lastRefill";
+        ELSIF rate_limit."value" + tokens >= capacity THEN
+            RETURN CURRENT_TIMESTAMP;
+        END IF;
+
+        RETURN rate_limit."lastRefill" + make_interval(secs => tokens / refill_rate);
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        RETURN CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN rate_limit."lastRefill";
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_next_refill_at(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+BEGIN
+    IF rate_limit."algorithm" = 'SEMAPHORE' THEN
+        -- units are returned when tasks are released, which the scheduler picks up on its next flush
+        RETURN NOW() + rate_limit."window"::INTERVAL;
+    ELSIF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        RETURN rate_limit."lastRefill" + make_interval(secs => 1 / get_token_bucket_refill_rate(rate_limit));
+    ELSIF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        -- the previous window's usage decays continuously, so we refill once per unit of previous usage
+        RETURN LEAST(
+            rate_limit."lastRefill" + rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds',
+            NOW() + make_interval(secs => EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL) / GREATEST(rate_limit."previousUsage", 1))
+        );
+    END IF;
+
+    RETURN rate_limit."lastRefill" + rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose NO TRANSACTION
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+    capacity INTEGER;
+    tokens DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        capacity := get_token_bucket_capacity(rate_limit);
+
+        IF rate_limit."value" >= capacity THEN
+            RETURN capacity;
+        END IF;
+
+        tokens := FLOOR(EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) * get_token_bucket_refill_rate(rate_limit));
+
+        RETURN LEAST(capacity::DOUBLE PRECISION, rate_limit."value" + tokens)::INTEGER;
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_refill_last_refill(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+DECLARE
+    capacity INTEGER;
+    refill_rate DOUBLE PRECISION;
+    tokens DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        capacity := get_token_bucket_capacity(rate_limit);
+
+        IF rate_limit."value" >= capacity THEN
+            RETURN rate_limit."lastRefill";
+        END IF;
+
+        refill_rate := get_token_bucket_refill_rate(rate_limit);
+        tokens := FLOOR(EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill")) * refill_rate);
+
+        IF tokens <= 0 THEN
+            RETURN rate_limit."lastRefill";
+        ELSIF rate_limit."value" + tokens >= capacity THEN
+            RETURN CURRENT_TIMESTAMP;
+        END IF;
+
+        RETURN rate_limit."lastRefill" + make_interval(secs => tokens / refill_rate);
+    END IF;
+
+    IF (NOW() - rate_limit."lastRefill") >= (rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
+        RETURN CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN rate_limit."lastRefill";
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_next_refill_at(rate_limit "RateLimit")
+RETURNS TIMESTAMP(3) AS $$
+BEGIN
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET' THEN
+        RETURN rate_limit."lastRefill" + make_interval(secs => 1 / get_token_bucket_refill_rate(rate_limit));
+    ELSIF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        RETURN LEAST(
+            rate_limit."lastRefill" + rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds',
+            NOW() + make_interval(secs => EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL) / GREATEST(rate_limit."previousUsage", 1))
+        );
+    END IF;
+
+    RETURN rate_limit."lastRefill" + rate_limit."window"::INTERVAL - INTERVAL '10 milliseconds';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS v1_task_runtime_semaphore;
+
+-- Note: Removing the enum value 'SEMAPHORE' from "RateLimitAlgorithm" is not supported by PostgreSQL.
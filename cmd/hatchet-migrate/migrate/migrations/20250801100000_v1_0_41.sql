@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_rate_limit_usage_olap (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    bucket TIMESTAMPTZ NOT NULL,
+    units_consumed BIGINT NOT NULL DEFAULT 0,
+    tasks_rate_limited BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (tenant_id, key, bucket)
+) PARTITION BY RANGE(bucket);
+
+SELECT create_v1_range_partition('v1_rate_limit_usage_olap', DATE 'today');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_rate_limit_usage_olap;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "RateLimit"
+    ADD COLUMN IF NOT EXISTS "isDynamic" BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS "lastUsedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "RateLimit"
+    DROP COLUMN IF EXISTS "isDynamic",
+    DROP COLUMN IF EXISTS "lastUsedAt";
+-- +goose StatementEnd
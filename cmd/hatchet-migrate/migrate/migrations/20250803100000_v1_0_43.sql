@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TYPE v1_concurrency_strategy ADD VALUE IF NOT EXISTS 'QUEUE_NEWEST';
+
+CREATE TYPE v1_concurrency_queue_overflow AS ENUM ('DROP_OLDEST', 'REJECT_NEWEST');
+
+ALTER TABLE v1_workflow_concurrency
+    ADD COLUMN max_queued INTEGER,
+    ADD COLUMN queue_overflow v1_concurrency_queue_overflow NOT NULL DEFAULT 'DROP_OLDEST';
+
+ALTER TABLE v1_step_concurrency
+    ADD COLUMN max_queued INTEGER,
+    ADD COLUMN queue_overflow v1_concurrency_queue_overflow NOT NULL DEFAULT 'DROP_OLDEST';
+
+-- +goose Down
+-- +goose NO TRANSACTION
+ALTER TABLE v1_step_concurrency
+    DROP COLUMN max_queued,
+    DROP COLUMN queue_overflow;
+
+ALTER TABLE v1_workflow_concurrency
+    DROP COLUMN max_queued,
+    DROP COLUMN queue_overflow;
+
+DROP TYPE v1_concurrency_queue_overflow;
+
+-- Note: Removing the enum value 'QUEUE_NEWEST' from v1_concurrency_strategy is not supported by PostgreSQL.
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "WorkflowVersion"
+    ADD COLUMN "debounceExpression" TEXT,
+    ADD COLUMN "debounceWindow" TEXT;
+
+CREATE TABLE v1_debounced_run (
+    tenant_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    workflow_version_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    input JSONB NOT NULL,
+    additional_metadata JSONB,
+    priority INTEGER,
+    trigger_count INTEGER NOT NULL DEFAULT 1,
+    first_triggered_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    fire_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, workflow_id, key)
+);
+
+CREATE INDEX v1_debounced_run_fire_at_idx ON v1_debounced_run (tenant_id ASC, fire_at ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_debounced_run;
+
+ALTER TABLE "WorkflowVersion"
+    DROP COLUMN "debounceExpression",
+    DROP COLUMN "debounceWindow";
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_idempotency_key (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    external_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    triggered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, key)
+);
+
+CREATE INDEX v1_idempotency_key_expires_at_idx ON v1_idempotency_key (expires_at ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_idempotency_key;
+-- +goose StatementEnd
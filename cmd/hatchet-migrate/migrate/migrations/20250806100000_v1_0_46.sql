@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_concurrency_key_limit (
+    tenant_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    max_runs INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, workflow_id, key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_concurrency_key_limit;
+-- +goose StatementEnd
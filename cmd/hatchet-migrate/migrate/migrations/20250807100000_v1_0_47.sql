@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE v1_workflow_concurrency ADD COLUMN concurrency_group TEXT;
+
+ALTER TABLE v1_step_concurrency ADD COLUMN concurrency_group TEXT;
+
+CREATE INDEX v1_workflow_concurrency_group_idx ON v1_workflow_concurrency (tenant_id, concurrency_group) WHERE concurrency_group IS NOT NULL;
+
+CREATE INDEX v1_step_concurrency_group_idx ON v1_step_concurrency (tenant_id, concurrency_group) WHERE concurrency_group IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX v1_step_concurrency_group_idx;
+
+DROP INDEX v1_workflow_concurrency_group_idx;
+
+ALTER TABLE v1_step_concurrency DROP COLUMN concurrency_group;
+
+ALTER TABLE v1_workflow_concurrency DROP COLUMN concurrency_group;
+-- +goose StatementEnd
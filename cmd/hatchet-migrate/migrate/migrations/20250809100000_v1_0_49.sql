@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "totalTimeout" TEXT;
+
+ALTER TABLE "WorkflowVersion" ADD COLUMN IF NOT EXISTS "executionTimeout" TEXT;
+
+CREATE TABLE v1_task_execution_timeout (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    timeout_at TIMESTAMPTZ NOT NULL,
+    timeout TEXT NOT NULL,
+    is_workflow_timeout BOOLEAN NOT NULL DEFAULT FALSE,
+
+    CONSTRAINT v1_task_execution_timeout_pkey PRIMARY KEY (task_id, task_inserted_at)
+);
+
+CREATE INDEX v1_task_execution_timeout_tenant_id_timeout_at_idx ON v1_task_execution_timeout (tenant_id ASC, timeout_at ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_task_execution_timeout;
+
+ALTER TABLE "WorkflowVersion" DROP COLUMN IF EXISTS "executionTimeout";
+
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "totalTimeout";
+-- +goose StatementEnd
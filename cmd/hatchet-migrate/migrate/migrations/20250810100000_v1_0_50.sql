@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE v1_task_events_olap ADD COLUMN IF NOT EXISTS error_details JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_task_events_olap DROP COLUMN IF EXISTS error_details;
+-- +goose StatementEnd
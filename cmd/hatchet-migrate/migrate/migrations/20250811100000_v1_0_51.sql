@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TYPE v1_match_condition_action ADD VALUE IF NOT EXISTS 'FAIL';
+
+ALTER TYPE v1_step_match_condition_kind ADD VALUE IF NOT EXISTS 'APPROVAL';
+
+ALTER TABLE v1_step_match_condition
+    ADD COLUMN approval_expires_in TEXT,
+    ADD COLUMN approval_expiry_action v1_match_condition_action;
+
+CREATE TYPE v1_approval_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED');
+
+CREATE TABLE v1_approval (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    external_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    task_external_id UUID NOT NULL,
+    workflow_run_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    readable_data_key TEXT NOT NULL,
+    status v1_approval_status NOT NULL DEFAULT 'PENDING',
+    expires_at TIMESTAMPTZ,
+    decided_at TIMESTAMPTZ,
+    decided_by UUID,
+    decided_by_email TEXT,
+    comment TEXT,
+    payload JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX v1_approval_tenant_external_id_idx ON v1_approval (tenant_id, external_id);
+
+CREATE INDEX v1_approval_tenant_status_idx ON v1_approval (tenant_id, status, created_at);
+
+-- +goose Down
+-- +goose NO TRANSACTION
+DROP TABLE v1_approval;
+
+DROP TYPE v1_approval_status;
+
+ALTER TABLE v1_step_match_condition
+    DROP COLUMN approval_expires_in,
+    DROP COLUMN approval_expiry_action;
+
+-- Note: Removing the enum values 'FAIL' from v1_match_condition_action and 'APPROVAL' from
+-- v1_step_match_condition_kind is not supported by PostgreSQL.
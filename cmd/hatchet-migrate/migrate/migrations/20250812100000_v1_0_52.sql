@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE v1_durable_signal (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    task_external_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}'::JSONB,
+    v1_match_id BIGINT,
+    match_condition_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX v1_durable_signal_pending_idx ON v1_durable_signal (tenant_id, task_external_id, name, id) WHERE match_condition_id IS NULL;
+
+CREATE INDEX v1_durable_signal_delivered_idx ON v1_durable_signal (tenant_id, task_external_id, name) WHERE match_condition_id IS NOT NULL;
+
+-- +goose Down
+DROP TABLE v1_durable_signal;
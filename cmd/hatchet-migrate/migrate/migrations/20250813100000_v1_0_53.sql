@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+ALTER TYPE v1_approval_status ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+-- +goose Down
+-- +goose NO TRANSACTION
+UPDATE v1_approval SET status = 'PENDING' WHERE status = 'EXPIRED';
+
+-- Note: Removing the enum value 'EXPIRED' from v1_approval_status is not supported by PostgreSQL.
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION get_consumed_last_refill(rate_limit "RateLimit", units INTEGER)
+RETURNS TIMESTAMP(3) AS $$
+BEGIN
+    -- a full token bucket doesn't accrue tokens, so it starts refilling when units are first taken from it
+    IF rate_limit."algorithm" = 'TOKEN_BUCKET'
+        AND units > 0
+        AND get_refill_value(rate_limit) >= get_token_bucket_capacity(rate_limit) THEN
+        RETURN CURRENT_TIMESTAMP;
+    END IF;
+
+    RETURN get_refill_last_refill(rate_limit);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP FUNCTION IF EXISTS get_consumed_last_refill("RateLimit", INTEGER);
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS v1_durable_signal_created_at_idx ON v1_durable_signal (created_at) WHERE match_condition_id IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS v1_durable_signal_created_at_idx;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rate limits created from dynamic key expressions before "isDynamic" was added default to false, so they'd
+-- never be deleted as unused. Mark the keys which were evaluated by retained tasks as dynamic, unless a step
+-- references them as a static key or they're semaphores, which are only created through the API.
+--
+-- The evaluated keys are matched by value only, to avoid joining the partitioned v1_task table at upgrade time,
+-- so the tenant of the rate limit must at least have a step with a dynamic key expression.
+UPDATE
+    "RateLimit" rl
+SET
+    "isDynamic" = true
+WHERE
+    NOT rl."isDynamic"
+    AND rl."algorithm" <> 'SEMAPHORE'
+    AND EXISTS (
+        SELECT 1
+        FROM v1_task_expression_eval e
+        WHERE e.kind = 'DYNAMIC_RATE_LIMIT_KEY' AND e.value_str = rl."key"
+    )
+    AND EXISTS (
+        SELECT 1
+        FROM "StepExpression" se
+        JOIN "Step" s ON s."id" = se."stepId"
+        WHERE s."tenantId" = rl."tenantId" AND se."kind" = 'DYNAMIC_RATE_LIMIT_KEY'
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE srl."tenantId" = rl."tenantId" AND srl."rateLimitKey" = rl."key"
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
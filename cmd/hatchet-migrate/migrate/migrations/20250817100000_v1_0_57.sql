@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- tasks_rate_limited used to count every scheduling attempt, which is now counted by rate_limited_attempts
+ALTER TABLE v1_rate_limit_usage_olap ADD COLUMN rate_limited_attempts BIGINT NOT NULL DEFAULT 0;
+
+UPDATE v1_rate_limit_usage_olap SET rate_limited_attempts = tasks_rate_limited;
+
+CREATE TABLE v1_rate_limited_task_olap (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    rate_limited_on DATE NOT NULL,
+    rate_limited_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (tenant_id, key, task_id, task_inserted_at, rate_limited_on)
+) PARTITION BY RANGE(rate_limited_on);
+
+SELECT create_v1_range_partition('v1_rate_limited_task_olap', DATE 'today');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_rate_limited_task_olap;
+
+ALTER TABLE v1_rate_limit_usage_olap DROP COLUMN rate_limited_attempts;
+-- +goose StatementEnd
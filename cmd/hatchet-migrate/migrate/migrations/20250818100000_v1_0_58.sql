@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- get_held_semaphore_units returns the units of a semaphore which are held by running tasks
+CREATE OR REPLACE FUNCTION get_held_semaphore_units(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+BEGIN
+    RETURN COALESCE((
+        SELECT SUM(s.units)
+        FROM v1_task_runtime_semaphore s
+        WHERE s.tenant_id = rate_limit."tenantId" AND s.key = rate_limit."key"
+    ), 0)::INTEGER;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS get_held_semaphore_units("RateLimit");
+-- +goose StatementEnd
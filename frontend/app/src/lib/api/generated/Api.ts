@@ -46,6 +46,7 @@ import {
   LogLineOrderByDirection,
   LogLineOrderByField,
   LogLineSearch,
+  RateLimit,
   RateLimitList,
   RateLimitOrderByDirection,
   RateLimitOrderByField,
@@ -84,21 +85,36 @@ import {
   UserLoginRequest,
   UserRegisterRequest,
   UserTenantMembershipsList,
+  V1Approval,
+  V1ApprovalDecisionRequest,
+  V1ApprovalList,
   V1CELDebugRequest,
   V1CELDebugResponse,
   V1CancelTaskRequest,
   V1CancelledTasks,
+  V1ConcurrencyKeyLimit,
+  V1ConcurrencyKeyLimitList,
+  V1ConcurrencySlotKeyList,
   V1CreateFilterRequest,
+  V1DeleteConcurrencyKeyLimitRequest,
+  V1DeleteRateLimitsRequest,
+  V1DeleteUnusedRateLimitsRequest,
+  V1DeletedRateLimits,
+  V1SetConcurrencyKeyLimitRequest,
   V1DagChildren,
   V1EventList,
   V1Filter,
   V1FilterList,
   V1LogLineList,
+  V1RateLimitUsage,
   V1ReplayTaskRequest,
   V1ReplayedTasks,
+  V1SignalTaskRequest,
+  V1SignalledTasks,
   V1TaskEventList,
   V1TaskPointMetrics,
   V1TaskRunMetrics,
+  V1TaskSchedulingExplanation,
   V1TaskStatus,
   V1TaskSummary,
   V1TaskSummaryList,
@@ -112,6 +128,7 @@ import {
   WebhookWorkerListResponse,
   WebhookWorkerRequestListResponse,
   Worker,
+  WorkerDrainStatus,
   WorkerList,
   Workflow,
   WorkflowID,
@@ -161,6 +178,26 @@ export class Api<
       format: "json",
       ...params,
     });
+  /**
+   * @description Explain why a task has not started running, for example because no worker matches its labels or it is rate limited
+   *
+   * @tags Task
+   * @name V1TaskGetSchedulingExplanation
+   * @summary Get a task's scheduling explanation
+   * @request GET:/api/v1/stable/tasks/{task}/scheduling-explanation
+   * @secure
+   */
+  v1TaskGetSchedulingExplanation = (
+    task: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1TaskSchedulingExplanation, APIErrors>({
+      path: `/api/v1/stable/tasks/${task}/scheduling-explanation`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description List events for a task
    *
@@ -257,6 +294,29 @@ export class Api<
       format: "json",
       ...params,
     });
+  /**
+   * @description Send a signal to a task, or to each task of a workflow run. The signal is delivered to durable tasks which wait on a signal with the same name, and is stored until the task waits on it.
+   *
+   * @tags Task
+   * @name V1TaskSignal
+   * @summary Signal a task
+   * @request POST:/api/v1/stable/tenants/{tenant}/tasks/signal
+   * @secure
+   */
+  v1TaskSignal = (
+    tenant: string,
+    data: V1SignalTaskRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1SignalledTasks, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/tasks/signal`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Lists all tasks that belong a specific list of dags
    *
@@ -811,6 +871,316 @@ export class Api<
       format: "json",
       ...params,
     });
+  /**
+   * @description Get a time series of the units consumed from a rate limit key and the tasks which were rate limited by it
+   *
+   * @tags Rate Limits
+   * @name V1RateLimitGetUsage
+   * @summary Get rate limit usage
+   * @request GET:/api/v1/stable/tenants/{tenant}/rate-limits/{key}/usage
+   * @secure
+   */
+  v1RateLimitGetUsage = (
+    tenant: string,
+    key: string,
+    query?: {
+      /**
+       * The start of the time range, defaults to 24 hours ago
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      since?: string;
+      /**
+       * The end of the time range, defaults to now. The range can be at most 31 days.
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      until?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1RateLimitUsage, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/rate-limits/${key}/usage`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete a rate limit. Rate limits which are used by a workflow step or by tasks waiting to be scheduled can't be deleted.
+   *
+   * @tags Rate Limits
+   * @name V1RateLimitDelete
+   * @summary Delete rate limit
+   * @request DELETE:/api/v1/stable/tenants/{tenant}/rate-limits/{key}
+   * @secure
+   */
+  v1RateLimitDelete = (
+    tenant: string,
+    key: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DeletedRateLimits, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/rate-limits/${key}`,
+      method: "DELETE",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Refill a rate limit to its limit. Semaphores are refilled to the units which aren't held by running tasks.
+   *
+   * @tags Rate Limits
+   * @name V1RateLimitReset
+   * @summary Reset rate limit
+   * @request POST:/api/v1/stable/tenants/{tenant}/rate-limits/{key}/reset
+   * @secure
+   */
+  v1RateLimitReset = (
+    tenant: string,
+    key: string,
+    params: RequestParams = {},
+  ) =>
+    this.request<RateLimit, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/rate-limits/${key}/reset`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete all rate limits whose key starts with a prefix. Rate limits which are used by a workflow step or by tasks waiting to be scheduled are skipped.
+   *
+   * @tags Rate Limits
+   * @name V1RateLimitDeleteByPrefix
+   * @summary Delete rate limits by prefix
+   * @request POST:/api/v1/stable/tenants/{tenant}/rate-limits/delete
+   * @secure
+   */
+  v1RateLimitDeleteByPrefix = (
+    tenant: string,
+    data: V1DeleteRateLimitsRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DeletedRateLimits, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/rate-limits/delete`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete the rate limits created from dynamic key expressions which haven't been used for a period. Keys which are still referenced by tasks waiting to be scheduled are skipped. Dynamic keys which were created before unused keys were tracked are only detected if the tasks which evaluated them are still retained.
+   *
+   * @tags Rate Limits
+   * @name V1RateLimitDeleteUnused
+   * @summary Delete unused dynamic rate limits
+   * @request POST:/api/v1/stable/tenants/{tenant}/rate-limits/delete-unused
+   * @secure
+   */
+  v1RateLimitDeleteUnused = (
+    tenant: string,
+    data: V1DeleteUnusedRateLimitsRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1DeletedRateLimits, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/rate-limits/delete-unused`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description List the per-key concurrency limits of the tenant, which override the max runs of GROUP_ROUND_ROBIN strategies without registering a new workflow version.
+   *
+   * @tags Concurrency
+   * @name V1ConcurrencyLimitList
+   * @summary List concurrency limits
+   * @request GET:/api/v1/stable/tenants/{tenant}/concurrency-limits
+   * @secure
+   */
+  v1ConcurrencyLimitList = (tenant: string, params: RequestParams = {}) =>
+    this.request<V1ConcurrencyKeyLimitList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/concurrency-limits`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Set the maximum number of concurrent runs for a single concurrency key of a workflow. The limit applies to the GROUP_ROUND_ROBIN strategies of all versions of the workflow, and replaces the max runs of the workflow version for the key. Runs which are already running are not cancelled when a limit is lowered.
+   *
+   * @tags Concurrency
+   * @name V1ConcurrencyLimitSet
+   * @summary Set concurrency limit
+   * @request POST:/api/v1/stable/tenants/{tenant}/concurrency-limits
+   * @secure
+   */
+  v1ConcurrencyLimitSet = (
+    tenant: string,
+    data: V1SetConcurrencyKeyLimitRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1ConcurrencyKeyLimit, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/concurrency-limits`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Delete a per-key concurrency limit, so the key uses the max runs of the workflow version again.
+   *
+   * @tags Concurrency
+   * @name V1ConcurrencyLimitDelete
+   * @summary Delete concurrency limit
+   * @request POST:/api/v1/stable/tenants/{tenant}/concurrency-limits/delete
+   * @secure
+   */
+  v1ConcurrencyLimitDelete = (
+    tenant: string,
+    data: V1DeleteConcurrencyKeyLimitRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1ConcurrencyKeyLimit, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/concurrency-limits/delete`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description List the active keys of the workflow and step concurrency strategies of the tenant, with the number of running and queued runs and the oldest queued run per key.
+   *
+   * @tags Concurrency
+   * @name V1ConcurrencySlotList
+   * @summary List concurrency slots
+   * @request GET:/api/v1/stable/tenants/{tenant}/concurrency-slots
+   * @secure
+   */
+  v1ConcurrencySlotList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** The workflow ids to filter by */
+      workflowIds?: string[];
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1ConcurrencySlotKeyList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/concurrency-slots`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Lists the pending approvals of the tenant, which tasks are currently waiting on.
+   *
+   * @tags Approval
+   * @name V1ApprovalList
+   * @summary List pending approvals
+   * @request GET:/api/v1/stable/tenants/{tenant}/approvals
+   * @secure
+   */
+  v1ApprovalList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /**
+       * The workflow run id to filter by
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      workflowRunId?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<V1ApprovalList, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/approvals`,
+      method: "GET",
+      query: query,
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Approve a pending approval. The task waiting on the approval is queued, and the decision is made available to the task under the readable data key of the approval condition.
+   *
+   * @tags Approval
+   * @name V1ApprovalApprove
+   * @summary Approve a task
+   * @request POST:/api/v1/stable/tenants/{tenant}/approvals/{v1-approval}/approve
+   * @secure
+   */
+  v1ApprovalApprove = (
+    tenant: string,
+    v1Approval: string,
+    data: V1ApprovalDecisionRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1Approval, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/approvals/${v1Approval}/approve`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Reject a pending approval. The task waiting on the approval is failed without being run.
+   *
+   * @tags Approval
+   * @name V1ApprovalReject
+   * @summary Reject a task
+   * @request POST:/api/v1/stable/tenants/{tenant}/approvals/{v1-approval}/reject
+   * @secure
+   */
+  v1ApprovalReject = (
+    tenant: string,
+    v1Approval: string,
+    data: V1ApprovalDecisionRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<V1Approval, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/approvals/${v1Approval}/reject`,
+      method: "POST",
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Gets the readiness status
    *
@@ -2868,6 +3238,40 @@ export class Api<
       format: "json",
       ...params,
     });
+  /**
+   * @description Drain a worker, so that no new runs are assigned to it. Returns the tasks which are still running on the worker.
+   *
+   * @tags Worker
+   * @name WorkerDrain
+   * @summary Drain worker
+   * @request POST:/api/v1/workers/{worker}/drain
+   * @secure
+   */
+  workerDrain = (worker: string, params: RequestParams = {}) =>
+    this.request<WorkerDrainStatus, APIErrors>({
+      path: `/api/v1/workers/${worker}/drain`,
+      method: "POST",
+      secure: true,
+      format: "json",
+      ...params,
+    });
+  /**
+   * @description Get the drain status of a worker, including the tasks which are still running on the worker
+   *
+   * @tags Worker
+   * @name WorkerDrainStatus
+   * @summary Get worker drain status
+   * @request GET:/api/v1/workers/{worker}/drain
+   * @secure
+   */
+  workerDrainStatus = (worker: string, params: RequestParams = {}) =>
+    this.request<WorkerDrainStatus, APIErrors>({
+      path: `/api/v1/workers/${worker}/drain`,
+      method: "GET",
+      secure: true,
+      format: "json",
+      ...params,
+    });
   /**
    * @description Lists all webhooks
    *
@@ -249,6 +249,7 @@ export enum V1TaskEventType {
   CREATED = "CREATED",
   QUEUED = "QUEUED",
   SKIPPED = "SKIPPED",
+  PREEMPTED = "PREEMPTED",
 }
 
 export enum V1WorkflowType {
@@ -264,6 +265,28 @@ export enum V1TaskStatus {
   FAILED = "FAILED",
 }
 
+export enum V1SchedulingReason {
+  PENDING = "PENDING",
+  NO_WORKERS = "NO_WORKERS",
+  NO_SLOTS = "NO_SLOTS",
+  LABEL_MISMATCH = "LABEL_MISMATCH",
+  STICKY_WORKER_UNAVAILABLE = "STICKY_WORKER_UNAVAILABLE",
+  RATE_LIMITED = "RATE_LIMITED",
+  RESOURCES_UNAVAILABLE = "RESOURCES_UNAVAILABLE",
+  GANG_WAITING = "GANG_WAITING",
+  CONCURRENCY_LIMITED = "CONCURRENCY_LIMITED",
+  RETRY_BACKOFF = "RETRY_BACKOFF",
+  RUNNING = "RUNNING",
+  NOT_QUEUED = "NOT_QUEUED",
+}
+
+export enum V1ApprovalStatus {
+  PENDING = "PENDING",
+  APPROVED = "APPROVED",
+  REJECTED = "REJECTED",
+  EXPIRED = "EXPIRED",
+}
+
 export interface APIResourceMeta {
   /**
    * the id of this resource, in UUID format
@@ -309,6 +332,8 @@ export interface V1TaskSummary {
   duration?: number;
   /** The error message of the task run (for the latest run) */
   errorMessage?: string;
+  /** The structured error of a failed task run. */
+  errorDetails?: V1TaskError;
   /**
    * The timestamp the task run finished.
    * @format date-time
@@ -376,6 +401,10 @@ export interface V1TaskSummary {
    * @format uuid
    */
   parentTaskExternalId?: string;
+  /** The priority of the task. */
+  priority?: number;
+  /** The effective priority of the task, which is higher than its priority when the task has been aged while queued. */
+  effectivePriority?: number;
 }
 
 export interface APIError {
@@ -437,6 +466,8 @@ export interface V1TaskEvent {
   eventType: V1TaskEventType;
   message: string;
   errorMessage?: string;
+  /** The structured error of a failed task run. */
+  errorDetails?: V1TaskError;
   output?: string;
   /** @format uuid */
   workerId?: string;
@@ -452,6 +483,20 @@ export interface V1TaskEventList {
   rows?: V1TaskEvent[];
 }
 
+/** The structured error of a failed task run. */
+export interface V1TaskError {
+  /** The type of the error. */
+  type: string;
+  /** The error message. */
+  message: string;
+  /** The stack trace of the error, if available. */
+  stackTrace?: string;
+  /** Whether the error was reported as retryable. */
+  retryable: boolean;
+  /** Arbitrary details attached to the error. */
+  details?: object;
+}
+
 export interface V1LogLine {
   /**
    * The creation date of the log line.
@@ -507,6 +552,25 @@ export interface V1ReplayedTasks {
   ids?: string[];
 }
 
+export interface V1SignalTaskRequest {
+  /**
+   * The external id of the task or workflow run to signal
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  externalId: string;
+  /** The name of the signal */
+  name: string;
+  /** The payload which is passed to the task along with the signal */
+  payload?: object;
+}
+
+export interface V1SignalledTasks {
+  /** The list of task external ids that were signalled */
+  ids?: string[];
+}
+
 export interface V1DagChildren {
   /** @format uuid */
   dagId?: string;
@@ -537,6 +601,10 @@ export interface V1TriggerWorkflowRunRequest {
   additionalMetadata?: object;
   /** The priority of the workflow run. */
   priority?: number;
+  /** Triggers with the same idempotency key for a workflow return the original run instead of creating a new one. */
+  idempotencyKey?: string;
+  /** How long the idempotency key is retained, as a duration string (e.g. "24h"). Defaults to 24h. */
+  idempotencyKeyRetention?: string;
 }
 
 export interface V1WorkflowRun {
@@ -572,6 +640,8 @@ export interface V1WorkflowRun {
   output: object;
   /** The error message of the task run (for the latest run) */
   errorMessage?: string;
+  /** The structured error of a failed task run. */
+  errorDetails?: V1TaskError;
   /**
    * The ID of the workflow version.
    * @format uuid
@@ -689,6 +759,25 @@ export interface V1TaskTimingList {
   rows: V1TaskTiming[];
 }
 
+export interface V1TaskSchedulingExplanation {
+  /** The reason that the task has not started running. */
+  reason: V1SchedulingReason;
+  /** A human-readable explanation of the reason. */
+  message: string;
+  /**
+   * The time at which the scheduler recorded the reason, if the reason was recorded by the scheduler.
+   * @format date-time
+   */
+  evaluatedAt?: string;
+  /** The concurrency key which is blocking the task, if the task is waiting on a concurrency slot. */
+  concurrencyKey?: string;
+  /**
+   * The time after which the task will be retried, if the task is waiting to be retried.
+   * @format date-time
+   */
+  retryAfter?: string;
+}
+
 export interface V1TaskRunMetric {
   status: V1TaskStatus;
   count: number;
@@ -724,6 +813,8 @@ export interface Tenant {
   version: TenantVersion;
   /** The UI of the tenant. */
   uiVersion?: TenantUIVersion;
+  /** The number of seconds a queued task waits before its priority is raised by 1. Priority aging is disabled when this is not set. */
+  priorityAgingSeconds?: number;
 }
 
 export interface V1EventWorkflowRunSummary {
@@ -873,6 +964,204 @@ export interface V1CELDebugResponse {
   error?: string;
 }
 
+export interface V1RateLimitUsagePoint {
+  /**
+   * The start of the bucket.
+   * @format date-time
+   */
+  time: string;
+  /** The units consumed from the rate limit by tasks which were assigned in the bucket. */
+  unitsConsumed: number;
+  /** The number of tasks which were first rate limited by the key in the bucket. A task which is rate limited on several days is counted once per day. */
+  tasksRateLimited: number;
+}
+
+export interface V1RateLimitUsage {
+  /** The rate limit key. */
+  key: string;
+  /** @format date-time */
+  since: string;
+  /** @format date-time */
+  until: string;
+  /** The units consumed from the rate limit between since and until. */
+  totalUnitsConsumed: number;
+  /** The number of tasks which were rate limited by the key between since and until, counted once per day. */
+  totalTasksRateLimited: number;
+  /**
+   * The start of the latest bucket in which the rate limit was exhausted, if it was exhausted between since and until.
+   * @format date-time
+   */
+  lastRateLimitedAt?: string;
+  results: V1RateLimitUsagePoint[];
+}
+
+export interface V1DeleteRateLimitsRequest {
+  /**
+   * The prefix of the rate limit keys to delete.
+   * @minLength 1
+   */
+  prefix: string;
+}
+
+export interface V1DeleteUnusedRateLimitsRequest {
+  /**
+   * Dynamic rate limits which haven't been used for this many seconds are deleted.
+   * @min 1
+   */
+  unusedForSeconds: number;
+}
+
+export interface V1DeletedRateLimits {
+  /** The keys of the deleted rate limits. */
+  keys: string[];
+}
+
+export interface V1ConcurrencyKeyLimit {
+  /** The name of the workflow. */
+  workflowName: string;
+  /** The concurrency key which the limit applies to. */
+  key: string;
+  /** The maximum number of concurrent runs for the key, which overrides the max runs of the workflow version. */
+  maxRuns: number;
+  /**
+   * The time the limit was last changed.
+   * @format date-time
+   */
+  updatedAt: string;
+}
+
+export interface V1ConcurrencyKeyLimitList {
+  rows: V1ConcurrencyKeyLimit[];
+}
+
+export interface V1SetConcurrencyKeyLimitRequest {
+  /**
+   * The name of the workflow.
+   * @minLength 1
+   */
+  workflowName: string;
+  /**
+   * The concurrency key, as evaluated from the concurrency expression.
+   * @minLength 1
+   */
+  key: string;
+  /**
+   * The maximum number of concurrent runs for the key.
+   * @min 1
+   */
+  maxRuns: number;
+}
+
+export interface V1DeleteConcurrencyKeyLimitRequest {
+  /**
+   * The name of the workflow.
+   * @minLength 1
+   */
+  workflowName: string;
+  /**
+   * The concurrency key.
+   * @minLength 1
+   */
+  key: string;
+}
+
+export interface V1ConcurrencySlotKey {
+  /**
+   * The id of the workflow.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /** The name of the workflow. */
+  workflowName: string;
+  /** The readable id of the step, if the strategy is set on a step rather than on the workflow. */
+  stepReadableId?: string;
+  /** The concurrency strategy, for example GROUP_ROUND_ROBIN or CANCEL_IN_PROGRESS. */
+  strategy: string;
+  /** The expression which the key was evaluated from. */
+  expression: string;
+  /** The concurrency key. */
+  key: string;
+  /** The maximum number of concurrent runs for the key, including per-key concurrency limits. */
+  maxRuns: number;
+  /** The number of runs which hold a slot for the key. */
+  runningCount: number;
+  /** The number of runs which are waiting for a slot for the key. */
+  queuedCount: number;
+  /**
+   * The id of the oldest workflow run waiting for a slot for the key. For standalone tasks, this is the id of the task.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  oldestQueuedRunId?: string;
+  /**
+   * The time the oldest run waiting for a slot for the key was created.
+   * @format date-time
+   */
+  oldestQueuedAt?: string;
+}
+
+export interface V1ConcurrencySlotKeyList {
+  rows: V1ConcurrencySlotKey[];
+}
+
+export interface V1Approval {
+  metadata: APIResourceMeta;
+  /**
+   * The external id of the task which is waiting on the approval.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  taskExternalId: string;
+  /**
+   * The external id of the workflow run of the task.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowRunId: string;
+  /** The key under which the decision is made available to the task. */
+  readableDataKey: string;
+  status: V1ApprovalStatus;
+  /**
+   * The time at which the approval expires, if it has an expiry.
+   * @format date-time
+   */
+  expiresAt?: string;
+  /**
+   * The time at which the approval was approved or rejected.
+   * @format date-time
+   */
+  decidedAt?: string;
+  /**
+   * The id of the user who approved or rejected the task.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  decidedBy?: string;
+  /** The email of the user who approved or rejected the task. */
+  decidedByEmail?: string;
+  /** The comment left with the decision. */
+  comment?: string;
+  /** The payload passed to the task with the decision. */
+  payload?: object;
+}
+
+export interface V1ApprovalList {
+  rows?: V1Approval[];
+}
+
+export interface V1ApprovalDecisionRequest {
+  /** A comment explaining the decision. */
+  comment?: string;
+  /** A payload which is passed to the task along with the decision. */
+  payload?: object;
+}
+
 export interface APIMetaAuth {
   /**
    * the supported types of authentication
@@ -1167,6 +1456,8 @@ export interface UpdateTenantRequest {
   version?: TenantVersion;
   /** The UI of the tenant. */
   uiVersion?: TenantUIVersion;
+  /** The number of seconds a queued task waits before its priority is raised by 1. Set to 0 to disable priority aging. */
+  priorityAgingSeconds?: number;
 }
 
 export interface TenantAlertingSettings {
@@ -1930,7 +2221,7 @@ export interface Worker {
   /** The recent step runs for the worker. */
   recentStepRuns?: RecentStepRuns[];
   /** The status of the worker. */
-  status?: "ACTIVE" | "INACTIVE" | "PAUSED";
+  status?: "ACTIVE" | "INACTIVE" | "PAUSED" | "DRAINING";
   /** The maximum number of runs this worker can execute concurrently. */
   maxRuns?: number;
   /** The number of runs this worker can execute concurrently. */
@@ -1965,6 +2256,25 @@ export interface UpdateWorkerRequest {
   isPaused?: boolean;
 }
 
+export interface WorkerDrainStatus {
+  /**
+   * The id of the worker.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workerId: string;
+  /** Whether the worker is draining and cannot accept new runs. */
+  isDraining: boolean;
+  /**
+   * The time the worker started draining.
+   * @format date-time
+   */
+  drainingAt?: string;
+  /** The ids of the tasks which are still running on the worker. */
+  inFlightTaskIds: string[];
+}
+
 export interface WebhookWorker {
   metadata: APIResourceMeta;
   /** The name of the webhook worker. */
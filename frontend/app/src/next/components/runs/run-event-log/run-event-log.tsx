@@ -188,6 +188,13 @@ const EVENT_CONFIG: Record<V1TaskEventType, EventConfig> = {
     status: WorkflowRunStatus.SUCCEEDED,
     title: 'Task Skipped',
   },
+  [V1TaskEventType.PREEMPTED]: {
+    icon: PauseCircle,
+    message: 'Task preempted by a higher-priority task and requeued',
+    showWorkerButton: true,
+    status: WorkflowRunStatus.BACKOFF,
+    title: 'Task Preempted',
+  },
   [V1TaskEventType.REQUEUED_NO_WORKER]: {
     icon: PauseCircle,
     message: 'Task requeued - no available worker',
@@ -54,19 +54,21 @@ export const WorkerStatus = ({
   status = 'INACTIVE',
   health,
 }: {
-  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED';
+  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED' | 'DRAINING';
   health: string[];
 }) => {
   const label: Record<typeof status, string> = {
     ACTIVE: 'Active',
     INACTIVE: 'Inactive',
     PAUSED: 'Paused',
+    DRAINING: 'Draining',
   };
 
   const variant: Record<typeof status, BadgeProps['variant']> = {
     ACTIVE: 'successful',
     INACTIVE: 'failed',
     PAUSED: 'inProgress',
+    DRAINING: 'inProgress',
   };
 
   return (
@@ -29,6 +29,7 @@ function eventTypeToSeverity(
     case V1TaskEventType.CANCELLED:
       return StepRunEventSeverity.CRITICAL;
     case V1TaskEventType.REASSIGNED:
+    case V1TaskEventType.PREEMPTED:
     case V1TaskEventType.REQUEUED_NO_WORKER:
     case V1TaskEventType.REQUEUED_RATE_LIMIT:
     case V1TaskEventType.RETRIED_BY_USER:
@@ -196,6 +197,8 @@ function mapEventTypeToTitle(eventType: V1TaskEventType | undefined): string {
       return 'Queued';
     case V1TaskEventType.SKIPPED:
       return 'Skipped';
+    case V1TaskEventType.PREEMPTED:
+      return 'Preempted by a higher-priority task';
     case undefined:
       return 'Unknown';
     default:
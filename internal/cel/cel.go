@@ -323,6 +323,16 @@ func (p *CELParser) CheckStepRunOutAgainstKnownV1(out *StepRunOut, knownType sql
 				return fmt.Errorf("%s, got string", prefix)
 			}
 
+			return fmt.Errorf("%s, got unknown type", prefix)
+		}
+	case sqlcv1.StepExpressionKindFAIRNESSKEY:
+		if out.String == nil {
+			prefix := "expected string output for fairness key"
+
+			if out.Int != nil {
+				return fmt.Errorf("%s, got int", prefix)
+			}
+
 			return fmt.Errorf("%s, got unknown type", prefix)
 		}
 	}
@@ -419,6 +419,36 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 		Duration: &duration,
 	}
 
+	if req.Algorithm != nil {
+		createOpts.Algorithm = repository.StringPtr(req.Algorithm.String())
+	}
+
+	if req.Burst != nil || req.RefillRate != nil {
+		if req.GetAlgorithm() != contracts.RateLimitAlgorithm_TOKEN_BUCKET {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"burst and refill rate are only supported for TOKEN_BUCKET rate limits",
+			)
+		}
+
+		if req.Burst != nil {
+			if *req.Burst <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "burst must be positive")
+			}
+
+			burst := int(*req.Burst)
+			createOpts.Burst = &burst
+		}
+
+		if req.RefillRate != nil {
+			if *req.RefillRate <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "refill rate must be positive")
+			}
+
+			createOpts.RefillRate = req.RefillRate
+		}
+	}
+
 	_, err := a.repo.RateLimit().UpsertRateLimit(ctx, tenantId, req.Key, createOpts)
 
 	if err != nil {
@@ -681,6 +711,10 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 	childWorkflowMap := make(map[string]*dbsqlc.WorkflowRun)
 
 	for _, req := range requests {
+		if req.IdempotencyKey != nil {
+			return nil, nil, status.Error(codes.Unimplemented, "idempotency keys are not supported on major engine version V0")
+		}
+
 		isParentTriggered := req.ParentId != nil
 
 		if isParentTriggered {
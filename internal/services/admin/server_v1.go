@@ -62,6 +62,10 @@ func (a *AdminServiceImpl) triggerWorkflowV1(ctx context.Context, req *contracts
 	opt, err := a.newTriggerOpt(ctx, tenantId, req)
 
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
 		return nil, fmt.Errorf("could not create trigger opt: %w", err)
 	}
 
@@ -104,6 +108,10 @@ func (a *AdminServiceImpl) bulkTriggerWorkflowV1(ctx context.Context, req *contr
 		opt, err := a.newTriggerOpt(ctx, tenantId, workflow)
 
 		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+
 			return nil, fmt.Errorf("could not create trigger opt: %w", err)
 		}
 
@@ -191,6 +199,16 @@ func (i *AdminServiceImpl) newTriggerOpt(
 		t.ChildKey = req.ChildKey
 	}
 
+	if req.IdempotencyKey != nil {
+		idempotencyKey, err := v1.NewIdempotencyKeyOpts(*req.IdempotencyKey, req.IdempotencyKeyRetention)
+
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		t.IdempotencyKey = idempotencyKey
+	}
+
 	return &v1.WorkflowNameTriggerOpts{
 		TriggerTaskData: t,
 	}, nil
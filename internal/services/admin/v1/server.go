@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -383,6 +384,10 @@ func (a *AdminServiceImpl) TriggerWorkflowRun(ctx context.Context, req *contract
 	opt, err := a.newTriggerOpt(ctx, tenantId, req)
 
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
 		return nil, fmt.Errorf("could not create trigger opt: %w", err)
 	}
 
@@ -418,6 +423,16 @@ func (i *AdminServiceImpl) newTriggerOpt(
 		AdditionalMetadata: req.AdditionalMetadata,
 	}
 
+	if req.IdempotencyKey != nil {
+		idempotencyKey, err := v1.NewIdempotencyKeyOpts(*req.IdempotencyKey, req.IdempotencyKeyRetention)
+
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		t.IdempotencyKey = idempotencyKey
+	}
+
 	return &v1.WorkflowNameTriggerOpts{
 		TriggerTaskData: t,
 	}, nil
@@ -513,7 +528,7 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 	tasks, err := getCreateTaskOpts(req.Tasks, "DEFAULT")
 
 	if err != nil {
-		if errors.Is(err, v1.ErrDagParentNotFound) {
+		if errors.Is(err, v1.ErrDagParentNotFound) || errors.Is(err, v1.ErrInvalidGang) {
 			// Extract the additional error information
 			return nil, status.Error(
 				codes.InvalidArgument,
@@ -540,6 +555,21 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 		onFailureTask = &onFailureTasks[0]
 	}
 
+	// the workflow placement strategy is the default for tasks which don't set their own
+	if req.PlacementStrategy != nil {
+		placementStrategy := req.PlacementStrategy.String()
+
+		for i := range tasks {
+			if tasks[i].PlacementStrategy == nil {
+				tasks[i].PlacementStrategy = &placementStrategy
+			}
+		}
+
+		if onFailureTask != nil && onFailureTask.PlacementStrategy == nil {
+			onFailureTask.PlacementStrategy = &placementStrategy
+		}
+	}
+
 	var sticky *string
 
 	if req.Sticky != nil {
@@ -564,10 +594,20 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 			limitStrategy = &s
 		}
 
+		var queueOverflow *string
+
+		if req.Concurrency.QueueOverflow != nil {
+			s := req.Concurrency.QueueOverflow.String()
+			queueOverflow = &s
+		}
+
 		concurrency = append(concurrency, v1.CreateConcurrencyOpts{
 			LimitStrategy: limitStrategy,
 			Expression:    req.Concurrency.Expression,
 			MaxRuns:       req.Concurrency.MaxRuns,
+			MaxQueued:     req.Concurrency.MaxQueued,
+			QueueOverflow: queueOverflow,
+			Group:         req.Concurrency.Group,
 		})
 	}
 
@@ -586,10 +626,20 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 			limitStrategy = &s
 		}
 
+		var queueOverflow *string
+
+		if c.QueueOverflow != nil {
+			s := c.QueueOverflow.String()
+			queueOverflow = &s
+		}
+
 		concurrency = append(concurrency, v1.CreateConcurrencyOpts{
 			LimitStrategy: limitStrategy,
 			Expression:    c.Expression,
 			MaxRuns:       c.MaxRuns,
+			MaxQueued:     c.MaxQueued,
+			QueueOverflow: queueOverflow,
+			Group:         c.Group,
 		})
 	}
 
@@ -621,18 +671,30 @@ func getCreateWorkflowOpts(req *contracts.CreateWorkflowVersionRequest) (*v1.Cre
 		})
 	}
 
+	var debounce *v1.CreateDebounceOpts
+
+	if req.Debounce != nil {
+		debounce = &v1.CreateDebounceOpts{
+			Expression: req.Debounce.Expression,
+			Window:     req.Debounce.Window,
+		}
+	}
+
 	return &v1.CreateWorkflowVersionOpts{
-		Name:            req.Name,
-		Concurrency:     concurrency,
-		Description:     &req.Description,
-		EventTriggers:   req.EventTriggers,
-		CronTriggers:    req.CronTriggers,
-		CronInput:       cronInput,
-		Tasks:           tasks,
-		OnFailure:       onFailureTask,
-		Sticky:          sticky,
-		DefaultPriority: req.DefaultPriority,
-		DefaultFilters:  defaultFilters,
+		Name:                 req.Name,
+		Concurrency:          concurrency,
+		Description:          &req.Description,
+		EventTriggers:        req.EventTriggers,
+		CronTriggers:         req.CronTriggers,
+		CronInput:            cronInput,
+		Tasks:                tasks,
+		OnFailure:            onFailureTask,
+		Sticky:               sticky,
+		DefaultPriority:      req.DefaultPriority,
+		DefaultFilters:       defaultFilters,
+		PriorityAgingSeconds: req.PriorityAgingSeconds,
+		Debounce:             debounce,
+		ExecutionTimeout:     req.ExecutionTimeout,
 	}, nil
 }
 
@@ -730,10 +792,57 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 			}
 		}
 
+		if len(stepCp.RetryErrorCodes) > 0 {
+			steps[j].RetryErrorCodes = stepCp.RetryErrorCodes
+		}
+
+		if stepCp.RetryJitter != nil {
+			jitter := float64(*stepCp.RetryJitter)
+			steps[j].RetryJitter = &jitter
+		}
+
+		if stepCp.RetryMinBackoffSeconds != nil {
+			steps[j].RetryMinBackoffSeconds = stepCp.RetryMinBackoffSeconds
+		}
+
 		if stepCp.Timeout != "" {
 			steps[j].Timeout = &stepCp.Timeout
 		}
 
+		if stepCp.TotalTimeout != nil && *stepCp.TotalTimeout != "" {
+			steps[j].TotalTimeout = stepCp.TotalTimeout
+		}
+
+		if stepCp.SlotWeight != nil {
+			slotWeight := int(*stepCp.SlotWeight)
+			steps[j].SlotWeight = &slotWeight
+		}
+
+		if len(stepCp.ResourceRequests) > 0 {
+			steps[j].ResourceRequests = stepCp.ResourceRequests
+		}
+
+		if stepCp.FairnessKey != nil && *stepCp.FairnessKey != "" {
+			steps[j].FairnessKey = stepCp.FairnessKey
+		}
+
+		if stepCp.Preemptible != nil {
+			steps[j].Preemptible = stepCp.Preemptible
+		}
+
+		if stepCp.Gang != nil && *stepCp.Gang != "" {
+			steps[j].Gang = stepCp.Gang
+
+			if stepCp.GangTimeout != nil && *stepCp.GangTimeout != "" {
+				steps[j].GangTimeout = stepCp.GangTimeout
+			}
+		}
+
+		if stepCp.PlacementStrategy != nil {
+			placementStrategy := stepCp.PlacementStrategy.String()
+			steps[j].PlacementStrategy = &placementStrategy
+		}
+
 		// Safely handle rate limits
 		if stepCp.RateLimits != nil {
 			for _, rateLimit := range stepCp.RateLimits {
@@ -827,6 +936,51 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 				}
 			}
 
+			// Check ApprovalConditions
+			if stepCp.Conditions.ApprovalConditions != nil {
+				for _, approvalCondition := range stepCp.Conditions.ApprovalConditions {
+					// Skip nil conditions
+					if approvalCondition == nil || approvalCondition.Base == nil {
+						continue
+					}
+
+					if approvalCondition.Base.Action != contracts.Action_QUEUE {
+						return nil, status.Error(
+							codes.InvalidArgument,
+							fmt.Sprintf("approval conditions can only be used as wait conditions (step %s)", stepCp.ReadableId),
+						)
+					}
+
+					// the expression of an approval condition is generated from the decision
+					if approvalCondition.Base.Expression != "" {
+						return nil, status.Error(
+							codes.InvalidArgument,
+							fmt.Sprintf("approval conditions do not support expressions (step %s)", stepCp.ReadableId),
+						)
+					}
+
+					opt := v1.CreateStepMatchConditionOpt{
+						MatchConditionKind: "APPROVAL",
+						ReadableDataKey:    approvalCondition.Base.ReadableDataKey,
+						Action:             approvalCondition.Base.Action.String(),
+						OrGroupId:          approvalCondition.Base.OrGroupId,
+					}
+
+					if approvalCondition.ExpiresIn != "" {
+						expiresIn := approvalCondition.ExpiresIn
+						expiryAction := "FAIL"
+
+						if approvalCondition.SkipOnExpiry {
+							expiryAction = "SKIP"
+						}
+
+						opt.ApprovalExpiresIn = &expiresIn
+						opt.ApprovalExpiryAction = &expiryAction
+					}
+
+					steps[j].TriggerConditions = append(steps[j].TriggerConditions, opt)
+				}
+			}
 		}
 
 		if stepCp.Concurrency != nil {
@@ -850,10 +1004,20 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 					limitStrategy = &s
 				}
 
+				var queueOverflow *string
+
+				if concurrency.QueueOverflow != nil {
+					s := concurrency.QueueOverflow.String()
+					queueOverflow = &s
+				}
+
 				steps[j].Concurrency = append(steps[j].Concurrency, v1.CreateConcurrencyOpts{
 					Expression:    concurrency.Expression,
 					MaxRuns:       concurrency.MaxRuns,
 					LimitStrategy: limitStrategy,
+					MaxQueued:     concurrency.MaxQueued,
+					QueueOverflow: queueOverflow,
+					Group:         concurrency.Group,
 				})
 			}
 		}
@@ -868,5 +1032,44 @@ func getCreateTaskOpts(tasks []*contracts.CreateTaskOpts, kind string) ([]v1.Cre
 		}
 	}
 
+	if err := validateGangs(steps); err != nil {
+		return nil, err
+	}
+
 	return steps, nil
 }
+
+// validateGangs checks that the steps in each gang have the same parents, so that they are queued at the
+// same time, and that they don't have conflicting gang timeouts.
+func validateGangs(steps []v1.CreateStepOpts) error {
+	gangToStep := make(map[string]v1.CreateStepOpts)
+
+	for _, step := range steps {
+		if step.Gang == nil {
+			continue
+		}
+
+		first, ok := gangToStep[*step.Gang]
+
+		if !ok {
+			gangToStep[*step.Gang] = step
+			continue
+		}
+
+		firstParents := slices.Clone(first.Parents)
+		parents := slices.Clone(step.Parents)
+
+		slices.Sort(firstParents)
+		slices.Sort(parents)
+
+		if !slices.Equal(firstParents, parents) {
+			return fmt.Errorf("%w: steps '%s' and '%s' in gang '%s' must have the same parents", v1.ErrInvalidGang, first.ReadableId, step.ReadableId, *step.Gang)
+		}
+
+		if first.GangTimeout != nil && step.GangTimeout != nil && *first.GangTimeout != *step.GangTimeout {
+			return fmt.Errorf("%w: steps '%s' and '%s' in gang '%s' have different gang timeouts", v1.ErrInvalidGang, first.ReadableId, step.ReadableId, *step.Gang)
+		}
+	}
+
+	return nil
+}
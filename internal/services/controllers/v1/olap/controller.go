@@ -337,6 +337,8 @@ func (tc *OLAPControllerImpl) handleBufferedMsgs(tenantId, msgId string, payload
 		return tc.handleCreateMonitoringEvent(context.Background(), tenantId, payloads)
 	case "created-event-trigger":
 		return tc.handleCreateEventTriggers(context.Background(), tenantId, payloads)
+	case "rate-limit-usage":
+		return tc.handleRateLimitUsage(context.Background(), tenantId, payloads)
 	}
 
 	return fmt.Errorf("unknown message id: %s", msgId)
@@ -377,6 +379,32 @@ func (tc *OLAPControllerImpl) handleCreatedDAG(ctx context.Context, tenantId str
 	return tc.repo.OLAP().CreateDAGs(ctx, tenantId, createDAGOpts)
 }
 
+// handleRateLimitUsage is responsible for flushing rate limit usage from the scheduler to the OLAP repository
+func (tc *OLAPControllerImpl) handleRateLimitUsage(ctx context.Context, tenantId string, payloads [][]byte) error {
+	msgs := msgqueue.JSONConvert[tasktypes.RateLimitUsagePayload](payloads)
+	usage := make([]v1.RateLimitUsage, 0, len(msgs))
+
+	for _, msg := range msgs {
+		rateLimitedTasks := make([]v1.RateLimitedTask, 0, len(msg.RateLimitedTasks))
+
+		for _, task := range msg.RateLimitedTasks {
+			rateLimitedTasks = append(rateLimitedTasks, v1.RateLimitedTask{
+				TaskId:         task.TaskId,
+				TaskInsertedAt: task.TaskInsertedAt,
+			})
+		}
+
+		usage = append(usage, v1.RateLimitUsage{
+			Key:              msg.Key,
+			Timestamp:        msg.Timestamp,
+			UnitsConsumed:    msg.UnitsConsumed,
+			RateLimitedTasks: rateLimitedTasks,
+		})
+	}
+
+	return tc.repo.OLAP().RecordRateLimitUsage(ctx, tenantId, usage)
+}
+
 func (tc *OLAPControllerImpl) handleCreateEventTriggers(ctx context.Context, tenantId string, payloads [][]byte) error {
 	msgs := msgqueue.JSONConvert[tasktypes.CreatedEventTriggerPayload](payloads)
 
@@ -475,6 +503,7 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 	readableStatuses := make([]sqlcv1.V1ReadableStatusOlap, 0)
 	eventPayloads := make([]string, 0)
 	eventMessages := make([]string, 0)
+	errorDetails := make([][]byte, 0)
 	timestamps := make([]pgtype.Timestamptz, 0)
 
 	for _, msg := range msgs {
@@ -497,6 +526,7 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 		eventTypes = append(eventTypes, msg.EventType)
 		eventPayloads = append(eventPayloads, msg.EventPayload)
 		eventMessages = append(eventMessages, msg.EventMessage)
+		errorDetails = append(errorDetails, msg.ErrorDetails)
 		timestamps = append(timestamps, sqlchelpers.TimestamptzFromTime(msg.EventTimestamp))
 
 		if msg.WorkerId != nil {
@@ -510,6 +540,8 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
 		case sqlcv1.V1EventTypeOlapREASSIGNED:
 			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
+		case sqlcv1.V1EventTypeOlapPREEMPTED:
+			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
 		case sqlcv1.V1EventTypeOlapRETRIEDBYUSER:
 			readableStatuses = append(readableStatuses, sqlcv1.V1ReadableStatusOlapQUEUED)
 		case sqlcv1.V1EventTypeOlapCREATED:
@@ -578,6 +610,7 @@ func (tc *OLAPControllerImpl) handleCreateMonitoringEvent(ctx context.Context, t
 			}
 		case sqlcv1.V1EventTypeOlapFAILED:
 			event.ErrorMessage = sqlchelpers.TextFromStr(eventPayloads[i])
+			event.ErrorDetails = errorDetails[i]
 		case sqlcv1.V1EventTypeOlapCANCELLED:
 			event.AdditionalEventMessage = sqlchelpers.TextFromStr(eventMessages[i])
 		}
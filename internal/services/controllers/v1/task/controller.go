@@ -41,25 +41,26 @@ type TasksController interface {
 }
 
 type TasksControllerImpl struct {
-	mq                     msgqueue.MessageQueue
-	pubBuffer              *msgqueue.MQPubBuffer
-	l                      *zerolog.Logger
-	queueLogger            *zerolog.Logger
-	pgxStatsLogger         *zerolog.Logger
-	repo                   repository.EngineRepository
-	repov1                 v1.Repository
-	dv                     datautils.DataDecoderValidator
-	s                      gocron.Scheduler
-	a                      *hatcheterrors.Wrapped
-	p                      *partition.Partition
-	celParser              *cel.CELParser
-	opsPoolPollInterval    time.Duration
-	opsPoolJitter          time.Duration
-	timeoutTaskOperations  *queueutils.OperationPool
-	reassignTaskOperations *queueutils.OperationPool
-	retryTaskOperations    *queueutils.OperationPool
-	emitSleepOperations    *queueutils.OperationPool
-	replayEnabled          bool
+	mq                             msgqueue.MessageQueue
+	pubBuffer                      *msgqueue.MQPubBuffer
+	l                              *zerolog.Logger
+	queueLogger                    *zerolog.Logger
+	pgxStatsLogger                 *zerolog.Logger
+	repo                           repository.EngineRepository
+	repov1                         v1.Repository
+	dv                             datautils.DataDecoderValidator
+	s                              gocron.Scheduler
+	a                              *hatcheterrors.Wrapped
+	p                              *partition.Partition
+	celParser                      *cel.CELParser
+	opsPoolPollInterval            time.Duration
+	opsPoolJitter                  time.Duration
+	timeoutTaskOperations          *queueutils.OperationPool
+	executionTimeoutTaskOperations *queueutils.OperationPool
+	reassignTaskOperations         *queueutils.OperationPool
+	retryTaskOperations            *queueutils.OperationPool
+	emitSleepOperations            *queueutils.OperationPool
+	replayEnabled                  bool
 }
 
 type TasksControllerOpt func(*TasksControllerOpts)
@@ -226,6 +227,7 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 	timeout := time.Second * 5
 
 	t.timeoutTaskOperations = queueutils.NewOperationPool(opts.l, timeout, "timeout step runs", t.processTaskTimeouts).WithJitter(jitter)
+	t.executionTimeoutTaskOperations = queueutils.NewOperationPool(opts.l, timeout, "execution timeout step runs", t.processTaskExecutionTimeouts).WithJitter(jitter)
 	t.emitSleepOperations = queueutils.NewOperationPool(opts.l, timeout, "emit sleep step runs", t.processSleeps).WithJitter(jitter)
 	t.reassignTaskOperations = queueutils.NewOperationPool(opts.l, timeout, "reassign step runs", t.processTaskReassignments).WithJitter(jitter)
 	t.retryTaskOperations = queueutils.NewOperationPool(opts.l, timeout, "retry step runs", t.processTaskRetryQueueItems).WithJitter(jitter)
@@ -274,6 +276,24 @@ func (tc *TasksControllerImpl) Start() (func() error, error) {
 		return nil, wrappedErr
 	}
 
+	_, err = tc.s.NewJob(
+		gocron.DurationJob(tc.opsPoolPollInterval),
+		gocron.NewTask(
+			tc.runTenantExecutionTimeoutTasks(spanContext),
+		),
+	)
+
+	if err != nil {
+		wrappedErr := fmt.Errorf("could not schedule step run execution timeout: %w", err)
+
+		cancel()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "could not schedule step run execution timeout")
+		span.End()
+
+		return nil, wrappedErr
+	}
+
 	_, err = tc.s.NewJob(
 		gocron.DurationJob(tc.opsPoolPollInterval),
 		gocron.NewTask(
@@ -392,6 +412,8 @@ func (tc *TasksControllerImpl) handleBufferedMsgs(tenantId, msgId string, payloa
 		return tc.handleTaskFailed(context.Background(), tenantId, payloads)
 	case "task-cancelled":
 		return tc.handleTaskCancelled(context.Background(), tenantId, payloads)
+	case "task-preempted":
+		return tc.handleTaskPreempted(context.Background(), tenantId, payloads)
 	case "cancel-tasks":
 		return tc.handleCancelTasks(context.Background(), tenantId, payloads)
 	case "replay-tasks":
@@ -459,6 +481,8 @@ func (tc *TasksControllerImpl) handleTaskFailed(ctx context.Context, tenantId st
 			IsAppError:     msg.IsAppError,
 			ErrorMessage:   msg.ErrorMsg,
 			IsNonRetryable: msg.IsNonRetryable,
+			ErrorCode:      msg.ErrorCode,
+			ErrorDetails:   msg.ErrorDetails,
 		})
 
 		if msg.ErrorMsg != "" {
@@ -474,6 +498,7 @@ func (tc *TasksControllerImpl) handleTaskFailed(ctx context.Context, tenantId st
 				EventType:      sqlcv1.V1EventTypeOlapFAILED,
 				EventTimestamp: time.Now().UTC(),
 				EventPayload:   msg.ErrorMsg,
+				ErrorDetails:   msg.ErrorDetails,
 			},
 		)
 
@@ -638,6 +663,90 @@ func (tc *TasksControllerImpl) handleTaskCancelled(ctx context.Context, tenantId
 	return err
 }
 
+func (tc *TasksControllerImpl) handleTaskPreempted(ctx context.Context, tenantId string, payloads [][]byte) error {
+	opts := make([]v1.TaskIdInsertedAtRetryCount, 0)
+
+	msgs := msgqueue.JSONConvert[tasktypes.PreemptedTaskPayload](payloads)
+	preemptedBy := make(map[int64]string)
+
+	for _, msg := range msgs {
+		opts = append(opts, v1.TaskIdInsertedAtRetryCount{
+			Id:         msg.TaskId,
+			InsertedAt: msg.InsertedAt,
+			RetryCount: msg.RetryCount,
+		})
+
+		preemptedBy[msg.TaskId] = msg.PreemptedByExternalId
+	}
+
+	res, err := tc.repov1.Tasks().PreemptTasks(ctx, tenantId, opts)
+
+	if err != nil {
+		return err
+	}
+
+	tasksToSendToDispatcher := make([]tasktypes.SignalTaskCancelledPayload, 0, len(res.ReleasedTasks))
+
+	for _, task := range res.ReleasedTasks {
+		tasksToSendToDispatcher = append(tasksToSendToDispatcher, tasktypes.SignalTaskCancelledPayload{
+			TaskId:     task.ID,
+			WorkerId:   sqlchelpers.UUIDToStr(task.WorkerID),
+			RetryCount: task.RetryCount,
+		})
+	}
+
+	// send task cancellations to the dispatcher
+	err = tc.sendTaskCancellationsToDispatcher(ctx, tenantId, tasksToSendToDispatcher)
+
+	if err != nil {
+		return fmt.Errorf("could not send task cancellations to dispatcher: %w", err)
+	}
+
+	tc.notifyQueuesOnCompletion(ctx, tenantId, res.ReleasedTasks)
+
+	var outerErr error
+
+	for _, task := range res.ReleasedTasks {
+		workerId := sqlchelpers.UUIDToStr(task.WorkerID)
+
+		olapMsg, err := tasktypes.MonitoringEventMessageFromInternal(
+			tenantId,
+			tasktypes.CreateMonitoringEventPayload{
+				TaskId:         task.ID,
+				RetryCount:     task.RetryCount,
+				WorkerId:       &workerId,
+				EventType:      sqlcv1.V1EventTypeOlapPREEMPTED,
+				EventTimestamp: time.Now(),
+				EventMessage:   fmt.Sprintf("Preempted by higher-priority task %s", preemptedBy[task.ID]),
+			},
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not create monitoring event message: %w", err))
+			continue
+		}
+
+		err = tc.pubBuffer.Pub(
+			ctx,
+			msgqueue.OLAP_QUEUE,
+			olapMsg,
+			false,
+		)
+
+		if err != nil {
+			outerErr = multierror.Append(outerErr, fmt.Errorf("could not publish monitoring event message: %w", err))
+		}
+	}
+
+	// instrumentation
+	for range res.ReleasedTasks {
+		prometheus.PreemptedTasks.Inc()
+		prometheus.TenantPreemptedTasks.WithLabelValues(tenantId).Inc()
+	}
+
+	return outerErr
+}
+
 func (tc *TasksControllerImpl) handleCancelTasks(ctx context.Context, tenantId string, payloads [][]byte) error {
 	// sure would be nice if we could use our own durable execution primitives here, but that's a bootstrapping
 	// problem that we don't have a clean way to solve (yet)
@@ -917,6 +1026,7 @@ func (tc *TasksControllerImpl) handleProcessUserEventTrigger(ctx context.Context
 			AdditionalMetadata: msg.EventAdditionalMetadata,
 			Priority:           msg.EventPriority,
 			Scope:              msg.EventScope,
+			IdempotencyKey:     msg.EventIdempotencyKey,
 		}
 
 		opts = append(opts, opt)
@@ -1721,7 +1831,12 @@ func (tc *TasksControllerImpl) pubRetryEvent(ctx context.Context, tenantId strin
 
 	retryMsg := fmt.Sprintf("This is retry number %d.", task.AppRetryCount)
 
-	if task.RetryBackoffFactor.Valid && task.RetryMaxBackoff.Valid {
+	if task.RetryAfter.Valid {
+		retryTime := task.RetryAfter.Time
+		retryDur := time.Until(retryTime).Round(time.Millisecond)
+
+		retryMsg = fmt.Sprintf("%s Retrying in %s (%s).", retryMsg, retryDur.String(), retryTime.Format(time.RFC3339))
+	} else if task.RetryBackoffFactor.Valid && task.RetryMaxBackoff.Valid {
 		maxBackoffSeconds := int(task.RetryMaxBackoff.Int32)
 		backoffFactor := task.RetryBackoffFactor.Float64
 
@@ -1759,7 +1874,7 @@ func (tc *TasksControllerImpl) pubRetryEvent(ctx context.Context, tenantId strin
 		return fmt.Errorf("could not publish monitoring event message: %w", err)
 	}
 
-	if !task.RetryBackoffFactor.Valid {
+	if !task.RetryBackoffFactor.Valid && !task.RetryAfter.Valid {
 		olapMsg, err = tasktypes.MonitoringEventMessageFromInternal(
 			tenantId,
 			tasktypes.CreateMonitoringEventPayload{
@@ -2,9 +2,13 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 )
@@ -49,5 +53,31 @@ func (tc *TasksControllerImpl) processSleeps(ctx context.Context, tenantId strin
 		}
 	}
 
-	return shouldContinue, nil
+	tasks, dags, shouldContinueDebounces, err := tc.repov1.Triggers().ProcessDebouncedRuns(ctx, tenantId)
+
+	if err != nil {
+		if errors.Is(err, metered.ErrResourceExhausted) {
+			tc.l.Warn().Msg("resource exhausted while triggering debounced runs. Not retrying")
+
+			return shouldContinue, nil
+		}
+
+		return false, fmt.Errorf("could not process debounced runs for tenant %s: %w", tenantId, err)
+	}
+
+	eg := &errgroup.Group{}
+
+	eg.Go(func() error {
+		return tc.signalTasksCreated(ctx, tenantId, tasks)
+	})
+
+	eg.Go(func() error {
+		return tc.signalDAGsCreated(ctx, tenantId, dags)
+	})
+
+	if err := eg.Wait(); err != nil {
+		return false, fmt.Errorf("could not signal debounced runs created: %w", err)
+	}
+
+	return shouldContinue || shouldContinueDebounces, nil
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
@@ -98,3 +99,84 @@ func (tc *TasksControllerImpl) processTaskTimeouts(ctx context.Context, tenantId
 
 	return shouldContinue, nil
 }
+
+func (tc *TasksControllerImpl) runTenantExecutionTimeoutTasks(ctx context.Context) func() {
+	return func() {
+		tc.l.Debug().Msgf("partition: running execution timeout for tasks")
+
+		// list all tenants
+		tenants, err := tc.p.ListTenantsForController(ctx, dbsqlc.TenantMajorEngineVersionV1)
+
+		if err != nil {
+			tc.l.Error().Err(err).Msg("could not list tenants")
+			return
+		}
+
+		tc.executionTimeoutTaskOperations.SetTenants(tenants)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			tc.executionTimeoutTaskOperations.RunOrContinue(tenantId)
+		}
+	}
+}
+
+// processTaskExecutionTimeouts cancels tasks which have exceeded their total timeout or the execution timeout
+// of their workflow run. Unlike step timeouts, these apply across all attempts of a task, so the task is
+// cancelled rather than failed and retried.
+func (tc *TasksControllerImpl) processTaskExecutionTimeouts(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "process-task-execution-timeout")
+	defer span.End()
+
+	shouldContinue, err := tc.repov1.Tasks().ProcessTaskExecutionTimeouts(ctx, tenantId, func(ctx context.Context, tasks []*sqlcv1.ListTasksToExecutionTimeoutRow) error {
+		payloads := make([]tasktypes.CancelledTaskPayload, 0, len(tasks))
+
+		for _, task := range tasks {
+			eventMessage := fmt.Sprintf("Task exceeded total timeout of %s", task.Timeout)
+
+			if task.IsWorkflowTimeout {
+				eventMessage = fmt.Sprintf("Workflow run exceeded execution timeout of %s", task.Timeout)
+			}
+
+			payloads = append(payloads, tasktypes.CancelledTaskPayload{
+				TaskId:        task.ID,
+				InsertedAt:    task.InsertedAt,
+				ExternalId:    sqlchelpers.UUIDToStr(task.ExternalID),
+				WorkflowRunId: sqlchelpers.UUIDToStr(task.WorkflowRunID),
+				RetryCount:    task.RetryCount,
+				EventType:     sqlcv1.V1EventTypeOlapTIMEDOUT,
+				EventMessage:  eventMessage,
+				ShouldNotify:  true,
+			})
+		}
+
+		err := queueutils.BatchLinear(BULK_MSG_BATCH_SIZE, payloads, func(payloads []tasktypes.CancelledTaskPayload) error {
+			msg, err := msgqueue.NewTenantMessage(
+				tenantId,
+				"task-cancelled",
+				false,
+				true,
+				payloads...,
+			)
+
+			if err != nil {
+				return fmt.Errorf("could not create message for task cancellation: %w", err)
+			}
+
+			return tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not send task cancellations for execution timeouts: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not process execution timeouts for tenant %s: %w", tenantId, err)
+	}
+
+	return shouldContinue, nil
+}
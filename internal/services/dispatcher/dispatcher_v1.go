@@ -283,6 +283,8 @@ func (d *DispatcherImpl) handleTaskBulkAssignedTask(ctx context.Context, msg *ms
 					false,
 					"Could not send task to worker",
 					false,
+					"",
+					nil,
 				)
 
 				if err != nil {
@@ -248,6 +248,10 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
 	}
 
+	if err := s.validateSlotWeights(ctx, tenantId, request.Actions, opts.MaxRuns); err != nil {
+		return nil, err
+	}
+
 	// create a worker in the database
 	worker, err := s.repo.Worker().CreateNewWorker(ctx, tenantId, opts)
 
@@ -270,6 +274,15 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		}
 	}
 
+	if len(request.Resources) > 0 {
+		err = s.repov1.Workers().UpsertWorkerResources(ctx, tenantId, workerId, request.Resources)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not upsert worker resources for worker %s", workerId)
+			return nil, err
+		}
+	}
+
 	// return the worker id to the worker
 	return &contracts.WorkerRegisterResponse{
 		TenantId:   tenantId,
@@ -278,6 +291,40 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 	}, nil
 }
 
+// validateSlotWeights rejects a worker which has fewer slots than the slot weight of one of its tasks, since
+// the worker would never be able to run the task.
+func (s *DispatcherImpl) validateSlotWeights(ctx context.Context, tenantId string, actions []string, maxRuns *int) error {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	slots := 100
+
+	if maxRuns != nil {
+		slots = *maxRuns
+	}
+
+	slotWeights, err := s.repov1.Workers().GetActionSlotWeights(ctx, tenantId, actions)
+
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		if weight, ok := slotWeights[action]; ok && int(weight) > slots {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"task %s has a slot weight of %d, which exceeds the worker's %d slots",
+				action,
+				weight,
+				slots,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (s *DispatcherImpl) UpsertWorkerLabels(ctx context.Context, request *contracts.UpsertWorkerLabelsRequest) (*contracts.UpsertWorkerLabelsResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 
@@ -293,6 +340,19 @@ func (s *DispatcherImpl) UpsertWorkerLabels(ctx context.Context, request *contra
 	}, nil
 }
 
+// DrainWorker marks a worker as draining, which stops new tasks from being assigned to the worker, and returns
+// the tasks which are still running on the worker. Workers call this repeatedly on shutdown until no tasks remain.
+func (s *DispatcherImpl) DrainWorker(ctx context.Context, request *contracts.WorkerDrainRequest) (*contracts.WorkerDrainResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	switch tenant.Version {
+	case dbsqlc.TenantMajorEngineVersionV1:
+		return s.drainWorkerV1(ctx, tenant, request)
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "DrainWorker is not implemented in engine version %s", string(tenant.Version))
+	}
+}
+
 func (s *DispatcherImpl) upsertLabels(ctx context.Context, workerId pgtype.UUID, request map[string]*contracts.WorkerLabels) ([]*dbsqlc.WorkerLabel, error) {
 	affinities := make([]repository.UpsertWorkerLabelOpts, 0, len(request))
 
@@ -670,6 +670,16 @@ func (s *DispatcherImpl) handleTaskFailed(inputCtx context.Context, task *sqlcv1
 		shouldNotRetry = *request.ShouldNotRetry
 	}
 
+	var errorDetails []byte
+
+	if request.ErrorDetails != nil {
+		if json.Valid([]byte(*request.ErrorDetails)) {
+			errorDetails = []byte(*request.ErrorDetails)
+		} else {
+			s.l.Warn().Msgf("ignoring invalid error details for task %d", task.ID)
+		}
+	}
+
 	msg, err := tasktypes.FailedTaskMessage(
 		tenantId,
 		task.ID,
@@ -680,6 +690,8 @@ func (s *DispatcherImpl) handleTaskFailed(inputCtx context.Context, task *sqlcv1
 		true,
 		request.EventPayload,
 		shouldNotRetry,
+		request.GetErrorCode(),
+		errorDetails,
 	)
 
 	if err != nil {
@@ -752,6 +764,42 @@ func (d *DispatcherImpl) refreshTimeoutV1(ctx context.Context, tenant *dbsqlc.Te
 	}, nil
 }
 
+func (d *DispatcherImpl) drainWorkerV1(ctx context.Context, tenant *dbsqlc.Tenant, request *contracts.WorkerDrainRequest) (*contracts.WorkerDrainResponse, error) {
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if _, err := uuid.Parse(request.WorkerId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid worker id: %s", request.WorkerId)
+	}
+
+	_, err := d.repov1.Workers().DrainWorker(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "worker not found: %s", request.WorkerId)
+		}
+
+		return nil, err
+	}
+
+	inFlight, err := d.repov1.Workers().ListInFlightTasks(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inFlightTaskIds := make([]string, 0, len(inFlight))
+
+	for _, task := range inFlight {
+		inFlightTaskIds = append(inFlightTaskIds, sqlchelpers.UUIDToStr(task.ExternalID))
+	}
+
+	return &contracts.WorkerDrainResponse{
+		TenantId:        tenantId,
+		WorkerId:        request.WorkerId,
+		InFlightTaskIds: inFlightTaskIds,
+	}, nil
+}
+
 func (d *DispatcherImpl) releaseSlotV1(ctx context.Context, tenant *dbsqlc.Tenant, request *contracts.ReleaseSlotRequest) (*contracts.ReleaseSlotResponse, error) {
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
@@ -14,7 +14,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/shared/proto/v1"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
@@ -57,6 +59,23 @@ func (d *DispatcherServiceImpl) RegisterDurableEvent(ctx context.Context, req *c
 		})
 	}
 
+	signalNames := make([]string, 0, len(req.Conditions.SignalConditions))
+
+	for _, condition := range req.Conditions.SignalConditions {
+		if condition.SignalName == "" {
+			return nil, status.Error(codes.InvalidArgument, "signal condition requires a signal name")
+		}
+
+		createConditionOpts = append(createConditionOpts, v1.CreateExternalSignalConditionOpt{
+			Kind:            v1.CreateExternalSignalConditionKindSIGNAL,
+			ReadableDataKey: condition.Base.ReadableDataKey,
+			OrGroupId:       condition.Base.OrGroupId,
+			SignalName:      &condition.SignalName,
+		})
+
+		signalNames = append(signalNames, condition.SignalName)
+	}
+
 	createMatchOpts := make([]v1.ExternalCreateSignalMatchOpts, 0)
 
 	createMatchOpts = append(createMatchOpts, v1.ExternalCreateSignalMatchOpts{
@@ -73,6 +92,23 @@ func (d *DispatcherServiceImpl) RegisterDurableEvent(ctx context.Context, req *c
 		return nil, err
 	}
 
+	if len(signalNames) > 0 {
+		// deliver the signals which were sent to the task before it started waiting on them
+		err := d.repo.Signals().DeliverPendingSignals(ctx, tenantId, req.TaskId, signalNames, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			msg, err := tasktypes.NewInternalEventMessage(tenantId, time.Now(), events...)
+
+			if err != nil {
+				return fmt.Errorf("could not create internal event message: %w", err)
+			}
+
+			return d.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not deliver pending signals: %w", err)
+		}
+	}
+
 	return &contracts.RegisterDurableEventResponse{}, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 )
 
 type EventResult struct {
@@ -25,7 +26,7 @@ type EventResult struct {
 	AdditionalMetadata string
 }
 
-func (i *IngestorImpl) ingestEventV1(ctx context.Context, tenant *dbsqlc.Tenant, key string, data []byte, metadata []byte, priority *int32, scope *string) (*dbsqlc.Event, error) {
+func (i *IngestorImpl) ingestEventV1(ctx context.Context, tenant *dbsqlc.Tenant, key string, data []byte, metadata []byte, priority *int32, scope *string, idempotencyKey *v1.IdempotencyKeyOpts) (*dbsqlc.Event, error) {
 	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
 	defer span.End()
 
@@ -49,30 +50,48 @@ func (i *IngestorImpl) ingestEventV1(ctx context.Context, tenant *dbsqlc.Tenant,
 		)
 	}
 
-	return i.ingestSingleton(tenantId, key, data, metadata, priority, scope)
+	return i.ingestSingleton(ctx, tenantId, key, data, metadata, priority, scope, idempotencyKey)
 }
 
-func (i *IngestorImpl) ingestSingleton(tenantId, key string, data []byte, metadata []byte, priority *int32, scope *string) (*dbsqlc.Event, error) {
+func (i *IngestorImpl) ingestSingleton(ctx context.Context, tenantId, key string, data []byte, metadata []byte, priority *int32, scope *string, idempotencyKey *v1.IdempotencyKeyOpts) (*dbsqlc.Event, error) {
 	eventId := uuid.New().String()
+	isDuplicate := false
 
-	msg, err := eventToTaskV1(
-		tenantId,
-		eventId,
-		key,
-		data,
-		metadata,
-		priority,
-		scope,
-	)
+	if idempotencyKey != nil {
+		claimedEventId, triggered, err := i.repov1.Triggers().ClaimEventIdempotencyKey(ctx, tenantId, eventId, idempotencyKey)
 
-	if err != nil {
-		return nil, fmt.Errorf("could not create event task: %w", err)
+		if err != nil {
+			return nil, fmt.Errorf("could not claim idempotency key: %w", err)
+		}
+
+		// if the original event has triggered its runs, we return it without triggering runs again. otherwise, it
+		// may never have been sent, so we send it again with the original id and its runs are deduplicated by
+		// their own idempotency keys.
+		isDuplicate = claimedEventId != eventId && triggered
+		eventId = claimedEventId
 	}
 
-	err = i.mqv1.SendMessage(context.Background(), msgqueue.TASK_PROCESSING_QUEUE, msg)
+	if !isDuplicate {
+		msg, err := eventToTaskV1(
+			tenantId,
+			eventId,
+			key,
+			data,
+			metadata,
+			priority,
+			scope,
+			idempotencyKey,
+		)
 
-	if err != nil {
-		return nil, fmt.Errorf("could not add event to task queue: %w", err)
+		if err != nil {
+			return nil, fmt.Errorf("could not create event task: %w", err)
+		}
+
+		err = i.mqv1.SendMessage(context.Background(), msgqueue.TASK_PROCESSING_QUEUE, msg)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not add event to task queue: %w", err)
+		}
 	}
 
 	now := time.Now().UTC()
@@ -117,7 +136,17 @@ func (i *IngestorImpl) bulkIngestEventV1(ctx context.Context, tenant *dbsqlc.Ten
 	results := make([]*dbsqlc.Event, 0, len(eventOpts))
 
 	for _, event := range eventOpts {
-		res, err := i.ingestSingleton(tenantId, event.Key, event.Data, event.AdditionalMetadata, event.Priority, event.Scope)
+		var idempotencyKey *v1.IdempotencyKeyOpts
+
+		if event.IdempotencyKey != nil {
+			idempotencyKey, err = v1.NewIdempotencyKeyOpts(*event.IdempotencyKey, event.IdempotencyKeyRetention)
+
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		res, err := i.ingestSingleton(ctx, tenantId, event.Key, event.Data, event.AdditionalMetadata, event.Priority, event.Scope, idempotencyKey)
 
 		if err != nil {
 			return nil, fmt.Errorf("could not ingest event: %w", err)
@@ -135,10 +164,10 @@ func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *dbsqlc
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	return i.ingestSingleton(tenantId, replayedEvent.Key, replayedEvent.Data, replayedEvent.AdditionalMetadata, nil, nil)
+	return i.ingestSingleton(ctx, tenantId, replayedEvent.Key, replayedEvent.Data, replayedEvent.AdditionalMetadata, nil, nil, nil)
 }
 
-func eventToTaskV1(tenantId, eventExternalId, key string, data, additionalMeta []byte, priority *int32, scope *string) (*msgqueue.Message, error) {
+func eventToTaskV1(tenantId, eventExternalId, key string, data, additionalMeta []byte, priority *int32, scope *string, idempotencyKey *v1.IdempotencyKeyOpts) (*msgqueue.Message, error) {
 	payloadTyped := tasktypes.UserEventTaskPayload{
 		EventExternalId:         eventExternalId,
 		EventKey:                key,
@@ -146,6 +175,7 @@ func eventToTaskV1(tenantId, eventExternalId, key string, data, additionalMeta [
 		EventAdditionalMetadata: additionalMeta,
 		EventPriority:           priority,
 		EventScope:              scope,
+		EventIdempotencyKey:     idempotencyKey,
 	}
 
 	return msgqueue.NewTenantMessage(
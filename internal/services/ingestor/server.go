@@ -17,6 +17,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 )
 
 func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest) (*contracts.Event, error) {
@@ -38,7 +39,19 @@ func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
 	}
 
-	event, err := i.IngestEvent(ctx, tenant, req.Key, []byte(req.Payload), additionalMeta, req.Priority, req.Scope)
+	var idempotencyKey *v1.IdempotencyKeyOpts
+
+	if req.IdempotencyKey != nil {
+		var err error
+
+		idempotencyKey, err = v1.NewIdempotencyKeyOpts(*req.IdempotencyKey, req.IdempotencyKeyRetention)
+
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+		}
+	}
+
+	event, err := i.IngestEvent(ctx, tenant, req.Key, []byte(req.Payload), additionalMeta, req.Priority, req.Scope, idempotencyKey)
 
 	if err == metered.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
@@ -90,12 +103,14 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 		}
 
 		events = append(events, &repository.CreateEventOpts{
-			TenantId:           tenantId,
-			Key:                e.Key,
-			Data:               payloadBytes,
-			AdditionalMetadata: additionalMeta,
-			Priority:           e.Priority,
-			Scope:              e.Scope,
+			TenantId:                tenantId,
+			Key:                     e.Key,
+			Data:                    payloadBytes,
+			AdditionalMetadata:      additionalMeta,
+			Priority:                e.Priority,
+			Scope:                   e.Scope,
+			IdempotencyKey:          e.IdempotencyKey,
+			IdempotencyKeyRetention: e.IdempotencyKeyRetention,
 		})
 	}
 
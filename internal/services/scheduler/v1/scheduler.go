@@ -357,6 +357,10 @@ func (s *Scheduler) scheduleStepRuns(ctx context.Context, tenantId string, res *
 
 	var outerErr error
 
+	if err := s.publishRateLimitUsage(ctx, tenantId, res); err != nil {
+		outerErr = multierror.Append(outerErr, err)
+	}
+
 	// bulk assign step runs
 	if len(res.Assigned) > 0 {
 		dispatcherIdToWorkerIdsToStepRuns := make(map[string]map[string][]int64)
@@ -533,6 +537,33 @@ func (s *Scheduler) scheduleStepRuns(ctx context.Context, tenantId string, res *
 		}
 	}
 
+	if len(res.Preempted) > 0 {
+		for _, preempted := range res.Preempted {
+			msg, err := tasktypes.PreemptedTaskMessage(
+				tenantId,
+				preempted.Task.TaskID,
+				preempted.Task.TaskInsertedAt,
+				preempted.Task.RetryCount,
+				sqlchelpers.UUIDToStr(preempted.PreemptedBy.ExternalID),
+			)
+
+			if err != nil {
+				outerErr = multierror.Append(outerErr, fmt.Errorf("could not create preempted task: %w", err))
+				continue
+			}
+
+			err = s.mq.SendMessage(
+				ctx,
+				msgqueue.TASK_PROCESSING_QUEUE,
+				msg,
+			)
+
+			if err != nil {
+				outerErr = multierror.Append(outerErr, fmt.Errorf("could not send preempted task: %w", err))
+			}
+		}
+	}
+
 	if len(res.Unassigned) > 0 {
 		for _, unassigned := range res.Unassigned {
 			taskId := unassigned.TaskID
@@ -568,6 +599,68 @@ func (s *Scheduler) scheduleStepRuns(ctx context.Context, tenantId string, res *
 	return outerErr
 }
 
+// publishRateLimitUsage sends the units consumed from each rate limit key and the tasks which were rate limited
+// to the OLAP repository
+func (s *Scheduler) publishRateLimitUsage(ctx context.Context, tenantId string, res *v1.QueueResults) error {
+	now := time.Now().UTC()
+	keyToUsage := make(map[string]*tasktypes.RateLimitUsagePayload)
+
+	getUsage := func(key string) *tasktypes.RateLimitUsagePayload {
+		if _, ok := keyToUsage[key]; !ok {
+			keyToUsage[key] = &tasktypes.RateLimitUsagePayload{
+				Key:       key,
+				Timestamp: now,
+			}
+		}
+
+		return keyToUsage[key]
+	}
+
+	for _, assigned := range res.Assigned {
+		for key, units := range assigned.RateLimits {
+			getUsage(key).UnitsConsumed += int64(units)
+		}
+	}
+
+	for _, rateLimited := range res.RateLimited {
+		usage := getUsage(rateLimited.ExceededKey)
+
+		usage.RateLimitedTasks = append(usage.RateLimitedTasks, tasktypes.RateLimitedTaskPayload{
+			TaskId:         rateLimited.TaskId,
+			TaskInsertedAt: rateLimited.TaskInsertedAt.Time,
+		})
+	}
+
+	if len(keyToUsage) == 0 {
+		return nil
+	}
+
+	payloads := make([]tasktypes.RateLimitUsagePayload, 0, len(keyToUsage))
+
+	for _, usage := range keyToUsage {
+		payloads = append(payloads, *usage)
+	}
+
+	msg, err := tasktypes.RateLimitUsageMessage(tenantId, payloads)
+
+	if err != nil {
+		return fmt.Errorf("could not create rate limit usage message: %w", err)
+	}
+
+	err = s.pubBuffer.Pub(
+		ctx,
+		msgqueue.OLAP_QUEUE,
+		msg,
+		false,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not send rate limit usage message: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Scheduler) internalRetry(ctx context.Context, tenantId string, assigned ...*repov1.AssignedItem) {
 	for _, a := range assigned {
 		msg, err := tasktypes.FailedTaskMessage(
@@ -580,6 +673,8 @@ func (s *Scheduler) internalRetry(ctx context.Context, tenantId string, assigned
 			false,
 			"could not assign step run to worker",
 			false,
+			"",
+			nil,
 		)
 
 		if err != nil {
@@ -638,6 +733,8 @@ func (s *Scheduler) notifyAfterConcurrency(ctx context.Context, tenantId string,
 		if cancelled.CancelledReason == "SCHEDULING_TIMED_OUT" {
 			eventType = sqlcv1.V1EventTypeOlapSCHEDULINGTIMEDOUT
 			shouldNotify = false
+		} else if cancelled.CancelledMessage != "" {
+			eventMessage = cancelled.CancelledMessage
 		} else {
 			eventMessage = "Cancelled due to concurrency strategy"
 		}
@@ -316,6 +316,7 @@ type TaskConditions struct {
 	ParentOverrideConditions []*ParentOverrideMatchCondition `protobuf:"bytes,1,rep,name=parent_override_conditions,json=parentOverrideConditions,proto3" json:"parent_override_conditions,omitempty"`
 	SleepConditions          []*SleepMatchCondition          `protobuf:"bytes,2,rep,name=sleep_conditions,json=sleepConditions,proto3" json:"sleep_conditions,omitempty"`
 	UserEventConditions      []*UserEventMatchCondition      `protobuf:"bytes,3,rep,name=user_event_conditions,json=userEventConditions,proto3" json:"user_event_conditions,omitempty"`
+	ApprovalConditions       []*ApprovalMatchCondition       `protobuf:"bytes,4,rep,name=approval_conditions,json=approvalConditions,proto3" json:"approval_conditions,omitempty"`
 }
 
 func (x *TaskConditions) Reset() {
@@ -371,6 +372,13 @@ func (x *TaskConditions) GetUserEventConditions() []*UserEventMatchCondition {
 	return nil
 }
 
+func (x *TaskConditions) GetApprovalConditions() []*ApprovalMatchCondition {
+	if x != nil {
+		return x.ApprovalConditions
+	}
+	return nil
+}
+
 type DurableEventListenerConditions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -378,6 +386,7 @@ type DurableEventListenerConditions struct {
 
 	SleepConditions     []*SleepMatchCondition     `protobuf:"bytes,1,rep,name=sleep_conditions,json=sleepConditions,proto3" json:"sleep_conditions,omitempty"`
 	UserEventConditions []*UserEventMatchCondition `protobuf:"bytes,2,rep,name=user_event_conditions,json=userEventConditions,proto3" json:"user_event_conditions,omitempty"`
+	SignalConditions    []*SignalMatchCondition    `protobuf:"bytes,3,rep,name=signal_conditions,json=signalConditions,proto3" json:"signal_conditions,omitempty"`
 }
 
 func (x *DurableEventListenerConditions) Reset() {
@@ -426,6 +435,131 @@ func (x *DurableEventListenerConditions) GetUserEventConditions() []*UserEventMa
 	return nil
 }
 
+func (x *DurableEventListenerConditions) GetSignalConditions() []*SignalMatchCondition {
+	if x != nil {
+		return x.SignalConditions
+	}
+	return nil
+}
+
+type ApprovalMatchCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base         *BaseMatchCondition `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ExpiresIn    string              `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`             // (optional) a duration string after which the approval expires
+	SkipOnExpiry bool                `protobuf:"varint,3,opt,name=skip_on_expiry,json=skipOnExpiry,proto3" json:"skip_on_expiry,omitempty"` // if true, the task is skipped instead of failed when the approval expires
+}
+
+func (x *ApprovalMatchCondition) Reset() {
+	*x = ApprovalMatchCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_shared_condition_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovalMatchCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalMatchCondition) ProtoMessage() {}
+
+func (x *ApprovalMatchCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_shared_condition_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalMatchCondition.ProtoReflect.Descriptor instead.
+func (*ApprovalMatchCondition) Descriptor() ([]byte, []int) {
+	return file_v1_shared_condition_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApprovalMatchCondition) GetBase() *BaseMatchCondition {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ApprovalMatchCondition) GetExpiresIn() string {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return ""
+}
+
+func (x *ApprovalMatchCondition) GetSkipOnExpiry() bool {
+	if x != nil {
+		return x.SkipOnExpiry
+	}
+	return false
+}
+
+type SignalMatchCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base       *BaseMatchCondition `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SignalName string              `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"` // the name of the signal sent to the task
+}
+
+func (x *SignalMatchCondition) Reset() {
+	*x = SignalMatchCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_shared_condition_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalMatchCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalMatchCondition) ProtoMessage() {}
+
+func (x *SignalMatchCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_shared_condition_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalMatchCondition.ProtoReflect.Descriptor instead.
+func (*SignalMatchCondition) Descriptor() ([]byte, []int) {
+	return file_v1_shared_condition_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignalMatchCondition) GetBase() *BaseMatchCondition {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SignalMatchCondition) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
+	}
+	return ""
+}
+
 var File_v1_shared_condition_proto protoreflect.FileDescriptor
 
 var file_v1_shared_condition_proto_rawDesc = []byte{
@@ -461,7 +595,7 @@ var file_v1_shared_condition_proto_rawDesc = []byte{
 	0x61, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
 	0x6e, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x02,
+	0x0c, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xd2, 0x02,
 	0x0a, 0x0e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x12, 0x5e, 0x0a, 0x1a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
 	0x69, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
@@ -478,26 +612,51 @@ var file_v1_shared_condition_proto_rawDesc = []byte{
 	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65,
 	0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x1e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x73, 0x6c, 0x65, 0x65,
-	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x6c, 0x65,
-	0x65, 0x70, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x15,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x35, 0x0a,
-	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b,
-	0x49, 0x50, 0x10, 0x03, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x1e, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x15, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x73, 0x6b, 0x69, 0x70, 0x4f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x63, 0x0a,
+	0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x2a, 0x35, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x55,
+	0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x03, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2d,
+	0x64, 0x65, 0x76, 0x2f, 0x68, 0x61, 0x74, 0x63, 0x68, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -513,7 +672,7 @@ func file_v1_shared_condition_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_shared_condition_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_shared_condition_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_v1_shared_condition_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_v1_shared_condition_proto_goTypes = []interface{}{
 	(Action)(0),                            // 0: v1.Action
 	(*BaseMatchCondition)(nil),             // 1: v1.BaseMatchCondition
@@ -522,22 +681,28 @@ var file_v1_shared_condition_proto_goTypes = []interface{}{
 	(*UserEventMatchCondition)(nil),        // 4: v1.UserEventMatchCondition
 	(*TaskConditions)(nil),                 // 5: v1.TaskConditions
 	(*DurableEventListenerConditions)(nil), // 6: v1.DurableEventListenerConditions
+	(*ApprovalMatchCondition)(nil),         // 7: v1.ApprovalMatchCondition
+	(*SignalMatchCondition)(nil),           // 8: v1.SignalMatchCondition
 }
 var file_v1_shared_condition_proto_depIdxs = []int32{
-	0, // 0: v1.BaseMatchCondition.action:type_name -> v1.Action
-	1, // 1: v1.ParentOverrideMatchCondition.base:type_name -> v1.BaseMatchCondition
-	1, // 2: v1.SleepMatchCondition.base:type_name -> v1.BaseMatchCondition
-	1, // 3: v1.UserEventMatchCondition.base:type_name -> v1.BaseMatchCondition
-	2, // 4: v1.TaskConditions.parent_override_conditions:type_name -> v1.ParentOverrideMatchCondition
-	3, // 5: v1.TaskConditions.sleep_conditions:type_name -> v1.SleepMatchCondition
-	4, // 6: v1.TaskConditions.user_event_conditions:type_name -> v1.UserEventMatchCondition
-	3, // 7: v1.DurableEventListenerConditions.sleep_conditions:type_name -> v1.SleepMatchCondition
-	4, // 8: v1.DurableEventListenerConditions.user_event_conditions:type_name -> v1.UserEventMatchCondition
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: v1.BaseMatchCondition.action:type_name -> v1.Action
+	1,  // 1: v1.ParentOverrideMatchCondition.base:type_name -> v1.BaseMatchCondition
+	1,  // 2: v1.SleepMatchCondition.base:type_name -> v1.BaseMatchCondition
+	1,  // 3: v1.UserEventMatchCondition.base:type_name -> v1.BaseMatchCondition
+	2,  // 4: v1.TaskConditions.parent_override_conditions:type_name -> v1.ParentOverrideMatchCondition
+	3,  // 5: v1.TaskConditions.sleep_conditions:type_name -> v1.SleepMatchCondition
+	4,  // 6: v1.TaskConditions.user_event_conditions:type_name -> v1.UserEventMatchCondition
+	7,  // 7: v1.TaskConditions.approval_conditions:type_name -> v1.ApprovalMatchCondition
+	3,  // 8: v1.DurableEventListenerConditions.sleep_conditions:type_name -> v1.SleepMatchCondition
+	4,  // 9: v1.DurableEventListenerConditions.user_event_conditions:type_name -> v1.UserEventMatchCondition
+	8,  // 10: v1.DurableEventListenerConditions.signal_conditions:type_name -> v1.SignalMatchCondition
+	1,  // 11: v1.ApprovalMatchCondition.base:type_name -> v1.BaseMatchCondition
+	1,  // 12: v1.SignalMatchCondition.base:type_name -> v1.BaseMatchCondition
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_v1_shared_condition_proto_init() }
@@ -618,6 +783,30 @@ func file_v1_shared_condition_proto_init() {
 				return nil
 			}
 		}
+		file_v1_shared_condition_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovalMatchCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_shared_condition_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalMatchCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -625,7 +814,7 @@ func file_v1_shared_condition_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_shared_condition_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -82,6 +82,9 @@ type CreateMonitoringEventPayload struct {
 	EventTimestamp time.Time `json:"event_timestamp" validate:"required"`
 	EventPayload   string    `json:"event_payload" validate:"required"`
 	EventMessage   string    `json:"event_message,omitempty"`
+
+	// the JSON-encoded structured error of a FAILED event
+	ErrorDetails []byte `json:"error_details,omitempty"`
 }
 
 func MonitoringEventMessageFromActionEvent(tenantId string, taskId int64, retryCount int32, request *contracts.StepActionEvent) (*msgqueue.Message, error) {
@@ -128,3 +131,25 @@ func MonitoringEventMessageFromInternal(tenantId string, payload CreateMonitorin
 		payload,
 	)
 }
+
+type RateLimitUsagePayload struct {
+	Key              string                   `json:"key"`
+	Timestamp        time.Time                `json:"timestamp"`
+	UnitsConsumed    int64                    `json:"units_consumed"`
+	RateLimitedTasks []RateLimitedTaskPayload `json:"rate_limited_tasks,omitempty"`
+}
+
+type RateLimitedTaskPayload struct {
+	TaskId         int64     `json:"task_id"`
+	TaskInsertedAt time.Time `json:"task_inserted_at"`
+}
+
+func RateLimitUsageMessage(tenantId string, payloads []RateLimitUsagePayload) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		"rate-limit-usage",
+		false,
+		true,
+		payloads...,
+	)
+}
@@ -87,6 +87,12 @@ type FailedTaskPayload struct {
 
 	// (optional) A boolean flag to indicate whether the error is non-retryable, meaning it should _not_ be retried. Defaults to false.
 	IsNonRetryable bool `json:"is_non_retryable"`
+
+	// (optional) the error code of the failure, matched against the retry policy of the task
+	ErrorCode string `json:"error_code,omitempty"`
+
+	// (optional) the JSON-encoded structured error of the failure
+	ErrorDetails []byte `json:"error_details,omitempty"`
 }
 
 func FailedTaskMessage(
@@ -99,6 +105,8 @@ func FailedTaskMessage(
 	isAppError bool,
 	errorMsg string,
 	isNonRetryable bool,
+	errorCode string,
+	errorDetails []byte,
 ) (*msgqueue.Message, error) {
 	return msgqueue.NewTenantMessage(
 		tenantId,
@@ -114,6 +122,8 @@ func FailedTaskMessage(
 			IsAppError:     isAppError,
 			ErrorMsg:       errorMsg,
 			IsNonRetryable: isNonRetryable,
+			ErrorCode:      errorCode,
+			ErrorDetails:   errorDetails,
 		},
 	)
 }
@@ -173,6 +183,41 @@ func CancelledTaskMessage(
 	)
 }
 
+type PreemptedTaskPayload struct {
+	// (required) the task id
+	TaskId int64 `validate:"required"`
+
+	// (required) the task inserted at
+	InsertedAt pgtype.Timestamptz
+
+	// (required) the retry count which the task was running on
+	RetryCount int32
+
+	// (required) the external id of the task which the task was preempted for
+	PreemptedByExternalId string
+}
+
+func PreemptedTaskMessage(
+	tenantId string,
+	taskId int64,
+	taskInsertedAt pgtype.Timestamptz,
+	retryCount int32,
+	preemptedByExternalId string,
+) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		"task-preempted",
+		false,
+		true,
+		PreemptedTaskPayload{
+			TaskId:                taskId,
+			InsertedAt:            taskInsertedAt,
+			RetryCount:            retryCount,
+			PreemptedByExternalId: preemptedByExternalId,
+		},
+	)
+}
+
 type SignalTaskCancelledPayload struct {
 	// (required) the worker id
 	WorkerId string `validate:"required,uuid"`
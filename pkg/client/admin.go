@@ -231,6 +231,22 @@ func WithPriority(priority int32) RunOptFunc {
 	}
 }
 
+// WithRunIdempotencyKey sets an idempotency key for the workflow run, so that triggers of the same workflow with
+// the same key return the original workflow run instead of creating a new one. The key is retained for the given
+// duration, or 24h if retention is nil.
+func WithRunIdempotencyKey(key string, retention *time.Duration) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		r.IdempotencyKey = &key
+
+		if retention != nil {
+			retentionStr := retention.String()
+			r.IdempotencyKeyRetention = &retentionStr
+		}
+
+		return nil
+	}
+}
+
 // func WithSticky(sticky bool) RunOptFunc {
 // 	return func(r *admincontracts.TriggerWorkflowRequest) error {
 // 		r.Sticky = &sticky
@@ -449,6 +465,24 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 		putParams.Duration = admincontracts.RateLimitDuration_SECOND
 	}
 
+	switch opts.Algorithm {
+	case types.SlidingWindow:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_SLIDING_WINDOW.Enum()
+	case types.TokenBucket:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_TOKEN_BUCKET.Enum()
+	case types.Semaphore:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_SEMAPHORE.Enum()
+	case types.FixedWindow:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_FIXED_WINDOW.Enum()
+	}
+
+	if opts.Burst != nil {
+		burst := int32(*opts.Burst) // nolint: gosec
+		putParams.Burst = &burst
+	}
+
+	putParams.RefillRate = opts.RefillRate
+
 	_, err := a.client.PutRateLimit(a.ctx.newContext(context.Background()), putParams)
 
 	if err != nil {
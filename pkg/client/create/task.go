@@ -32,6 +32,11 @@ type WorkflowTask[I, O any] struct {
 	// (optional) ScheduleTimeout specifies the maximum time a task can wait to be scheduled
 	ScheduleTimeout time.Duration
 
+	// (optional) TotalTimeout specifies the maximum wall-clock time of the task across all of its attempts,
+	// including retries and backoff. Unlike ExecutionTimeout, the task is cancelled rather than retried once
+	// it is exceeded.
+	TotalTimeout time.Duration
+
 	// (optional) Retries defines the number of times to retry a failed task
 	Retries int32
 
@@ -41,6 +46,10 @@ type WorkflowTask[I, O any] struct {
 	// (optional) RetryMaxBackoffSeconds is the maximum backoff duration in seconds between retries
 	RetryMaxBackoffSeconds int32
 
+	// (optional) RetryPolicy decides whether and when the task is retried based on the error code of a failure,
+	// see worker.NewErrorWithCode
+	RetryPolicy *types.RetryPolicy
+
 	// (optional) RateLimits define constraints on how frequently the task can be executed
 	RateLimits []*types.RateLimit
 
@@ -50,6 +59,32 @@ type WorkflowTask[I, O any] struct {
 	// (optional) Concurrency defines constraints on how many instances of this task can run simultaneously
 	Concurrency []*types.Concurrency
 
+	// (optional) SlotWeight is the number of worker slots the task consumes while running, defaults to 1
+	SlotWeight int32
+
+	// (optional) ResourceRequests are the named worker resources the task consumes while running
+	ResourceRequests map[string]int32
+
+	// (optional) FairnessKey is a CEL expression for the task's fairness key. Queued tasks with different
+	// fairness keys share worker slots in a round-robin fashion.
+	FairnessKey string
+
+	// (optional) Preemptible allows the task to be cancelled and requeued while running, without consuming a
+	// retry, when a higher-priority task cannot be assigned to a worker
+	Preemptible bool
+
+	// (optional) Gang is the name of a gang of sibling tasks which must start together. Tasks in the same gang
+	// are only assigned to workers when every task in the gang can be assigned, and must have the same parents.
+	Gang string
+
+	// (optional) GangTimeout is the maximum time to wait for every task in the gang to be assigned, after which
+	// the tasks fail with a schedule timeout
+	GangTimeout time.Duration
+
+	// (optional) PlacementStrategy determines how the task is placed across workers, overriding the
+	// workflow's placement strategy
+	PlacementStrategy *types.PlacementStrategy
+
 	// WaitFor represents a set of conditions which must be satisfied before the task can run.
 	WaitFor condition.Condition
 
@@ -78,6 +113,11 @@ type WorkflowOnFailureTask[I, O any] struct {
 	// (optional) ScheduleTimeout specifies the maximum time a task can wait to be scheduled
 	ScheduleTimeout time.Duration
 
+	// (optional) TotalTimeout specifies the maximum wall-clock time of the task across all of its attempts,
+	// including retries and backoff. Unlike ExecutionTimeout, the task is cancelled rather than retried once
+	// it is exceeded.
+	TotalTimeout time.Duration
+
 	// (optional) Retries defines the number of times to retry a failed task
 	Retries int32
 
@@ -87,6 +127,10 @@ type WorkflowOnFailureTask[I, O any] struct {
 	// (optional) RetryMaxBackoffSeconds is the maximum backoff duration in seconds between retries
 	RetryMaxBackoffSeconds int32
 
+	// (optional) RetryPolicy decides whether and when the task is retried based on the error code of a failure,
+	// see worker.NewErrorWithCode
+	RetryPolicy *types.RetryPolicy
+
 	// (optional) RateLimits define constraints on how frequently the task can be executed
 	RateLimits []*types.RateLimit
 
@@ -95,6 +139,24 @@ type WorkflowOnFailureTask[I, O any] struct {
 
 	// (optional) Concurrency defines constraints on how many instances of this task can run simultaneously
 	Concurrency []*types.Concurrency
+
+	// (optional) SlotWeight is the number of worker slots the task consumes while running, defaults to 1
+	SlotWeight int32
+
+	// (optional) ResourceRequests are the named worker resources the task consumes while running
+	ResourceRequests map[string]int32
+
+	// (optional) FairnessKey is a CEL expression for the task's fairness key. Queued tasks with different
+	// fairness keys share worker slots in a round-robin fashion.
+	FairnessKey string
+
+	// (optional) Preemptible allows the task to be cancelled and requeued while running, without consuming a
+	// retry, when a higher-priority task cannot be assigned to a worker
+	Preemptible bool
+
+	// (optional) PlacementStrategy determines how the task is placed across workers, overriding the
+	// workflow's placement strategy
+	PlacementStrategy *types.PlacementStrategy
 }
 
 // TaskCreateOpts defines options for creating a standalone task.
@@ -116,6 +178,11 @@ type StandaloneTask struct {
 	// (optional) ScheduleTimeout specifies the maximum time a task can wait to be scheduled
 	ScheduleTimeout time.Duration
 
+	// (optional) TotalTimeout specifies the maximum wall-clock time of the task across all of its attempts,
+	// including retries and backoff. Unlike ExecutionTimeout, the task is cancelled rather than retried once
+	// it is exceeded.
+	TotalTimeout time.Duration
+
 	// (optional) Retries defines the number of times to retry a failed task
 	Retries int32
 
@@ -125,6 +192,10 @@ type StandaloneTask struct {
 	// (optional) RetryMaxBackoffSeconds is the maximum backoff duration in seconds between retries
 	RetryMaxBackoffSeconds int32
 
+	// (optional) RetryPolicy decides whether and when the task is retried based on the error code of a failure,
+	// see worker.NewErrorWithCode
+	RetryPolicy *types.RetryPolicy
+
 	// (optional) RateLimits define constraints on how frequently the task can be executed
 	RateLimits []*types.RateLimit
 
@@ -134,6 +205,23 @@ type StandaloneTask struct {
 	// (optional) Concurrency defines constraints on how many instances of this task can run simultaneously
 	Concurrency []*types.Concurrency
 
+	// (optional) SlotWeight is the number of worker slots the task consumes while running, defaults to 1
+	SlotWeight int32
+
+	// (optional) ResourceRequests are the named worker resources the task consumes while running
+	ResourceRequests map[string]int32
+
+	// (optional) FairnessKey is a CEL expression for the task's fairness key. Queued tasks with different
+	// fairness keys share worker slots in a round-robin fashion.
+	FairnessKey string
+
+	// (optional) Preemptible allows the task to be cancelled and requeued while running, without consuming a
+	// retry, when a higher-priority task cannot be assigned to a worker
+	Preemptible bool
+
+	// (optional) PlacementStrategy determines how the task is placed across workers, defaults to SPREAD
+	PlacementStrategy *types.PlacementStrategy
+
 	// (optional) The event names that trigger the workflow
 	OnEvents []string
 
@@ -142,7 +230,13 @@ type StandaloneTask struct {
 
 	DefaultPriority *int32
 
+	// (optional) The number of seconds a queued task waits before its priority is raised by 1
+	PriorityAgingSeconds *int32
+
 	DefaultFilters []types.DefaultFilter
+
+	// (optional) Coalesces triggers with the same debounce key into a single run
+	Debounce *types.Debounce
 }
 
 // DurableTaskCreateOpts defines options for creating a standalone durable task.
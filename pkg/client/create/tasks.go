@@ -47,6 +47,9 @@ type WorkflowCreateOpts[I any] struct {
 	// (optional) Strategy for sticky execution of workflow runs
 	StickyStrategy *types.StickyStrategy
 
+	// (optional) The default placement strategy for tasks in this workflow, defaults to SPREAD
+	PlacementStrategy *types.PlacementStrategy
+
 	// (optional) Default settings for all tasks within this workflow
 	TaskDefaults *TaskDefaults
 
@@ -56,5 +59,17 @@ type WorkflowCreateOpts[I any] struct {
 	// (optional) The default priority for tasks in this workflow
 	DefaultPriority *int32
 
+	// (optional) The number of seconds a queued task waits before its priority is raised by 1. Overrides
+	// the tenant's priority aging interval.
+	PriorityAgingSeconds *int32
+
 	DefaultFilters []types.DefaultFilter
+
+	// (optional) Coalesces triggers with the same debounce key into a single run, which starts once no new
+	// triggers have arrived for the debounce window
+	Debounce *types.Debounce
+
+	// (optional) The maximum wall-clock time of a run of the workflow, including retries, backoff and durable
+	// sleeps. The remaining tasks in the run are cancelled once it is exceeded.
+	RunTimeout time.Duration
 }
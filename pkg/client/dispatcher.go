@@ -32,6 +32,8 @@ type DispatcherClient interface {
 
 	UpsertWorkerLabels(ctx context.Context, workerId string, labels map[string]interface{}) error
 
+	DrainWorker(ctx context.Context, workerId string) ([]string, error)
+
 	RegisterDurableEvent(ctx context.Context, req *sharedcontracts.RegisterDurableEventRequest) (*sharedcontracts.RegisterDurableEventResponse, error)
 }
 
@@ -46,6 +48,7 @@ type GetActionListenerRequest struct {
 	Actions    []string
 	MaxRuns    *int
 	Labels     map[string]interface{}
+	Resources  map[string]int32
 	WebhookId  *string
 }
 
@@ -151,6 +154,12 @@ type ActionEvent struct {
 
 	// If this is an error, whether to retry on failure
 	ShouldNotRetry *bool
+
+	// If this is an error, the error code which is matched against the retry policy of the task
+	ErrorCode *string
+
+	// If this is an error, the JSON-encoded structured error of the failure
+	ErrorDetails *string
 }
 
 type ActionEventResponse struct {
@@ -272,6 +281,10 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		registerReq.MaxRuns = &mr
 	}
 
+	if len(req.Resources) > 0 {
+		registerReq.Resources = req.Resources
+	}
+
 	// register the worker
 	resp, err := d.client.Register(d.ctx.newContext(ctx), registerReq)
 
@@ -556,6 +569,8 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		EventPayload:   string(payloadBytes),
 		RetryCount:     &in.RetryCount,
 		ShouldNotRetry: in.ShouldNotRetry,
+		ErrorCode:      in.ErrorCode,
+		ErrorDetails:   in.ErrorDetails,
 	})
 
 	if err != nil {
@@ -653,6 +668,20 @@ func (a *dispatcherClientImpl) UpsertWorkerLabels(ctx context.Context, workerId
 	return nil
 }
 
+// DrainWorker stops new tasks from being assigned to the worker and returns the ids of the tasks which are
+// still running on the worker.
+func (a *dispatcherClientImpl) DrainWorker(ctx context.Context, workerId string) ([]string, error) {
+	resp, err := a.client.DrainWorker(a.ctx.newContext(ctx), &dispatchercontracts.WorkerDrainRequest{
+		WorkerId: workerId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.InFlightTaskIds, nil
+}
+
 func mapLabels(req map[string]interface{}) map[string]*dispatchercontracts.WorkerLabels {
 	labels := map[string]*dispatchercontracts.WorkerLabels{}
 
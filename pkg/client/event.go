@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
@@ -18,6 +19,8 @@ type pushOpt struct {
 	additionalMetadata map[string]string
 	priority           *int32
 	scope              *string
+	idempotencyKey     *string
+	retention          *string
 }
 
 type PushOpFunc func(*pushOpt) error
@@ -104,6 +107,22 @@ func WithFilterScope(scope *string) PushOpFunc {
 	}
 }
 
+// WithEventIdempotencyKey sets an idempotency key for the event, so that pushes with the same key return the
+// original event instead of triggering runs again. The key is retained for the given duration, or 24h if
+// retention is nil.
+func WithEventIdempotencyKey(key string, retention *time.Duration) PushOpFunc {
+	return func(r *pushOpt) error {
+		r.idempotencyKey = &key
+
+		if retention != nil {
+			retentionStr := retention.String()
+			r.retention = &retentionStr
+		}
+
+		return nil
+	}
+}
+
 func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error {
 	key := client.ApplyNamespace(eventKey, &a.namespace)
 
@@ -140,6 +159,8 @@ func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload int
 	request.AdditionalMetadata = &additionalMetaString
 	request.Priority = opts.priority
 	request.Scope = opts.scope
+	request.IdempotencyKey = opts.idempotencyKey
+	request.IdempotencyKeyRetention = opts.retention
 
 	_, err = a.client.Push(a.ctx.newContext(ctx), &request)
 
@@ -20,10 +20,48 @@ func StickyStrategyPtr(v StickyStrategy) *StickyStrategy {
 	return &v
 }
 
+type PlacementStrategy int32
+
+const (
+	// PlacementStrategy_SPREAD spreads tasks across workers in round-robin order
+	PlacementStrategy_SPREAD PlacementStrategy = 0
+
+	// PlacementStrategy_PACK places tasks on the most utilized worker with free slots, so that idle workers
+	// can be scaled down
+	PlacementStrategy_PACK PlacementStrategy = 1
+
+	// PlacementStrategy_LEAST_LOADED places tasks on the least utilized worker
+	PlacementStrategy_LEAST_LOADED PlacementStrategy = 2
+)
+
+func PlacementStrategyPtr(v PlacementStrategy) *PlacementStrategy {
+	return &v
+}
+
 type Concurrency struct {
 	Expression    string                            `yaml:"expression,omitempty"`
 	MaxRuns       *int32                            `yaml:"maxRuns,omitempty"`
 	LimitStrategy *WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
+
+	// (optional) for QueueNewest, the maximum number of runs which can be queued per key, defaults to unbounded
+	MaxQueued *int32 `yaml:"maxQueued,omitempty"`
+
+	// (optional) for QueueNewest, the run to cancel once the queue is full, defaults to DropOldest
+	QueueOverflow *ConcurrencyQueueOverflow `yaml:"queueOverflow,omitempty"`
+
+	// (optional) for GroupRoundRobin, the name of a tenant-level concurrency group whose slots are shared
+	// with every other workflow or task in the same group
+	Group *string `yaml:"group,omitempty"`
+}
+
+// Debounce coalesces triggers of a workflow with the same key into a single run. Each new trigger replaces
+// the pending run's input and pushes back its start until no new triggers have arrived for the window.
+type Debounce struct {
+	// a CEL expression for the debounce key, which can reference input and additional_metadata
+	Expression string
+
+	// the duration without new triggers for a key before its run is started
+	Window time.Duration
 }
 
 type Workflow struct {
@@ -56,6 +94,16 @@ const (
 	QueueNewest      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
 )
 
+type ConcurrencyQueueOverflow string
+
+const (
+	// DropOldest cancels the oldest queued run to make room for the new arrival.
+	DropOldest ConcurrencyQueueOverflow = "DROP_OLDEST"
+
+	// RejectNewest cancels the new arrival.
+	RejectNewest ConcurrencyQueueOverflow = "REJECT_NEWEST"
+)
+
 type WorkflowConcurrency struct {
 	Expression *string `yaml:"expression,omitempty"`
 
@@ -134,6 +182,19 @@ type WorkflowStep struct {
 	RetryMaxBackoffSeconds *int32                         `yaml:"retryMaxBackoffSeconds,omitempty"`
 }
 
+// RetryPolicy decides whether and when a failed task is retried, based on the error code of the failure.
+type RetryPolicy struct {
+	// (optional) the maximum number of retries for failures with the given error code, overriding the retries
+	// of the task. A value of 0 means failures with the error code are never retried.
+	ErrorCodes map[string]int32 `yaml:"errorCodes,omitempty"`
+
+	// (optional) randomizes the retry delay by up to this fraction of the delay, between 0 and 1
+	Jitter *float32 `yaml:"jitter,omitempty"`
+
+	// (optional) the minimum delay before a retry, in seconds
+	MinBackoffSeconds *int32 `yaml:"minBackoffSeconds,omitempty"`
+}
+
 type DefaultFilter struct {
 	Expression string                 `json:"expression"`
 	Scope      string                 `json:"scope"`
@@ -12,7 +12,34 @@ const (
 	Year   RateLimitDuration = "year"
 )
 
+type RateLimitAlgorithm string
+
+const (
+	// FixedWindow refills the rate limit at the end of every window. This is the default.
+	FixedWindow RateLimitAlgorithm = "fixed_window"
+
+	// SlidingWindow enforces the limit over any window of the duration, so bursts can't straddle a window
+	// boundary.
+	SlidingWindow RateLimitAlgorithm = "sliding_window"
+
+	// TokenBucket adds tokens at the refill rate, up to the burst.
+	TokenBucket RateLimitAlgorithm = "token_bucket"
+
+	// Semaphore holds units for the lifetime of each task which uses them, and returns them when the task
+	// completes, fails, is cancelled or times out. Max is the number of units which can be held at once.
+	Semaphore RateLimitAlgorithm = "semaphore"
+)
+
 type RateLimitOpts struct {
 	Max      int
 	Duration RateLimitDuration
+
+	// (optional) the algorithm for the rate limit, defaults to FixedWindow
+	Algorithm RateLimitAlgorithm `validate:"omitempty,oneof=fixed_window sliding_window token_bucket semaphore"`
+
+	// (optional) the capacity of a TokenBucket rate limit, defaults to Max
+	Burst *int `validate:"omitnil,gt=0"`
+
+	// (optional) the tokens added to a TokenBucket rate limit per second, defaults to Max per Duration
+	RefillRate *float64 `validate:"omitnil,gt=0"`
 }
@@ -15,6 +15,7 @@ const (
 	FailedTasksTotal            GlobalHatchetMetric = "hatchet_failed_tasks_total"
 	SkippedTasksTotal           GlobalHatchetMetric = "hatchet_skipped_tasks_total"
 	CancelledTasksTotal         GlobalHatchetMetric = "hatchet_cancelled_tasks_total"
+	PreemptedTasksTotal         GlobalHatchetMetric = "hatchet_preempted_tasks_total"
 	AssignedTasksTotal          GlobalHatchetMetric = "hatchet_assigned_tasks"
 	SchedulingTimedOutTotal     GlobalHatchetMetric = "hatchet_scheduling_timed_out"
 	RateLimitedTotal            GlobalHatchetMetric = "hatchet_rate_limited"
@@ -59,6 +60,11 @@ var (
 		Help: "The total number of tasks cancelled",
 	})
 
+	PreemptedTasks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: string(PreemptedTasksTotal),
+		Help: "The total number of tasks preempted by higher-priority tasks",
+	})
+
 	AssignedTasks = promauto.NewCounter(prometheus.CounterOpts{
 		Name: string(AssignedTasksTotal),
 		Help: "The total number of tasks assigned to a worker",
@@ -21,6 +21,7 @@ const (
 	TenantFailedTasksTotal             TenantHatchetMetric = "hatchet_tenant_failed_tasks"
 	TenantSkippedTasksTotal            TenantHatchetMetric = "hatchet_tenant_skipped_tasks"
 	TenantCancelledTasksTotal          TenantHatchetMetric = "hatchet_tenant_cancelled_tasks"
+	TenantPreemptedTasksTotal          TenantHatchetMetric = "hatchet_tenant_preempted_tasks"
 	TenantReassignedTasksTotal         TenantHatchetMetric = "hatchet_tenant_reassigned_tasks"
 )
 
@@ -96,6 +97,11 @@ var (
 		Help: "The total number of tasks cancelled",
 	}, []string{"tenant_id"})
 
+	TenantPreemptedTasks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: string(TenantPreemptedTasksTotal),
+		Help: "The total number of tasks preempted by higher-priority tasks",
+	}, []string{"tenant_id"})
+
 	TenantAssignedTasks = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: string(TenantAssignedTasksTotal),
 		Help: "The total number of tasks assigned to a worker",
@@ -33,6 +33,12 @@ type CreateEventOpts struct {
 
 	// (optional) the event scope
 	Scope *string `validate:"omitempty"`
+
+	// (optional) pushes with the same idempotency key return the original event
+	IdempotencyKey *string `validate:"omitnil,min=1"`
+
+	// (optional) how long the idempotency key is retained, as a duration string
+	IdempotencyKeyRetention *string `validate:"omitnil,duration"`
 }
 
 type ListEventOpts struct {
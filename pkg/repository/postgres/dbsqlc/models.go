@@ -408,6 +408,50 @@ func (ns NullMessageQueueItemStatus) Value() (driver.Value, error) {
 	return string(ns.MessageQueueItemStatus), nil
 }
 
+type RateLimitAlgorithm string
+
+const (
+	RateLimitAlgorithmFIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	RateLimitAlgorithmSLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+	RateLimitAlgorithmTOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+	RateLimitAlgorithmSEMAPHORE     RateLimitAlgorithm = "SEMAPHORE"
+)
+
+func (e *RateLimitAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitAlgorithm(s)
+	case string:
+		*e = RateLimitAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitAlgorithm struct {
+	RateLimitAlgorithm RateLimitAlgorithm `json:"RateLimitAlgorithm"`
+	Valid              bool               `json:"valid"` // Valid is true if RateLimitAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitAlgorithm), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -415,6 +459,7 @@ const (
 	StepExpressionKindDYNAMICRATELIMITVALUE  StepExpressionKind = "DYNAMIC_RATE_LIMIT_VALUE"
 	StepExpressionKindDYNAMICRATELIMITUNITS  StepExpressionKind = "DYNAMIC_RATE_LIMIT_UNITS"
 	StepExpressionKindDYNAMICRATELIMITWINDOW StepExpressionKind = "DYNAMIC_RATE_LIMIT_WINDOW"
+	StepExpressionKindFAIRNESSKEY            StepExpressionKind = "FAIRNESS_KEY"
 )
 
 func (e *StepExpressionKind) Scan(src interface{}) error {
@@ -1442,12 +1487,18 @@ type QueueItem struct {
 }
 
 type RateLimit struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId      pgtype.UUID        `json:"tenantId"`
+	Key           string             `json:"key"`
+	LimitValue    int32              `json:"limitValue"`
+	Value         int32              `json:"value"`
+	Window        string             `json:"window"`
+	LastRefill    pgtype.Timestamp   `json:"lastRefill"`
+	Algorithm     RateLimitAlgorithm `json:"algorithm"`
+	Burst         pgtype.Int4        `json:"burst"`
+	RefillRate    pgtype.Float8      `json:"refillRate"`
+	PreviousUsage int32              `json:"previousUsage"`
+	IsDynamic     bool               `json:"isDynamic"`
+	LastUsedAt    pgtype.Timestamp   `json:"lastUsedAt"`
 }
 
 type RetryQueueItem struct {
@@ -1527,6 +1578,12 @@ type Step struct {
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	SlotWeight         int32            `json:"slotWeight"`
+	Preemptible        bool             `json:"preemptible"`
+	GangName           pgtype.Text      `json:"gangName"`
+	GangTimeout        pgtype.Text      `json:"gangTimeout"`
+	PlacementStrategy  pgtype.Text      `json:"placementStrategy"`
+	TotalTimeout       pgtype.Text      `json:"totalTimeout"`
 }
 
 type StepDesiredWorkerLabel struct {
@@ -1665,6 +1722,7 @@ type Tenant struct {
 	DataRetentionPeriod   string                   `json:"dataRetentionPeriod"`
 	SchedulerPartitionId  pgtype.Text              `json:"schedulerPartitionId"`
 	CanUpgradeV1          bool                     `json:"canUpgradeV1"`
+	PriorityAgingSeconds  pgtype.Int4              `json:"priorityAgingSeconds"`
 }
 
 type TenantAlertEmailGroup struct {
@@ -1855,6 +1913,7 @@ type Worker struct {
 	Os                      pgtype.Text      `json:"os"`
 	RuntimeExtra            pgtype.Text      `json:"runtimeExtra"`
 	SdkVersion              pgtype.Text      `json:"sdkVersion"`
+	DrainingAt              pgtype.Timestamp `json:"drainingAt"`
 }
 
 type WorkerAssignEvent struct {
@@ -2030,4 +2089,8 @@ type WorkflowVersion struct {
 	Kind                      WorkflowKind       `json:"kind"`
 	DefaultPriority           pgtype.Int4        `json:"defaultPriority"`
 	CreateWorkflowVersionOpts []byte             `json:"createWorkflowVersionOpts"`
+	PriorityAgingSeconds      pgtype.Int4        `json:"priorityAgingSeconds"`
+	DebounceExpression        pgtype.Text        `json:"debounceExpression"`
+	DebounceWindow            pgtype.Text        `json:"debounceWindow"`
+	ExecutionTimeout          pgtype.Text        `json:"executionTimeout"`
 }
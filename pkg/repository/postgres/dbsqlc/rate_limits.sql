@@ -4,17 +4,40 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "algorithm",
+    "burst",
+    "refillRate"
 ) VALUES (
     @tenantId::uuid,
     @key::text,
     sqlc.arg('limit')::int,
-    sqlc.arg('limit')::int,
-    COALESCE(sqlc.narg('window')::text, '1 minute')
+    -- token buckets start full
+    CASE
+        WHEN sqlc.narg('algorithm')::"RateLimitAlgorithm" = 'TOKEN_BUCKET' THEN COALESCE(sqlc.narg('burst')::int, sqlc.arg('limit')::int)
+        ELSE sqlc.arg('limit')::int
+    END,
+    COALESCE(sqlc.narg('window')::text, '1 minute'),
+    COALESCE(sqlc.narg('algorithm')::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    sqlc.narg('burst')::int,
+    sqlc.narg('refillRate')::double precision
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = sqlc.arg('limit')::int,
     "window" = COALESCE(sqlc.narg('window')::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "algorithm" = EXCLUDED."algorithm",
+    "burst" = EXCLUDED."burst",
+    "refillRate" = EXCLUDED."refillRate",
+    -- semaphores keep the units which are held by running tasks, other rate limits are capped at the new limit.
+    -- units used by another algorithm aren't held by any task, so they're dropped when switching to a semaphore.
+    "value" = CASE
+        WHEN EXCLUDED."algorithm" = 'SEMAPHORE' AND "RateLimit"."algorithm" = 'SEMAPHORE' THEN
+            EXCLUDED."value" - ("RateLimit"."limitValue" - "RateLimit"."value")
+        WHEN EXCLUDED."algorithm" = 'SEMAPHORE' THEN
+            EXCLUDED."value" - get_held_semaphore_units("RateLimit")
+        ELSE
+            LEAST(EXCLUDED."value", "RateLimit"."value")
+    END,
+    "previousUsage" = CASE WHEN EXCLUDED."algorithm" = "RateLimit"."algorithm" THEN "RateLimit"."previousUsage" ELSE 0 END
 RETURNING *;
 
 -- name: UpsertRateLimitsBulk :exec
@@ -84,19 +107,9 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_last_refill(rl)::timestamp AS "lastRefill"
 FROM
     "RateLimit" rl
 WHERE
@@ -23,7 +23,7 @@ WITH input AS (
         ) AS subquery
 ), rls_to_update AS (
     SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
     FROM
         "RateLimit" rl
     WHERE
@@ -48,7 +48,7 @@ FROM
 WHERE
     rl2."tenantId" = rl."tenantId"
     AND rl2."key" = rl."key"
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
 `
 
 type BulkUpdateRateLimitsParams struct {
@@ -73,6 +73,12 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Algorithm,
+			&i.Burst,
+			&i.RefillRate,
+			&i.PreviousUsage,
+			&i.IsDynamic,
+			&i.LastUsedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -171,19 +177,9 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_last_refill(rl)::timestamp AS "lastRefill"
 FROM
     "RateLimit" rl
 WHERE
@@ -260,7 +256,7 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 const listRateLimitsForTenantWithMutate = `-- name: ListRateLimitsForTenantWithMutate :many
 WITH rls_to_update AS (
     SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
     FROM
         "RateLimit" rl
     WHERE
@@ -280,10 +276,10 @@ WITH rls_to_update AS (
     WHERE
         rl."tenantId" = rls_to_update."tenantId"
         AND rl."key" = rls_to_update."key"
-    RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+    RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
 )
 SELECT
-    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill",
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt",
     (rl."lastRefill" + rl."window"::INTERVAL)::timestamp AS "nextRefillAt"
 FROM
     "RateLimit" rl
@@ -294,7 +290,7 @@ WHERE
 UNION ALL
 
 SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
+    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill", refill.algorithm, refill.burst, refill."refillRate", refill."previousUsage", refill."isDynamic", refill."lastUsedAt",
     -- return the next refill time
     (refill."lastRefill" + refill."window"::INTERVAL)::timestamp AS "nextRefillAt"
 FROM
@@ -302,13 +298,19 @@ FROM
 `
 
 type ListRateLimitsForTenantWithMutateRow struct {
-	TenantId     pgtype.UUID      `json:"tenantId"`
-	Key          string           `json:"key"`
-	LimitValue   int32            `json:"limitValue"`
-	Value        int32            `json:"value"`
-	Window       string           `json:"window"`
-	LastRefill   pgtype.Timestamp `json:"lastRefill"`
-	NextRefillAt pgtype.Timestamp `json:"nextRefillAt"`
+	TenantId      pgtype.UUID        `json:"tenantId"`
+	Key           string             `json:"key"`
+	LimitValue    int32              `json:"limitValue"`
+	Value         int32              `json:"value"`
+	Window        string             `json:"window"`
+	LastRefill    pgtype.Timestamp   `json:"lastRefill"`
+	Algorithm     RateLimitAlgorithm `json:"algorithm"`
+	Burst         pgtype.Int4        `json:"burst"`
+	RefillRate    pgtype.Float8      `json:"refillRate"`
+	PreviousUsage int32              `json:"previousUsage"`
+	IsDynamic     bool               `json:"isDynamic"`
+	LastUsedAt    pgtype.Timestamp   `json:"lastUsedAt"`
+	NextRefillAt  pgtype.Timestamp   `json:"nextRefillAt"`
 }
 
 func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitsForTenantWithMutateRow, error) {
@@ -327,6 +329,12 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Algorithm,
+			&i.Burst,
+			&i.RefillRate,
+			&i.PreviousUsage,
+			&i.IsDynamic,
+			&i.LastUsedAt,
 			&i.NextRefillAt,
 		); err != nil {
 			return nil, err
@@ -345,25 +353,51 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "algorithm",
+    "burst",
+    "refillRate"
 ) VALUES (
     $1::uuid,
     $2::text,
     $3::int,
-    $3::int,
-    COALESCE($4::text, '1 minute')
+    -- token buckets start full
+    CASE
+        WHEN $4::"RateLimitAlgorithm" = 'TOKEN_BUCKET' THEN COALESCE($5::int, $3::int)
+        ELSE $3::int
+    END,
+    COALESCE($6::text, '1 minute'),
+    COALESCE($4::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    $5::int,
+    $7::double precision
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = $3::int,
-    "window" = COALESCE($4::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
-RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+    "window" = COALESCE($6::text, '1 minute'),
+    "algorithm" = EXCLUDED."algorithm",
+    "burst" = EXCLUDED."burst",
+    "refillRate" = EXCLUDED."refillRate",
+    -- semaphores keep the units which are held by running tasks, other rate limits are capped at the new limit.
+    -- units used by another algorithm aren't held by any task, so they're dropped when switching to a semaphore.
+    "value" = CASE
+        WHEN EXCLUDED."algorithm" = 'SEMAPHORE' AND "RateLimit"."algorithm" = 'SEMAPHORE' THEN
+            EXCLUDED."value" - ("RateLimit"."limitValue" - "RateLimit"."value")
+        WHEN EXCLUDED."algorithm" = 'SEMAPHORE' THEN
+            EXCLUDED."value" - get_held_semaphore_units("RateLimit")
+        ELSE
+            LEAST(EXCLUDED."value", "RateLimit"."value")
+    END,
+    "previousUsage" = CASE WHEN EXCLUDED."algorithm" = "RateLimit"."algorithm" THEN "RateLimit"."previousUsage" ELSE 0 END
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", algorithm, burst, "refillRate", "previousUsage", "isDynamic", "lastUsedAt"
 `
 
 type UpsertRateLimitParams struct {
-	Tenantid pgtype.UUID `json:"tenantid"`
-	Key      string      `json:"key"`
-	Limit    int32       `json:"limit"`
-	Window   pgtype.Text `json:"window"`
+	Tenantid   pgtype.UUID            `json:"tenantid"`
+	Key        string                 `json:"key"`
+	Limit      int32                  `json:"limit"`
+	Algorithm  NullRateLimitAlgorithm `json:"algorithm"`
+	Burst      pgtype.Int4            `json:"burst"`
+	Window     pgtype.Text            `json:"window"`
+	RefillRate pgtype.Float8          `json:"refillRate"`
 }
 
 func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLimitParams) (*RateLimit, error) {
@@ -371,7 +405,10 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		arg.Tenantid,
 		arg.Key,
 		arg.Limit,
+		arg.Algorithm,
+		arg.Burst,
 		arg.Window,
+		arg.RefillRate,
 	)
 	var i RateLimit
 	err := row.Scan(
@@ -381,6 +418,12 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		&i.Value,
 		&i.Window,
 		&i.LastRefill,
+		&i.Algorithm,
+		&i.Burst,
+		&i.RefillRate,
+		&i.PreviousUsage,
+		&i.IsDynamic,
+		&i.LastUsedAt,
 	)
 	return &i, err
 }
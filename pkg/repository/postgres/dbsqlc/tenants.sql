@@ -35,7 +35,12 @@ SET
     "analyticsOptOut" = COALESCE(sqlc.narg('analyticsOptOut')::boolean, "analyticsOptOut"),
     "alertMemberEmails" = COALESCE(sqlc.narg('alertMemberEmails')::boolean, "alertMemberEmails"),
     "version" = COALESCE(sqlc.narg('version')::"TenantMajorEngineVersion", "version"),
-    "uiVersion" = COALESCE(sqlc.narg('uiVersion')::"TenantMajorUIVersion", "uiVersion")
+    "uiVersion" = COALESCE(sqlc.narg('uiVersion')::"TenantMajorUIVersion", "uiVersion"),
+    -- a value of 0 disables priority aging
+    "priorityAgingSeconds" = CASE
+        WHEN sqlc.narg('priorityAgingSeconds')::integer IS NULL THEN "priorityAgingSeconds"
+        ELSE NULLIF(sqlc.narg('priorityAgingSeconds')::integer, 0)
+    END
 WHERE
     "id" = sqlc.arg('id')::uuid
 RETURNING *;
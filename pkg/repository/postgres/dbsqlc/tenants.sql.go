@@ -101,7 +101,7 @@ VALUES (
     COALESCE($5::"TenantMajorEngineVersion", 'V0'),
     COALESCE($6::"TenantMajorUIVersion", 'V0')
 )
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 `
 
 type CreateTenantParams struct {
@@ -139,6 +139,7 @@ func (q *Queries) CreateTenant(ctx context.Context, db DBTX, arg CreateTenantPar
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
 		&i.CanUpgradeV1,
+		&i.PriorityAgingSeconds,
 	)
 	return &i, err
 }
@@ -378,7 +379,7 @@ func (q *Queries) GetEmailGroups(ctx context.Context, db DBTX, tenantid pgtype.U
 
 const getInternalTenantForController = `-- name: GetInternalTenantForController :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 WHERE
@@ -405,6 +406,7 @@ func (q *Queries) GetInternalTenantForController(ctx context.Context, db DBTX, c
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
 		&i.CanUpgradeV1,
+		&i.PriorityAgingSeconds,
 	)
 	return &i, err
 }
@@ -530,7 +532,7 @@ func (q *Queries) GetTenantAlertingSettings(ctx context.Context, db DBTX, tenant
 
 const getTenantByID = `-- name: GetTenantByID :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 WHERE
@@ -556,13 +558,14 @@ func (q *Queries) GetTenantByID(ctx context.Context, db DBTX, id pgtype.UUID) (*
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
 		&i.CanUpgradeV1,
+		&i.PriorityAgingSeconds,
 	)
 	return &i, err
 }
 
 const getTenantBySlug = `-- name: GetTenantBySlug :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 WHERE
@@ -588,6 +591,7 @@ func (q *Queries) GetTenantBySlug(ctx context.Context, db DBTX, slug string) (*T
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
 		&i.CanUpgradeV1,
+		&i.PriorityAgingSeconds,
 	)
 	return &i, err
 }
@@ -885,7 +889,7 @@ func (q *Queries) ListTenantMembers(ctx context.Context, db DBTX, tenantid pgtyp
 
 const listTenants = `-- name: ListTenants :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 `
@@ -915,6 +919,7 @@ func (q *Queries) ListTenants(ctx context.Context, db DBTX) ([]*Tenant, error) {
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
 			&i.CanUpgradeV1,
+			&i.PriorityAgingSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -928,7 +933,7 @@ func (q *Queries) ListTenants(ctx context.Context, db DBTX) ([]*Tenant, error) {
 
 const listTenantsByControllerPartitionId = `-- name: ListTenantsByControllerPartitionId :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 WHERE
@@ -966,6 +971,7 @@ func (q *Queries) ListTenantsByControllerPartitionId(ctx context.Context, db DBT
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
 			&i.CanUpgradeV1,
+			&i.PriorityAgingSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -979,7 +985,7 @@ func (q *Queries) ListTenantsByControllerPartitionId(ctx context.Context, db DBT
 
 const listTenantsBySchedulerPartitionId = `-- name: ListTenantsBySchedulerPartitionId :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 WHERE
@@ -1017,6 +1023,7 @@ func (q *Queries) ListTenantsBySchedulerPartitionId(ctx context.Context, db DBTX
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
 			&i.CanUpgradeV1,
+			&i.PriorityAgingSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -1030,7 +1037,7 @@ func (q *Queries) ListTenantsBySchedulerPartitionId(ctx context.Context, db DBTX
 
 const listTenantsByTenantWorkerPartitionId = `-- name: ListTenantsByTenantWorkerPartitionId :many
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 FROM
     "Tenant" as tenants
 WHERE
@@ -1068,6 +1075,7 @@ func (q *Queries) ListTenantsByTenantWorkerPartitionId(ctx context.Context, db D
 			&i.DataRetentionPeriod,
 			&i.SchedulerPartitionId,
 			&i.CanUpgradeV1,
+			&i.PriorityAgingSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -1437,19 +1445,25 @@ SET
     "analyticsOptOut" = COALESCE($2::boolean, "analyticsOptOut"),
     "alertMemberEmails" = COALESCE($3::boolean, "alertMemberEmails"),
     "version" = COALESCE($4::"TenantMajorEngineVersion", "version"),
-    "uiVersion" = COALESCE($5::"TenantMajorUIVersion", "uiVersion")
+    "uiVersion" = COALESCE($5::"TenantMajorUIVersion", "uiVersion"),
+    -- a value of 0 disables priority aging
+    "priorityAgingSeconds" = CASE
+        WHEN $6::integer IS NULL THEN "priorityAgingSeconds"
+        ELSE NULLIF($6::integer, 0)
+    END
 WHERE
-    "id" = $6::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1"
+    "id" = $7::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "uiVersion", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId", "canUpgradeV1", "priorityAgingSeconds"
 `
 
 type UpdateTenantParams struct {
-	Name              pgtype.Text                  `json:"name"`
-	AnalyticsOptOut   pgtype.Bool                  `json:"analyticsOptOut"`
-	AlertMemberEmails pgtype.Bool                  `json:"alertMemberEmails"`
-	Version           NullTenantMajorEngineVersion `json:"version"`
-	UiVersion         NullTenantMajorUIVersion     `json:"uiVersion"`
-	ID                pgtype.UUID                  `json:"id"`
+	Name                 pgtype.Text                  `json:"name"`
+	AnalyticsOptOut      pgtype.Bool                  `json:"analyticsOptOut"`
+	AlertMemberEmails    pgtype.Bool                  `json:"alertMemberEmails"`
+	Version              NullTenantMajorEngineVersion `json:"version"`
+	UiVersion            NullTenantMajorUIVersion     `json:"uiVersion"`
+	PriorityAgingSeconds pgtype.Int4                  `json:"priorityAgingSeconds"`
+	ID                   pgtype.UUID                  `json:"id"`
 }
 
 func (q *Queries) UpdateTenant(ctx context.Context, db DBTX, arg UpdateTenantParams) (*Tenant, error) {
@@ -1459,6 +1473,7 @@ func (q *Queries) UpdateTenant(ctx context.Context, db DBTX, arg UpdateTenantPar
 		arg.AlertMemberEmails,
 		arg.Version,
 		arg.UiVersion,
+		arg.PriorityAgingSeconds,
 		arg.ID,
 	)
 	var i Tenant
@@ -1478,6 +1493,7 @@ func (q *Queries) UpdateTenant(ctx context.Context, db DBTX, arg UpdateTenantPar
 		&i.DataRetentionPeriod,
 		&i.SchedulerPartitionId,
 		&i.CanUpgradeV1,
+		&i.PriorityAgingSeconds,
 	)
 	return &i, err
 }
@@ -395,7 +395,7 @@ INSERT INTO "Step" (
     coalesce($12::text, '5m'),
     $13,
     $14
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout"
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout", "slotWeight", preemptible, "gangName", "gangTimeout", "placementStrategy", "totalTimeout"
 `
 
 type CreateStepParams struct {
@@ -448,6 +448,12 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.RetryBackoffFactor,
 		&i.RetryMaxBackoff,
 		&i.ScheduleTimeout,
+		&i.SlotWeight,
+		&i.Preemptible,
+		&i.GangName,
+		&i.GangTimeout,
+		&i.PlacementStrategy,
+		&i.TotalTimeout,
 	)
 	return &i, err
 }
@@ -983,7 +989,7 @@ INSERT INTO "WorkflowVersion" (
     $9::"StickyStrategy",
     coalesce($10::"WorkflowKind", 'DAG'),
     $11::integer
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts"
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "priorityAgingSeconds", "debounceExpression", "debounceWindow", "executionTimeout"
 `
 
 type CreateWorkflowVersionParams struct {
@@ -1030,6 +1036,10 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.Kind,
 		&i.DefaultPriority,
 		&i.CreateWorkflowVersionOpts,
+		&i.PriorityAgingSeconds,
+		&i.DebounceExpression,
+		&i.DebounceWindow,
+		&i.ExecutionTimeout,
 	)
 	return &i, err
 }
@@ -1191,7 +1201,7 @@ func (q *Queries) GetWorkflowLatestVersion(ctx context.Context, db DBTX, workflo
 
 const getWorkflowVersionById = `-- name: GetWorkflowVersionById :one
 SELECT
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."createWorkflowVersionOpts",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv."createWorkflowVersionOpts", wv."priorityAgingSeconds", wv."debounceExpression", wv."debounceWindow", wv."executionTimeout",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
     wc."id" as "concurrencyId",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1234,6 +1244,10 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id pgtype
 		&i.WorkflowVersion.Kind,
 		&i.WorkflowVersion.DefaultPriority,
 		&i.WorkflowVersion.CreateWorkflowVersionOpts,
+		&i.WorkflowVersion.PriorityAgingSeconds,
+		&i.WorkflowVersion.DebounceExpression,
+		&i.WorkflowVersion.DebounceWindow,
+		&i.WorkflowVersion.ExecutionTimeout,
 		&i.Workflow.ID,
 		&i.Workflow.CreatedAt,
 		&i.Workflow.UpdatedAt,
@@ -1326,7 +1340,7 @@ func (q *Queries) GetWorkflowVersionEventTriggerRefs(ctx context.Context, db DBT
 
 const getWorkflowVersionForEngine = `-- name: GetWorkflowVersionForEngine :many
 SELECT
-    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."createWorkflowVersionOpts",
+    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."createWorkflowVersionOpts", workflowversions."priorityAgingSeconds", workflowversions."debounceExpression", workflowversions."debounceWindow", workflowversions."executionTimeout",
     w."name" as "workflowName",
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1383,6 +1397,10 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
 			&i.WorkflowVersion.CreateWorkflowVersionOpts,
+			&i.WorkflowVersion.PriorityAgingSeconds,
+			&i.WorkflowVersion.DebounceExpression,
+			&i.WorkflowVersion.DebounceWindow,
+			&i.WorkflowVersion.ExecutionTimeout,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -1582,7 +1600,7 @@ const linkOnFailureJob = `-- name: LinkOnFailureJob :one
 UPDATE "WorkflowVersion"
 SET "onFailureJobId" = $1::uuid
 WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "priorityAgingSeconds", "debounceExpression", "debounceWindow", "executionTimeout"
 `
 
 type LinkOnFailureJobParams struct {
@@ -1608,6 +1626,10 @@ func (q *Queries) LinkOnFailureJob(ctx context.Context, db DBTX, arg LinkOnFailu
 		&i.Kind,
 		&i.DefaultPriority,
 		&i.CreateWorkflowVersionOpts,
+		&i.PriorityAgingSeconds,
+		&i.DebounceExpression,
+		&i.DebounceWindow,
+		&i.ExecutionTimeout,
 	)
 	return &i, err
 }
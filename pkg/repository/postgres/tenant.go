@@ -132,6 +132,10 @@ func (r *tenantAPIRepository) UpdateTenant(ctx context.Context, id string, opts
 		params.UiVersion = *opts.UIVersion
 	}
 
+	if opts.PriorityAgingSeconds != nil {
+		params.PriorityAgingSeconds = sqlchelpers.ToInt(*opts.PriorityAgingSeconds)
+	}
+
 	return r.queries.UpdateTenant(
 		ctx,
 		r.pool,
@@ -34,6 +34,15 @@ type UpsertRateLimitOpts struct {
 
 	// The rate limit duration
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the rate limit algorithm, defaults to FIXED_WINDOW
+	Algorithm *string `validate:"omitnil,oneof=FIXED_WINDOW SLIDING_WINDOW TOKEN_BUCKET SEMAPHORE"`
+
+	// (optional) the capacity of a token bucket, defaults to the limit
+	Burst *int `validate:"omitnil,gt=0"`
+
+	// (optional) the tokens added to a token bucket per second, defaults to the limit per duration
+	RefillRate *float64 `validate:"omitnil,gt=0"`
 }
 
 type RateLimitEngineRepository interface {
@@ -34,6 +34,10 @@ type UpdateTenantOpts struct {
 	Version *dbsqlc.NullTenantMajorEngineVersion `validate:"omitempty"`
 
 	UIVersion *dbsqlc.NullTenantMajorUIVersion `validate:"omitempty"`
+
+	// PriorityAgingSeconds is the number of seconds a queued task waits before its priority is raised by 1.
+	// A value of 0 disables priority aging.
+	PriorityAgingSeconds *int32 `validate:"omitnil,min=0"`
 }
 
 type CreateTenantMemberOpts struct {
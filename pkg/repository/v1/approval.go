@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+type ListApprovalsOpts struct {
+	// (optional) only list approvals for this workflow run
+	WorkflowRunId *string `validate:"omitnil,uuid"`
+
+	// (optional) the number of approvals to return
+	Limit *int64 `validate:"omitnil,min=1"`
+
+	// (optional) the number of approvals to skip
+	Offset *int64 `validate:"omitnil,min=0"`
+}
+
+type DecideApprovalOpts struct {
+	// (required) whether the task was approved or rejected
+	Approved bool
+
+	// (required) the id of the user who made the decision
+	UserId string `validate:"required,uuid"`
+
+	// (required) the email of the user who made the decision
+	UserEmail string `validate:"required"`
+
+	// (optional) a comment explaining the decision
+	Comment *string
+
+	// (optional) a JSON payload which is passed to the task along with the decision
+	Payload []byte
+}
+
+// approvalEventData is the data of the internal event which delivers an approval decision to the task's
+// match conditions. On approval, it is made available to the task under the condition's readable data key.
+type approvalEventData struct {
+	Approved bool `json:"approved"`
+
+	ApproverId string `json:"approver_id"`
+
+	ApproverEmail string `json:"approver_email"`
+
+	Comment string `json:"comment,omitempty"`
+
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	// the failure reason for a rejected task
+	Reason string `json:"reason,omitempty"`
+}
+
+type ApprovalRepository interface {
+	// ListPendingApprovals lists the approvals which tasks are currently waiting on
+	ListPendingApprovals(ctx context.Context, tenantId string, opts ListApprovalsOpts) ([]*sqlcv1.V1Approval, error)
+
+	GetApproval(ctx context.Context, tenantId, approvalId string) (*sqlcv1.V1Approval, error)
+
+	// DecideApproval approves or rejects a pending approval. It returns the internal event which should be
+	// processed against the task's match conditions to queue or fail the task. The same user can retry the same
+	// decision until the task has received it, so that a decision whose event could not be sent isn't lost.
+	DecideApproval(ctx context.Context, tenantId, approvalId string, opts DecideApprovalOpts) (*sqlcv1.V1Approval, *InternalTaskEvent, error)
+}
+
+type approvalRepository struct {
+	*sharedRepository
+}
+
+func newApprovalRepository(shared *sharedRepository) ApprovalRepository {
+	return &approvalRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *approvalRepository) ListPendingApprovals(ctx context.Context, tenantId string, opts ListApprovalsOpts) ([]*sqlcv1.V1Approval, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.ListPendingApprovalsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if opts.WorkflowRunId != nil {
+		params.WorkflowRunId = sqlchelpers.UUIDFromStr(*opts.WorkflowRunId)
+	}
+
+	if opts.Limit != nil {
+		params.ApprovalLimit = pgtype.Int8{
+			Int64: *opts.Limit,
+			Valid: true,
+		}
+	}
+
+	if opts.Offset != nil {
+		params.ApprovalOffset = pgtype.Int8{
+			Int64: *opts.Offset,
+			Valid: true,
+		}
+	}
+
+	return r.queries.ListPendingApprovals(ctx, r.pool, params)
+}
+
+func (r *approvalRepository) GetApproval(ctx context.Context, tenantId, approvalId string) (*sqlcv1.V1Approval, error) {
+	return r.queries.GetApproval(ctx, r.pool, sqlcv1.GetApprovalParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Externalid: sqlchelpers.UUIDFromStr(approvalId),
+	})
+}
+
+func (r *approvalRepository) DecideApproval(ctx context.Context, tenantId, approvalId string, opts DecideApprovalOpts) (*sqlcv1.V1Approval, *InternalTaskEvent, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, nil, err
+	}
+
+	if len(opts.Payload) > 0 && !json.Valid(opts.Payload) {
+		return nil, nil, fmt.Errorf("approval payload must be valid JSON")
+	}
+
+	status := sqlcv1.V1ApprovalStatusAPPROVED
+
+	if !opts.Approved {
+		status = sqlcv1.V1ApprovalStatusREJECTED
+	}
+
+	params := sqlcv1.DecideApprovalParams{
+		Status:         status,
+		Decidedby:      sqlchelpers.UUIDFromStr(opts.UserId),
+		Decidedbyemail: opts.UserEmail,
+		Payload:        opts.Payload,
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Externalid:     sqlchelpers.UUIDFromStr(approvalId),
+	}
+
+	data := approvalEventData{
+		Approved:      opts.Approved,
+		ApproverId:    opts.UserId,
+		ApproverEmail: opts.UserEmail,
+		Payload:       opts.Payload,
+	}
+
+	if opts.Comment != nil {
+		params.Comment = sqlchelpers.TextFromStr(*opts.Comment)
+		data.Comment = *opts.Comment
+	}
+
+	if !opts.Approved {
+		data.Reason = fmt.Sprintf("rejected by %s", opts.UserEmail)
+
+		if data.Comment != "" {
+			data.Reason = fmt.Sprintf("%s: %s", data.Reason, data.Comment)
+		}
+	}
+
+	// NOTE: this only updates the approval if it is still pending and its task is still waiting on it
+	approval, err := r.queries.DecideApproval(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataBytes, err := json.Marshal(data)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal approval event data: %w", err)
+	}
+
+	event := &InternalTaskEvent{
+		TenantID:       tenantId,
+		TaskExternalID: sqlchelpers.UUIDToStr(approval.TaskExternalID),
+		// NOTE: the event type of internal events is used as the event key for the match condition
+		EventType: sqlcv1.V1TaskEventType(getApprovalEventKey(approval.ID)),
+		EventKey:  getApprovalEventKey(approval.ID),
+		Data:      dataBytes,
+	}
+
+	return approval, event, nil
+}
@@ -0,0 +1,169 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestApprovalMatchResolution(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		approved := true
+		rejected := false
+		expiresIn := "1s"
+		fail := "FAIL"
+		skip := "SKIP"
+
+		tests := []struct {
+			name         string
+			decision     *bool
+			expiryAction *string
+			state        sqlcv1.V1TaskInitialState
+			status       sqlcv1.V1ApprovalStatus
+		}{
+			{
+				name:     "approve queues the task",
+				decision: &approved,
+				state:    sqlcv1.V1TaskInitialStateQUEUED,
+				status:   sqlcv1.V1ApprovalStatusAPPROVED,
+			},
+			{
+				name:         "reject fails the task on its own",
+				decision:     &rejected,
+				expiryAction: &fail,
+				state:        sqlcv1.V1TaskInitialStateFAILED,
+				status:       sqlcv1.V1ApprovalStatusREJECTED,
+			},
+			{
+				name:         "reject fails the task when expiry skips",
+				decision:     &rejected,
+				expiryAction: &skip,
+				state:        sqlcv1.V1TaskInitialStateFAILED,
+				status:       sqlcv1.V1ApprovalStatusREJECTED,
+			},
+			{
+				name:         "expiry fails the task on its own",
+				expiryAction: &fail,
+				state:        sqlcv1.V1TaskInitialStateFAILED,
+				status:       sqlcv1.V1ApprovalStatusEXPIRED,
+			},
+			{
+				name:         "expiry skips the task",
+				expiryAction: &skip,
+				state:        sqlcv1.V1TaskInitialStateSKIPPED,
+				status:       sqlcv1.V1ApprovalStatusEXPIRED,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				condition := v1.CreateStepMatchConditionOpt{
+					MatchConditionKind: "APPROVAL",
+					ReadableDataKey:    "approval",
+					Action:             "QUEUE",
+					OrGroupId:          uuid.NewString(),
+				}
+
+				if tt.expiryAction != nil {
+					condition.ApprovalExpiresIn = &expiresIn
+					condition.ApprovalExpiryAction = tt.expiryAction
+				}
+
+				name := putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+					{
+						ReadableId:        "approve",
+						Action:            "test:approve",
+						TriggerConditions: []v1.CreateStepMatchConditionOpt{condition},
+					},
+				})
+
+				runId := triggerTestWorkflow(t, conf, tenantId, name)
+
+				approvals, err := conf.V1.Approvals().ListPendingApprovals(ctx, tenantId, v1.ListApprovalsOpts{
+					WorkflowRunId: &runId,
+				})
+				require.NoError(t, err)
+				require.Len(t, approvals, 1)
+
+				approvalId := sqlchelpers.UUIDToStr(approvals[0].ExternalID)
+
+				var res *v1.EventMatchResults
+
+				if tt.decision != nil {
+					opts := v1.DecideApprovalOpts{
+						Approved:  *tt.decision,
+						UserId:    uuid.NewString(),
+						UserEmail: "approver@example.com",
+					}
+
+					_, event, err := conf.V1.Approvals().DecideApproval(ctx, tenantId, approvalId, opts)
+					require.NoError(t, err)
+
+					// the same decision can be retried until the task has received it, but not changed
+					_, event, err = conf.V1.Approvals().DecideApproval(ctx, tenantId, approvalId, opts)
+					require.NoError(t, err)
+
+					opposite := opts
+					opposite.Approved = !opts.Approved
+
+					_, _, err = conf.V1.Approvals().DecideApproval(ctx, tenantId, approvalId, opposite)
+					assert.True(t, errors.Is(err, pgx.ErrNoRows))
+
+					res, err = conf.V1.Matches().ProcessInternalEventMatches(ctx, tenantId, []v1.CandidateEventMatch{
+						{
+							ID:             uuid.NewString(),
+							EventTimestamp: time.Now(),
+							Key:            string(event.EventType),
+							Data:           event.Data,
+							ResourceHint:   &event.TaskExternalID,
+						},
+					})
+					require.NoError(t, err)
+
+					// once the task has received the decision, it can no longer be retried
+					_, _, err = conf.V1.Approvals().DecideApproval(ctx, tenantId, approvalId, opts)
+					assert.True(t, errors.Is(err, pgx.ErrNoRows))
+				} else {
+					time.Sleep(1500 * time.Millisecond)
+
+					res, _, err = conf.V1.Tasks().ProcessDurableSleeps(ctx, tenantId)
+					require.NoError(t, err)
+				}
+
+				var task *sqlcv1.V1Task
+
+				for _, created := range res.CreatedTasks {
+					if created.ExternalID == approvals[0].TaskExternalID {
+						task = created
+					}
+				}
+
+				require.NotNil(t, task, "task was not created from the approval's match")
+				assert.Equal(t, tt.state, task.InitialState)
+
+				approval, err := conf.V1.Approvals().GetApproval(ctx, tenantId, approvalId)
+				require.NoError(t, err)
+				assert.Equal(t, tt.status, approval.Status)
+			})
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+var ErrConcurrencyWorkflowNotFound = errors.New("workflow not found")
+
+var ErrConcurrencyKeyLimitNotFound = errors.New("concurrency limit not found")
+
+// ConcurrencyManagementRepository manages the concurrency settings of a tenant which can be changed without
+// registering a new workflow version. Concurrency strategies are run by the scheduler through the
+// ConcurrencyRepository.
+type ConcurrencyManagementRepository interface {
+	// SetConcurrencyKeyLimit overrides the max runs of the GROUP_ROUND_ROBIN strategies of a workflow for a
+	// single concurrency key
+	SetConcurrencyKeyLimit(ctx context.Context, tenantId string, opts SetConcurrencyKeyLimitOpts) (*sqlcv1.V1ConcurrencyKeyLimit, error)
+
+	// DeleteConcurrencyKeyLimit removes an override, so the key uses the max runs of the workflow version again
+	DeleteConcurrencyKeyLimit(ctx context.Context, tenantId, workflowName, key string) (*sqlcv1.V1ConcurrencyKeyLimit, error)
+
+	// ListConcurrencyKeyLimits lists the overrides of a tenant
+	ListConcurrencyKeyLimits(ctx context.Context, tenantId string) ([]*sqlcv1.ListConcurrencyKeyLimitsRow, error)
+
+	// ListConcurrencySlotKeys lists the active keys of the workflow and step concurrency strategies of a tenant,
+	// with the number of running and queued runs and the oldest queued run per key
+	ListConcurrencySlotKeys(ctx context.Context, tenantId string, opts ListConcurrencySlotKeysOpts) ([]*sqlcv1.ListConcurrencySlotKeysRow, error)
+}
+
+type SetConcurrencyKeyLimitOpts struct {
+	// (required) the name of the workflow
+	WorkflowName string `validate:"required"`
+
+	// (required) the concurrency key, as evaluated from the concurrency expression
+	Key string `validate:"required"`
+
+	// (required) the max runs for the key
+	MaxRuns int32 `validate:"min=1"`
+}
+
+type ListConcurrencySlotKeysOpts struct {
+	// (optional) only list keys of these workflows
+	WorkflowIds []pgtype.UUID
+
+	// (optional) the number of keys to skip
+	Offset *int64 `validate:"omitnil,min=0"`
+
+	// (optional) the maximum number of keys to return, defaults to 1000
+	Limit *int64 `validate:"omitnil,min=1"`
+}
+
+type concurrencyManagementRepository struct {
+	*sharedRepository
+}
+
+func newConcurrencyManagementRepository(shared *sharedRepository) ConcurrencyManagementRepository {
+	return &concurrencyManagementRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *concurrencyManagementRepository) SetConcurrencyKeyLimit(ctx context.Context, tenantId string, opts SetConcurrencyKeyLimitOpts) (*sqlcv1.V1ConcurrencyKeyLimit, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	workflow, err := r.getWorkflowByName(ctx, tenantId, opts.WorkflowName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queries.UpsertConcurrencyKeyLimit(ctx, r.pool, sqlcv1.UpsertConcurrencyKeyLimitParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: workflow.ID,
+		Key:        opts.Key,
+		Maxruns:    opts.MaxRuns,
+	})
+}
+
+func (r *concurrencyManagementRepository) DeleteConcurrencyKeyLimit(ctx context.Context, tenantId, workflowName, key string) (*sqlcv1.V1ConcurrencyKeyLimit, error) {
+	workflow, err := r.getWorkflowByName(ctx, tenantId, workflowName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := r.queries.DeleteConcurrencyKeyLimit(ctx, r.pool, sqlcv1.DeleteConcurrencyKeyLimitParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: workflow.ID,
+		Key:        key,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrConcurrencyKeyLimitNotFound
+	}
+
+	return limit, err
+}
+
+func (r *concurrencyManagementRepository) ListConcurrencyKeyLimits(ctx context.Context, tenantId string) ([]*sqlcv1.ListConcurrencyKeyLimitsRow, error) {
+	return r.queries.ListConcurrencyKeyLimits(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *concurrencyManagementRepository) ListConcurrencySlotKeys(ctx context.Context, tenantId string, opts ListConcurrencySlotKeysOpts) ([]*sqlcv1.ListConcurrencySlotKeysRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := sqlcv1.ListConcurrencySlotKeysParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		WorkflowIds: opts.WorkflowIds,
+	}
+
+	if opts.Offset != nil {
+		params.Offset = pgtype.Int8{Int64: *opts.Offset, Valid: true}
+	}
+
+	if opts.Limit != nil {
+		params.Limit = pgtype.Int8{Int64: *opts.Limit, Valid: true}
+	}
+
+	return r.queries.ListConcurrencySlotKeys(ctx, r.pool, params)
+}
+
+func (r *concurrencyManagementRepository) getWorkflowByName(ctx context.Context, tenantId, workflowName string) (*sqlcv1.Workflow, error) {
+	workflow, err := r.queries.GetWorkflowByName(ctx, r.pool, sqlcv1.GetWorkflowByNameParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Name:     workflowName,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrConcurrencyWorkflowNotFound
+	}
+
+	return workflow, err
+}
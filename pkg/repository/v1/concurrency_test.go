@@ -0,0 +1,269 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// putConcurrencyTestWorkflow creates a workflow with a single task and the given workflow-level concurrency.
+func putConcurrencyTestWorkflow(t *testing.T, conf *database.Layer, tenantId string, concurrency ...v1.CreateConcurrencyOpts) (*sqlcv1.GetWorkflowVersionForEngineRow, error) {
+	t.Helper()
+
+	return conf.V1.Workflows().PutWorkflowVersion(context.Background(), tenantId, &v1.CreateWorkflowVersionOpts{
+		Name: fmt.Sprintf("test-workflow-%s", uuid.NewString()[:8]),
+		Tasks: []v1.CreateStepOpts{
+			{
+				ReadableId: "step",
+				Action:     "test:step",
+			},
+		},
+		Concurrency: concurrency,
+	})
+}
+
+func groupRoundRobin(group string, maxRuns int32, expression string) v1.CreateConcurrencyOpts {
+	strategy := "GROUP_ROUND_ROBIN"
+
+	return v1.CreateConcurrencyOpts{
+		MaxRuns:       &maxRuns,
+		LimitStrategy: &strategy,
+		Group:         &group,
+		Expression:    expression,
+	}
+}
+
+func TestConcurrencyGroupMembersMustMatch(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		group := "billing-api"
+
+		member, err := putConcurrencyTestWorkflow(t, conf, tenantId, groupRoundRobin(group, 2, "input.customer_id"))
+		require.NoError(t, err)
+
+		_, err = putConcurrencyTestWorkflow(t, conf, tenantId, groupRoundRobin(group, 2, "input.customer_id"))
+		require.NoError(t, err)
+
+		// members of a group can't use a different max runs or expression
+		_, err = putConcurrencyTestWorkflow(t, conf, tenantId, groupRoundRobin(group, 5, "input.customer_id"))
+		assert.ErrorContains(t, err, "max runs")
+
+		_, err = putConcurrencyTestWorkflow(t, conf, tenantId, groupRoundRobin(group, 2, "input.account_id"))
+		assert.ErrorContains(t, err, "expression")
+
+		// a group can't be shared between workflow-level and task-level concurrency
+		_, err = conf.V1.Workflows().PutWorkflowVersion(ctx, tenantId, &v1.CreateWorkflowVersionOpts{
+			Name: fmt.Sprintf("test-workflow-%s", uuid.NewString()[:8]),
+			Tasks: []v1.CreateStepOpts{
+				{
+					ReadableId:  "step",
+					Action:      "test:step",
+					Concurrency: []v1.CreateConcurrencyOpts{groupRoundRobin(group, 2, "input.customer_id")},
+				},
+			},
+		})
+		assert.ErrorContains(t, err, "different level")
+
+		// a new version of a member replaces its previous version in the group
+		_, err = conf.V1.Workflows().PutWorkflowVersion(ctx, tenantId, &v1.CreateWorkflowVersionOpts{
+			Name: member.WorkflowName,
+			Tasks: []v1.CreateStepOpts{
+				{
+					ReadableId: "step",
+					Action:     "test:step",
+				},
+			},
+			Concurrency: []v1.CreateConcurrencyOpts{groupRoundRobin(group, 2, "input.customer_id")},
+		})
+		require.NoError(t, err)
+
+		return nil
+	})
+}
+
+func TestConcurrencySlotKeysListWorkflowRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		strategy := "GROUP_ROUND_ROBIN"
+		maxRuns := int32(1)
+
+		workflow, err := putConcurrencyTestWorkflow(t, conf, tenantId, v1.CreateConcurrencyOpts{
+			MaxRuns:       &maxRuns,
+			LimitStrategy: &strategy,
+			Expression:    "'constant'",
+		})
+		require.NoError(t, err)
+
+		first := triggerTestWorkflow(t, conf, tenantId, workflow.WorkflowName)
+		triggerTestWorkflow(t, conf, tenantId, workflow.WorkflowName)
+
+		keys, err := conf.V1.Concurrency().ListConcurrencySlotKeys(ctx, tenantId, v1.ListConcurrencySlotKeysOpts{
+			WorkflowIds: []pgtype.UUID{workflow.WorkflowVersion.WorkflowId},
+		})
+		require.NoError(t, err)
+
+		// the child strategy of the task isn't listed next to the workflow-level strategy
+		require.Len(t, keys, 1)
+		assert.False(t, keys[0].StepID.Valid)
+		assert.Equal(t, "constant", keys[0].Key)
+		assert.Equal(t, int64(2), keys[0].RunningCount+keys[0].QueuedCount)
+		assert.Equal(t, first, sqlchelpers.UUIDToStr(keys[0].OldestQueuedWorkflowRunID))
+
+		return nil
+	})
+}
+
+// runQueueNewestOverflow triggers runs of a task with a QUEUE_NEWEST strategy which allows a single running
+// and a single queued run per key, runs the strategy once and returns the external ids of the triggered runs.
+func runQueueNewestOverflow(t *testing.T, conf *database.Layer, tenantId, queueOverflow string, runs int) ([]string, *v1.RunConcurrencyResult) {
+	t.Helper()
+
+	ctx := context.Background()
+	strategy := "QUEUE_NEWEST"
+	maxRuns := int32(1)
+	maxQueued := int32(1)
+
+	name := putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+		{
+			ReadableId: "step",
+			Action:     "test:step",
+			Concurrency: []v1.CreateConcurrencyOpts{
+				{
+					MaxRuns:       &maxRuns,
+					LimitStrategy: &strategy,
+					MaxQueued:     &maxQueued,
+					QueueOverflow: &queueOverflow,
+					Expression:    "'constant'",
+				},
+			},
+		},
+	})
+
+	externalIds := make([]string, 0, runs)
+
+	for i := 0; i < runs; i++ {
+		externalIds = append(externalIds, triggerTestWorkflow(t, conf, tenantId, name))
+	}
+
+	strategies, err := sqlcv1.New().ListActiveConcurrencyStrategies(ctx, conf.Pool, sqlchelpers.UUIDFromStr(tenantId))
+	require.NoError(t, err)
+	require.Len(t, strategies, 1)
+
+	res, err := conf.V1.Scheduler().Concurrency().RunConcurrencyStrategy(ctx, sqlchelpers.UUIDFromStr(tenantId), strategies[0])
+	require.NoError(t, err)
+
+	return externalIds, res
+}
+
+func cancelledWorkflowRunIds(t *testing.T, res *v1.RunConcurrencyResult) []string {
+	t.Helper()
+
+	ids := make([]string, 0, len(res.Cancelled))
+
+	for _, c := range res.Cancelled {
+		assert.Equal(t, "CONCURRENCY_QUEUE_FULL", c.CancelledReason)
+		ids = append(ids, c.WorkflowRunId)
+	}
+
+	return ids
+}
+
+func TestQueueNewestDropsOldestQueuedRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		tenantId := createTestTenant(t, conf)
+
+		runs, res := runQueueNewestOverflow(t, conf, tenantId, "DROP_OLDEST", 4)
+
+		// the first run starts, and the newest run keeps the only spot in the queue
+		require.Len(t, res.Queued, 1)
+		assert.ElementsMatch(t, []string{runs[1], runs[2]}, cancelledWorkflowRunIds(t, res))
+
+		return nil
+	})
+}
+
+func TestQueueNewestRejectsNewestRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		tenantId := createTestTenant(t, conf)
+
+		runs, res := runQueueNewestOverflow(t, conf, tenantId, "REJECT_NEWEST", 4)
+
+		// the first run starts, and the second run keeps the only spot in the queue
+		require.Len(t, res.Queued, 1)
+		assert.ElementsMatch(t, []string{runs[2], runs[3]}, cancelledWorkflowRunIds(t, res))
+
+		return nil
+	})
+}
+
+func TestConcurrencyKeyLimitOverridesMaxRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+
+		// runGroupRoundRobin triggers three runs of a task with a GROUP_ROUND_ROBIN strategy which allows a
+		// single run per key, and returns the number of runs which were started
+		runGroupRoundRobin := func(keyLimit *int32) int {
+			tenantId := createTestTenant(t, conf)
+			strategy := "GROUP_ROUND_ROBIN"
+			maxRuns := int32(1)
+
+			name := putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+				{
+					ReadableId: "step",
+					Action:     "test:step",
+					Concurrency: []v1.CreateConcurrencyOpts{
+						{
+							MaxRuns:       &maxRuns,
+							LimitStrategy: &strategy,
+							Expression:    "'constant'",
+						},
+					},
+				},
+			})
+
+			for i := 0; i < 3; i++ {
+				triggerTestWorkflow(t, conf, tenantId, name)
+			}
+
+			if keyLimit != nil {
+				_, err := conf.V1.Concurrency().SetConcurrencyKeyLimit(ctx, tenantId, v1.SetConcurrencyKeyLimitOpts{
+					WorkflowName: name,
+					Key:          "constant",
+					MaxRuns:      *keyLimit,
+				})
+				require.NoError(t, err)
+			}
+
+			strategies, err := sqlcv1.New().ListActiveConcurrencyStrategies(ctx, conf.Pool, sqlchelpers.UUIDFromStr(tenantId))
+			require.NoError(t, err)
+			require.Len(t, strategies, 1)
+
+			res, err := conf.V1.Scheduler().Concurrency().RunConcurrencyStrategy(ctx, sqlchelpers.UUIDFromStr(tenantId), strategies[0])
+			require.NoError(t, err)
+
+			return len(res.Queued)
+		}
+
+		keyLimit := int32(2)
+
+		assert.Equal(t, 1, runGroupRoundRobin(nil))
+		assert.Equal(t, 2, runGroupRoundRobin(&keyLimit))
+
+		return nil
+	})
+}
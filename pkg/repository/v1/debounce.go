@@ -0,0 +1,380 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// debounceTuples registers or replaces a pending run for every tuple which belongs to a debounced workflow
+// version, and returns the tuples which should be triggered immediately. Child workflows, sticky runs and
+// tuples which fire a pending run are never debounced.
+func (r *TriggerRepositoryImpl) debounceTuples(ctx context.Context, tenantId string, workflowVersionIds []pgtype.UUID, tuples []triggerTuple) ([]triggerTuple, error) {
+	debounces, err := r.queries.ListWorkflowVersionDebounces(ctx, r.pool, workflowVersionIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow debounces: %w", err)
+	}
+
+	if len(debounces) == 0 {
+		return tuples, nil
+	}
+
+	workflowVersionToDebounce := make(map[string]*sqlcv1.ListWorkflowVersionDebouncesRow, len(debounces))
+
+	for _, d := range debounces {
+		workflowVersionToDebounce[sqlchelpers.UUIDToStr(d.WorkflowVersionId)] = d
+	}
+
+	toTrigger := make([]triggerTuple, 0, len(tuples))
+
+	// the input of the most recent trigger for each (workflow, key) wins, and earlier triggers in the batch are counted
+	type pendingRun struct {
+		tuple        triggerTuple
+		key          string
+		window       string
+		triggerCount int32
+	}
+
+	pendingRuns := make(map[string]*pendingRun)
+	pendingOrder := make([]string, 0)
+
+	for _, tuple := range tuples {
+		debounce, ok := workflowVersionToDebounce[tuple.workflowVersionId]
+
+		if !ok || tuple.debounceKey != nil || tuple.parentTaskId != nil || tuple.desiredWorkerId != nil {
+			toTrigger = append(toTrigger, tuple)
+			continue
+		}
+
+		key, err := r.evalDebounceKey(debounce.DebounceExpression, tuple.externalId, tuple.input, tuple.additionalMetadata)
+
+		if err != nil {
+			// if we can't evaluate the key, we trigger the run immediately rather than dropping it
+			r.l.Warn().Err(err).Msgf("could not evaluate debounce key for workflow %s, triggering immediately", tuple.workflowName)
+			toTrigger = append(toTrigger, tuple)
+			continue
+		}
+
+		pendingKey := fmt.Sprintf("%s:%s", tuple.workflowId, key)
+
+		if existing, ok := pendingRuns[pendingKey]; ok {
+			// the pending run keeps the external id of the first trigger
+			externalId := existing.tuple.externalId
+			existing.tuple = tuple
+			existing.tuple.externalId = externalId
+			existing.triggerCount++
+			continue
+		}
+
+		pendingRuns[pendingKey] = &pendingRun{
+			tuple:        tuple,
+			key:          key,
+			window:       debounce.DebounceWindow,
+			triggerCount: 1,
+		}
+
+		pendingOrder = append(pendingOrder, pendingKey)
+	}
+
+	if len(pendingRuns) == 0 {
+		return toTrigger, nil
+	}
+
+	params := sqlcv1.UpsertDebouncedRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	for _, pendingKey := range pendingOrder {
+		p := pendingRuns[pendingKey]
+
+		input := p.tuple.input
+
+		if len(input) == 0 {
+			input = []byte("{}")
+		}
+
+		additionalMeta := p.tuple.additionalMetadata
+
+		if len(additionalMeta) == 0 {
+			additionalMeta = []byte("{}")
+		}
+
+		var priority int32
+
+		if p.tuple.priority != nil {
+			priority = *p.tuple.priority
+		}
+
+		params.Workflowids = append(params.Workflowids, sqlchelpers.UUIDFromStr(p.tuple.workflowId))
+		params.Keys = append(params.Keys, p.key)
+		params.Workflowversionids = append(params.Workflowversionids, sqlchelpers.UUIDFromStr(p.tuple.workflowVersionId))
+		params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(p.tuple.externalId))
+		params.Inputs = append(params.Inputs, input)
+		params.Additionalmetadatas = append(params.Additionalmetadatas, additionalMeta)
+		params.Priorities = append(params.Priorities, priority)
+		params.Triggercounts = append(params.Triggercounts, p.triggerCount)
+		params.Windows = append(params.Windows, p.window)
+	}
+
+	err = r.queries.UpsertDebouncedRuns(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert debounced runs: %w", err)
+	}
+
+	return toTrigger, nil
+}
+
+// claimDebouncedRunIds gives every opt which will be coalesced into a pending run of a debounced workflow the
+// external id of that run, so that callers can wait on the run which their trigger ends up in.
+func (s *sharedRepository) claimDebouncedRunIds(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error {
+	uniqueNames := make(map[string]struct{})
+	workflowNames := make([]string, 0)
+
+	for _, opt := range opts {
+		if opt.ShouldSkip || opt.ParentTaskId != nil || opt.DesiredWorkerId != nil {
+			continue
+		}
+
+		if _, ok := uniqueNames[opt.WorkflowName]; ok {
+			continue
+		}
+
+		uniqueNames[opt.WorkflowName] = struct{}{}
+		workflowNames = append(workflowNames, opt.WorkflowName)
+	}
+
+	if len(workflowNames) == 0 {
+		return nil
+	}
+
+	debounces, err := s.queries.ListWorkflowDebouncesByNames(ctx, s.pool, sqlcv1.ListWorkflowDebouncesByNamesParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Workflownames: workflowNames,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to list workflow debounces: %w", err)
+	}
+
+	if len(debounces) == 0 {
+		return nil
+	}
+
+	workflowNameToDebounce := make(map[string]*sqlcv1.ListWorkflowDebouncesByNamesRow, len(debounces))
+
+	for _, d := range debounces {
+		workflowNameToDebounce[d.WorkflowName] = d
+	}
+
+	params := sqlcv1.ClaimDebouncedRunIdsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	pendingKeysToOpts := make(map[string][]*WorkflowNameTriggerOpts)
+
+	for i := range opts {
+		opt := opts[i] // we don't want a copy here, we want the actual pointer as we modify in-place
+
+		debounce, ok := workflowNameToDebounce[opt.WorkflowName]
+
+		if !ok || opt.ShouldSkip || opt.ParentTaskId != nil || opt.DesiredWorkerId != nil {
+			continue
+		}
+
+		key, err := s.evalDebounceKey(debounce.DebounceExpression, opt.ExternalId, opt.Data, opt.AdditionalMetadata)
+
+		if err != nil {
+			// the run is triggered immediately with its own id if the key can't be evaluated
+			continue
+		}
+
+		workflowId := sqlchelpers.UUIDToStr(debounce.WorkflowId)
+		pendingKey := fmt.Sprintf("%s:%s", workflowId, key)
+
+		// triggers for the same key in the batch adopt the external id of the first trigger
+		if _, ok := pendingKeysToOpts[pendingKey]; !ok {
+			params.Workflowids = append(params.Workflowids, debounce.WorkflowId)
+			params.Keys = append(params.Keys, key)
+			params.Workflowversionids = append(params.Workflowversionids, debounce.WorkflowVersionId)
+			params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(opt.ExternalId))
+			params.Windows = append(params.Windows, debounce.DebounceWindow)
+		}
+
+		pendingKeysToOpts[pendingKey] = append(pendingKeysToOpts[pendingKey], opt)
+	}
+
+	if len(params.Keys) == 0 {
+		return nil
+	}
+
+	claimed, err := s.queries.ClaimDebouncedRunIds(ctx, s.pool, params)
+
+	if err != nil {
+		return fmt.Errorf("failed to claim debounced run ids: %w", err)
+	}
+
+	for _, row := range claimed {
+		pendingKey := fmt.Sprintf("%s:%s", sqlchelpers.UUIDToStr(row.WorkflowID), row.Key)
+		externalId := sqlchelpers.UUIDToStr(row.ExternalID)
+
+		for _, opt := range pendingKeysToOpts[pendingKey] {
+			opt.ExternalId = externalId
+		}
+	}
+
+	return nil
+}
+
+func (s *sharedRepository) evalDebounceKey(expression, externalId string, inputBytes, additionalMetaBytes []byte) (string, error) {
+	input := make(map[string]interface{})
+
+	if len(inputBytes) > 0 {
+		if err := json.Unmarshal(inputBytes, &input); err != nil {
+			return "", fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+	}
+
+	additionalMeta := make(map[string]interface{})
+
+	if len(additionalMetaBytes) > 0 {
+		if err := json.Unmarshal(additionalMetaBytes, &additionalMeta); err != nil {
+			return "", fmt.Errorf("failed to unmarshal additional metadata: %w", err)
+		}
+	}
+
+	return s.celParser.ParseAndEvalWorkflowString(expression, cel.NewInput(
+		cel.WithInput(input),
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithWorkflowRunID(externalId),
+	))
+}
+
+// claimDebouncedRuns deletes the pending runs fired by the tuples, and returns the external ids of tuples
+// whose pending run was triggered again after it was listed, which should be skipped.
+func (r *TriggerRepositoryImpl) claimDebouncedRuns(ctx context.Context, tx sqlcv1.DBTX, tenantId string, tuples []triggerTuple) (map[string]struct{}, error) {
+	params := sqlcv1.ClaimDebouncedRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	for _, tuple := range tuples {
+		if tuple.debounceKey == nil {
+			continue
+		}
+
+		params.Workflowids = append(params.Workflowids, sqlchelpers.UUIDFromStr(tuple.workflowId))
+		params.Keys = append(params.Keys, *tuple.debounceKey)
+		params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(tuple.externalId))
+		params.Triggercounts = append(params.Triggercounts, tuple.debounceTriggerCount)
+	}
+
+	tuplesToSkip := make(map[string]struct{})
+
+	if len(params.Keys) == 0 {
+		return tuplesToSkip, nil
+	}
+
+	claimed, err := r.queries.ClaimDebouncedRuns(ctx, tx, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	claimedIds := make(map[string]struct{}, len(claimed))
+
+	for _, externalId := range claimed {
+		claimedIds[sqlchelpers.UUIDToStr(externalId)] = struct{}{}
+	}
+
+	for _, tuple := range tuples {
+		if tuple.debounceKey == nil {
+			continue
+		}
+
+		if _, ok := claimedIds[tuple.externalId]; !ok {
+			tuplesToSkip[tuple.externalId] = struct{}{}
+		}
+	}
+
+	return tuplesToSkip, nil
+}
+
+// ProcessDebouncedRuns creates the runs for debounced workflows which haven't been triggered again for their
+// debounce window, using the input of the most recent trigger.
+func (r *TriggerRepositoryImpl) ProcessDebouncedRuns(ctx context.Context, tenantId string) ([]*sqlcv1.V1Task, []*DAGWithData, bool, error) {
+	limit := 1000
+
+	due, err := r.queries.ListDueDebouncedRuns(ctx, r.pool, sqlcv1.ListDueDebouncedRunsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    pgtype.Int4{Int32: int32(limit), Valid: true}, // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to list due debounced runs: %w", err)
+	}
+
+	if len(due) == 0 {
+		return nil, nil, false, nil
+	}
+
+	tuples := make([]triggerTuple, 0, len(due))
+	unusedClaims := make([]triggerTuple, 0)
+
+	for _, run := range due {
+		key := run.Key
+
+		// a claimed id whose trigger never arrived is removed without creating a run
+		if run.TriggerCount == 0 {
+			unusedClaims = append(unusedClaims, triggerTuple{
+				workflowId:  sqlchelpers.UUIDToStr(run.WorkflowID),
+				externalId:  sqlchelpers.UUIDToStr(run.ExternalID),
+				debounceKey: &key,
+			})
+
+			continue
+		}
+
+		var priority *int32
+
+		if run.Priority.Valid {
+			priority = &run.Priority.Int32
+		}
+
+		tuples = append(tuples, triggerTuple{
+			workflowVersionId:    sqlchelpers.UUIDToStr(run.WorkflowVersionID),
+			workflowId:           sqlchelpers.UUIDToStr(run.WorkflowID),
+			workflowName:         run.WorkflowName,
+			externalId:           sqlchelpers.UUIDToStr(run.ExternalID),
+			input:                run.Input,
+			additionalMetadata:   run.AdditionalMetadata,
+			priority:             priority,
+			debounceKey:          &key,
+			debounceTriggerCount: run.TriggerCount,
+		})
+	}
+
+	if len(unusedClaims) > 0 {
+		if _, err := r.claimDebouncedRuns(ctx, r.pool, tenantId, unusedClaims); err != nil {
+			return nil, nil, false, fmt.Errorf("failed to remove unused debounce claims: %w", err)
+		}
+	}
+
+	if len(tuples) == 0 {
+		return nil, nil, len(due) == limit, nil
+	}
+
+	tasks, dags, err := r.triggerWorkflows(ctx, tenantId, tuples)
+
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to trigger debounced runs: %w", err)
+	}
+
+	return tasks, dags, len(due) == limit, nil
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+// putDebouncedTestWorkflow creates a workflow which is debounced by the key in its input, and returns the name.
+func putDebouncedTestWorkflow(t *testing.T, conf *database.Layer, tenantId string, window time.Duration) string {
+	t.Helper()
+
+	name := fmt.Sprintf("test-workflow-%s", uuid.NewString()[:8])
+
+	_, err := conf.V1.Workflows().PutWorkflowVersion(context.Background(), tenantId, &v1.CreateWorkflowVersionOpts{
+		Name: name,
+		Tasks: []v1.CreateStepOpts{
+			{
+				ReadableId: "step",
+				Action:     "test:step",
+			},
+		},
+		Debounce: &v1.CreateDebounceOpts{
+			Expression: "input.key",
+			Window:     window.String(),
+		},
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func newDebouncedTriggerOpt(name, value string) *v1.WorkflowNameTriggerOpts {
+	return &v1.WorkflowNameTriggerOpts{
+		TriggerTaskData: &v1.TriggerTaskData{
+			WorkflowName: name,
+			Data:         []byte(fmt.Sprintf(`{"key": "a", "value": "%s"}`, value)),
+		},
+	}
+}
+
+func TestDebouncedTriggersShareTheFirstExternalId(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		name := putDebouncedTestWorkflow(t, conf, tenantId, time.Second)
+
+		first := newDebouncedTriggerOpt(name, "first")
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{first}))
+
+		tasks, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{first})
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+
+		// a trigger within the window, and triggers in the same batch, are given the id of the pending run
+		second := newDebouncedTriggerOpt(name, "second")
+		third := newDebouncedTriggerOpt(name, "third")
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{second, third}))
+		assert.Equal(t, first.ExternalId, second.ExternalId)
+		assert.Equal(t, first.ExternalId, third.ExternalId)
+
+		tasks, _, err = conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{second, third})
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+
+		time.Sleep(1500 * time.Millisecond)
+
+		tasks, _, _, err = conf.V1.Triggers().ProcessDebouncedRuns(ctx, tenantId)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, first.ExternalId, sqlchelpers.UUIDToStr(tasks[0].ExternalID))
+		assert.Contains(t, string(tasks[0].Input), "third")
+
+		// a trigger which was given the id of the run before it fired is coalesced into that run
+		late := newDebouncedTriggerOpt(name, "late")
+		late.ExternalId = first.ExternalId
+
+		tasks, _, err = conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{late})
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+
+		time.Sleep(1500 * time.Millisecond)
+
+		tasks, _, _, err = conf.V1.Triggers().ProcessDebouncedRuns(ctx, tenantId)
+		require.NoError(t, err)
+
+		for _, task := range tasks {
+			assert.NotEqual(t, first.ExternalId, sqlchelpers.UUIDToStr(task.ExternalID))
+		}
+
+		return nil
+	})
+}
+
+func TestDebouncedRunIdClaimsExpireWithoutTrigger(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		name := putDebouncedTestWorkflow(t, conf, tenantId, time.Second)
+
+		// the trigger claims an id, but is never sent
+		claimed := newDebouncedTriggerOpt(name, "claimed")
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{claimed}))
+
+		time.Sleep(1500 * time.Millisecond)
+
+		tasks, _, _, err := conf.V1.Triggers().ProcessDebouncedRuns(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+
+		next := newDebouncedTriggerOpt(name, "next")
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{next}))
+		assert.NotEqual(t, claimed.ExternalId, next.ExternalId)
+
+		return nil
+	})
+}
@@ -0,0 +1,71 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+// createTestTenant creates a new tenant, so that tests don't share queues, rate limits or concurrency slots.
+func createTestTenant(t *testing.T, conf *database.Layer) string {
+	t.Helper()
+
+	tenantId := uuid.NewString()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	_, err = conf.APIRepository.Tenant().CreateTenant(context.Background(), &repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+	require.NoError(t, err)
+
+	return tenantId
+}
+
+// putTestWorkflow creates a workflow with the given tasks and a random name, and returns the name.
+func putTestWorkflow(t *testing.T, conf *database.Layer, tenantId string, tasks []v1.CreateStepOpts) string {
+	t.Helper()
+
+	name := fmt.Sprintf("test-workflow-%s", uuid.NewString()[:8])
+
+	_, err := conf.V1.Workflows().PutWorkflowVersion(context.Background(), tenantId, &v1.CreateWorkflowVersionOpts{
+		Name:  name,
+		Tasks: tasks,
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+// triggerTestWorkflow triggers a run of the workflow with the given name, and returns the external id of the run.
+func triggerTestWorkflow(t *testing.T, conf *database.Layer, tenantId, name string) string {
+	t.Helper()
+
+	externalId := uuid.NewString()
+
+	_, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(context.Background(), tenantId, []*v1.WorkflowNameTriggerOpts{
+		{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: name,
+				Data:         []byte("{}"),
+			},
+			ExternalId: externalId,
+		},
+	})
+	require.NoError(t, err)
+
+	return externalId
+}
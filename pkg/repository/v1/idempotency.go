@@ -0,0 +1,241 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// DefaultIdempotencyKeyRetention is how long an idempotency key is retained if the trigger doesn't set a
+// retention.
+const DefaultIdempotencyKeyRetention = 24 * time.Hour
+
+type IdempotencyKeyOpts struct {
+	// (required) the idempotency key
+	Key string `json:"key" validate:"required"`
+
+	// (required) the time after which the key can be used to trigger a new run
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewIdempotencyKeyOpts returns the options for an idempotency key which is retained for the given duration
+// string, or DefaultIdempotencyKeyRetention if retention is nil.
+func NewIdempotencyKeyOpts(key string, retention *string) (*IdempotencyKeyOpts, error) {
+	if key == "" {
+		return nil, fmt.Errorf("idempotency key cannot be empty")
+	}
+
+	retentionDuration := DefaultIdempotencyKeyRetention
+
+	if retention != nil {
+		d, err := time.ParseDuration(*retention)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid idempotency key retention %q: %w", *retention, err)
+		}
+
+		if d <= 0 {
+			return nil, fmt.Errorf("idempotency key retention must be positive")
+		}
+
+		retentionDuration = d
+	}
+
+	return &IdempotencyKeyOpts{
+		Key:       key,
+		ExpiresAt: time.Now().UTC().Add(retentionDuration),
+	}, nil
+}
+
+// workflow run keys are scoped to the workflow, and event keys are scoped to events, so the same key can be
+// used for different workflows.
+func workflowRunIdempotencyKey(workflowName, key string) string {
+	return fmt.Sprintf("run:%s:%s", workflowName, key)
+}
+
+func eventIdempotencyKey(key string) string {
+	return fmt.Sprintf("event:%s", key)
+}
+
+// eventRunIdempotencyKey is the key for a run triggered by an event, which is unique per workflow and filter
+// as a single event can trigger multiple runs.
+func eventRunIdempotencyKey(key, workflowId string, filterId *string) string {
+	filter := ""
+
+	if filterId != nil {
+		filter = *filterId
+	}
+
+	return fmt.Sprintf("event:%s:%s:%s", key, workflowId, filter)
+}
+
+// claimIdempotencyKeys claims the idempotency keys of the opts with their external ids. Opts whose key was
+// already claimed by another trigger adopt the original external id, and are skipped if the original run has
+// been triggered. Otherwise, the original trigger may have failed before creating the run, so the opt is
+// triggered again with the original external id and deduplicated by triggerIdempotencyKeys.
+func (s *sharedRepository) claimIdempotencyKeys(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error {
+	params := sqlcv1.ClaimIdempotencyKeysParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	keysToOpts := make(map[string][]*WorkflowNameTriggerOpts)
+
+	for i := range opts {
+		opt := opts[i] // we don't want a copy here, we want the actual pointer as we modify in-place
+
+		if opt.IdempotencyKey == nil || opt.ShouldSkip {
+			continue
+		}
+
+		key := workflowRunIdempotencyKey(opt.WorkflowName, opt.IdempotencyKey.Key)
+
+		// duplicate keys in the same batch adopt the external id of the first trigger
+		if _, ok := keysToOpts[key]; !ok {
+			params.Keys = append(params.Keys, key)
+			params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(opt.ExternalId))
+			params.Expiresats = append(params.Expiresats, sqlchelpers.TimestamptzFromTime(opt.IdempotencyKey.ExpiresAt))
+		}
+
+		keysToOpts[key] = append(keysToOpts[key], opt)
+	}
+
+	if len(params.Keys) == 0 {
+		return nil
+	}
+
+	claimed, err := s.queries.ClaimIdempotencyKeys(ctx, s.pool, params)
+
+	if err != nil {
+		return fmt.Errorf("failed to claim idempotency keys: %w", err)
+	}
+
+	for _, row := range claimed {
+		externalId := sqlchelpers.UUIDToStr(row.ExternalID)
+
+		for i, opt := range keysToOpts[row.Key] {
+			if i > 0 || opt.ExternalId != externalId {
+				opt.ExternalId = externalId
+				opt.ShouldSkip = i > 0 || row.TriggeredAt.Valid
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *TriggerRepositoryImpl) ClaimEventIdempotencyKey(ctx context.Context, tenantId, eventExternalId string, opts *IdempotencyKeyOpts) (string, bool, error) {
+	claimed, err := r.queries.ClaimIdempotencyKeys(ctx, r.pool, sqlcv1.ClaimIdempotencyKeysParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Keys:        []string{eventIdempotencyKey(opts.Key)},
+		Externalids: []pgtype.UUID{sqlchelpers.UUIDFromStr(eventExternalId)},
+		Expiresats:  []pgtype.Timestamptz{sqlchelpers.TimestamptzFromTime(opts.ExpiresAt)},
+	})
+
+	if err != nil {
+		return "", false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
+	if len(claimed) == 0 {
+		return "", false, fmt.Errorf("could not claim idempotency key %s", opts.Key)
+	}
+
+	return sqlchelpers.UUIDToStr(claimed[0].ExternalID), claimed[0].TriggeredAt.Valid, nil
+}
+
+// triggerEventIdempotencyKeys marks the idempotency keys of the events as triggered once their runs have been
+// triggered, after which new events with the same key are duplicates.
+func (r *TriggerRepositoryImpl) triggerEventIdempotencyKeys(ctx context.Context, tenantId string, opts []EventTriggerOpts) error {
+	params := sqlcv1.TriggerIdempotencyKeysParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	seenKeys := make(map[string]struct{})
+
+	for _, opt := range opts {
+		if opt.IdempotencyKey == nil {
+			continue
+		}
+
+		key := eventIdempotencyKey(opt.IdempotencyKey.Key)
+
+		if _, ok := seenKeys[key]; ok {
+			continue
+		}
+
+		seenKeys[key] = struct{}{}
+
+		params.Keys = append(params.Keys, key)
+		params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(opt.ExternalId))
+		params.Expiresats = append(params.Expiresats, sqlchelpers.TimestamptzFromTime(opt.IdempotencyKey.ExpiresAt))
+	}
+
+	if len(params.Keys) == 0 {
+		return nil
+	}
+
+	_, err := r.queries.TriggerIdempotencyKeys(ctx, r.pool, params)
+
+	return err
+}
+
+// triggerIdempotencyKeys marks the idempotency keys of the tuples as triggered, and returns the external ids of
+// tuples which are duplicates of a run which was already triggered, which should be skipped.
+func (r *TriggerRepositoryImpl) triggerIdempotencyKeys(ctx context.Context, tx sqlcv1.DBTX, tenantId string, tuples []triggerTuple) (map[string]struct{}, error) {
+	params := sqlcv1.TriggerIdempotencyKeysParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	tuplesToSkip := make(map[string]struct{})
+	seenKeys := make(map[string]struct{})
+
+	for _, tuple := range tuples {
+		if tuple.idempotencyKey == nil {
+			continue
+		}
+
+		// only the first tuple for each key in the batch can be triggered
+		if _, ok := seenKeys[tuple.idempotencyKey.Key]; ok {
+			tuplesToSkip[tuple.externalId] = struct{}{}
+			continue
+		}
+
+		seenKeys[tuple.idempotencyKey.Key] = struct{}{}
+
+		params.Keys = append(params.Keys, tuple.idempotencyKey.Key)
+		params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(tuple.externalId))
+		params.Expiresats = append(params.Expiresats, sqlchelpers.TimestamptzFromTime(tuple.idempotencyKey.ExpiresAt))
+	}
+
+	if len(params.Keys) == 0 {
+		return tuplesToSkip, nil
+	}
+
+	triggered, err := r.queries.TriggerIdempotencyKeys(ctx, tx, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	triggeredIds := make(map[string]struct{}, len(triggered))
+
+	for _, externalId := range triggered {
+		triggeredIds[sqlchelpers.UUIDToStr(externalId)] = struct{}{}
+	}
+
+	for _, tuple := range tuples {
+		if tuple.idempotencyKey == nil {
+			continue
+		}
+
+		if _, ok := triggeredIds[tuple.externalId]; !ok {
+			tuplesToSkip[tuple.externalId] = struct{}{}
+		}
+	}
+
+	return tuplesToSkip, nil
+}
@@ -0,0 +1,117 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func TestWorkflowRunIdempotencyKeyRetriesUntriggeredClaims(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		name := putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+			{
+				ReadableId: "step",
+				Action:     "test:step",
+			},
+		})
+
+		key, err := v1.NewIdempotencyKeyOpts(uuid.NewString(), nil)
+		require.NoError(t, err)
+
+		newOpt := func() *v1.WorkflowNameTriggerOpts {
+			return &v1.WorkflowNameTriggerOpts{
+				TriggerTaskData: &v1.TriggerTaskData{
+					WorkflowName:   name,
+					Data:           []byte("{}"),
+					IdempotencyKey: key,
+				},
+			}
+		}
+
+		// the first trigger claims the key, but fails before the run is created
+		first := newOpt()
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{first}))
+		assert.False(t, first.ShouldSkip)
+
+		// a retry is sent again with the original external id
+		retry := newOpt()
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{retry}))
+		assert.False(t, retry.ShouldSkip)
+		assert.Equal(t, first.ExternalId, retry.ExternalId)
+
+		tasks, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{retry})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, first.ExternalId, sqlchelpers.UUIDToStr(tasks[0].ExternalID))
+
+		// if the original trigger was only delayed, its run is deduplicated with the retry
+		tasks, _, err = conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{first})
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+
+		// once the run has been triggered, new triggers are skipped
+		duplicate := newOpt()
+		require.NoError(t, conf.V1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{duplicate}))
+		assert.True(t, duplicate.ShouldSkip)
+		assert.Equal(t, first.ExternalId, duplicate.ExternalId)
+
+		return nil
+	})
+}
+
+func TestEventIdempotencyKeyRetriesUntriggeredClaims(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		key := &v1.IdempotencyKeyOpts{
+			Key:       uuid.NewString(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		firstId := uuid.NewString()
+
+		claimedId, triggered, err := conf.V1.Triggers().ClaimEventIdempotencyKey(ctx, tenantId, firstId, key)
+		require.NoError(t, err)
+		assert.Equal(t, firstId, claimedId)
+		assert.False(t, triggered)
+
+		// the first event hasn't triggered its runs, so a retry should send it again with its id
+		claimedId, triggered, err = conf.V1.Triggers().ClaimEventIdempotencyKey(ctx, tenantId, uuid.NewString(), key)
+		require.NoError(t, err)
+		assert.Equal(t, firstId, claimedId)
+		assert.False(t, triggered)
+
+		_, err = conf.V1.Triggers().TriggerFromEvents(ctx, tenantId, []v1.EventTriggerOpts{
+			{
+				ExternalId:     firstId,
+				Key:            "test:event",
+				Data:           []byte("{}"),
+				IdempotencyKey: key,
+			},
+		})
+		require.NoError(t, err)
+
+		// once the event has triggered its runs, new events with the key are duplicates
+		claimedId, triggered, err = conf.V1.Triggers().ClaimEventIdempotencyKey(ctx, tenantId, uuid.NewString(), key)
+		require.NoError(t, err)
+		assert.Equal(t, firstId, claimedId)
+		assert.True(t, triggered)
+
+		return nil
+	})
+}
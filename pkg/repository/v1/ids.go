@@ -103,7 +103,12 @@ func (s *sharedRepository) PopulateExternalIdsForWorkflow(ctx context.Context, t
 		}
 	}
 
-	return nil
+	// debounced runs are claimed first, so that an idempotency key is claimed with the id of the pending run
+	if err := s.claimDebouncedRunIds(ctx, tenantId, opts); err != nil {
+		return err
+	}
+
+	return s.claimIdempotencyKeys(ctx, tenantId, opts)
 }
 
 func (s *sharedRepository) generateExternalIdsForChildWorkflows(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error {
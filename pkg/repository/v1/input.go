@@ -84,6 +84,7 @@ func (s *sharedRepository) ToV1StepRunData(t *TaskInput) *V1StepRunData {
 	parents := make(map[string]map[string]interface{})
 	triggers := make(map[string]map[string]interface{})
 	stepRunErrors := make(map[string]string)
+	stepRunErrorDetails := make(map[string]json.RawMessage)
 
 	if t.TriggerData != nil {
 		for _, stepReadableId := range t.TriggerData.DataKeys() {
@@ -102,6 +103,10 @@ func (s *sharedRepository) ToV1StepRunData(t *TaskInput) *V1StepRunData {
 				parents[stepReadableId] = dataMap
 			case data.IsFailed():
 				stepRunErrors[stepReadableId] = data.ErrorMessage
+
+				if len(data.ErrorDetails) > 0 {
+					stepRunErrorDetails[stepReadableId] = data.ErrorDetails
+				}
 			}
 		}
 
@@ -115,11 +120,12 @@ func (s *sharedRepository) ToV1StepRunData(t *TaskInput) *V1StepRunData {
 	triggers["filter_payload"] = t.FilterPayload
 
 	return &V1StepRunData{
-		Input:         t.Input,
-		TriggeredBy:   "manual",
-		Parents:       parents,
-		Triggers:      triggers,
-		StepRunErrors: stepRunErrors,
+		Input:               t.Input,
+		TriggeredBy:         "manual",
+		Parents:             parents,
+		Triggers:            triggers,
+		StepRunErrors:       stepRunErrors,
+		StepRunErrorDetails: stepRunErrorDetails,
 	}
 }
 
@@ -138,6 +144,9 @@ type V1StepRunData struct {
 
 	// errors in upstream steps (only used in on-failure step)
 	StepRunErrors map[string]string `json:"step_run_errors,omitempty"`
+
+	// structured errors in upstream steps (only used in on-failure step)
+	StepRunErrorDetails map[string]json.RawMessage `json:"step_run_error_details,omitempty"`
 }
 
 func (v1 *V1StepRunData) Bytes() []byte {
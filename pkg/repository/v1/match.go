@@ -49,10 +49,11 @@ type CreateExternalSignalConditionKind string
 const (
 	CreateExternalSignalConditionKindSLEEP     CreateExternalSignalConditionKind = "SLEEP"
 	CreateExternalSignalConditionKindUSEREVENT CreateExternalSignalConditionKind = "USER_EVENT"
+	CreateExternalSignalConditionKindSIGNAL    CreateExternalSignalConditionKind = "SIGNAL"
 )
 
 type CreateExternalSignalConditionOpt struct {
-	Kind CreateExternalSignalConditionKind `validate:"required, oneof=SLEEP USER_EVENT"`
+	Kind CreateExternalSignalConditionKind `validate:"required, oneof=SLEEP USER_EVENT SIGNAL"`
 
 	ReadableDataKey string `validate:"required"`
 
@@ -62,6 +63,9 @@ type CreateExternalSignalConditionOpt struct {
 
 	SleepFor *string `validate:"omitempty,duration"`
 
+	// (optional) the name of the signal sent to the task, for SIGNAL conditions
+	SignalName *string
+
 	Expression string
 }
 
@@ -206,6 +210,17 @@ func (m *MatchRepositoryImpl) RegisterSignalMatchConditions(ctx context.Context,
 					condition.Expression,
 					sqlcv1.V1MatchConditionActionCREATE,
 				))
+			case CreateExternalSignalConditionKindSIGNAL:
+				if condition.SignalName == nil {
+					return fmt.Errorf("signal condition requires a signal name")
+				}
+
+				conditions = append(conditions, m.signalCondition(
+					condition.OrGroupId,
+					condition.ReadableDataKey,
+					*condition.SignalName,
+					signalMatch.SignalExternalId,
+				))
 			}
 		}
 
@@ -491,6 +506,10 @@ func (m *sharedRepository) processEventMatches(ctx context.Context, tx sqlcv1.DB
 						opt.InitialState = sqlcv1.V1TaskInitialStateCANCELLED
 					case sqlcv1.V1MatchConditionActionSKIP:
 						opt.InitialState = sqlcv1.V1TaskInitialStateSKIPPED
+					case sqlcv1.V1MatchConditionActionFAIL:
+						reason := matchData.FailureReason()
+						opt.InitialState = sqlcv1.V1TaskInitialStateFAILED
+						opt.InitialStateReason = &reason
 					}
 
 					replayTaskOpts = append(replayTaskOpts, opt)
@@ -513,6 +532,10 @@ func (m *sharedRepository) processEventMatches(ctx context.Context, tx sqlcv1.DB
 						opt.InitialState = sqlcv1.V1TaskInitialStateCANCELLED
 					case sqlcv1.V1MatchConditionActionSKIP:
 						opt.InitialState = sqlcv1.V1TaskInitialStateSKIPPED
+					case sqlcv1.V1MatchConditionActionFAIL:
+						reason := matchData.FailureReason()
+						opt.InitialState = sqlcv1.V1TaskInitialStateFAILED
+						opt.InitialStateReason = &reason
 					}
 
 					if match.TriggerDagID.Valid && match.TriggerDagInsertedAt.Valid {
@@ -1099,6 +1122,21 @@ func (m *sharedRepository) createAdditionalMatches(ctx context.Context, tx sqlcv
 						condition.Expression.String,
 						condition.Action,
 					))
+				case sqlcv1.V1StepMatchConditionKindAPPROVAL:
+					cs, err := m.approvalConditions(
+						ctx,
+						tx,
+						tenantId,
+						condition,
+						triggerExternalId,
+						triggerWorkflowRunId,
+					)
+
+					if err != nil {
+						return err
+					}
+
+					opt.Conditions = append(opt.Conditions, cs...)
 				default:
 					// PARENT_OVERRIDE is another kind, but it isn't processed here
 					continue
@@ -1160,6 +1198,107 @@ func (m *sharedRepository) userEventCondition(orGroupId, readableDataKey, eventK
 	}
 }
 
+// signalCondition returns a condition which is satisfied by a signal with the given name which is sent to the task.
+// Signals are delivered by the signal repository, which assigns each signal to a single waiting condition.
+func (m *sharedRepository) signalCondition(orGroupId, readableDataKey, name, taskExternalId string) GroupMatchCondition {
+	return GroupMatchCondition{
+		GroupId:           orGroupId,
+		EventType:         sqlcv1.V1EventTypeINTERNAL,
+		EventKey:          getSignalEventKey(name),
+		EventResourceHint: &taskExternalId,
+		ReadableDataKey:   readableDataKey,
+		Expression:        "true",
+		Action:            sqlcv1.V1MatchConditionActionCREATE,
+	}
+}
+
+// approvalConditions creates a pending approval for the task and returns the conditions which are satisfied
+// by its decision: an approval performs the condition's action, while a rejection fails the task. If the
+// approval expires, the task is skipped or failed depending on the condition's expiry action. A rejection and
+// a failing expiry share a single FAIL or-group, so that either one fails the task on its own.
+func (m *sharedRepository) approvalConditions(ctx context.Context, tx sqlcv1.DBTX, tenantId string, condition *sqlcv1.V1StepMatchCondition, taskExternalId, workflowRunId string) ([]GroupMatchCondition, error) {
+	if condition.Expression.Valid && condition.Expression.String != "" {
+		return nil, fmt.Errorf("approval conditions do not support expressions")
+	}
+
+	var expiresIn pgtype.Text
+
+	if condition.ApprovalExpiresIn.Valid && condition.ApprovalExpiresIn.String != "" {
+		expiresIn = condition.ApprovalExpiresIn
+	}
+
+	approval, err := m.queries.CreateApproval(ctx, tx, sqlcv1.CreateApprovalParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskexternalid:  sqlchelpers.UUIDFromStr(taskExternalId),
+		Workflowrunid:   sqlchelpers.UUIDFromStr(workflowRunId),
+		Stepid:          condition.StepID,
+		Readabledatakey: condition.ReadableDataKey,
+		ExpiresIn:       expiresIn,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create approval: %w", err)
+	}
+
+	eventKey := getApprovalEventKey(approval.ID)
+
+	// or-groups are ANDed when a match is resolved, so the conditions which fail the task share a group
+	failGroupId := uuid.NewString()
+
+	res := []GroupMatchCondition{
+		{
+			GroupId:           sqlchelpers.UUIDToStr(condition.OrGroupID),
+			EventType:         sqlcv1.V1EventTypeINTERNAL,
+			EventKey:          eventKey,
+			EventResourceHint: &taskExternalId,
+			ReadableDataKey:   condition.ReadableDataKey,
+			Expression:        "input.approved == true",
+			Action:            condition.Action,
+		},
+		{
+			GroupId:           failGroupId,
+			EventType:         sqlcv1.V1EventTypeINTERNAL,
+			EventKey:          eventKey,
+			EventResourceHint: &taskExternalId,
+			ReadableDataKey:   condition.ReadableDataKey,
+			Expression:        "input.approved == false",
+			Action:            sqlcv1.V1MatchConditionActionFAIL,
+		},
+	}
+
+	if expiresIn.Valid {
+		expiryAction := sqlcv1.V1MatchConditionActionFAIL
+		expiryGroupId := failGroupId
+
+		if condition.ApprovalExpiryAction.Valid && condition.ApprovalExpiryAction.V1MatchConditionAction != sqlcv1.V1MatchConditionActionFAIL {
+			expiryAction = condition.ApprovalExpiryAction.V1MatchConditionAction
+			expiryGroupId = uuid.NewString()
+		}
+
+		c, err := m.durableSleepCondition(
+			ctx,
+			tx,
+			tenantId,
+			expiryGroupId,
+			condition.ReadableDataKey,
+			expiresIn.String,
+			expiryAction,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create approval expiry condition: %w", err)
+		}
+
+		res = append(res, *c)
+	}
+
+	return res, nil
+}
+
 func getDurableSleepEventKey(sleepId int64) string {
 	return fmt.Sprintf("sleep-%d", sleepId)
 }
+
+func getApprovalEventKey(approvalId int64) string {
+	return fmt.Sprintf("approval-%d", approvalId)
+}
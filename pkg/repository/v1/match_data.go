@@ -74,6 +74,37 @@ func (m *MatchData) TriggerDataValue(key string) map[string]interface{} {
 	return nil
 }
 
+// FailureReason returns the reason a task is failed by a match with a FAIL action. Conditions can set
+// the reason through a `reason` field in their data, otherwise expired conditions (which are satisfied
+// by a durable sleep) report the expiry.
+func (m *MatchData) FailureReason() string {
+	dataKeys := m.triggerDataKeys
+
+	if len(dataKeys) == 0 {
+		dataKeys = m.dataKeys
+	}
+
+	for key, values := range dataKeys {
+		for _, v := range values {
+			data, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if reason, ok := data["reason"].(string); ok && reason != "" {
+				return reason
+			}
+
+			if duration, ok := data["sleep_duration"].(string); ok {
+				return fmt.Sprintf("%s expired after %s", key, duration)
+			}
+		}
+	}
+
+	return "task failed by a match condition"
+}
+
 // Helper function for internal events
 func (m *MatchData) DataValueAsTaskOutputEvent(key string) *TaskOutputEvent {
 	values := m.dataKeys[key]
@@ -136,6 +167,8 @@ func NewMatchData(mcAggregatedData []byte) (*MatchData, error) {
 			action = sqlcv1.V1MatchConditionActionCANCEL
 		case "SKIP":
 			action = sqlcv1.V1MatchConditionActionSKIP
+		case "FAIL":
+			action = sqlcv1.V1MatchConditionActionFAIL
 		}
 
 		triggerDataKeys := map[string][]interface{}{}
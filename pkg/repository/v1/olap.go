@@ -91,6 +91,7 @@ type WorkflowRunData struct {
 	AdditionalMetadata   []byte                      `json:"additional_metadata"`
 	CreatedAt            pgtype.Timestamptz          `json:"created_at"`
 	DisplayName          string                      `json:"display_name"`
+	ErrorDetails         []byte                      `json:"error_details,omitempty"`
 	ErrorMessage         string                      `json:"error_message"`
 	ExternalID           pgtype.UUID                 `json:"external_id"`
 	FinishedAt           pgtype.Timestamptz          `json:"finished_at"`
@@ -150,6 +151,7 @@ const (
 	EVENT_TYPE_ACKNOWLEDGED         EventType = "ACKNOWLEDGED"
 	EVENT_TYPE_CREATED              EventType = "CREATED"
 	EVENT_TYPE_QUEUED               EventType = "QUEUED"
+	EVENT_TYPE_PREEMPTED            EventType = "PREEMPTED"
 )
 
 type ReadableTaskStatus string
@@ -179,6 +181,23 @@ func (s ReadableTaskStatus) EnumValue() int {
 	}
 }
 
+// RateLimitUsage is the usage of a rate limit key at a point in time
+type RateLimitUsage struct {
+	Key       string
+	Timestamp time.Time
+
+	// UnitsConsumed is the number of units consumed from the rate limit by assigned tasks
+	UnitsConsumed int64
+
+	// RateLimitedTasks are the tasks which could not be assigned because the rate limit was exhausted
+	RateLimitedTasks []RateLimitedTask
+}
+
+type RateLimitedTask struct {
+	TaskId         int64
+	TaskInsertedAt time.Time
+}
+
 type UpdateTaskStatusRow struct {
 	TenantId       pgtype.UUID
 	TaskId         int64
@@ -234,6 +253,13 @@ type OLAPRepository interface {
 
 	GetDagDurationsByDagIds(ctx context.Context, tenantId string, dagIds []int64, dagInsertedAts []pgtype.Timestamptz, readableStatuses []sqlcv1.V1ReadableStatusOlap) (map[string]*sqlcv1.GetDagDurationsByDagIdsRow, error)
 	GetTaskDurationsByTaskIds(ctx context.Context, tenantId string, taskIds []int64, taskInsertedAts []pgtype.Timestamptz, readableStatuses []sqlcv1.V1ReadableStatusOlap) (map[int64]*sqlcv1.GetTaskDurationsByTaskIdsRow, error)
+
+	// RecordRateLimitUsage adds the usage to the minute buckets of each rate limit key
+	RecordRateLimitUsage(ctx context.Context, tenantId string, usage []RateLimitUsage) error
+
+	// GetRateLimitUsage returns the usage of a rate limit key between since and until, grouped into buckets of
+	// bucketInterval
+	GetRateLimitUsage(ctx context.Context, tenantId, key string, since, until time.Time, bucketInterval time.Duration) ([]*sqlcv1.GetRateLimitUsageOLAPRow, error)
 }
 
 type OLAPRepositoryImpl struct {
@@ -465,6 +491,7 @@ func (r *OLAPRepositoryImpl) ReadWorkflowRun(ctx context.Context, workflowRunExt
 			StartedAt:            row.StartedAt,
 			FinishedAt:           row.FinishedAt,
 			ErrorMessage:         row.ErrorMessage.String,
+			ErrorDetails:         row.ErrorDetails,
 			WorkflowVersionId:    row.WorkflowVersionID,
 			Input:                row.Input,
 			ParentTaskExternalId: &row.ParentTaskExternalID,
@@ -897,6 +924,7 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId stri
 				StartedAt:            dag.StartedAt,
 				FinishedAt:           dag.FinishedAt,
 				ErrorMessage:         dag.ErrorMessage.String,
+				ErrorDetails:         dag.ErrorDetails,
 				Kind:                 sqlcv1.V1RunKindDAG,
 				WorkflowVersionId:    dag.WorkflowVersionID,
 				TaskExternalId:       nil,
@@ -930,6 +958,7 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId stri
 				StartedAt:          task.StartedAt,
 				FinishedAt:         task.FinishedAt,
 				ErrorMessage:       task.ErrorMessage.String,
+				ErrorDetails:       task.ErrorDetails,
 				Kind:               sqlcv1.V1RunKindTASK,
 				TaskExternalId:     &task.ExternalID,
 				TaskId:             &task.ID,
@@ -1730,3 +1759,147 @@ func (r *OLAPRepositoryImpl) GetTaskDurationsByTaskIds(ctx context.Context, tena
 
 	return taskDurations, nil
 }
+
+func (r *OLAPRepositoryImpl) RecordRateLimitUsage(ctx context.Context, tenantId string, usage []RateLimitUsage) error {
+	type bucketKey struct {
+		key    string
+		bucket time.Time
+	}
+
+	type taskKey struct {
+		key            string
+		taskId         int64
+		taskInsertedAt time.Time
+	}
+
+	type bucketUsage struct {
+		unitsConsumed       int64
+		tasksRateLimited    int64
+		rateLimitedAttempts int64
+	}
+
+	// aggregate the usage by key and minute, since a row can only be upserted once per statement
+	buckets := make(map[bucketKey]*bucketUsage)
+	order := make([]bucketKey, 0, len(usage))
+
+	getBucket := func(k bucketKey) *bucketUsage {
+		if _, ok := buckets[k]; !ok {
+			buckets[k] = &bucketUsage{}
+			order = append(order, k)
+		}
+
+		return buckets[k]
+	}
+
+	rateLimitedParams := sqlcv1.InsertRateLimitedTasksOLAPParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	seenTasks := make(map[taskKey]struct{})
+
+	for _, u := range usage {
+		k := bucketKey{
+			key:    u.Key,
+			bucket: u.Timestamp.UTC().Truncate(time.Minute),
+		}
+
+		b := getBucket(k)
+		b.unitsConsumed += u.UnitsConsumed
+		b.rateLimitedAttempts += int64(len(u.RateLimitedTasks))
+
+		for _, task := range u.RateLimitedTasks {
+			tk := taskKey{
+				key:            u.Key,
+				taskId:         task.TaskId,
+				taskInsertedAt: task.TaskInsertedAt,
+			}
+
+			if _, ok := seenTasks[tk]; ok {
+				continue
+			}
+
+			seenTasks[tk] = struct{}{}
+
+			rateLimitedParams.Keys = append(rateLimitedParams.Keys, u.Key)
+			rateLimitedParams.Taskids = append(rateLimitedParams.Taskids, task.TaskId)
+			rateLimitedParams.Taskinsertedats = append(rateLimitedParams.Taskinsertedats, sqlchelpers.TimestamptzFromTime(task.TaskInsertedAt))
+			rateLimitedParams.Ratelimitedats = append(rateLimitedParams.Ratelimitedats, sqlchelpers.TimestamptzFromTime(k.bucket))
+		}
+	}
+
+	if len(order) == 0 {
+		return nil
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	if len(rateLimitedParams.Keys) > 0 {
+		// a task is rate limited on every scheduling attempt, so only the tasks which haven't been counted yet are
+		// added to the buckets
+		newlyRateLimited, err := r.queries.InsertRateLimitedTasksOLAP(ctx, tx, rateLimitedParams)
+
+		if err != nil {
+			return fmt.Errorf("could not record rate limited tasks: %w", err)
+		}
+
+		for _, row := range newlyRateLimited {
+			getBucket(bucketKey{
+				key:    row.Key,
+				bucket: row.RateLimitedAt.Time.UTC(),
+			}).tasksRateLimited++
+		}
+	}
+
+	params := sqlcv1.UpsertRateLimitUsageOLAPParams{
+		Tenantid:            sqlchelpers.UUIDFromStr(tenantId),
+		Keys:                make([]string, 0, len(order)),
+		Buckets:             make([]pgtype.Timestamptz, 0, len(order)),
+		Unitsconsumed:       make([]int64, 0, len(order)),
+		Tasksratelimited:    make([]int64, 0, len(order)),
+		Ratelimitedattempts: make([]int64, 0, len(order)),
+	}
+
+	for _, k := range order {
+		b := buckets[k]
+
+		params.Keys = append(params.Keys, k.key)
+		params.Buckets = append(params.Buckets, sqlchelpers.TimestamptzFromTime(k.bucket))
+		params.Unitsconsumed = append(params.Unitsconsumed, b.unitsConsumed)
+		params.Tasksratelimited = append(params.Tasksratelimited, b.tasksRateLimited)
+		params.Ratelimitedattempts = append(params.Ratelimitedattempts, b.rateLimitedAttempts)
+	}
+
+	err = r.queries.UpsertRateLimitUsageOLAP(ctx, tx, params)
+
+	if err != nil {
+		return err
+	}
+
+	return commit(ctx)
+}
+
+func (r *OLAPRepositoryImpl) GetRateLimitUsage(ctx context.Context, tenantId, key string, since, until time.Time, bucketInterval time.Duration) ([]*sqlcv1.GetRateLimitUsageOLAPRow, error) {
+	rows, err := r.queries.GetRateLimitUsageOLAP(ctx, r.readPool, sqlcv1.GetRateLimitUsageOLAPParams{
+		Interval: durationToPgInterval(bucketInterval),
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Key:      key,
+		Since:    sqlchelpers.TimestamptzFromTime(since),
+		Until:    sqlchelpers.TimestamptzFromTime(until),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []*sqlcv1.GetRateLimitUsageOLAPRow{}, nil
+		}
+
+		return nil, err
+	}
+
+	return rows, nil
+}
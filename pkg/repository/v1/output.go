@@ -24,6 +24,8 @@ type TaskOutputEvent struct {
 
 	ErrorMessage string `json:"error_message"`
 
+	ErrorDetails []byte `json:"error_details,omitempty"`
+
 	StepReadableID string `json:"step_readable_id"`
 }
 
@@ -100,10 +102,11 @@ func NewCompletedTaskOutputEvent(row *sqlcv1.ReleaseTasksRow, output []byte) *Ta
 	return e
 }
 
-func NewFailedTaskOutputEvent(row *sqlcv1.ReleaseTasksRow, errorMsg string) *TaskOutputEvent {
+func NewFailedTaskOutputEvent(row *sqlcv1.ReleaseTasksRow, errorMsg string, errorDetails []byte) *TaskOutputEvent {
 	e := baseFromReleaseTasksRow(row)
 	e.IsFailure = true
 	e.ErrorMessage = errorMsg
+	e.ErrorDetails = errorDetails
 	e.EventType = sqlcv1.V1TaskEventTypeFAILED
 	return e
 }
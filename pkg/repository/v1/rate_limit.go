@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+var ErrRateLimitNotFound = errors.New("rate limit not found")
+
+// ErrRateLimitInUse is returned when deleting a rate limit which is referenced by a workflow step or by tasks
+// waiting to be scheduled
+var ErrRateLimitInUse = errors.New("rate limit is used by a workflow step or a queued task")
+
+// RateLimitManagementRepository manages the rate limits of a tenant. Rate limits are consumed by the scheduler
+// through the RateLimitRepository.
+type RateLimitManagementRepository interface {
+	// DeleteRateLimit deletes a single rate limit, unless it's referenced by a workflow step or by tasks waiting
+	// to be scheduled
+	DeleteRateLimit(ctx context.Context, tenantId, key string) error
+
+	// DeleteRateLimitsByPrefix deletes all rate limits whose key starts with the prefix and returns the deleted
+	// keys. Rate limits which are referenced by a workflow step or by tasks waiting to be scheduled are skipped.
+	DeleteRateLimitsByPrefix(ctx context.Context, tenantId, prefix string) ([]string, error)
+
+	// ResetRateLimit refills a rate limit to its limit
+	ResetRateLimit(ctx context.Context, tenantId, key string) (*sqlcv1.RateLimit, error)
+
+	// DeleteUnusedDynamicRateLimits deletes the rate limits created from dynamic key expressions which haven't
+	// been used for the duration and returns the deleted keys
+	DeleteUnusedDynamicRateLimits(ctx context.Context, tenantId string, unusedFor time.Duration) ([]string, error)
+}
+
+type rateLimitManagementRepository struct {
+	*sharedRepository
+}
+
+func newRateLimitManagementRepository(shared *sharedRepository) RateLimitManagementRepository {
+	return &rateLimitManagementRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *rateLimitManagementRepository) DeleteRateLimit(ctx context.Context, tenantId, key string) error {
+	deleted, err := r.queries.DeleteRateLimits(ctx, r.pool, sqlcv1.DeleteRateLimitsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Keys:     []string{key},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(deleted) > 0 {
+		return nil
+	}
+
+	// nothing was deleted, so the rate limit either doesn't exist or is in use
+	_, err = r.queries.GetRateLimit(ctx, r.pool, sqlcv1.GetRateLimitParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Key:      key,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrRateLimitNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return ErrRateLimitInUse
+}
+
+func (r *rateLimitManagementRepository) DeleteRateLimitsByPrefix(ctx context.Context, tenantId, prefix string) ([]string, error) {
+	if prefix == "" {
+		return nil, errors.New("prefix is required")
+	}
+
+	return r.queries.DeleteRateLimits(ctx, r.pool, sqlcv1.DeleteRateLimitsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Keys:     []string{},
+		Prefix:   sqlchelpers.TextFromStr(prefix),
+	})
+}
+
+func (r *rateLimitManagementRepository) ResetRateLimit(ctx context.Context, tenantId, key string) (*sqlcv1.RateLimit, error) {
+	rl, err := r.queries.ResetRateLimit(ctx, r.pool, sqlcv1.ResetRateLimitParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Key:      key,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRateLimitNotFound
+	}
+
+	return rl, err
+}
+
+func (r *rateLimitManagementRepository) DeleteUnusedDynamicRateLimits(ctx context.Context, tenantId string, unusedFor time.Duration) ([]string, error) {
+	if unusedFor <= 0 {
+		return nil, errors.New("unused for must be positive")
+	}
+
+	return r.queries.DeleteUnusedDynamicRateLimits(ctx, r.pool, sqlcv1.DeleteUnusedDynamicRateLimitsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Usedbefore: pgtype.Timestamp{
+			Time:  time.Now().UTC().Add(-unusedFor),
+			Valid: true,
+		},
+	})
+}
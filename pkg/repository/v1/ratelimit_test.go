@@ -0,0 +1,510 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// setRateLimitLastRefill moves the last refill of a rate limit back in time, to simulate time passing.
+func setRateLimitLastRefill(t *testing.T, conf *database.Layer, tenantId, key, ago string) {
+	t.Helper()
+
+	_, err := conf.Pool.Exec(
+		context.Background(),
+		`UPDATE "RateLimit" SET "lastRefill" = NOW() - $3::interval WHERE "tenantId" = $1::uuid AND "key" = $2`,
+		tenantId, key, ago,
+	)
+	require.NoError(t, err)
+}
+
+func getAvailableRateLimitValue(t *testing.T, conf *database.Layer, tenantId, key string) int32 {
+	t.Helper()
+
+	rows, err := sqlcv1.New().ListRateLimitsForTenantNoMutate(context.Background(), conf.Pool, sqlcv1.ListRateLimitsForTenantNoMutateParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Search:   sqlchelpers.TextFromStr(key),
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	return rows[0].Value
+}
+
+func TestTokenBucketStartsRefillingWhenFullBucketIsUsed(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "token-bucket"
+		refillRate := 1.0
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:      10,
+			Duration:   repository.StringPtr("MINUTE"),
+			Algorithm:  repository.StringPtr("TOKEN_BUCKET"),
+			RefillRate: &refillRate,
+		})
+		require.NoError(t, err)
+
+		// the bucket has been full and idle for an hour
+		setRateLimitLastRefill(t, conf, tenantId, key, "1 hour")
+		assert.Equal(t, int32(10), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		updated, err := sqlcv1.New().BulkUpdateRateLimits(ctx, conf.Pool, sqlcv1.BulkUpdateRateLimitsParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Keys:     []string{key},
+			Units:    []int32{10},
+		})
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+		assert.Equal(t, int32(0), updated[0].Value)
+
+		// the idle time before the bucket was used doesn't count towards the refill
+		var refilledRecently bool
+
+		err = conf.Pool.QueryRow(
+			ctx,
+			`SELECT "lastRefill" >= NOW() - INTERVAL '5 seconds' FROM "RateLimit" WHERE "tenantId" = $1::uuid AND "key" = $2`,
+			tenantId, key,
+		).Scan(&refilledRecently)
+		require.NoError(t, err)
+		assert.True(t, refilledRecently)
+		assert.LessOrEqual(t, getAvailableRateLimitValue(t, conf, tenantId, key), int32(5))
+
+		// tokens are refilled at the refill rate once the bucket is used
+		setRateLimitLastRefill(t, conf, tenantId, key, "3 seconds")
+		assert.Equal(t, int32(3), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		setRateLimitLastRefill(t, conf, tenantId, key, "1 hour")
+		assert.Equal(t, int32(10), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		return nil
+	})
+}
+
+func TestSlidingWindowWeightsPreviousUsage(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "sliding-window"
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:     10,
+			Duration:  repository.StringPtr("MINUTE"),
+			Algorithm: repository.StringPtr("SLIDING_WINDOW"),
+		})
+		require.NoError(t, err)
+
+		_, err = sqlcv1.New().BulkUpdateRateLimits(ctx, conf.Pool, sqlcv1.BulkUpdateRateLimitsParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Keys:     []string{key},
+			Units:    []int32{6},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		// once the window has elapsed, its usage is carried over to the next window
+		setRateLimitLastRefill(t, conf, tenantId, key, "61 seconds")
+
+		refilled, err := sqlcv1.New().ListRateLimitsForTenantWithMutate(ctx, conf.Pool, sqlchelpers.UUIDFromStr(tenantId))
+		require.NoError(t, err)
+		require.Len(t, refilled, 1)
+		assert.Equal(t, int32(10), refilled[0].Value)
+		assert.Equal(t, int32(6), refilled[0].PreviousUsage)
+		assert.Equal(t, int32(4), refilled[0].AvailableValue)
+
+		// halfway through the window, half of the previous usage counts against the limit
+		setRateLimitLastRefill(t, conf, tenantId, key, "30 seconds")
+		assert.Equal(t, int32(7), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		// after two windows without usage, the full limit is available
+		setRateLimitLastRefill(t, conf, tenantId, key, "2 minutes")
+		assert.Equal(t, int32(10), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		return nil
+	})
+}
+
+func TestRateLimitUsageCountsRateLimitedTasksOnce(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "usage"
+		now := time.Now().UTC()
+		task := v1.RateLimitedTask{
+			TaskId:         1,
+			TaskInsertedAt: now,
+		}
+
+		// the same task is rate limited on every scheduling attempt, in two different minutes
+		err := conf.V1.OLAP().RecordRateLimitUsage(ctx, tenantId, []v1.RateLimitUsage{
+			{Key: key, Timestamp: now.Add(-time.Minute), RateLimitedTasks: []v1.RateLimitedTask{task, task}},
+			{Key: key, Timestamp: now, UnitsConsumed: 3, RateLimitedTasks: []v1.RateLimitedTask{task}},
+		})
+		require.NoError(t, err)
+
+		err = conf.V1.OLAP().RecordRateLimitUsage(ctx, tenantId, []v1.RateLimitUsage{
+			{Key: key, Timestamp: now, RateLimitedTasks: []v1.RateLimitedTask{task, {TaskId: 2, TaskInsertedAt: now}}},
+		})
+		require.NoError(t, err)
+
+		rows, err := conf.V1.OLAP().GetRateLimitUsage(ctx, tenantId, key, now.Add(-time.Hour), now.Add(time.Minute), time.Hour)
+		require.NoError(t, err)
+
+		var unitsConsumed, tasksRateLimited, attempts int64
+
+		for _, row := range rows {
+			unitsConsumed += row.UnitsConsumed
+			tasksRateLimited += row.TasksRateLimited
+			attempts += row.RateLimitedAttempts
+		}
+
+		assert.Equal(t, int64(3), unitsConsumed)
+		assert.Equal(t, int64(2), tasksRateLimited)
+		assert.Equal(t, int64(5), attempts)
+
+		return nil
+	})
+}
+
+func TestSemaphoreUnitsAreReturnedWhenTasksAreReleased(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "semaphore"
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:     3,
+			Algorithm: repository.StringPtr("SEMAPHORE"),
+		})
+		require.NoError(t, err)
+
+		// two tasks acquire all units of the semaphore
+		_, err = sqlcv1.New().BulkUpdateRateLimits(ctx, conf.Pool, sqlcv1.BulkUpdateRateLimitsParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Keys:     []string{key},
+			Units:    []int32{3},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		insertedAt := time.Now().UTC()
+
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO v1_task_runtime_semaphore (task_id, task_inserted_at, retry_count, tenant_id, key, units)
+			VALUES (1, $1, 0, $2::uuid, $3, 1), (2, $1, 0, $2::uuid, $3, 2)`,
+			insertedAt, tenantId, key,
+		)
+		require.NoError(t, err)
+
+		// semaphores aren't refilled over time, only when the tasks holding them are released
+		setRateLimitLastRefill(t, conf, tenantId, key, "1 hour")
+		assert.Equal(t, int32(0), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		_, err = sqlcv1.New().ReleaseTasks(ctx, conf.Pool, sqlcv1.ReleaseTasksParams{
+			Taskids:         []int64{2},
+			Taskinsertedats: []pgtype.Timestamptz{sqlchelpers.TimestamptzFromTime(insertedAt)},
+			Retrycounts:     []int32{0},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		// releasing the same task again doesn't return its units twice
+		_, err = sqlcv1.New().ReleaseTasks(ctx, conf.Pool, sqlcv1.ReleaseTasksParams{
+			Taskids:         []int64{2},
+			Taskinsertedats: []pgtype.Timestamptz{sqlchelpers.TimestamptzFromTime(insertedAt)},
+			Retrycounts:     []int32{0},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		return nil
+	})
+}
+
+func TestSwitchingToSemaphoreOnlyKeepsHeldUnits(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "semaphore"
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:    5,
+			Duration: repository.StringPtr("MINUTE"),
+		})
+		require.NoError(t, err)
+
+		_, err = sqlcv1.New().BulkUpdateRateLimits(ctx, conf.Pool, sqlcv1.BulkUpdateRateLimitsParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Keys:     []string{key},
+			Units:    []int32{3},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		// a task still holds a unit from when the key was last a semaphore
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO v1_task_runtime_semaphore (task_id, task_inserted_at, retry_count, tenant_id, key, units)
+			VALUES (1, NOW(), 0, $1::uuid, $2, 1)`,
+			tenantId, key,
+		)
+		require.NoError(t, err)
+
+		_, err = conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:     5,
+			Algorithm: repository.StringPtr("SEMAPHORE"),
+		})
+		require.NoError(t, err)
+
+		// the units used in the fixed window aren't held by any task, so only the held unit is taken
+		assert.Equal(t, int32(4), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		return nil
+	})
+}
+
+func TestDynamicRateLimitsDontOverwriteSemaphores(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "semaphore"
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:     3,
+			Algorithm: repository.StringPtr("SEMAPHORE"),
+		})
+		require.NoError(t, err)
+
+		err = sqlcv1.New().UpsertRateLimitsBulk(ctx, conf.Pool, sqlcv1.UpsertRateLimitsBulkParams{
+			Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+			Keys:        []string{key},
+			Limitvalues: []int32{1},
+			Windows:     []string{"1 minute"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(3), getAvailableRateLimitValue(t, conf, tenantId, key))
+
+		return nil
+	})
+}
+
+// setRateLimitLastUsedAt moves the last use of a rate limit back in time, so that it's picked up as unused.
+func setRateLimitLastUsedAt(t *testing.T, conf *database.Layer, tenantId, key, ago string) {
+	t.Helper()
+
+	_, err := conf.Pool.Exec(
+		context.Background(),
+		`UPDATE "RateLimit" SET "lastUsedAt" = NOW() - $3::interval WHERE "tenantId" = $1::uuid AND "key" = $2`,
+		tenantId, key, ago,
+	)
+	require.NoError(t, err)
+}
+
+// putStaticRateLimitWorkflow creates a workflow with a step which consumes the given rate limit key.
+func putStaticRateLimitWorkflow(t *testing.T, conf *database.Layer, tenantId, key string) {
+	t.Helper()
+
+	_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(context.Background(), tenantId, key, &repository.UpsertRateLimitOpts{
+		Limit:    10,
+		Duration: repository.StringPtr("MINUTE"),
+	})
+	require.NoError(t, err)
+
+	units := 1
+
+	putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+		{
+			ReadableId: "step",
+			Action:     "test:step",
+			RateLimits: []v1.CreateWorkflowStepRateLimitOpts{
+				{
+					Key:   key,
+					Units: &units,
+				},
+			},
+		},
+	})
+}
+
+// queueDynamicRateLimitTask triggers a task which waits for a dynamic rate limit with the given key, and creates
+// the rate limit the same way the scheduler does.
+func queueDynamicRateLimitTask(t *testing.T, conf *database.Layer, tenantId, key string) {
+	t.Helper()
+
+	units := 1
+	keyExpr := fmt.Sprintf("'%s'", key)
+
+	name := putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+		{
+			ReadableId: "step",
+			Action:     "test:step",
+			RateLimits: []v1.CreateWorkflowStepRateLimitOpts{
+				{
+					Key:     "dynamic",
+					KeyExpr: &keyExpr,
+					Units:   &units,
+				},
+			},
+		},
+	})
+
+	triggerTestWorkflow(t, conf, tenantId, name)
+
+	createDynamicRateLimit(t, conf, tenantId, key)
+}
+
+func createDynamicRateLimit(t *testing.T, conf *database.Layer, tenantId, key string) {
+	t.Helper()
+
+	err := sqlcv1.New().UpsertRateLimitsBulk(context.Background(), conf.Pool, sqlcv1.UpsertRateLimitsBulkParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Keys:        []string{key},
+		Limitvalues: []int32{10},
+		Windows:     []string{"1 minute"},
+	})
+	require.NoError(t, err)
+}
+
+func TestDeleteRateLimit(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		err := conf.V1.RateLimits().DeleteRateLimit(ctx, tenantId, "missing")
+		require.ErrorIs(t, err, v1.ErrRateLimitNotFound)
+
+		putStaticRateLimitWorkflow(t, conf, tenantId, "static")
+
+		err = conf.V1.RateLimits().DeleteRateLimit(ctx, tenantId, "static")
+		require.ErrorIs(t, err, v1.ErrRateLimitInUse)
+
+		queueDynamicRateLimitTask(t, conf, tenantId, "pending")
+
+		err = conf.V1.RateLimits().DeleteRateLimit(ctx, tenantId, "pending")
+		require.ErrorIs(t, err, v1.ErrRateLimitInUse)
+
+		createDynamicRateLimit(t, conf, tenantId, "unused")
+
+		err = conf.V1.RateLimits().DeleteRateLimit(ctx, tenantId, "unused")
+		require.NoError(t, err)
+
+		err = conf.V1.RateLimits().DeleteRateLimit(ctx, tenantId, "unused")
+		require.ErrorIs(t, err, v1.ErrRateLimitNotFound)
+
+		return nil
+	})
+}
+
+func TestDeleteRateLimitsByPrefixSkipsKeysInUse(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		putStaticRateLimitWorkflow(t, conf, tenantId, "prefix-static")
+		queueDynamicRateLimitTask(t, conf, tenantId, "prefix-pending")
+		createDynamicRateLimit(t, conf, tenantId, "prefix-unused")
+		createDynamicRateLimit(t, conf, tenantId, "other-unused")
+
+		deleted, err := conf.V1.RateLimits().DeleteRateLimitsByPrefix(ctx, tenantId, "prefix-")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"prefix-unused"}, deleted)
+
+		for _, key := range []string{"prefix-static", "prefix-pending", "other-unused"} {
+			assert.Equal(t, int32(10), getAvailableRateLimitValue(t, conf, tenantId, key))
+		}
+
+		return nil
+	})
+}
+
+func TestResetSemaphoreKeepsHeldUnits(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		key := "semaphore"
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, key, &repository.UpsertRateLimitOpts{
+			Limit:     5,
+			Algorithm: repository.StringPtr("SEMAPHORE"),
+		})
+		require.NoError(t, err)
+
+		_, err = sqlcv1.New().BulkUpdateRateLimits(ctx, conf.Pool, sqlcv1.BulkUpdateRateLimitsParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Keys:     []string{key},
+			Units:    []int32{5},
+		})
+		require.NoError(t, err)
+
+		// a running task still holds 2 of the 5 used units
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO v1_task_runtime_semaphore (task_id, task_inserted_at, retry_count, tenant_id, key, units)
+			VALUES (1, NOW(), 0, $1::uuid, $2, 2)`,
+			tenantId, key,
+		)
+		require.NoError(t, err)
+
+		reset, err := conf.V1.RateLimits().ResetRateLimit(ctx, tenantId, key)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), reset.Value)
+
+		_, err = conf.V1.RateLimits().ResetRateLimit(ctx, tenantId, "missing")
+		require.ErrorIs(t, err, v1.ErrRateLimitNotFound)
+
+		return nil
+	})
+}
+
+func TestDeleteUnusedDynamicRateLimits(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		createDynamicRateLimit(t, conf, tenantId, "stale")
+		createDynamicRateLimit(t, conf, tenantId, "recent")
+		createDynamicRateLimit(t, conf, tenantId, "held")
+		queueDynamicRateLimitTask(t, conf, tenantId, "pending")
+
+		_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, "static", &repository.UpsertRateLimitOpts{
+			Limit:    10,
+			Duration: repository.StringPtr("MINUTE"),
+		})
+		require.NoError(t, err)
+
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO v1_task_runtime_semaphore (task_id, task_inserted_at, retry_count, tenant_id, key, units)
+			VALUES (1, NOW(), 0, $1::uuid, 'held', 1)`,
+			tenantId,
+		)
+		require.NoError(t, err)
+
+		for _, key := range []string{"stale", "held", "pending", "static"} {
+			setRateLimitLastUsedAt(t, conf, tenantId, key, "2 hours")
+		}
+
+		deleted, err := conf.V1.RateLimits().DeleteUnusedDynamicRateLimits(ctx, tenantId, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"stale"}, deleted)
+
+		return nil
+	})
+}
@@ -23,19 +23,27 @@ type Repository interface {
 	Workflows() WorkflowRepository
 	Ticker() TickerRepository
 	Filters() FilterRepository
+	RateLimits() RateLimitManagementRepository
+	Concurrency() ConcurrencyManagementRepository
+	Approvals() ApprovalRepository
+	Signals() SignalRepository
 }
 
 type repositoryImpl struct {
-	triggers  TriggerRepository
-	tasks     TaskRepository
-	scheduler SchedulerRepository
-	matches   MatchRepository
-	olap      OLAPRepository
-	logs      LogLineRepository
-	workers   WorkerRepository
-	workflows WorkflowRepository
-	ticker    TickerRepository
-	filters   FilterRepository
+	triggers    TriggerRepository
+	tasks       TaskRepository
+	scheduler   SchedulerRepository
+	matches     MatchRepository
+	olap        OLAPRepository
+	logs        LogLineRepository
+	workers     WorkerRepository
+	workflows   WorkflowRepository
+	ticker      TickerRepository
+	filters     FilterRepository
+	rateLimits  RateLimitManagementRepository
+	concurrency ConcurrencyManagementRepository
+	approvals   ApprovalRepository
+	signals     SignalRepository
 }
 
 func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger, taskRetentionPeriod, olapRetentionPeriod time.Duration, maxInternalRetryCount int32, entitlements repository.EntitlementsRepository) (Repository, func() error) {
@@ -50,16 +58,20 @@ func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger, taskRetentionPeriod, o
 	}
 
 	impl := &repositoryImpl{
-		triggers:  newTriggerRepository(shared),
-		tasks:     newTaskRepository(shared, taskRetentionPeriod, maxInternalRetryCount),
-		scheduler: newSchedulerRepository(shared),
-		matches:   matchRepo,
-		olap:      newOLAPRepository(shared, olapRetentionPeriod, true),
-		logs:      newLogLineRepository(shared),
-		workers:   newWorkerRepository(shared),
-		workflows: newWorkflowRepository(shared),
-		ticker:    newTickerRepository(shared),
-		filters:   newFilterRepository(shared),
+		triggers:    newTriggerRepository(shared),
+		tasks:       newTaskRepository(shared, taskRetentionPeriod, maxInternalRetryCount),
+		scheduler:   newSchedulerRepository(shared),
+		matches:     matchRepo,
+		olap:        newOLAPRepository(shared, olapRetentionPeriod, true),
+		logs:        newLogLineRepository(shared),
+		workers:     newWorkerRepository(shared),
+		workflows:   newWorkflowRepository(shared),
+		ticker:      newTickerRepository(shared),
+		filters:     newFilterRepository(shared),
+		rateLimits:  newRateLimitManagementRepository(shared),
+		concurrency: newConcurrencyManagementRepository(shared),
+		approvals:   newApprovalRepository(shared),
+		signals:     newSignalRepository(shared),
 	}
 
 	return impl, func() error {
@@ -114,3 +126,19 @@ func (r *repositoryImpl) Ticker() TickerRepository {
 func (r *repositoryImpl) Filters() FilterRepository {
 	return r.filters
 }
+
+func (r *repositoryImpl) RateLimits() RateLimitManagementRepository {
+	return r.rateLimits
+}
+
+func (r *repositoryImpl) Concurrency() ConcurrencyManagementRepository {
+	return r.concurrency
+}
+
+func (r *repositoryImpl) Approvals() ApprovalRepository {
+	return r.approvals
+}
+
+func (r *repositoryImpl) Signals() SignalRepository {
+	return r.signals
+}
@@ -35,9 +35,56 @@ type QueueRepository interface {
 
 	GetTaskRateLimits(ctx context.Context, queueItems []*sqlcv1.V1QueueItem) (map[int64]map[string]int32, error)
 	GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) (map[string][]*sqlcv1.GetDesiredLabelsRow, error)
+	GetStepSlotWeights(ctx context.Context, stepIds []pgtype.UUID) (map[string]int32, error)
+	GetStepResourceRequests(ctx context.Context, stepIds []pgtype.UUID) (map[string]map[string]int32, error)
+
+	// GetStepGangs returns the gang that each step belongs to, keyed by step id.
+	GetStepGangs(ctx context.Context, stepIds []pgtype.UUID) (map[string]*StepGang, error)
+
+	// GetStepPlacementStrategies returns the placement strategy of each step, keyed by step id. Steps which
+	// use the default SPREAD strategy may be omitted.
+	GetStepPlacementStrategies(ctx context.Context, stepIds []pgtype.UUID) (map[string]PlacementStrategy, error)
+
+	// AgeQueueItems raises the priority of queue items which have waited longer than their priority aging
+	// interval, and returns the number of queue items which were updated.
+	AgeQueueItems(ctx context.Context) (int, error)
+
+	// UpdateSchedulingReasons records the reason that the scheduler last failed to assign each queue item.
+	UpdateSchedulingReasons(ctx context.Context, reasons []*SchedulingReason) error
+
+	// ListPreemptibleTasks lists the preemptible tasks running on the given workers which have a priority
+	// lower than belowPriority, ordered by priority.
+	ListPreemptibleTasks(ctx context.Context, workerIds []pgtype.UUID, belowPriority int32) ([]*sqlcv1.ListPreemptibleTasksForWorkersRow, error)
 	Cleanup()
 }
 
+// StepGang is a set of sibling steps in a workflow which are only assigned to workers when every step in the
+// gang can be assigned.
+type StepGang struct {
+	Name string
+
+	// Size is the number of steps in the gang
+	Size int
+
+	// Timeout is the maximum amount of time to wait for the whole gang to be assigned
+	Timeout *time.Duration
+}
+
+// PlacementStrategy determines how the scheduler chooses between workers with free slots.
+type PlacementStrategy string
+
+const (
+	// PLACEMENT_STRATEGY_SPREAD spreads tasks across workers in round-robin order
+	PLACEMENT_STRATEGY_SPREAD PlacementStrategy = "SPREAD"
+
+	// PLACEMENT_STRATEGY_PACK places tasks on the most utilized worker which has free slots, so that idle
+	// workers can be scaled down
+	PLACEMENT_STRATEGY_PACK PlacementStrategy = "PACK"
+
+	// PLACEMENT_STRATEGY_LEAST_LOADED places tasks on the least utilized worker
+	PLACEMENT_STRATEGY_LEAST_LOADED PlacementStrategy = "LEAST_LOADED"
+)
+
 type RateLimitRepository interface {
 	UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, *time.Time, error)
 }
@@ -45,6 +92,7 @@ type RateLimitRepository interface {
 type AssignmentRepository interface {
 	ListActionsForWorkers(ctx context.Context, tenantId pgtype.UUID, workerIds []pgtype.UUID) ([]*sqlcv1.ListActionsForWorkersRow, error)
 	ListAvailableSlotsForWorkers(ctx context.Context, tenantId pgtype.UUID, params sqlcv1.ListAvailableSlotsForWorkersParams) ([]*sqlcv1.ListAvailableSlotsForWorkersRow, error)
+	ListResourceUsageForWorkers(ctx context.Context, tenantId pgtype.UUID, workerIds []pgtype.UUID) ([]*sqlcv1.ListResourceUsageForWorkersRow, error)
 }
 
 type schedulerRepository struct {
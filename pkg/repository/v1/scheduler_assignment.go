@@ -35,3 +35,13 @@ func (d *assignmentRepository) ListAvailableSlotsForWorkers(ctx context.Context,
 
 	return d.queries.ListAvailableSlotsForWorkers(ctx, d.pool, params)
 }
+
+func (d *assignmentRepository) ListResourceUsageForWorkers(ctx context.Context, tenantId pgtype.UUID, workerIds []pgtype.UUID) ([]*sqlcv1.ListResourceUsageForWorkersRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-resource-usage-for-workers")
+	defer span.End()
+
+	return d.queries.ListResourceUsageForWorkers(ctx, d.pool, sqlcv1.ListResourceUsageForWorkersParams{
+		Tenantid:  tenantId,
+		Workerids: workerIds,
+	})
+}
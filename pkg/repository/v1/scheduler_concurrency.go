@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
@@ -11,6 +12,16 @@ import (
 
 const PARENT_STRATEGY_LOCK_OFFSET = 1000000000000 // 1 trillion
 
+// concurrencyGroupLockKey returns the advisory lock key for a tenant-level concurrency group. The sign bit
+// is always set so that the key can't collide with strategy ids, which are positive.
+func concurrencyGroupLockKey(tenantId pgtype.UUID, group string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write(tenantId.Bytes[:])
+	_, _ = h.Write([]byte(group))
+
+	return int64(h.Sum64() | 1<<63) // nolint: gosec
+}
+
 type TaskWithQueue struct {
 	*TaskIdInsertedAtRetryCount
 
@@ -22,6 +33,9 @@ type TaskWithCancelledReason struct {
 
 	CancelledReason string
 
+	// (optional) a human-readable explanation of why the task was cancelled
+	CancelledMessage string
+
 	TaskExternalId string
 
 	WorkflowRunId string
@@ -125,11 +139,38 @@ func (c *ConcurrencyRepositoryImpl) RunConcurrencyStrategy(
 		if err != nil {
 			return nil, fmt.Errorf("cancel newest (strategy ID: %d): %w", strategy.ID, err)
 		}
+	case sqlcv1.V1ConcurrencyStrategyQUEUENEWEST:
+		res, err = c.runQueueNewest(ctx, tenantId, strategy)
+
+		if err != nil {
+			return nil, fmt.Errorf("queue newest (strategy ID: %d): %w", strategy.ID, err)
+		}
 	}
 
 	return res, nil
 }
 
+// lockConcurrencyGroup serializes the strategies of a tenant-level concurrency group, since they fill the
+// same slots. It's a no-op for strategies which don't belong to a group.
+func (c *ConcurrencyRepositoryImpl) lockConcurrencyGroup(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId pgtype.UUID,
+	strategy *sqlcv1.V1StepConcurrency,
+) error {
+	if !strategy.ConcurrencyGroup.Valid {
+		return nil
+	}
+
+	err := c.queries.AdvisoryLock(ctx, tx, concurrencyGroupLockKey(tenantId, strategy.ConcurrencyGroup.String))
+
+	if err != nil {
+		return fmt.Errorf("failed to acquire concurrency group advisory lock (strategy ID: %d, group: %s): %w", strategy.ID, strategy.ConcurrencyGroup.String, err)
+	}
+
+	return nil
+}
+
 func (c *ConcurrencyRepositoryImpl) runGroupRoundRobin(
 	ctx context.Context,
 	tenantId pgtype.UUID,
@@ -162,10 +203,18 @@ func (c *ConcurrencyRepositoryImpl) runGroupRoundRobin(
 		}
 
 		if acquired {
+			err = c.lockConcurrencyGroup(ctx, tx, tenantId, strategy)
+
+			if err != nil {
+				return nil, err
+			}
+
 			err = c.queries.RunParentGroupRoundRobin(ctx, tx, sqlcv1.RunParentGroupRoundRobinParams{
-				Tenantid:   tenantId,
-				Strategyid: strategy.ParentStrategyID.Int64,
-				Maxruns:    strategy.MaxConcurrency,
+				Tenantid:         tenantId,
+				Strategyid:       strategy.ParentStrategyID.Int64,
+				Workflowid:       strategy.WorkflowID,
+				ConcurrencyGroup: strategy.ConcurrencyGroup,
+				Maxruns:          strategy.MaxConcurrency,
 			})
 
 			if err != nil {
@@ -216,10 +265,18 @@ func (c *ConcurrencyRepositoryImpl) runGroupRoundRobin(
 			}
 		}
 	} else {
+		err = c.lockConcurrencyGroup(ctx, tx, tenantId, strategy)
+
+		if err != nil {
+			return nil, err
+		}
+
 		poppedResults, err := c.queries.RunGroupRoundRobin(ctx, tx, sqlcv1.RunGroupRoundRobinParams{
-			Tenantid:   tenantId,
-			Strategyid: strategy.ID,
-			Maxruns:    strategy.MaxConcurrency,
+			Tenantid:         tenantId,
+			Strategyid:       strategy.ID,
+			Workflowid:       strategy.WorkflowID,
+			ConcurrencyGroup: strategy.ConcurrencyGroup,
+			Maxruns:          strategy.MaxConcurrency,
 		})
 
 		if err != nil {
@@ -744,3 +801,192 @@ WHERE tenant_id = $1::uuid AND strategy_id = $2::bigint;`,
 		NextConcurrencyStrategies: nextConcurrencyStrategies,
 	}, nil
 }
+
+func (c *ConcurrencyRepositoryImpl) runQueueNewest(
+	ctx context.Context,
+	tenantId pgtype.UUID,
+	strategy *sqlcv1.V1StepConcurrency,
+) (res *RunConcurrencyResult, err error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, c.pool, c.l, 30000)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare transaction (strategy ID: %d): %w", strategy.ID, err)
+	}
+
+	defer rollback()
+
+	acquired, err := c.queries.TryAdvisoryLock(ctx, tx, strategy.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to try advisory lock (strategy ID: %d): %w", strategy.ID, err)
+	}
+
+	if !acquired {
+		c.l.Warn().Msgf("Advisory lock not acquired (strategy ID: %d). Possible lock contention.", strategy.ID)
+
+		return &RunConcurrencyResult{
+			Queued:                    []TaskWithQueue{},
+			Cancelled:                 []TaskWithCancelledReason{},
+			NextConcurrencyStrategies: []int64{},
+		}, nil
+	}
+
+	var poppedResults []*sqlcv1.RunQueueNewestRow
+
+	if strategy.ParentStrategyID.Valid {
+		parentAcquired, err := c.queries.TryAdvisoryLock(ctx, tx, PARENT_STRATEGY_LOCK_OFFSET+strategy.ParentStrategyID.Int64)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to try parent advisory lock (strategy ID: %d, parent: %d): %w", strategy.ID, strategy.ParentStrategyID.Int64, err)
+		}
+
+		if !parentAcquired {
+			c.l.Warn().Msgf("Parent advisory lock not acquired (strategy ID: %d, parent: %d)", strategy.ID, strategy.ParentStrategyID.Int64)
+
+			return &RunConcurrencyResult{
+				Queued:                    []TaskWithQueue{},
+				Cancelled:                 []TaskWithCancelledReason{},
+				NextConcurrencyStrategies: []int64{},
+			}, nil
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`
+-- name: CreateParentTempTable :exec
+CREATE TEMP TABLE tmp_workflow_concurrency_slot ON COMMIT DROP AS
+SELECT *
+FROM v1_workflow_concurrency_slot
+WHERE tenant_id = $1::uuid AND strategy_id = $2::bigint;`,
+			tenantId,
+			strategy.ParentStrategyID.Int64,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("error creating parent temp table (strategy ID: %d, parent: %d): %w", strategy.ID, strategy.ParentStrategyID.Int64, err)
+		}
+
+		err = c.queries.RunParentQueueNewest(ctx, tx, sqlcv1.RunParentQueueNewestParams{
+			Tenantid:   tenantId,
+			Strategyid: strategy.ParentStrategyID.Int64,
+			Maxruns:    strategy.MaxConcurrency,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error running parent queue newest (strategy ID: %d, parent: %d): %w", strategy.ID, strategy.ParentStrategyID.Int64, err)
+		}
+
+		rows, err := c.queries.RunChildQueueNewest(ctx, tx, sqlcv1.RunChildQueueNewestParams{
+			Tenantid:      tenantId,
+			Strategyid:    strategy.ID,
+			Maxruns:       strategy.MaxConcurrency,
+			MaxQueued:     strategy.MaxQueued,
+			Queueoverflow: strategy.QueueOverflow,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error running child queue newest (strategy ID: %d): %w", strategy.ID, err)
+		}
+
+		// the child query returns the same columns as the query without a parent strategy
+		poppedResults = make([]*sqlcv1.RunQueueNewestRow, 0, len(rows))
+
+		for _, r := range rows {
+			row := sqlcv1.RunQueueNewestRow(*r)
+			poppedResults = append(poppedResults, &row)
+		}
+	} else {
+		poppedResults, err = c.queries.RunQueueNewest(ctx, tx, sqlcv1.RunQueueNewestParams{
+			Tenantid:      tenantId,
+			Strategyid:    strategy.ID,
+			Maxruns:       strategy.MaxConcurrency,
+			MaxQueued:     strategy.MaxQueued,
+			Queueoverflow: strategy.QueueOverflow,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error running queue newest (strategy ID: %d): %w", strategy.ID, err)
+		}
+	}
+
+	taskIds := make([]int64, 0, len(poppedResults))
+	retryCounts := make([]int32, 0, len(poppedResults))
+
+	for _, r := range poppedResults {
+		if r.Operation == "CANCELLED" {
+			taskIds = append(taskIds, r.TaskID)
+			retryCounts = append(retryCounts, r.TaskRetryCount)
+		}
+	}
+
+	// remove tasks from queue
+	err = c.queries.DeleteTasksFromQueue(ctx, tx, sqlcv1.DeleteTasksFromQueueParams{
+		Taskids:     taskIds,
+		Retrycounts: retryCounts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error deleting tasks from queue (strategy ID: %d): %w", strategy.ID, err)
+	}
+
+	queued := make([]TaskWithQueue, 0, len(poppedResults))
+	cancelled := make([]TaskWithCancelledReason, 0, len(poppedResults))
+	nextConcurrencyStrategies := make([]int64, 0, len(poppedResults))
+
+	for _, r := range poppedResults {
+		idRetryCount := &TaskIdInsertedAtRetryCount{
+			Id:         r.TaskID,
+			InsertedAt: r.TaskInsertedAt,
+			RetryCount: r.TaskRetryCount,
+		}
+
+		switch {
+		case r.Operation == "CANCELLED":
+			cancelled = append(cancelled, TaskWithCancelledReason{
+				TaskIdInsertedAtRetryCount: idRetryCount,
+				CancelledReason:            "CONCURRENCY_QUEUE_FULL",
+				CancelledMessage:           queueFullMessage(strategy, r.Key),
+				TaskExternalId:             sqlchelpers.UUIDToStr(r.ExternalID),
+				WorkflowRunId:              sqlchelpers.UUIDToStr(r.WorkflowRunID),
+			})
+		case r.Operation == "SCHEDULING_TIMED_OUT":
+			cancelled = append(cancelled, TaskWithCancelledReason{
+				TaskIdInsertedAtRetryCount: idRetryCount,
+				CancelledReason:            "SCHEDULING_TIMED_OUT",
+				TaskExternalId:             sqlchelpers.UUIDToStr(r.ExternalID),
+				WorkflowRunId:              sqlchelpers.UUIDToStr(r.WorkflowRunID),
+			})
+		case len(r.NextStrategyIds) > 0:
+			nextConcurrencyStrategies = append(nextConcurrencyStrategies, r.NextStrategyIds[0])
+		default:
+			queued = append(queued, TaskWithQueue{
+				TaskIdInsertedAtRetryCount: idRetryCount,
+				Queue:                      r.QueueToNotify,
+			})
+		}
+	}
+
+	if err = commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction (strategy ID: %d): %w", strategy.ID, err)
+	}
+
+	return &RunConcurrencyResult{
+		Queued:                    queued,
+		Cancelled:                 cancelled,
+		NextConcurrencyStrategies: nextConcurrencyStrategies,
+	}, nil
+}
+
+func queueFullMessage(strategy *sqlcv1.V1StepConcurrency, key string) string {
+	if strategy.QueueOverflow == sqlcv1.V1ConcurrencyQueueOverflowREJECTNEWEST {
+		return fmt.Sprintf(
+			"Cancelled because the concurrency queue for key %q was full (max runs: %d, max queued: %d)",
+			key, strategy.MaxConcurrency, strategy.MaxQueued.Int32,
+		)
+	}
+
+	return fmt.Sprintf(
+		"Cancelled to make room for a newer run because the concurrency queue for key %q was full (max runs: %d, max queued: %d)",
+		key, strategy.MaxConcurrency, strategy.MaxQueued.Int32,
+	)
+}
@@ -16,6 +16,12 @@ type ListActiveWorkersResult struct {
 	ID      string
 	MaxRuns int
 	Labels  []*sqlcv1.ListManyWorkerLabelsRow
+
+	// Resources are the named resource capacities which the worker registered with
+	Resources map[string]int32
+
+	// IsDraining is set when the worker is finishing its in-flight tasks and should not be assigned new tasks
+	IsDraining bool
 }
 
 type leaseRepository struct {
@@ -146,14 +152,34 @@ func (d *leaseRepository) ListActiveWorkers(ctx context.Context, tenantId pgtype
 		workerIdsToLabels[wId] = append(workerIdsToLabels[wId], label)
 	}
 
+	resources, err := d.queries.ListWorkerResourceCapacities(ctx, d.pool, workerIds)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	workerIdsToResources := make(map[string]map[string]int32)
+
+	for _, resource := range resources {
+		wId := sqlchelpers.UUIDToStr(resource.WorkerID)
+
+		if _, ok := workerIdsToResources[wId]; !ok {
+			workerIdsToResources[wId] = make(map[string]int32)
+		}
+
+		workerIdsToResources[wId][resource.Key] = resource.Capacity
+	}
+
 	res := make([]*ListActiveWorkersResult, 0, len(activeWorkers))
 
 	for _, worker := range activeWorkers {
 		wId := sqlchelpers.UUIDToStr(worker.ID)
 		res = append(res, &ListActiveWorkersResult{
-			ID:      sqlchelpers.UUIDToStr(worker.ID),
-			MaxRuns: int(worker.MaxRuns),
-			Labels:  workerIdsToLabels[wId],
+			ID:         sqlchelpers.UUIDToStr(worker.ID),
+			MaxRuns:    int(worker.MaxRuns),
+			Labels:     workerIdsToLabels[wId],
+			Resources:  workerIdsToResources[wId],
+			IsDraining: worker.DrainingAt.Valid,
 		})
 	}
 
@@ -28,6 +28,9 @@ type AssignedItem struct {
 	WorkerId pgtype.UUID
 
 	QueueItem *sqlcv1.V1QueueItem
+
+	// RateLimits are the units of each rate limit key consumed by the assignment
+	RateLimits map[string]int32
 }
 
 type AssignResults struct {
@@ -37,6 +40,13 @@ type AssignResults struct {
 	RateLimited        []*RateLimitResult
 }
 
+// SchedulingReason is the reason that the scheduler could not assign a queue item to a worker.
+type SchedulingReason struct {
+	QueueItemId int64
+	Reason      sqlcv1.V1SchedulingReason
+	Message     string
+}
+
 type queueFactoryRepository struct {
 	*sharedRepository
 }
@@ -62,22 +72,43 @@ type queueRepository struct {
 
 	updateMinIdMu sync.Mutex
 
+	// fairnessAfterKey is the last fairness key which was listed, so that subsequent lists rotate through
+	// the fairness keys in the queue
+	fairnessAfterKey   string
+	fairnessAfterKeyMu sync.Mutex
+
 	cachedStepIdHasRateLimit *cache.Cache
+	cachedStepIdSlotWeight   *cache.Cache
+	cachedStepIdResources    *cache.Cache
+	cachedStepIdGangs        *cache.Cache
+	cachedStepIdPlacement    *cache.Cache
 }
 
 func newQueueRepository(shared *sharedRepository, tenantId pgtype.UUID, queueName string) *queueRepository {
 	c := cache.New(5 * time.Minute)
+	sw := cache.New(5 * time.Minute)
+	res := cache.New(5 * time.Minute)
+	gangs := cache.New(5 * time.Minute)
+	placement := cache.New(5 * time.Minute)
 
 	return &queueRepository{
 		sharedRepository:         shared,
 		tenantId:                 tenantId,
 		queueName:                queueName,
 		cachedStepIdHasRateLimit: c,
+		cachedStepIdSlotWeight:   sw,
+		cachedStepIdResources:    res,
+		cachedStepIdGangs:        gangs,
+		cachedStepIdPlacement:    placement,
 	}
 }
 
 func (d *queueRepository) Cleanup() {
 	d.cachedStepIdHasRateLimit.Stop()
+	d.cachedStepIdSlotWeight.Stop()
+	d.cachedStepIdResources.Stop()
+	d.cachedStepIdGangs.Stop()
+	d.cachedStepIdPlacement.Stop()
 }
 
 func (d *queueRepository) setMinId(id int64) {
@@ -106,15 +137,27 @@ func (d *queueRepository) ListQueueItems(ctx context.Context, limit int) ([]*sql
 	start := time.Now()
 	checkpoint := start
 
-	qis, err := d.queries.ListQueueItemsForQueue(ctx, d.pool, sqlcv1.ListQueueItemsForQueueParams{
-		Tenantid: d.tenantId,
-		Queue:    d.queueName,
-		GtId:     d.getMinId(),
-		Limit: pgtype.Int4{
-			Int32: int32(limit), // nolint: gosec
-			Valid: true,
-		},
-	})
+	fairnessKeys, err := d.listFairnessKeys(ctx, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var qis []*sqlcv1.V1QueueItem
+
+	if len(fairnessKeys) > 0 {
+		qis, err = d.listFairQueueItems(ctx, fairnessKeys, limit)
+	} else {
+		qis, err = d.queries.ListQueueItemsForQueue(ctx, d.pool, sqlcv1.ListQueueItemsForQueueParams{
+			Tenantid: d.tenantId,
+			Queue:    d.queueName,
+			GtId:     d.getMinId(),
+			Limit: pgtype.Int4{
+				Int32: int32(limit), // nolint: gosec
+				Valid: true,
+			},
+		})
+	}
 
 	if err != nil {
 		return nil, err
@@ -149,6 +192,154 @@ func (d *queueRepository) ListQueueItems(ctx context.Context, limit int) ([]*sql
 	return qis, nil
 }
 
+func (d *queueRepository) AgeQueueItems(ctx context.Context) (int, error) {
+	ctx, span := telemetry.NewSpan(ctx, "age-queue-items")
+	defer span.End()
+
+	aged, err := d.queries.AgeQueueItems(ctx, d.pool, sqlcv1.AgeQueueItemsParams{
+		Tenantid: d.tenantId,
+		Queue:    d.queueName,
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not age queue items: %w", err)
+	}
+
+	return len(aged), nil
+}
+
+func (d *queueRepository) UpdateSchedulingReasons(ctx context.Context, reasons []*SchedulingReason) error {
+	ctx, span := telemetry.NewSpan(ctx, "update-scheduling-reasons")
+	defer span.End()
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(reasons))
+	kinds := make([]string, 0, len(reasons))
+	messages := make([]string, 0, len(reasons))
+
+	for _, r := range reasons {
+		ids = append(ids, r.QueueItemId)
+		kinds = append(kinds, string(r.Reason))
+		messages = append(messages, r.Message)
+	}
+
+	err := d.queries.UpdateQueueItemSchedulingReasons(ctx, d.pool, sqlcv1.UpdateQueueItemSchedulingReasonsParams{
+		Ids:      ids,
+		Reasons:  kinds,
+		Messages: messages,
+		Tenantid: d.tenantId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not update scheduling reasons: %w", err)
+	}
+
+	return nil
+}
+
+func (d *queueRepository) ListPreemptibleTasks(ctx context.Context, workerIds []pgtype.UUID, belowPriority int32) ([]*sqlcv1.ListPreemptibleTasksForWorkersRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-preemptible-tasks")
+	defer span.End()
+
+	if len(workerIds) == 0 {
+		return nil, nil
+	}
+
+	tasks, err := d.queries.ListPreemptibleTasksForWorkers(ctx, d.pool, sqlcv1.ListPreemptibleTasksForWorkersParams{
+		Tenantid:    d.tenantId,
+		Workerids:   workerIds,
+		Maxpriority: belowPriority,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list preemptible tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// listFairnessKeys returns up to limit fairness keys in the queue, starting after the last key which was
+// listed and wrapping around to the start of the keys.
+func (d *queueRepository) listFairnessKeys(ctx context.Context, limit int) ([]string, error) {
+	d.fairnessAfterKeyMu.Lock()
+	defer d.fairnessAfterKeyMu.Unlock()
+
+	keys, err := d.queries.ListQueueFairnessKeys(ctx, d.pool, sqlcv1.ListQueueFairnessKeysParams{
+		Tenantid: d.tenantId,
+		Queue:    d.queueName,
+		Afterkey: d.fairnessAfterKey,
+		Limit:    sqlchelpers.ToInt(int32(limit)), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list fairness keys: %w", err)
+	}
+
+	if len(keys) < limit && d.fairnessAfterKey != "" {
+		wrapped, err := d.queries.ListQueueFairnessKeys(ctx, d.pool, sqlcv1.ListQueueFairnessKeysParams{
+			Tenantid: d.tenantId,
+			Queue:    d.queueName,
+			Afterkey: "",
+			Limit:    sqlchelpers.ToInt(int32(limit - len(keys))), // nolint: gosec
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list fairness keys: %w", err)
+		}
+
+		for _, key := range wrapped {
+			if key > d.fairnessAfterKey {
+				break
+			}
+
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) >= limit {
+		d.fairnessAfterKey = keys[len(keys)-1]
+	} else {
+		d.fairnessAfterKey = ""
+	}
+
+	return keys, nil
+}
+
+// listFairQueueItems lists queue items evenly across the fairness keys, treating queue items without a
+// fairness key as their own key.
+func (d *queueRepository) listFairQueueItems(ctx context.Context, fairnessKeys []string, limit int) ([]*sqlcv1.V1QueueItem, error) {
+	perKeyLimit := max(1, limit/(len(fairnessKeys)+1))
+
+	keyed, err := d.queries.ListQueueItemsForFairnessKeys(ctx, d.pool, sqlcv1.ListQueueItemsForFairnessKeysParams{
+		Fairnesskeys: fairnessKeys,
+		Tenantid:     d.tenantId,
+		Queue:        d.queueName,
+		GtId:         d.getMinId(),
+		Perkeylimit:  int32(perKeyLimit), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list queue items for fairness keys: %w", err)
+	}
+
+	unkeyed, err := d.queries.ListQueueItemsForQueue(ctx, d.pool, sqlcv1.ListQueueItemsForQueueParams{
+		Tenantid:    d.tenantId,
+		Queue:       d.queueName,
+		GtId:        d.getMinId(),
+		Limit:       sqlchelpers.ToInt(int32(perKeyLimit)), // nolint: gosec
+		UnkeyedOnly: sqlchelpers.BoolFromBoolean(true),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(keyed, unkeyed...), nil
+}
+
 func (d *queueRepository) updateMinId() {
 	if !d.updateMinIdMu.TryLock() {
 		return
@@ -557,6 +748,209 @@ func (d *queueRepository) GetDesiredLabels(ctx context.Context, stepIds []pgtype
 	return stepIdToLabels, nil
 }
 
+// GetStepSlotWeights returns the number of worker slots consumed by each step. Steps which consume
+// the default of a single slot are included in the result.
+func (d *queueRepository) GetStepSlotWeights(ctx context.Context, stepIds []pgtype.UUID) (map[string]int32, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-step-slot-weights")
+	defer span.End()
+
+	stepIdToWeight := make(map[string]int32, len(stepIds))
+	stepIdsToLookup := make([]pgtype.UUID, 0, len(stepIds))
+
+	for _, stepId := range sqlchelpers.UniqueSet(stepIds) {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+
+		// slot weights are immutable for a given step, so we can always use the cached value
+		if weight, ok := d.cachedStepIdSlotWeight.Get(stepIdStr); ok {
+			stepIdToWeight[stepIdStr] = weight.(int32)
+			continue
+		}
+
+		stepIdsToLookup = append(stepIdsToLookup, stepId)
+	}
+
+	if len(stepIdsToLookup) == 0 {
+		return stepIdToWeight, nil
+	}
+
+	rows, err := d.queries.ListStepSlotWeights(ctx, d.pool, stepIdsToLookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stepIdStr := sqlchelpers.UUIDToStr(row.ID)
+
+		stepIdToWeight[stepIdStr] = row.SlotWeight
+		d.cachedStepIdSlotWeight.Set(stepIdStr, row.SlotWeight)
+	}
+
+	return stepIdToWeight, nil
+}
+
+// GetStepResourceRequests returns the named worker resources consumed by each step. Steps which don't
+// request any resources are not included in the result.
+func (d *queueRepository) GetStepResourceRequests(ctx context.Context, stepIds []pgtype.UUID) (map[string]map[string]int32, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-step-resource-requests")
+	defer span.End()
+
+	stepIdToResources := make(map[string]map[string]int32)
+	stepIdsToLookup := make([]pgtype.UUID, 0, len(stepIds))
+
+	for _, stepId := range sqlchelpers.UniqueSet(stepIds) {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+
+		// resource requests are immutable for a given step, so we can always use the cached value
+		if resources, ok := d.cachedStepIdResources.Get(stepIdStr); ok {
+			if resourcesMap := resources.(map[string]int32); len(resourcesMap) > 0 {
+				stepIdToResources[stepIdStr] = resourcesMap
+			}
+
+			continue
+		}
+
+		stepIdsToLookup = append(stepIdsToLookup, stepId)
+	}
+
+	if len(stepIdsToLookup) == 0 {
+		return stepIdToResources, nil
+	}
+
+	rows, err := d.queries.ListStepResourceRequests(ctx, d.pool, stepIdsToLookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stepIdStr := sqlchelpers.UUIDToStr(row.StepID)
+
+		if _, ok := stepIdToResources[stepIdStr]; !ok {
+			stepIdToResources[stepIdStr] = make(map[string]int32)
+		}
+
+		stepIdToResources[stepIdStr][row.Key] = row.Units
+	}
+
+	// store all looked up step ids in the cache, so we can skip the lookup for steps without resource requests
+	for _, stepId := range stepIdsToLookup {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+		d.cachedStepIdResources.Set(stepIdStr, stepIdToResources[stepIdStr])
+	}
+
+	return stepIdToResources, nil
+}
+
+// GetStepGangs returns the gang that each step belongs to. Steps which don't belong to a gang are not
+// included in the result.
+func (d *queueRepository) GetStepGangs(ctx context.Context, stepIds []pgtype.UUID) (map[string]*StepGang, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-step-gangs")
+	defer span.End()
+
+	stepIdToGang := make(map[string]*StepGang)
+	stepIdsToLookup := make([]pgtype.UUID, 0, len(stepIds))
+
+	for _, stepId := range sqlchelpers.UniqueSet(stepIds) {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+
+		// gangs are immutable for a given step, so we can always use the cached value
+		if gang, ok := d.cachedStepIdGangs.Get(stepIdStr); ok {
+			if gang := gang.(*StepGang); gang != nil {
+				stepIdToGang[stepIdStr] = gang
+			}
+
+			continue
+		}
+
+		stepIdsToLookup = append(stepIdsToLookup, stepId)
+	}
+
+	if len(stepIdsToLookup) == 0 {
+		return stepIdToGang, nil
+	}
+
+	rows, err := d.queries.ListStepGangs(ctx, d.pool, stepIdsToLookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		gang := &StepGang{
+			Name: row.GangName,
+			Size: int(row.GangSize),
+		}
+
+		if row.GangTimeout.Valid {
+			timeout, err := time.ParseDuration(row.GangTimeout.String)
+
+			if err != nil {
+				d.l.Warn().Err(err).Msgf("invalid gang timeout %s for step %s", row.GangTimeout.String, sqlchelpers.UUIDToStr(row.ID))
+			} else {
+				gang.Timeout = &timeout
+			}
+		}
+
+		stepIdToGang[sqlchelpers.UUIDToStr(row.ID)] = gang
+	}
+
+	// store all looked up step ids in the cache, so we can skip the lookup for steps without a gang
+	for _, stepId := range stepIdsToLookup {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+		d.cachedStepIdGangs.Set(stepIdStr, stepIdToGang[stepIdStr])
+	}
+
+	return stepIdToGang, nil
+}
+
+// GetStepPlacementStrategies returns the placement strategy of each step. Steps without a placement strategy
+// are not included in the result, and should be treated as SPREAD.
+func (d *queueRepository) GetStepPlacementStrategies(ctx context.Context, stepIds []pgtype.UUID) (map[string]PlacementStrategy, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-step-placement-strategies")
+	defer span.End()
+
+	stepIdToStrategy := make(map[string]PlacementStrategy)
+	stepIdsToLookup := make([]pgtype.UUID, 0, len(stepIds))
+
+	for _, stepId := range sqlchelpers.UniqueSet(stepIds) {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+
+		// placement strategies are immutable for a given step, so we can always use the cached value
+		if strategy, ok := d.cachedStepIdPlacement.Get(stepIdStr); ok {
+			if strategy := strategy.(PlacementStrategy); strategy != "" {
+				stepIdToStrategy[stepIdStr] = strategy
+			}
+
+			continue
+		}
+
+		stepIdsToLookup = append(stepIdsToLookup, stepId)
+	}
+
+	if len(stepIdsToLookup) == 0 {
+		return stepIdToStrategy, nil
+	}
+
+	rows, err := d.queries.ListStepPlacementStrategies(ctx, d.pool, stepIdsToLookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stepIdToStrategy[sqlchelpers.UUIDToStr(row.ID)] = PlacementStrategy(row.PlacementStrategy)
+	}
+
+	// store all looked up step ids in the cache, so we can skip the lookup for steps without a strategy
+	for _, stepId := range stepIdsToLookup {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+		d.cachedStepIdPlacement.Set(stepIdStr, stepIdToStrategy[stepIdStr])
+	}
+
+	return stepIdToStrategy, nil
+}
+
 func getLargerDuration(s1, s2 string) (string, error) {
 	i1, err := getDurationIndex(s1)
 	if err != nil {
@@ -68,8 +68,10 @@ func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgt
 
 	res := make(map[string]int, len(newRls))
 
+	// the available value accounts for the previous window of sliding window rate limits, so it can be lower
+	// than the stored value
 	for _, rl := range newRls {
-		res[rl.Key] = int(rl.Value)
+		res[rl.Key] = int(rl.AvailableValue)
 	}
 
 	nextRefillAt := time.Now().Add(time.Second * 2)
@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// DurableSignalRetention is how long a signal is kept if no task waits on it, after which it is removed and can't
+// be delivered.
+const DurableSignalRetention = 24 * time.Hour
+
+type SendSignalOpts struct {
+	// (required) the external id of the task, or of the workflow run, which the signal is sent to
+	ExternalId string `validate:"required,uuid"`
+
+	// (required) the name of the signal
+	Name string `validate:"required"`
+
+	// (optional) a JSON payload which is passed to the task along with the signal
+	Payload []byte
+}
+
+// DeliverSignalsFunc publishes the internal events which deliver signals to the tasks which are waiting on them.
+// It's called before the signals are committed as delivered, so that signals aren't lost if it fails.
+type DeliverSignalsFunc func(ctx context.Context, events []InternalTaskEvent) error
+
+type SignalRepository interface {
+	// SendSignal stores a signal for the task, or for each task of the workflow run, with the given external id, and
+	// returns the external ids of the signalled tasks. The signal is delivered to the tasks which are already
+	// waiting on it with deliver. Signals for tasks which are not waiting are delivered when they wait.
+	SendSignal(ctx context.Context, tenantId string, opts SendSignalOpts, deliver DeliverSignalsFunc) ([]string, error)
+
+	// DeliverPendingSignals should be called after a task registers signal conditions. It delivers the signals
+	// which were sent before the task started waiting with deliver.
+	DeliverPendingSignals(ctx context.Context, tenantId, taskExternalId string, names []string, deliver DeliverSignalsFunc) error
+}
+
+type signalRepository struct {
+	*sharedRepository
+}
+
+func newSignalRepository(shared *sharedRepository) SignalRepository {
+	return &signalRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *signalRepository) SendSignal(ctx context.Context, tenantId string, opts SendSignalOpts, deliver DeliverSignalsFunc) ([]string, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	data := opts.Payload
+
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("signal payload must be valid JSON")
+	}
+
+	tasks, err := r.lookupExternalIds(ctx, r.pool, tenantId, []string{opts.ExternalId})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up tasks: %w", err)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	tenantIdUUID := sqlchelpers.UUIDFromStr(tenantId)
+	taskExternalIds := make([]string, 0, len(tasks))
+	events := make([]InternalTaskEvent, 0)
+
+	for _, task := range tasks {
+		if err := r.lockSignal(ctx, tx, tenantIdUUID, task.ExternalID, opts.Name); err != nil {
+			return nil, err
+		}
+
+		_, err = r.queries.CreateDurableSignal(ctx, tx, sqlcv1.CreateDurableSignalParams{
+			Tenantid:       tenantIdUUID,
+			Taskexternalid: task.ExternalID,
+			Name:           opts.Name,
+			Data:           data,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signal: %w", err)
+		}
+
+		taskEvents, err := r.assignSignals(ctx, tx, tenantIdUUID, task.ExternalID, opts.Name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		taskExternalIds = append(taskExternalIds, sqlchelpers.UUIDToStr(task.ExternalID))
+		events = append(events, taskEvents...)
+	}
+
+	// the signals are only committed as delivered once their events are published
+	if len(events) > 0 {
+		if err := deliver(ctx, events); err != nil {
+			return nil, fmt.Errorf("failed to deliver signals: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return taskExternalIds, nil
+}
+
+func (r *signalRepository) DeliverPendingSignals(ctx context.Context, tenantId, taskExternalId string, names []string, deliver DeliverSignalsFunc) error {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	tenantIdUUID := sqlchelpers.UUIDFromStr(tenantId)
+	taskExternalIdUUID := sqlchelpers.UUIDFromStr(taskExternalId)
+	events := make([]InternalTaskEvent, 0)
+
+	for _, name := range names {
+		if err := r.lockSignal(ctx, tx, tenantIdUUID, taskExternalIdUUID, name); err != nil {
+			return err
+		}
+
+		taskEvents, err := r.assignSignals(ctx, tx, tenantIdUUID, taskExternalIdUUID, name)
+
+		if err != nil {
+			return err
+		}
+
+		events = append(events, taskEvents...)
+	}
+
+	// the signals are only committed as delivered once their events are published
+	if len(events) > 0 {
+		if err := deliver(ctx, events); err != nil {
+			return fmt.Errorf("failed to deliver signals: %w", err)
+		}
+	}
+
+	return commit(ctx)
+}
+
+// lockSignal serializes the senders of a signal with the task which waits on it, so that a signal is assigned to
+// at most one match condition and a match condition is assigned at most one signal.
+func (r *signalRepository) lockSignal(ctx context.Context, tx sqlcv1.DBTX, tenantId, taskExternalId pgtype.UUID, name string) error {
+	h := fnv.New64a()
+	_, _ = h.Write(tenantId.Bytes[:])
+	_, _ = h.Write(taskExternalId.Bytes[:])
+	_, _ = h.Write([]byte(name))
+
+	err := r.queries.AdvisoryLock(ctx, tx, int64(h.Sum64()|1<<63)) // nolint: gosec
+
+	if err != nil {
+		return fmt.Errorf("failed to acquire signal advisory lock: %w", err)
+	}
+
+	return nil
+}
+
+// assignSignals assigns pending signals to the match conditions of the task which are waiting on them, and returns
+// the internal events which deliver the assigned signals. The caller must hold the signal's advisory lock.
+func (r *signalRepository) assignSignals(ctx context.Context, tx sqlcv1.DBTX, tenantId, taskExternalId pgtype.UUID, name string) ([]InternalTaskEvent, error) {
+	err := r.queries.DeleteDeliveredDurableSignals(ctx, tx, sqlcv1.DeleteDeliveredDurableSignalsParams{
+		Tenantid:       tenantId,
+		Taskexternalid: taskExternalId,
+		Name:           name,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete delivered signals: %w", err)
+	}
+
+	events := make([]InternalTaskEvent, 0)
+
+	for {
+		signal, err := r.queries.AssignDurableSignal(ctx, tx, sqlcv1.AssignDurableSignalParams{
+			Tenantid:       tenantId,
+			Name:           name,
+			Taskexternalid: taskExternalId,
+		})
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign signal: %w", err)
+		}
+
+		eventKey := getSignalEventKey(signal.Name)
+
+		events = append(events, InternalTaskEvent{
+			TenantID:       sqlchelpers.UUIDToStr(signal.TenantID),
+			TaskExternalID: sqlchelpers.UUIDToStr(signal.TaskExternalID),
+			// NOTE: the event type of internal events is used as the event key for the match condition
+			EventType: sqlcv1.V1TaskEventType(eventKey),
+			EventKey:  eventKey,
+			Data:      signal.Data,
+		})
+	}
+
+	return events, nil
+}
+
+func getSignalEventKey(name string) string {
+	return fmt.Sprintf("durable-signal-%s", name)
+}
@@ -0,0 +1,202 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// triggerSignalTestTask triggers a single task, and returns it.
+func triggerSignalTestTask(t *testing.T, conf *database.Layer, tenantId string) *sqlcv1.V1Task {
+	t.Helper()
+
+	name := putTestWorkflow(t, conf, tenantId, []v1.CreateStepOpts{
+		{
+			ReadableId: "step",
+			Action:     "test:step",
+		},
+	})
+
+	tasks, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(context.Background(), tenantId, []*v1.WorkflowNameTriggerOpts{
+		{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: name,
+				Data:         []byte("{}"),
+			},
+			ExternalId: uuid.NewString(),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	return tasks[0]
+}
+
+func countDurableSignals(t *testing.T, conf *database.Layer, tenantId string) int {
+	t.Helper()
+
+	var count int
+
+	err := conf.Pool.QueryRow(
+		context.Background(),
+		`SELECT COUNT(*) FROM v1_durable_signal WHERE tenant_id = $1::uuid`,
+		tenantId,
+	).Scan(&count)
+	require.NoError(t, err)
+
+	return count
+}
+
+func TestSignalIsNotDeliveredIfPublishFails(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		task := triggerSignalTestTask(t, conf, tenantId)
+		taskExternalId := sqlchelpers.UUIDToStr(task.ExternalID)
+		signalName := "approved"
+
+		err := conf.V1.Matches().RegisterSignalMatchConditions(ctx, tenantId, []v1.ExternalCreateSignalMatchOpts{
+			{
+				Conditions: []v1.CreateExternalSignalConditionOpt{
+					{
+						Kind:            v1.CreateExternalSignalConditionKindSIGNAL,
+						ReadableDataKey: signalName,
+						OrGroupId:       uuid.NewString(),
+						SignalName:      &signalName,
+					},
+				},
+				SignalTaskId:         task.ID,
+				SignalTaskInsertedAt: task.InsertedAt,
+				SignalExternalId:     taskExternalId,
+				SignalKey:            "signal-key",
+			},
+		})
+		require.NoError(t, err)
+
+		opts := v1.SendSignalOpts{
+			ExternalId: taskExternalId,
+			Name:       signalName,
+		}
+
+		_, err = conf.V1.Signals().SendSignal(ctx, tenantId, opts, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			return errors.New("could not publish")
+		})
+		require.Error(t, err)
+
+		// the signal isn't stored, so it can't be assigned to the condition without being delivered
+		assert.Equal(t, 0, countDurableSignals(t, conf, tenantId))
+
+		var delivered []v1.InternalTaskEvent
+
+		ids, err := conf.V1.Signals().SendSignal(ctx, tenantId, opts, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			delivered = append(delivered, events...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{taskExternalId}, ids)
+		require.Len(t, delivered, 1)
+		assert.Equal(t, taskExternalId, delivered[0].TaskExternalID)
+
+		return nil
+	})
+}
+
+func TestPendingSignalIsNotAssignedIfPublishFails(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		task := triggerSignalTestTask(t, conf, tenantId)
+		taskExternalId := sqlchelpers.UUIDToStr(task.ExternalID)
+		signalName := "approved"
+
+		// the signal is sent before the task waits on it, so it isn't delivered yet
+		_, err := conf.V1.Signals().SendSignal(ctx, tenantId, v1.SendSignalOpts{
+			ExternalId: taskExternalId,
+			Name:       signalName,
+		}, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			t.Fatal("signal should not be delivered before the task waits on it")
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = conf.V1.Matches().RegisterSignalMatchConditions(ctx, tenantId, []v1.ExternalCreateSignalMatchOpts{
+			{
+				Conditions: []v1.CreateExternalSignalConditionOpt{
+					{
+						Kind:            v1.CreateExternalSignalConditionKindSIGNAL,
+						ReadableDataKey: signalName,
+						OrGroupId:       uuid.NewString(),
+						SignalName:      &signalName,
+					},
+				},
+				SignalTaskId:         task.ID,
+				SignalTaskInsertedAt: task.InsertedAt,
+				SignalExternalId:     taskExternalId,
+				SignalKey:            "signal-key",
+			},
+		})
+		require.NoError(t, err)
+
+		err = conf.V1.Signals().DeliverPendingSignals(ctx, tenantId, taskExternalId, []string{signalName}, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			return errors.New("could not publish")
+		})
+		require.Error(t, err)
+
+		// the signal is still pending, so it's delivered when the delivery is retried
+		var delivered []v1.InternalTaskEvent
+
+		err = conf.V1.Signals().DeliverPendingSignals(ctx, tenantId, taskExternalId, []string{signalName}, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			delivered = append(delivered, events...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, delivered, 1)
+		assert.Equal(t, taskExternalId, delivered[0].TaskExternalID)
+
+		return nil
+	})
+}
+
+func TestUndeliveredSignalsExpire(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		task := triggerSignalTestTask(t, conf, tenantId)
+
+		// no task ever waits on the signal
+		_, err := conf.V1.Signals().SendSignal(ctx, tenantId, v1.SendSignalOpts{
+			ExternalId: sqlchelpers.UUIDToStr(task.ExternalID),
+			Name:       "never-awaited",
+		}, func(ctx context.Context, events []v1.InternalTaskEvent) error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, conf.V1.Tasks().UpdateTablePartitions(ctx))
+		assert.Equal(t, 1, countDurableSignals(t, conf, tenantId))
+
+		_, err = conf.Pool.Exec(
+			ctx,
+			`UPDATE v1_durable_signal SET created_at = NOW() - INTERVAL '2 days' WHERE tenant_id = $1::uuid`,
+			tenantId,
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, conf.V1.Tasks().UpdateTablePartitions(ctx))
+		assert.Equal(t, 0, countDurableSignals(t, conf, tenantId))
+
+		return nil
+	})
+}
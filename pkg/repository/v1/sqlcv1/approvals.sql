@@ -0,0 +1,108 @@
+-- name: CreateApproval :one
+INSERT INTO v1_approval (
+    tenant_id,
+    task_external_id,
+    workflow_run_id,
+    step_id,
+    readable_data_key,
+    expires_at
+)
+VALUES (
+    @tenantId::uuid,
+    @taskExternalId::uuid,
+    @workflowRunId::uuid,
+    @stepId::uuid,
+    @readableDataKey::text,
+    CASE
+        WHEN sqlc.narg('expiresIn')::text IS NULL THEN NULL
+        ELSE CURRENT_TIMESTAMP + convert_duration_to_interval(sqlc.narg('expiresIn')::text)
+    END
+)
+RETURNING *;
+
+-- name: ExpireApprovals :exec
+-- Marks the pending approvals which have passed their expiry as expired. The task which was waiting on an
+-- expired approval is skipped or failed by the approval's expiry condition.
+UPDATE
+    v1_approval
+SET
+    status = 'EXPIRED'
+WHERE
+    tenant_id = @tenantId::uuid
+    AND status = 'PENDING'
+    AND expires_at <= CURRENT_TIMESTAMP;
+
+-- name: GetApproval :one
+SELECT
+    *
+FROM
+    v1_approval
+WHERE
+    tenant_id = @tenantId::uuid
+    AND external_id = @externalId::uuid;
+
+-- name: ListPendingApprovals :many
+SELECT
+    a.*
+FROM
+    v1_approval a
+WHERE
+    a.tenant_id = @tenantId::uuid
+    AND a.status = 'PENDING'
+    AND (a.expires_at IS NULL OR a.expires_at > CURRENT_TIMESTAMP)
+    AND (
+        sqlc.narg('workflowRunId')::uuid IS NULL
+        OR a.workflow_run_id = sqlc.narg('workflowRunId')::uuid
+    )
+    -- the task may have been cancelled or replayed while waiting, in which case the approval's
+    -- match condition no longer exists and the approval can't be decided
+    AND EXISTS (
+        SELECT
+            1
+        FROM
+            v1_match_condition mc
+        WHERE
+            mc.tenant_id = a.tenant_id
+            AND mc.event_type = 'INTERNAL'
+            AND mc.event_key = 'approval-' || a.id::text
+            AND mc.is_satisfied = FALSE
+            AND mc.event_resource_hint = a.task_external_id::text
+    )
+ORDER BY
+    a.created_at DESC,
+    a.id DESC
+LIMIT COALESCE(sqlc.narg('approvalLimit')::bigint, 50)
+OFFSET COALESCE(sqlc.narg('approvalOffset')::bigint, 0);
+
+-- name: DecideApproval :one
+UPDATE
+    v1_approval
+SET
+    status = @status::v1_approval_status,
+    decided_at = COALESCE(decided_at, CURRENT_TIMESTAMP),
+    decided_by = @decidedBy::uuid,
+    decided_by_email = @decidedByEmail::text,
+    comment = sqlc.narg('comment')::text,
+    payload = sqlc.narg('payload')::jsonb
+WHERE
+    tenant_id = @tenantId::uuid
+    AND external_id = @externalId::uuid
+    -- a decision which was already recorded can be retried by the same user until the task has received it
+    AND (
+        status = 'PENDING'
+        OR (status = @status::v1_approval_status AND decided_by = @decidedBy::uuid)
+    )
+    AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+    AND EXISTS (
+        SELECT
+            1
+        FROM
+            v1_match_condition mc
+        WHERE
+            mc.tenant_id = v1_approval.tenant_id
+            AND mc.event_type = 'INTERNAL'
+            AND mc.event_key = 'approval-' || v1_approval.id::text
+            AND mc.is_satisfied = FALSE
+            AND mc.event_resource_hint = v1_approval.task_external_id::text
+    )
+RETURNING *;
@@ -131,15 +131,40 @@ WITH eligible_slots_per_group AS (
             tenant_id = @tenantId::uuid
             AND strategy_id = @strategyId::bigint
     ) distinct_keys
+    -- per-key overrides of the max runs, see v1_concurrency_key_limit. An override on any workflow in the
+    -- concurrency group applies to the whole group, and the lowest override wins.
+    LEFT JOIN LATERAL (
+        SELECT MIN(kl_all.max_runs) AS max_runs
+        FROM v1_concurrency_key_limit kl_all
+        WHERE
+            kl_all.tenant_id = @tenantId::uuid
+            AND kl_all.key = distinct_keys.key
+            AND (
+                kl_all.workflow_id = @workflowId::uuid
+                OR kl_all.workflow_id IN (
+                    SELECT workflow_id
+                    FROM v1_workflow_concurrency
+                    WHERE tenant_id = @tenantId::uuid AND concurrency_group = sqlc.narg('concurrencyGroup')::text
+                )
+            )
+    ) kl ON true
     JOIN LATERAL (
         SELECT *
         FROM v1_workflow_concurrency_slot wcs_all
         WHERE
             wcs_all.key = distinct_keys.key
             AND wcs_all.tenant_id = @tenantId::uuid
-            AND wcs_all.strategy_id = @strategyId::bigint
+            AND (
+                wcs_all.strategy_id = @strategyId::bigint
+                -- strategies in the same concurrency group share their slots across workflows
+                OR wcs_all.strategy_id IN (
+                    SELECT id
+                    FROM v1_workflow_concurrency
+                    WHERE tenant_id = @tenantId::uuid AND concurrency_group = sqlc.narg('concurrencyGroup')::text
+                )
+            )
         ORDER BY wcs_all.priority DESC, wcs_all.sort_id ASC
-        LIMIT @maxRuns::int
+        LIMIT COALESCE(kl.max_runs, @maxRuns::int)
     ) wsc ON true
 ), eligible_slots AS (
     SELECT
@@ -182,15 +207,40 @@ WITH eligible_slots_per_group AS (
             tenant_id = @tenantId::uuid
             AND strategy_id = @strategyId::bigint
     ) distinct_keys
+    -- per-key overrides of the max runs, see v1_concurrency_key_limit. An override on any workflow in the
+    -- concurrency group applies to the whole group, and the lowest override wins.
+    LEFT JOIN LATERAL (
+        SELECT MIN(kl_all.max_runs) AS max_runs
+        FROM v1_concurrency_key_limit kl_all
+        WHERE
+            kl_all.tenant_id = @tenantId::uuid
+            AND kl_all.key = distinct_keys.key
+            AND (
+                kl_all.workflow_id = @workflowId::uuid
+                OR kl_all.workflow_id IN (
+                    SELECT workflow_id
+                    FROM v1_step_concurrency
+                    WHERE tenant_id = @tenantId::uuid AND concurrency_group = sqlc.narg('concurrencyGroup')::text AND parent_strategy_id IS NULL
+                )
+            )
+    ) kl ON true
     JOIN LATERAL (
         SELECT *
         FROM v1_concurrency_slot wcs_all
         WHERE
             wcs_all.key = distinct_keys.key
             AND wcs_all.tenant_id = @tenantId::uuid
-            AND wcs_all.strategy_id = @strategyId::bigint
+            AND (
+                wcs_all.strategy_id = @strategyId::bigint
+                -- strategies in the same concurrency group share their slots across workflows
+                OR wcs_all.strategy_id IN (
+                    SELECT id
+                    FROM v1_step_concurrency
+                    WHERE tenant_id = @tenantId::uuid AND concurrency_group = sqlc.narg('concurrencyGroup')::text AND parent_strategy_id IS NULL
+                )
+            )
         ORDER BY wcs_all.sort_id ASC
-        LIMIT @maxRuns::int
+        LIMIT COALESCE(kl.max_runs, @maxRuns::int)
     ) cs ON true
 ), schedule_timeout_slots AS (
     SELECT
@@ -735,3 +785,605 @@ SELECT
     'RUNNING' AS "operation"
 FROM
     updated_slots;
+
+-- name: RunParentQueueNewest :exec
+WITH eligible_running_slots AS (
+    SELECT wsc.*
+    FROM (
+        SELECT DISTINCT key
+        FROM tmp_workflow_concurrency_slot
+        WHERE
+            tenant_id = @tenantId::uuid
+            AND strategy_id = @strategyId::bigint
+    ) distinct_keys
+    JOIN LATERAL (
+        SELECT *
+        FROM tmp_workflow_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = distinct_keys.key
+            AND wcs_all.tenant_id = @tenantId::uuid
+            AND wcs_all.strategy_id = @strategyId::bigint
+        ORDER BY wcs_all.is_filled DESC, wcs_all.sort_id ASC
+        LIMIT @maxRuns::int
+    ) wsc ON true
+), slots_to_run AS (
+    SELECT
+        *
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        (strategy_id, workflow_version_id, workflow_run_id) IN (
+            SELECT
+                ers.strategy_id,
+                ers.workflow_version_id,
+                ers.workflow_run_id
+            FROM
+                eligible_running_slots ers
+        )
+    ORDER BY
+        strategy_id, workflow_version_id, workflow_run_id
+    FOR UPDATE
+), update_tmp_table AS (
+    UPDATE
+        tmp_workflow_concurrency_slot wsc
+    SET
+        is_filled = TRUE
+    FROM
+        slots_to_run
+    WHERE
+        wsc.strategy_id = slots_to_run.strategy_id AND
+        wsc.workflow_version_id = slots_to_run.workflow_version_id AND
+        wsc.workflow_run_id = slots_to_run.workflow_run_id
+)
+UPDATE
+    v1_workflow_concurrency_slot wsc
+SET
+    is_filled = TRUE
+FROM
+    slots_to_run sr
+WHERE
+    wsc.strategy_id = sr.strategy_id AND
+    wsc.workflow_version_id = sr.workflow_version_id AND
+    wsc.workflow_run_id = sr.workflow_run_id;
+
+-- name: RunQueueNewest :many
+WITH slots AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        task_retry_count,
+        cs.tenant_id,
+        cs.strategy_id,
+        cs.key,
+        cs.is_filled,
+        -- Running slots come first, followed by the queued slots in FIFO order
+        row_number() OVER (PARTITION BY cs.key ORDER BY cs.is_filled DESC, cs.sort_id ASC) AS rn,
+        COUNT(*) OVER (PARTITION BY cs.key) AS key_count
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        cs.tenant_id = @tenantId::uuid AND
+        cs.strategy_id = @strategyId::bigint AND
+        (
+            schedule_timeout_at >= NOW() OR
+            cs.is_filled = TRUE
+        )
+), schedule_timeout_slots AS (
+    SELECT
+        *
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = @tenantId::uuid AND
+        strategy_id = @strategyId::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    LIMIT 1000
+), eligible_running_slots AS (
+    SELECT
+        *
+    FROM
+        slots
+    WHERE
+        rn <= @maxRuns::int
+), overflowed_slots AS (
+    SELECT
+        *
+    FROM
+        slots
+    WHERE
+        sqlc.narg('maxQueued')::int IS NOT NULL AND
+        is_filled = FALSE AND
+        rn > @maxRuns::int AND
+        (
+            -- drop the oldest queued slots so that only the newest max_queued slots remain
+            (@queueOverflow::v1_concurrency_queue_overflow = 'DROP_OLDEST' AND rn <= key_count - sqlc.narg('maxQueued')::int) OR
+            -- reject the newest slots which don't fit in the queue
+            (@queueOverflow::v1_concurrency_queue_overflow = 'REJECT_NEWEST' AND rn - @maxRuns::int > sqlc.narg('maxQueued')::int)
+        )
+), slots_to_cancel AS (
+    SELECT
+        *
+    FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                os.task_inserted_at,
+                os.task_id,
+                os.task_retry_count,
+                os.tenant_id,
+                os.strategy_id
+            FROM
+                overflowed_slots os
+        )
+    ORDER BY
+        task_id ASC, task_inserted_at ASC
+    FOR UPDATE
+), slots_to_run AS (
+    SELECT
+        *
+    FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                ers.task_inserted_at,
+                ers.task_id,
+                ers.task_retry_count,
+                ers.tenant_id,
+                ers.strategy_id
+            FROM
+                eligible_running_slots ers
+        )
+    ORDER BY
+        task_id ASC, task_inserted_at ASC
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        slots_to_run
+    WHERE
+        v1_concurrency_slot.task_id = slots_to_run.task_id AND
+        v1_concurrency_slot.task_inserted_at = slots_to_run.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = slots_to_run.task_retry_count AND
+        v1_concurrency_slot.key = slots_to_run.key AND
+        v1_concurrency_slot.is_filled = FALSE
+    RETURNING
+        v1_concurrency_slot.*
+), deleted_slots AS (
+    DELETE FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                slots_to_cancel c
+            UNION ALL
+            SELECT
+                t.task_inserted_at,
+                t.task_id,
+                t.task_retry_count
+            FROM
+                schedule_timeout_slots t
+        )
+)
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'CANCELLED' AS "operation"
+FROM
+    slots_to_cancel
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots;
+
+-- name: RunChildQueueNewest :many
+WITH parent_slots AS (
+    SELECT
+        wcs.strategy_id,
+        wcs.workflow_version_id,
+        wcs.workflow_run_id,
+        wcs.is_filled,
+        -- Running slots come first, followed by the queued slots in FIFO order
+        row_number() OVER (PARTITION BY wcs.key ORDER BY wcs.is_filled DESC, wcs.sort_id ASC) AS rn,
+        COUNT(*) OVER (PARTITION BY wcs.key) AS key_count
+    FROM
+        tmp_workflow_concurrency_slot wcs
+), overflowed_parent_slots AS (
+    SELECT
+        *
+    FROM
+        parent_slots
+    WHERE
+        sqlc.narg('maxQueued')::int IS NOT NULL AND
+        is_filled = FALSE AND
+        rn > @maxRuns::int AND
+        (
+            (@queueOverflow::v1_concurrency_queue_overflow = 'DROP_OLDEST' AND rn <= key_count - sqlc.narg('maxQueued')::int) OR
+            (@queueOverflow::v1_concurrency_queue_overflow = 'REJECT_NEWEST' AND rn - @maxRuns::int > sqlc.narg('maxQueued')::int)
+        )
+), slots AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        task_retry_count,
+        cs.tenant_id,
+        cs.strategy_id,
+        cs.key,
+        cs.is_filled,
+        row_number() OVER (PARTITION BY cs.key ORDER BY cs.is_filled DESC, cs.sort_id ASC) AS rn
+    FROM
+        v1_concurrency_slot cs
+    JOIN
+        tmp_workflow_concurrency_slot wcs ON (wcs.strategy_id, wcs.workflow_version_id, wcs.workflow_run_id) = (cs.parent_strategy_id, cs.workflow_version_id, cs.workflow_run_id)
+    WHERE
+        cs.tenant_id = @tenantId::uuid AND
+        cs.strategy_id = @strategyId::bigint AND
+        wcs.is_filled = TRUE AND
+        (
+            schedule_timeout_at >= NOW() OR
+            cs.is_filled = TRUE
+        )
+), schedule_timeout_slots AS (
+    SELECT
+        *
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = @tenantId::uuid AND
+        strategy_id = @strategyId::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    LIMIT 1000
+), eligible_running_slots AS (
+    SELECT
+        *
+    FROM
+        slots
+    WHERE
+        rn <= @maxRuns::int
+), slots_to_cancel AS (
+    SELECT
+        cs.*
+    FROM
+        v1_concurrency_slot cs
+    JOIN
+        overflowed_parent_slots ops ON (ops.strategy_id, ops.workflow_version_id, ops.workflow_run_id) = (cs.parent_strategy_id, cs.workflow_version_id, cs.workflow_run_id)
+    WHERE
+        cs.tenant_id = @tenantId::uuid AND
+        cs.strategy_id = @strategyId::bigint AND
+        cs.is_filled = FALSE AND
+        schedule_timeout_at >= NOW()
+    ORDER BY
+        cs.task_id ASC, cs.task_inserted_at ASC
+    FOR UPDATE
+), slots_to_run AS (
+    SELECT
+        *
+    FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                ers.task_inserted_at,
+                ers.task_id,
+                ers.task_retry_count,
+                ers.tenant_id,
+                ers.strategy_id
+            FROM
+                eligible_running_slots ers
+        )
+    ORDER BY
+        task_id ASC, task_inserted_at ASC
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        slots_to_run
+    WHERE
+        v1_concurrency_slot.task_id = slots_to_run.task_id AND
+        v1_concurrency_slot.task_inserted_at = slots_to_run.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = slots_to_run.task_retry_count AND
+        v1_concurrency_slot.key = slots_to_run.key AND
+        v1_concurrency_slot.is_filled = FALSE
+    RETURNING
+        v1_concurrency_slot.*
+), deleted_slots AS (
+    DELETE FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                slots_to_cancel c
+            UNION ALL
+            SELECT
+                t.task_inserted_at,
+                t.task_id,
+                t.task_retry_count
+            FROM
+                schedule_timeout_slots t
+        )
+)
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'CANCELLED' AS "operation"
+FROM
+    slots_to_cancel
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots;
+
+-- name: UpsertConcurrencyKeyLimit :one
+INSERT INTO v1_concurrency_key_limit (
+    tenant_id,
+    workflow_id,
+    key,
+    max_runs
+) VALUES (
+    @tenantId::uuid,
+    @workflowId::uuid,
+    @key::text,
+    @maxRuns::int
+)
+ON CONFLICT (tenant_id, workflow_id, key) DO UPDATE
+SET
+    max_runs = EXCLUDED.max_runs,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteConcurrencyKeyLimit :one
+DELETE FROM
+    v1_concurrency_key_limit
+WHERE
+    tenant_id = @tenantId::uuid
+    AND workflow_id = @workflowId::uuid
+    AND key = @key::text
+RETURNING *;
+
+-- name: ListConcurrencyKeyLimits :many
+SELECT
+    kl.*,
+    w."name" AS "workflowName"
+FROM
+    v1_concurrency_key_limit kl
+JOIN
+    "Workflow" w ON w."id" = kl.workflow_id
+WHERE
+    kl.tenant_id = @tenantId::uuid
+    AND w."deletedAt" IS NULL
+ORDER BY
+    w."name" ASC,
+    kl.key ASC;
+
+-- name: ListConcurrencySlotKeys :many
+-- Lists the active keys of the concurrency strategies of a tenant, with the number of running and queued
+-- runs per key and the oldest queued workflow run. Workflow-level strategies have a NULL step_id.
+WITH workflow_keys AS (
+    SELECT
+        strategy_id,
+        key,
+        COUNT(*) FILTER (WHERE is_filled) AS running_count,
+        COUNT(*) FILTER (WHERE NOT is_filled) AS queued_count
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        tenant_id = @tenantId::uuid
+    GROUP BY
+        strategy_id, key
+), step_keys AS (
+    SELECT
+        strategy_id,
+        key,
+        COUNT(*) FILTER (WHERE is_filled) AS running_count,
+        COUNT(*) FILTER (WHERE NOT is_filled) AS queued_count
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = @tenantId::uuid
+    GROUP BY
+        strategy_id, key
+), slot_keys AS (
+    SELECT
+        wc.id AS strategy_id,
+        wc.workflow_id,
+        NULL::uuid AS step_id,
+        wc.strategy,
+        wc.expression,
+        wc.max_concurrency,
+        wk.key,
+        wk.running_count,
+        wk.queued_count,
+        wc.concurrency_group,
+        oldest.workflow_run_id AS oldest_queued_workflow_run_id,
+        oldest.inserted_at AS oldest_queued_at
+    FROM
+        workflow_keys wk
+    JOIN
+        v1_workflow_concurrency wc ON wc.id = wk.strategy_id AND wc.tenant_id = @tenantId::uuid
+    LEFT JOIN LATERAL (
+        SELECT
+            s.workflow_run_id,
+            lt.inserted_at
+        FROM
+            v1_workflow_concurrency_slot s
+        LEFT JOIN
+            v1_lookup_table lt ON lt.external_id = s.workflow_run_id
+        WHERE
+            s.tenant_id = @tenantId::uuid
+            AND s.strategy_id = wk.strategy_id
+            AND s.key = wk.key
+            AND NOT s.is_filled
+        ORDER BY
+            s.sort_id ASC
+        LIMIT 1
+    ) oldest ON TRUE
+    UNION ALL
+    SELECT
+        sc.id AS strategy_id,
+        sc.workflow_id,
+        sc.step_id,
+        sc.strategy,
+        sc.expression,
+        sc.max_concurrency,
+        sk.key,
+        sk.running_count,
+        sk.queued_count,
+        sc.concurrency_group,
+        oldest.workflow_run_id AS oldest_queued_workflow_run_id,
+        oldest.inserted_at AS oldest_queued_at
+    FROM
+        step_keys sk
+    JOIN
+        v1_step_concurrency sc ON sc.id = sk.strategy_id AND sc.tenant_id = @tenantId::uuid
+            -- the runs of child strategies are already counted by their workflow-level strategy
+            AND sc.parent_strategy_id IS NULL
+    LEFT JOIN LATERAL (
+        SELECT
+            s.workflow_run_id,
+            lt.inserted_at
+        FROM
+            v1_concurrency_slot s
+        LEFT JOIN
+            v1_lookup_table lt ON lt.external_id = s.workflow_run_id
+        WHERE
+            s.tenant_id = @tenantId::uuid
+            AND s.strategy_id = sk.strategy_id
+            AND s.key = sk.key
+            AND NOT s.is_filled
+        ORDER BY
+            s.sort_id ASC
+        LIMIT 1
+    ) oldest ON TRUE
+)
+SELECT
+    k.strategy_id,
+    k.workflow_id,
+    k.step_id,
+    k.strategy,
+    k.expression,
+    k.key,
+    k.running_count,
+    k.queued_count,
+    k.oldest_queued_workflow_run_id,
+    k.oldest_queued_at,
+    -- the max runs in effect for the key, including per-key overrides of GROUP_ROUND_ROBIN strategies
+    (CASE
+        WHEN k.strategy = 'GROUP_ROUND_ROBIN' THEN COALESCE(kl.max_runs, k.max_concurrency)
+        ELSE k.max_concurrency
+    END)::int AS max_runs,
+    w."name" AS "workflowName",
+    s."readableId" AS "stepReadableId"
+FROM
+    slot_keys k
+JOIN
+    "Workflow" w ON w."id" = k.workflow_id
+LEFT JOIN
+    "Step" s ON s."id" = k.step_id
+-- an override on any workflow in the concurrency group applies to the whole group, see RunGroupRoundRobin
+LEFT JOIN LATERAL (
+    SELECT
+        MIN(kl_all.max_runs) AS max_runs
+    FROM
+        v1_concurrency_key_limit kl_all
+    WHERE
+        kl_all.tenant_id = @tenantId::uuid
+        AND kl_all.key = k.key
+        AND (
+            kl_all.workflow_id = k.workflow_id
+            OR kl_all.workflow_id IN (
+                SELECT workflow_id FROM v1_workflow_concurrency WHERE tenant_id = @tenantId::uuid AND concurrency_group = k.concurrency_group
+                UNION ALL
+                SELECT workflow_id FROM v1_step_concurrency WHERE tenant_id = @tenantId::uuid AND concurrency_group = k.concurrency_group
+            )
+        )
+) kl ON TRUE
+WHERE
+    sqlc.narg('workflowIds')::uuid[] IS NULL
+    OR k.workflow_id = ANY(sqlc.narg('workflowIds')::uuid[])
+ORDER BY
+    w."name" ASC,
+    k.strategy_id ASC,
+    k.key ASC
+OFFSET
+    COALESCE(sqlc.narg('offset')::BIGINT, 0)
+LIMIT
+    COALESCE(sqlc.narg('limit')::BIGINT, 1000);
@@ -108,6 +108,36 @@ func (q *Queries) CheckStrategyActive(ctx context.Context, db DBTX, arg CheckStr
 	return isActive, err
 }
 
+const deleteConcurrencyKeyLimit = `-- name: DeleteConcurrencyKeyLimit :one
+DELETE FROM
+    v1_concurrency_key_limit
+WHERE
+    tenant_id = $1::uuid
+    AND workflow_id = $2::uuid
+    AND key = $3::text
+RETURNING tenant_id, workflow_id, key, max_runs, created_at, updated_at
+`
+
+type DeleteConcurrencyKeyLimitParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Key        string      `json:"key"`
+}
+
+func (q *Queries) DeleteConcurrencyKeyLimit(ctx context.Context, db DBTX, arg DeleteConcurrencyKeyLimitParams) (*V1ConcurrencyKeyLimit, error) {
+	row := db.QueryRow(ctx, deleteConcurrencyKeyLimit, arg.Tenantid, arg.Workflowid, arg.Key)
+	var i V1ConcurrencyKeyLimit
+	err := row.Scan(
+		&i.TenantID,
+		&i.WorkflowID,
+		&i.Key,
+		&i.MaxRuns,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const getWorkflowConcurrencyQueueCounts = `-- name: GetWorkflowConcurrencyQueueCounts :many
 SELECT
     w."name" AS "workflowName",
@@ -153,7 +183,7 @@ func (q *Queries) GetWorkflowConcurrencyQueueCounts(ctx context.Context, db DBTX
 
 const listActiveConcurrencyStrategies = `-- name: ListActiveConcurrencyStrategies :many
 SELECT
-    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency
+    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency, sc.max_queued, sc.queue_overflow, sc.concurrency_group
 FROM
     v1_step_concurrency sc
 JOIN
@@ -183,6 +213,280 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.MaxQueued,
+			&i.QueueOverflow,
+			&i.ConcurrencyGroup,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencyKeyLimits = `-- name: ListConcurrencyKeyLimits :many
+SELECT
+    kl.tenant_id, kl.workflow_id, kl.key, kl.max_runs, kl.created_at, kl.updated_at,
+    w."name" AS "workflowName"
+FROM
+    v1_concurrency_key_limit kl
+JOIN
+    "Workflow" w ON w."id" = kl.workflow_id
+WHERE
+    kl.tenant_id = $1::uuid
+    AND w."deletedAt" IS NULL
+ORDER BY
+    w."name" ASC,
+    kl.key ASC
+`
+
+type ListConcurrencyKeyLimitsRow struct {
+	TenantID     pgtype.UUID        `json:"tenant_id"`
+	WorkflowID   pgtype.UUID        `json:"workflow_id"`
+	Key          string             `json:"key"`
+	MaxRuns      int32              `json:"max_runs"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	WorkflowName string             `json:"workflowName"`
+}
+
+func (q *Queries) ListConcurrencyKeyLimits(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListConcurrencyKeyLimitsRow, error) {
+	rows, err := db.Query(ctx, listConcurrencyKeyLimits, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencyKeyLimitsRow
+	for rows.Next() {
+		var i ListConcurrencyKeyLimitsRow
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.WorkflowID,
+			&i.Key,
+			&i.MaxRuns,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencySlotKeys = `-- name: ListConcurrencySlotKeys :many
+WITH workflow_keys AS (
+    SELECT
+        strategy_id,
+        key,
+        COUNT(*) FILTER (WHERE is_filled) AS running_count,
+        COUNT(*) FILTER (WHERE NOT is_filled) AS queued_count
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        tenant_id = $1::uuid
+    GROUP BY
+        strategy_id, key
+), step_keys AS (
+    SELECT
+        strategy_id,
+        key,
+        COUNT(*) FILTER (WHERE is_filled) AS running_count,
+        COUNT(*) FILTER (WHERE NOT is_filled) AS queued_count
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = $1::uuid
+    GROUP BY
+        strategy_id, key
+), slot_keys AS (
+    SELECT
+        wc.id AS strategy_id,
+        wc.workflow_id,
+        NULL::uuid AS step_id,
+        wc.strategy,
+        wc.expression,
+        wc.max_concurrency,
+        wk.key,
+        wk.running_count,
+        wk.queued_count,
+        wc.concurrency_group,
+        oldest.workflow_run_id AS oldest_queued_workflow_run_id,
+        oldest.inserted_at AS oldest_queued_at
+    FROM
+        workflow_keys wk
+    JOIN
+        v1_workflow_concurrency wc ON wc.id = wk.strategy_id AND wc.tenant_id = $1::uuid
+    LEFT JOIN LATERAL (
+        SELECT
+            s.workflow_run_id,
+            lt.inserted_at
+        FROM
+            v1_workflow_concurrency_slot s
+        LEFT JOIN
+            v1_lookup_table lt ON lt.external_id = s.workflow_run_id
+        WHERE
+            s.tenant_id = $1::uuid
+            AND s.strategy_id = wk.strategy_id
+            AND s.key = wk.key
+            AND NOT s.is_filled
+        ORDER BY
+            s.sort_id ASC
+        LIMIT 1
+    ) oldest ON TRUE
+    UNION ALL
+    SELECT
+        sc.id AS strategy_id,
+        sc.workflow_id,
+        sc.step_id,
+        sc.strategy,
+        sc.expression,
+        sc.max_concurrency,
+        sk.key,
+        sk.running_count,
+        sk.queued_count,
+        sc.concurrency_group,
+        oldest.workflow_run_id AS oldest_queued_workflow_run_id,
+        oldest.inserted_at AS oldest_queued_at
+    FROM
+        step_keys sk
+    JOIN
+        v1_step_concurrency sc ON sc.id = sk.strategy_id AND sc.tenant_id = $1::uuid
+            -- the runs of child strategies are already counted by their workflow-level strategy
+            AND sc.parent_strategy_id IS NULL
+    LEFT JOIN LATERAL (
+        SELECT
+            s.workflow_run_id,
+            lt.inserted_at
+        FROM
+            v1_concurrency_slot s
+        LEFT JOIN
+            v1_lookup_table lt ON lt.external_id = s.workflow_run_id
+        WHERE
+            s.tenant_id = $1::uuid
+            AND s.strategy_id = sk.strategy_id
+            AND s.key = sk.key
+            AND NOT s.is_filled
+        ORDER BY
+            s.sort_id ASC
+        LIMIT 1
+    ) oldest ON TRUE
+)
+SELECT
+    k.strategy_id,
+    k.workflow_id,
+    k.step_id,
+    k.strategy,
+    k.expression,
+    k.key,
+    k.running_count,
+    k.queued_count,
+    k.oldest_queued_workflow_run_id,
+    k.oldest_queued_at,
+    -- the max runs in effect for the key, including per-key overrides of GROUP_ROUND_ROBIN strategies
+    (CASE
+        WHEN k.strategy = 'GROUP_ROUND_ROBIN' THEN COALESCE(kl.max_runs, k.max_concurrency)
+        ELSE k.max_concurrency
+    END)::int AS max_runs,
+    w."name" AS "workflowName",
+    s."readableId" AS "stepReadableId"
+FROM
+    slot_keys k
+JOIN
+    "Workflow" w ON w."id" = k.workflow_id
+LEFT JOIN
+    "Step" s ON s."id" = k.step_id
+-- an override on any workflow in the concurrency group applies to the whole group, see RunGroupRoundRobin
+LEFT JOIN LATERAL (
+    SELECT
+        MIN(kl_all.max_runs) AS max_runs
+    FROM
+        v1_concurrency_key_limit kl_all
+    WHERE
+        kl_all.tenant_id = $1::uuid
+        AND kl_all.key = k.key
+        AND (
+            kl_all.workflow_id = k.workflow_id
+            OR kl_all.workflow_id IN (
+                SELECT workflow_id FROM v1_workflow_concurrency WHERE tenant_id = $1::uuid AND concurrency_group = k.concurrency_group
+                UNION ALL
+                SELECT workflow_id FROM v1_step_concurrency WHERE tenant_id = $1::uuid AND concurrency_group = k.concurrency_group
+            )
+        )
+) kl ON TRUE
+WHERE
+    $2::uuid[] IS NULL
+    OR k.workflow_id = ANY($2::uuid[])
+ORDER BY
+    w."name" ASC,
+    k.strategy_id ASC,
+    k.key ASC
+OFFSET
+    COALESCE($3::BIGINT, 0)
+LIMIT
+    COALESCE($4::BIGINT, 1000)
+`
+
+type ListConcurrencySlotKeysParams struct {
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+	WorkflowIds []pgtype.UUID `json:"workflowIds"`
+	Offset      pgtype.Int8   `json:"offset"`
+	Limit       pgtype.Int8   `json:"limit"`
+}
+
+type ListConcurrencySlotKeysRow struct {
+	StrategyID                int64                 `json:"strategy_id"`
+	WorkflowID                pgtype.UUID           `json:"workflow_id"`
+	StepID                    pgtype.UUID           `json:"step_id"`
+	Strategy                  V1ConcurrencyStrategy `json:"strategy"`
+	Expression                string                `json:"expression"`
+	Key                       string                `json:"key"`
+	RunningCount              int64                 `json:"running_count"`
+	QueuedCount               int64                 `json:"queued_count"`
+	OldestQueuedWorkflowRunID pgtype.UUID           `json:"oldest_queued_workflow_run_id"`
+	OldestQueuedAt            pgtype.Timestamptz    `json:"oldest_queued_at"`
+	MaxRuns                   int32                 `json:"max_runs"`
+	WorkflowName              string                `json:"workflowName"`
+	StepReadableId            pgtype.Text           `json:"stepReadableId"`
+}
+
+// Lists the active keys of the concurrency strategies of a tenant, with the number of running and queued
+// runs per key and the oldest queued workflow run. Workflow-level strategies have a NULL step_id.
+func (q *Queries) ListConcurrencySlotKeys(ctx context.Context, db DBTX, arg ListConcurrencySlotKeysParams) ([]*ListConcurrencySlotKeysRow, error) {
+	rows, err := db.Query(ctx, listConcurrencySlotKeys,
+		arg.Tenantid,
+		arg.WorkflowIds,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencySlotKeysRow
+	for rows.Next() {
+		var i ListConcurrencySlotKeysRow
+		if err := rows.Scan(
+			&i.StrategyID,
+			&i.WorkflowID,
+			&i.StepID,
+			&i.Strategy,
+			&i.Expression,
+			&i.Key,
+			&i.RunningCount,
+			&i.QueuedCount,
+			&i.OldestQueuedWorkflowRunID,
+			&i.OldestQueuedAt,
+			&i.MaxRuns,
+			&i.WorkflowName,
+			&i.StepReadableId,
 		); err != nil {
 			return nil, err
 		}
@@ -196,7 +500,7 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 
 const listConcurrencyStrategiesByStepId = `-- name: ListConcurrencyStrategiesByStepId :many
 SELECT
-    id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, max_queued, queue_overflow, concurrency_group
 FROM
     v1_step_concurrency
 WHERE
@@ -231,6 +535,9 @@ func (q *Queries) ListConcurrencyStrategiesByStepId(ctx context.Context, db DBTX
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.MaxQueued,
+			&i.QueueOverflow,
+			&i.ConcurrencyGroup,
 		); err != nil {
 			return nil, err
 		}
@@ -670,59 +977,104 @@ func (q *Queries) RunCancelNewest(ctx context.Context, db DBTX, arg RunCancelNew
 	return items, nil
 }
 
-const runGroupRoundRobin = `-- name: RunGroupRoundRobin :many
-WITH eligible_slots_per_group AS (
-    SELECT cs.sort_id, cs.task_id, cs.task_inserted_at, cs.task_retry_count, cs.external_id, cs.tenant_id, cs.workflow_id, cs.workflow_version_id, cs.workflow_run_id, cs.strategy_id, cs.parent_strategy_id, cs.priority, cs.key, cs.is_filled, cs.next_parent_strategy_ids, cs.next_strategy_ids, cs.next_keys, cs.queue_to_notify, cs.schedule_timeout_at
-    FROM (
-        SELECT DISTINCT key
-        FROM v1_concurrency_slot
-        WHERE
-            tenant_id = $1::uuid
-            AND strategy_id = $2::bigint
-    ) distinct_keys
-    JOIN LATERAL (
-        SELECT sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
-        FROM v1_concurrency_slot wcs_all
-        WHERE
-            wcs_all.key = distinct_keys.key
-            AND wcs_all.tenant_id = $1::uuid
-            AND wcs_all.strategy_id = $2::bigint
-        ORDER BY wcs_all.sort_id ASC
-        LIMIT $3::int
-    ) cs ON true
+const runChildQueueNewest = `-- name: RunChildQueueNewest :many
+WITH parent_slots AS (
+    SELECT
+        wcs.strategy_id,
+        wcs.workflow_version_id,
+        wcs.workflow_run_id,
+        wcs.is_filled,
+        -- Running slots come first, followed by the queued slots in FIFO order
+        row_number() OVER (PARTITION BY wcs.key ORDER BY wcs.is_filled DESC, wcs.sort_id ASC) AS rn,
+        COUNT(*) OVER (PARTITION BY wcs.key) AS key_count
+    FROM
+        tmp_workflow_concurrency_slot wcs
+), overflowed_parent_slots AS (
+    SELECT
+        strategy_id, workflow_version_id, workflow_run_id, is_filled, rn, key_count
+    FROM
+        parent_slots
+    WHERE
+        $1::int IS NOT NULL AND
+        is_filled = FALSE AND
+        rn > $2::int AND
+        (
+            ($3::v1_concurrency_queue_overflow = 'DROP_OLDEST' AND rn <= key_count - $1::int) OR
+            ($3::v1_concurrency_queue_overflow = 'REJECT_NEWEST' AND rn - $2::int > $1::int)
+        )
+), slots AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        task_retry_count,
+        cs.tenant_id,
+        cs.strategy_id,
+        cs.key,
+        cs.is_filled,
+        row_number() OVER (PARTITION BY cs.key ORDER BY cs.is_filled DESC, cs.sort_id ASC) AS rn
+    FROM
+        v1_concurrency_slot cs
+    JOIN
+        tmp_workflow_concurrency_slot wcs ON (wcs.strategy_id, wcs.workflow_version_id, wcs.workflow_run_id) = (cs.parent_strategy_id, cs.workflow_version_id, cs.workflow_run_id)
+    WHERE
+        cs.tenant_id = $4::uuid AND
+        cs.strategy_id = $5::bigint AND
+        wcs.is_filled = TRUE AND
+        (
+            schedule_timeout_at >= NOW() OR
+            cs.is_filled = TRUE
+        )
 ), schedule_timeout_slots AS (
     SELECT
         sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
     FROM
         v1_concurrency_slot
     WHERE
-        tenant_id = $1::uuid AND
-        strategy_id = $2::bigint AND
+        tenant_id = $4::uuid AND
+        strategy_id = $5::bigint AND
         schedule_timeout_at < NOW() AND
         is_filled = FALSE
-    ORDER BY
-        task_id, task_inserted_at
-    FOR UPDATE
     LIMIT 1000
-), eligible_slots AS (
+), eligible_running_slots AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count, tenant_id, strategy_id, key, is_filled, rn
+    FROM
+        slots
+    WHERE
+        rn <= $2::int
+), slots_to_cancel AS (
     SELECT
         cs.sort_id, cs.task_id, cs.task_inserted_at, cs.task_retry_count, cs.external_id, cs.tenant_id, cs.workflow_id, cs.workflow_version_id, cs.workflow_run_id, cs.strategy_id, cs.parent_strategy_id, cs.priority, cs.key, cs.is_filled, cs.next_parent_strategy_ids, cs.next_strategy_ids, cs.next_keys, cs.queue_to_notify, cs.schedule_timeout_at
     FROM
         v1_concurrency_slot cs
+    JOIN
+        overflowed_parent_slots ops ON (ops.strategy_id, ops.workflow_version_id, ops.workflow_run_id) = (cs.parent_strategy_id, cs.workflow_version_id, cs.workflow_run_id)
+    WHERE
+        cs.tenant_id = $4::uuid AND
+        cs.strategy_id = $5::bigint AND
+        cs.is_filled = FALSE AND
+        schedule_timeout_at >= NOW()
+    ORDER BY
+        cs.task_id ASC, cs.task_inserted_at ASC
+    FOR UPDATE
+), slots_to_run AS (
+    SELECT
+        sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v1_concurrency_slot
     WHERE
         (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
             SELECT
-                es.task_inserted_at,
-                es.task_id,
-                es.task_retry_count,
-                es.tenant_id,
-                es.strategy_id
+                ers.task_inserted_at,
+                ers.task_id,
+                ers.task_retry_count,
+                ers.tenant_id,
+                ers.strategy_id
             FROM
-                eligible_slots_per_group es
+                eligible_running_slots ers
         )
-        AND is_filled = FALSE
     ORDER BY
-        task_id, task_inserted_at
+        task_id ASC, task_inserted_at ASC
     FOR UPDATE
 ), updated_slots AS (
     UPDATE
@@ -730,14 +1082,13 @@ WITH eligible_slots_per_group AS (
     SET
         is_filled = TRUE
     FROM
-        eligible_slots
+        slots_to_run
     WHERE
-        v1_concurrency_slot.task_id = eligible_slots.task_id AND
-        v1_concurrency_slot.task_inserted_at = eligible_slots.task_inserted_at AND
-        v1_concurrency_slot.task_retry_count = eligible_slots.task_retry_count AND
-        v1_concurrency_slot.tenant_id = eligible_slots.tenant_id AND
-        v1_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
-        v1_concurrency_slot.key = eligible_slots.key
+        v1_concurrency_slot.task_id = slots_to_run.task_id AND
+        v1_concurrency_slot.task_inserted_at = slots_to_run.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = slots_to_run.task_retry_count AND
+        v1_concurrency_slot.key = slots_to_run.key AND
+        v1_concurrency_slot.is_filled = FALSE
     RETURNING
         v1_concurrency_slot.sort_id, v1_concurrency_slot.task_id, v1_concurrency_slot.task_inserted_at, v1_concurrency_slot.task_retry_count, v1_concurrency_slot.external_id, v1_concurrency_slot.tenant_id, v1_concurrency_slot.workflow_id, v1_concurrency_slot.workflow_version_id, v1_concurrency_slot.workflow_run_id, v1_concurrency_slot.strategy_id, v1_concurrency_slot.parent_strategy_id, v1_concurrency_slot.priority, v1_concurrency_slot.key, v1_concurrency_slot.is_filled, v1_concurrency_slot.next_parent_strategy_ids, v1_concurrency_slot.next_strategy_ids, v1_concurrency_slot.next_keys, v1_concurrency_slot.queue_to_notify, v1_concurrency_slot.schedule_timeout_at
 ), deleted_slots AS (
@@ -750,7 +1101,14 @@ WITH eligible_slots_per_group AS (
                 c.task_id,
                 c.task_retry_count
             FROM
-                schedule_timeout_slots c
+                slots_to_cancel c
+            UNION ALL
+            SELECT
+                t.task_inserted_at,
+                t.task_id,
+                t.task_retry_count
+            FROM
+                schedule_timeout_slots t
         )
 )
 SELECT
@@ -758,6 +1116,7 @@ SELECT
     task_inserted_at,
     task_retry_count,
     tenant_id,
+    key,
     next_strategy_ids,
     external_id,
     workflow_run_id,
@@ -771,6 +1130,21 @@ SELECT
     task_inserted_at,
     task_retry_count,
     tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'CANCELLED' AS "operation"
+FROM
+    slots_to_cancel
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
     next_strategy_ids,
     external_id,
     workflow_run_id,
@@ -780,17 +1154,20 @@ FROM
     updated_slots
 `
 
-type RunGroupRoundRobinParams struct {
-	Tenantid   pgtype.UUID `json:"tenantid"`
-	Strategyid int64       `json:"strategyid"`
-	Maxruns    int32       `json:"maxruns"`
+type RunChildQueueNewestParams struct {
+	MaxQueued     pgtype.Int4                `json:"maxQueued"`
+	Maxruns       int32                      `json:"maxruns"`
+	Queueoverflow V1ConcurrencyQueueOverflow `json:"queueoverflow"`
+	Tenantid      pgtype.UUID                `json:"tenantid"`
+	Strategyid    int64                      `json:"strategyid"`
 }
 
-type RunGroupRoundRobinRow struct {
+type RunChildQueueNewestRow struct {
 	TaskID          int64              `json:"task_id"`
 	TaskInsertedAt  pgtype.Timestamptz `json:"task_inserted_at"`
 	TaskRetryCount  int32              `json:"task_retry_count"`
 	TenantID        pgtype.UUID        `json:"tenant_id"`
+	Key             string             `json:"key"`
 	NextStrategyIds []int64            `json:"next_strategy_ids"`
 	ExternalID      pgtype.UUID        `json:"external_id"`
 	WorkflowRunID   pgtype.UUID        `json:"workflow_run_id"`
@@ -798,21 +1175,27 @@ type RunGroupRoundRobinRow struct {
 	Operation       string             `json:"operation"`
 }
 
-// Used for round-robin scheduling when a strategy doesn't have a parent strategy
-func (q *Queries) RunGroupRoundRobin(ctx context.Context, db DBTX, arg RunGroupRoundRobinParams) ([]*RunGroupRoundRobinRow, error) {
-	rows, err := db.Query(ctx, runGroupRoundRobin, arg.Tenantid, arg.Strategyid, arg.Maxruns)
+func (q *Queries) RunChildQueueNewest(ctx context.Context, db DBTX, arg RunChildQueueNewestParams) ([]*RunChildQueueNewestRow, error) {
+	rows, err := db.Query(ctx, runChildQueueNewest,
+		arg.MaxQueued,
+		arg.Maxruns,
+		arg.Queueoverflow,
+		arg.Tenantid,
+		arg.Strategyid,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*RunGroupRoundRobinRow
+	var items []*RunChildQueueNewestRow
 	for rows.Next() {
-		var i RunGroupRoundRobinRow
+		var i RunChildQueueNewestRow
 		if err := rows.Scan(
 			&i.TaskID,
 			&i.TaskInsertedAt,
 			&i.TaskRetryCount,
 			&i.TenantID,
+			&i.Key,
 			&i.NextStrategyIds,
 			&i.ExternalID,
 			&i.WorkflowRunID,
@@ -829,41 +1212,233 @@ func (q *Queries) RunGroupRoundRobin(ctx context.Context, db DBTX, arg RunGroupR
 	return items, nil
 }
 
-const runParentCancelInProgress = `-- name: RunParentCancelInProgress :exec
-WITH eligible_running_slots AS (
-    SELECT wsc.sort_id, wsc.tenant_id, wsc.workflow_id, wsc.workflow_version_id, wsc.workflow_run_id, wsc.strategy_id, wsc.completed_child_strategy_ids, wsc.child_strategy_ids, wsc.priority, wsc.key, wsc.is_filled
+const runGroupRoundRobin = `-- name: RunGroupRoundRobin :many
+WITH eligible_slots_per_group AS (
+    SELECT cs.sort_id, cs.task_id, cs.task_inserted_at, cs.task_retry_count, cs.external_id, cs.tenant_id, cs.workflow_id, cs.workflow_version_id, cs.workflow_run_id, cs.strategy_id, cs.parent_strategy_id, cs.priority, cs.key, cs.is_filled, cs.next_parent_strategy_ids, cs.next_strategy_ids, cs.next_keys, cs.queue_to_notify, cs.schedule_timeout_at
     FROM (
         SELECT DISTINCT key
-        FROM tmp_workflow_concurrency_slot
+        FROM v1_concurrency_slot
         WHERE
             tenant_id = $1::uuid
             AND strategy_id = $2::bigint
     ) distinct_keys
+    -- per-key overrides of the max runs, see v1_concurrency_key_limit. An override on any workflow in the
+    -- concurrency group applies to the whole group, and the lowest override wins.
+    LEFT JOIN LATERAL (
+        SELECT MIN(kl_all.max_runs) AS max_runs
+        FROM v1_concurrency_key_limit kl_all
+        WHERE
+            kl_all.tenant_id = $1::uuid
+            AND kl_all.key = distinct_keys.key
+            AND (
+                kl_all.workflow_id = $3::uuid
+                OR kl_all.workflow_id IN (
+                    SELECT workflow_id
+                    FROM v1_step_concurrency
+                    WHERE tenant_id = $1::uuid AND concurrency_group = $4::text AND parent_strategy_id IS NULL
+                )
+            )
+    ) kl ON true
     JOIN LATERAL (
-        SELECT sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
-        FROM tmp_workflow_concurrency_slot wcs_all
+        SELECT sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+        FROM v1_concurrency_slot wcs_all
         WHERE
             wcs_all.key = distinct_keys.key
             AND wcs_all.tenant_id = $1::uuid
-            AND wcs_all.strategy_id = $2::bigint
-        ORDER BY wcs_all.sort_id DESC
-        LIMIT $3::int
-    ) wsc ON true
-), slots_to_run AS (
+            AND (
+                wcs_all.strategy_id = $2::bigint
+                -- strategies in the same concurrency group share their slots across workflows
+                OR wcs_all.strategy_id IN (
+                    SELECT id
+                    FROM v1_step_concurrency
+                    WHERE tenant_id = $1::uuid AND concurrency_group = $4::text AND parent_strategy_id IS NULL
+                )
+            )
+        ORDER BY wcs_all.sort_id ASC
+        LIMIT COALESCE(kl.max_runs, $5::int)
+    ) cs ON true
+), schedule_timeout_slots AS (
     SELECT
-        sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+        sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
     FROM
-        v1_workflow_concurrency_slot
+        v1_concurrency_slot
     WHERE
-        (strategy_id, workflow_version_id, workflow_run_id) IN (
-            SELECT
-                ers.strategy_id,
-                ers.workflow_version_id,
-                ers.workflow_run_id
-            FROM
-                eligible_running_slots ers
-        )
-    ORDER BY
+        tenant_id = $1::uuid AND
+        strategy_id = $2::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+    LIMIT 1000
+), eligible_slots AS (
+    SELECT
+        cs.sort_id, cs.task_id, cs.task_inserted_at, cs.task_retry_count, cs.external_id, cs.tenant_id, cs.workflow_id, cs.workflow_version_id, cs.workflow_run_id, cs.strategy_id, cs.parent_strategy_id, cs.priority, cs.key, cs.is_filled, cs.next_parent_strategy_ids, cs.next_strategy_ids, cs.next_keys, cs.queue_to_notify, cs.schedule_timeout_at
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                es.task_inserted_at,
+                es.task_id,
+                es.task_retry_count,
+                es.tenant_id,
+                es.strategy_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        eligible_slots
+    WHERE
+        v1_concurrency_slot.task_id = eligible_slots.task_id AND
+        v1_concurrency_slot.task_inserted_at = eligible_slots.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = eligible_slots.task_retry_count AND
+        v1_concurrency_slot.tenant_id = eligible_slots.tenant_id AND
+        v1_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+        v1_concurrency_slot.key = eligible_slots.key
+    RETURNING
+        v1_concurrency_slot.sort_id, v1_concurrency_slot.task_id, v1_concurrency_slot.task_inserted_at, v1_concurrency_slot.task_retry_count, v1_concurrency_slot.external_id, v1_concurrency_slot.tenant_id, v1_concurrency_slot.workflow_id, v1_concurrency_slot.workflow_version_id, v1_concurrency_slot.workflow_run_id, v1_concurrency_slot.strategy_id, v1_concurrency_slot.parent_strategy_id, v1_concurrency_slot.priority, v1_concurrency_slot.key, v1_concurrency_slot.is_filled, v1_concurrency_slot.next_parent_strategy_ids, v1_concurrency_slot.next_strategy_ids, v1_concurrency_slot.next_keys, v1_concurrency_slot.queue_to_notify, v1_concurrency_slot.schedule_timeout_at
+), deleted_slots AS (
+    DELETE FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                schedule_timeout_slots c
+        )
+)
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots
+`
+
+type RunGroupRoundRobinParams struct {
+	Tenantid         pgtype.UUID `json:"tenantid"`
+	Strategyid       int64       `json:"strategyid"`
+	Workflowid       pgtype.UUID `json:"workflowid"`
+	ConcurrencyGroup pgtype.Text `json:"concurrencyGroup"`
+	Maxruns          int32       `json:"maxruns"`
+}
+
+type RunGroupRoundRobinRow struct {
+	TaskID          int64              `json:"task_id"`
+	TaskInsertedAt  pgtype.Timestamptz `json:"task_inserted_at"`
+	TaskRetryCount  int32              `json:"task_retry_count"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	NextStrategyIds []int64            `json:"next_strategy_ids"`
+	ExternalID      pgtype.UUID        `json:"external_id"`
+	WorkflowRunID   pgtype.UUID        `json:"workflow_run_id"`
+	QueueToNotify   string             `json:"queue_to_notify"`
+	Operation       string             `json:"operation"`
+}
+
+// Used for round-robin scheduling when a strategy doesn't have a parent strategy
+func (q *Queries) RunGroupRoundRobin(ctx context.Context, db DBTX, arg RunGroupRoundRobinParams) ([]*RunGroupRoundRobinRow, error) {
+	rows, err := db.Query(ctx, runGroupRoundRobin,
+		arg.Tenantid,
+		arg.Strategyid,
+		arg.Workflowid,
+		arg.ConcurrencyGroup,
+		arg.Maxruns,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RunGroupRoundRobinRow
+	for rows.Next() {
+		var i RunGroupRoundRobinRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.TaskRetryCount,
+			&i.TenantID,
+			&i.NextStrategyIds,
+			&i.ExternalID,
+			&i.WorkflowRunID,
+			&i.QueueToNotify,
+			&i.Operation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const runParentCancelInProgress = `-- name: RunParentCancelInProgress :exec
+WITH eligible_running_slots AS (
+    SELECT wsc.sort_id, wsc.tenant_id, wsc.workflow_id, wsc.workflow_version_id, wsc.workflow_run_id, wsc.strategy_id, wsc.completed_child_strategy_ids, wsc.child_strategy_ids, wsc.priority, wsc.key, wsc.is_filled
+    FROM (
+        SELECT DISTINCT key
+        FROM tmp_workflow_concurrency_slot
+        WHERE
+            tenant_id = $1::uuid
+            AND strategy_id = $2::bigint
+    ) distinct_keys
+    JOIN LATERAL (
+        SELECT sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+        FROM tmp_workflow_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = distinct_keys.key
+            AND wcs_all.tenant_id = $1::uuid
+            AND wcs_all.strategy_id = $2::bigint
+        ORDER BY wcs_all.sort_id DESC
+        LIMIT $3::int
+    ) wsc ON true
+), slots_to_run AS (
+    SELECT
+        sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        (strategy_id, workflow_version_id, workflow_run_id) IN (
+            SELECT
+                ers.strategy_id,
+                ers.workflow_version_id,
+                ers.workflow_run_id
+            FROM
+                eligible_running_slots ers
+        )
+    ORDER BY
         strategy_id, workflow_version_id, workflow_run_id
     FOR UPDATE
 ), update_tmp_table AS (
@@ -983,15 +1558,40 @@ WITH eligible_slots_per_group AS (
             tenant_id = $1::uuid
             AND strategy_id = $2::bigint
     ) distinct_keys
+    -- per-key overrides of the max runs, see v1_concurrency_key_limit. An override on any workflow in the
+    -- concurrency group applies to the whole group, and the lowest override wins.
+    LEFT JOIN LATERAL (
+        SELECT MIN(kl_all.max_runs) AS max_runs
+        FROM v1_concurrency_key_limit kl_all
+        WHERE
+            kl_all.tenant_id = $1::uuid
+            AND kl_all.key = distinct_keys.key
+            AND (
+                kl_all.workflow_id = $3::uuid
+                OR kl_all.workflow_id IN (
+                    SELECT workflow_id
+                    FROM v1_workflow_concurrency
+                    WHERE tenant_id = $1::uuid AND concurrency_group = $4::text
+                )
+            )
+    ) kl ON true
     JOIN LATERAL (
         SELECT sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
         FROM v1_workflow_concurrency_slot wcs_all
         WHERE
             wcs_all.key = distinct_keys.key
             AND wcs_all.tenant_id = $1::uuid
-            AND wcs_all.strategy_id = $2::bigint
+            AND (
+                wcs_all.strategy_id = $2::bigint
+                -- strategies in the same concurrency group share their slots across workflows
+                OR wcs_all.strategy_id IN (
+                    SELECT id
+                    FROM v1_workflow_concurrency
+                    WHERE tenant_id = $1::uuid AND concurrency_group = $4::text
+                )
+            )
         ORDER BY wcs_all.priority DESC, wcs_all.sort_id ASC
-        LIMIT $3::int
+        LIMIT COALESCE(kl.max_runs, $5::int)
     ) wsc ON true
 ), eligible_slots AS (
     SELECT
@@ -1025,16 +1625,325 @@ WHERE
 `
 
 type RunParentGroupRoundRobinParams struct {
+	Tenantid         pgtype.UUID `json:"tenantid"`
+	Strategyid       int64       `json:"strategyid"`
+	Workflowid       pgtype.UUID `json:"workflowid"`
+	ConcurrencyGroup pgtype.Text `json:"concurrencyGroup"`
+	Maxruns          int32       `json:"maxruns"`
+}
+
+func (q *Queries) RunParentGroupRoundRobin(ctx context.Context, db DBTX, arg RunParentGroupRoundRobinParams) error {
+	_, err := db.Exec(ctx, runParentGroupRoundRobin,
+		arg.Tenantid,
+		arg.Strategyid,
+		arg.Workflowid,
+		arg.ConcurrencyGroup,
+		arg.Maxruns,
+	)
+	return err
+}
+
+const runParentQueueNewest = `-- name: RunParentQueueNewest :exec
+WITH eligible_running_slots AS (
+    SELECT wsc.sort_id, wsc.tenant_id, wsc.workflow_id, wsc.workflow_version_id, wsc.workflow_run_id, wsc.strategy_id, wsc.completed_child_strategy_ids, wsc.child_strategy_ids, wsc.priority, wsc.key, wsc.is_filled
+    FROM (
+        SELECT DISTINCT key
+        FROM tmp_workflow_concurrency_slot
+        WHERE
+            tenant_id = $1::uuid
+            AND strategy_id = $2::bigint
+    ) distinct_keys
+    JOIN LATERAL (
+        SELECT sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+        FROM tmp_workflow_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = distinct_keys.key
+            AND wcs_all.tenant_id = $1::uuid
+            AND wcs_all.strategy_id = $2::bigint
+        ORDER BY wcs_all.is_filled DESC, wcs_all.sort_id ASC
+        LIMIT $3::int
+    ) wsc ON true
+), slots_to_run AS (
+    SELECT
+        sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        (strategy_id, workflow_version_id, workflow_run_id) IN (
+            SELECT
+                ers.strategy_id,
+                ers.workflow_version_id,
+                ers.workflow_run_id
+            FROM
+                eligible_running_slots ers
+        )
+    ORDER BY
+        strategy_id, workflow_version_id, workflow_run_id
+    FOR UPDATE
+), update_tmp_table AS (
+    UPDATE
+        tmp_workflow_concurrency_slot wsc
+    SET
+        is_filled = TRUE
+    FROM
+        slots_to_run
+    WHERE
+        wsc.strategy_id = slots_to_run.strategy_id AND
+        wsc.workflow_version_id = slots_to_run.workflow_version_id AND
+        wsc.workflow_run_id = slots_to_run.workflow_run_id
+)
+UPDATE
+    v1_workflow_concurrency_slot wsc
+SET
+    is_filled = TRUE
+FROM
+    slots_to_run sr
+WHERE
+    wsc.strategy_id = sr.strategy_id AND
+    wsc.workflow_version_id = sr.workflow_version_id AND
+    wsc.workflow_run_id = sr.workflow_run_id
+`
+
+type RunParentQueueNewestParams struct {
 	Tenantid   pgtype.UUID `json:"tenantid"`
 	Strategyid int64       `json:"strategyid"`
 	Maxruns    int32       `json:"maxruns"`
 }
 
-func (q *Queries) RunParentGroupRoundRobin(ctx context.Context, db DBTX, arg RunParentGroupRoundRobinParams) error {
-	_, err := db.Exec(ctx, runParentGroupRoundRobin, arg.Tenantid, arg.Strategyid, arg.Maxruns)
+func (q *Queries) RunParentQueueNewest(ctx context.Context, db DBTX, arg RunParentQueueNewestParams) error {
+	_, err := db.Exec(ctx, runParentQueueNewest, arg.Tenantid, arg.Strategyid, arg.Maxruns)
 	return err
 }
 
+const runQueueNewest = `-- name: RunQueueNewest :many
+WITH slots AS (
+    SELECT
+        task_id,
+        task_inserted_at,
+        task_retry_count,
+        cs.tenant_id,
+        cs.strategy_id,
+        cs.key,
+        cs.is_filled,
+        -- Running slots come first, followed by the queued slots in FIFO order
+        row_number() OVER (PARTITION BY cs.key ORDER BY cs.is_filled DESC, cs.sort_id ASC) AS rn,
+        COUNT(*) OVER (PARTITION BY cs.key) AS key_count
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        cs.tenant_id = $1::uuid AND
+        cs.strategy_id = $2::bigint AND
+        (
+            schedule_timeout_at >= NOW() OR
+            cs.is_filled = TRUE
+        )
+), schedule_timeout_slots AS (
+    SELECT
+        sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = $1::uuid AND
+        strategy_id = $2::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    LIMIT 1000
+), eligible_running_slots AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count, tenant_id, strategy_id, key, is_filled, rn, key_count
+    FROM
+        slots
+    WHERE
+        rn <= $3::int
+), overflowed_slots AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count, tenant_id, strategy_id, key, is_filled, rn, key_count
+    FROM
+        slots
+    WHERE
+        $4::int IS NOT NULL AND
+        is_filled = FALSE AND
+        rn > $3::int AND
+        (
+            -- drop the oldest queued slots so that only the newest max_queued slots remain
+            ($5::v1_concurrency_queue_overflow = 'DROP_OLDEST' AND rn <= key_count - $4::int) OR
+            -- reject the newest slots which don't fit in the queue
+            ($5::v1_concurrency_queue_overflow = 'REJECT_NEWEST' AND rn - $3::int > $4::int)
+        )
+), slots_to_cancel AS (
+    SELECT
+        sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                os.task_inserted_at,
+                os.task_id,
+                os.task_retry_count,
+                os.tenant_id,
+                os.strategy_id
+            FROM
+                overflowed_slots os
+        )
+    ORDER BY
+        task_id ASC, task_inserted_at ASC
+    FOR UPDATE
+), slots_to_run AS (
+    SELECT
+        sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                ers.task_inserted_at,
+                ers.task_id,
+                ers.task_retry_count,
+                ers.tenant_id,
+                ers.strategy_id
+            FROM
+                eligible_running_slots ers
+        )
+    ORDER BY
+        task_id ASC, task_inserted_at ASC
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        slots_to_run
+    WHERE
+        v1_concurrency_slot.task_id = slots_to_run.task_id AND
+        v1_concurrency_slot.task_inserted_at = slots_to_run.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = slots_to_run.task_retry_count AND
+        v1_concurrency_slot.key = slots_to_run.key AND
+        v1_concurrency_slot.is_filled = FALSE
+    RETURNING
+        v1_concurrency_slot.sort_id, v1_concurrency_slot.task_id, v1_concurrency_slot.task_inserted_at, v1_concurrency_slot.task_retry_count, v1_concurrency_slot.external_id, v1_concurrency_slot.tenant_id, v1_concurrency_slot.workflow_id, v1_concurrency_slot.workflow_version_id, v1_concurrency_slot.workflow_run_id, v1_concurrency_slot.strategy_id, v1_concurrency_slot.parent_strategy_id, v1_concurrency_slot.priority, v1_concurrency_slot.key, v1_concurrency_slot.is_filled, v1_concurrency_slot.next_parent_strategy_ids, v1_concurrency_slot.next_strategy_ids, v1_concurrency_slot.next_keys, v1_concurrency_slot.queue_to_notify, v1_concurrency_slot.schedule_timeout_at
+), deleted_slots AS (
+    DELETE FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                slots_to_cancel c
+            UNION ALL
+            SELECT
+                t.task_inserted_at,
+                t.task_id,
+                t.task_retry_count
+            FROM
+                schedule_timeout_slots t
+        )
+)
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'CANCELLED' AS "operation"
+FROM
+    slots_to_cancel
+UNION ALL
+SELECT
+    task_id,
+    task_inserted_at,
+    task_retry_count,
+    tenant_id,
+    key,
+    next_strategy_ids,
+    external_id,
+    workflow_run_id,
+    queue_to_notify,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots
+`
+
+type RunQueueNewestParams struct {
+	Tenantid      pgtype.UUID                `json:"tenantid"`
+	Strategyid    int64                      `json:"strategyid"`
+	Maxruns       int32                      `json:"maxruns"`
+	MaxQueued     pgtype.Int4                `json:"maxQueued"`
+	Queueoverflow V1ConcurrencyQueueOverflow `json:"queueoverflow"`
+}
+
+type RunQueueNewestRow struct {
+	TaskID          int64              `json:"task_id"`
+	TaskInsertedAt  pgtype.Timestamptz `json:"task_inserted_at"`
+	TaskRetryCount  int32              `json:"task_retry_count"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	Key             string             `json:"key"`
+	NextStrategyIds []int64            `json:"next_strategy_ids"`
+	ExternalID      pgtype.UUID        `json:"external_id"`
+	WorkflowRunID   pgtype.UUID        `json:"workflow_run_id"`
+	QueueToNotify   string             `json:"queue_to_notify"`
+	Operation       string             `json:"operation"`
+}
+
+func (q *Queries) RunQueueNewest(ctx context.Context, db DBTX, arg RunQueueNewestParams) ([]*RunQueueNewestRow, error) {
+	rows, err := db.Query(ctx, runQueueNewest,
+		arg.Tenantid,
+		arg.Strategyid,
+		arg.Maxruns,
+		arg.MaxQueued,
+		arg.Queueoverflow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RunQueueNewestRow
+	for rows.Next() {
+		var i RunQueueNewestRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.TaskRetryCount,
+			&i.TenantID,
+			&i.Key,
+			&i.NextStrategyIds,
+			&i.ExternalID,
+			&i.WorkflowRunID,
+			&i.QueueToNotify,
+			&i.Operation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setConcurrencyStrategyInactive = `-- name: SetConcurrencyStrategyInactive :exec
 UPDATE
     v1_step_concurrency
@@ -1074,3 +1983,48 @@ func (q *Queries) TryAdvisoryLock(ctx context.Context, db DBTX, key int64) (bool
 	err := row.Scan(&locked)
 	return locked, err
 }
+
+const upsertConcurrencyKeyLimit = `-- name: UpsertConcurrencyKeyLimit :one
+INSERT INTO v1_concurrency_key_limit (
+    tenant_id,
+    workflow_id,
+    key,
+    max_runs
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::text,
+    $4::int
+)
+ON CONFLICT (tenant_id, workflow_id, key) DO UPDATE
+SET
+    max_runs = EXCLUDED.max_runs,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING tenant_id, workflow_id, key, max_runs, created_at, updated_at
+`
+
+type UpsertConcurrencyKeyLimitParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Key        string      `json:"key"`
+	Maxruns    int32       `json:"maxruns"`
+}
+
+func (q *Queries) UpsertConcurrencyKeyLimit(ctx context.Context, db DBTX, arg UpsertConcurrencyKeyLimitParams) (*V1ConcurrencyKeyLimit, error) {
+	row := db.QueryRow(ctx, upsertConcurrencyKeyLimit,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Key,
+		arg.Maxruns,
+	)
+	var i V1ConcurrencyKeyLimit
+	err := row.Scan(
+		&i.TenantID,
+		&i.WorkflowID,
+		&i.Key,
+		&i.MaxRuns,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
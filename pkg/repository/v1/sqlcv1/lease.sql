@@ -51,7 +51,8 @@ RETURNING l.*;
 -- name: ListActiveWorkers :many
 SELECT
     w."id",
-    w."maxRuns"
+    w."maxRuns",
+    w."drainingAt"
 FROM
     "Worker" w
 WHERE
@@ -60,3 +61,13 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false;
+
+-- name: ListWorkerResourceCapacities :many
+SELECT
+    worker_id,
+    key,
+    capacity
+FROM
+    v1_worker_resource
+WHERE
+    worker_id = ANY(@workerIds::uuid[]);
@@ -106,7 +106,8 @@ func (q *Queries) GetLeasesToAcquire(ctx context.Context, db DBTX, arg GetLeases
 const listActiveWorkers = `-- name: ListActiveWorkers :many
 SELECT
     w."id",
-    w."maxRuns"
+    w."maxRuns",
+    w."drainingAt"
 FROM
     "Worker" w
 WHERE
@@ -118,8 +119,9 @@ WHERE
 `
 
 type ListActiveWorkersRow struct {
-	ID      pgtype.UUID `json:"id"`
-	MaxRuns int32       `json:"maxRuns"`
+	ID         pgtype.UUID      `json:"id"`
+	MaxRuns    int32            `json:"maxRuns"`
+	DrainingAt pgtype.Timestamp `json:"drainingAt"`
 }
 
 func (q *Queries) ListActiveWorkers(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListActiveWorkersRow, error) {
@@ -131,7 +133,44 @@ func (q *Queries) ListActiveWorkers(ctx context.Context, db DBTX, tenantid pgtyp
 	var items []*ListActiveWorkersRow
 	for rows.Next() {
 		var i ListActiveWorkersRow
-		if err := rows.Scan(&i.ID, &i.MaxRuns); err != nil {
+		if err := rows.Scan(&i.ID, &i.MaxRuns, &i.DrainingAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkerResourceCapacities = `-- name: ListWorkerResourceCapacities :many
+SELECT
+    worker_id,
+    key,
+    capacity
+FROM
+    v1_worker_resource
+WHERE
+    worker_id = ANY($1::uuid[])
+`
+
+type ListWorkerResourceCapacitiesRow struct {
+	WorkerID pgtype.UUID `json:"worker_id"`
+	Key      string      `json:"key"`
+	Capacity int32       `json:"capacity"`
+}
+
+func (q *Queries) ListWorkerResourceCapacities(ctx context.Context, db DBTX, workerids []pgtype.UUID) ([]*ListWorkerResourceCapacitiesRow, error) {
+	rows, err := db.Query(ctx, listWorkerResourceCapacities, workerids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkerResourceCapacitiesRow
+	for rows.Next() {
+		var i ListWorkerResourceCapacitiesRow
+		if err := rows.Scan(&i.WorkerID, &i.Key, &i.Capacity); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -295,7 +295,9 @@ WITH match_counts AS (
         COUNT(DISTINCT CASE WHEN action = 'SKIP' THEN or_group_id END) AS total_skip_groups,
         COUNT(DISTINCT CASE WHEN is_satisfied AND action = 'SKIP' THEN or_group_id END) AS satisfied_skip_groups,
         COUNT(DISTINCT CASE WHEN action = 'CREATE_MATCH' THEN or_group_id END) AS total_create_match_groups,
-        COUNT(DISTINCT CASE WHEN is_satisfied AND action = 'CREATE_MATCH' THEN or_group_id END) AS satisfied_create_match_groups
+        COUNT(DISTINCT CASE WHEN is_satisfied AND action = 'CREATE_MATCH' THEN or_group_id END) AS satisfied_create_match_groups,
+        COUNT(DISTINCT CASE WHEN action = 'FAIL' THEN or_group_id END) AS total_fail_groups,
+        COUNT(DISTINCT CASE WHEN is_satisfied AND action = 'FAIL' THEN or_group_id END) AS satisfied_fail_groups
     FROM v1_match_condition main
     WHERE v1_match_id = ANY($1::bigint[])
     GROUP BY v1_match_id
@@ -305,6 +307,7 @@ WITH match_counts AS (
         CASE WHEN
             (mc.total_skip_groups > 0 AND mc.total_skip_groups = mc.satisfied_skip_groups) THEN 'SKIP'
             WHEN (mc.total_cancel_groups > 0 AND mc.total_cancel_groups = mc.satisfied_cancel_groups) THEN 'CANCEL'
+            WHEN (mc.total_fail_groups > 0 AND mc.total_fail_groups = mc.satisfied_fail_groups) THEN 'FAIL'
             WHEN (mc.total_create_groups > 0 AND mc.total_create_groups = mc.satisfied_create_groups) THEN 'CREATE'
             WHEN (mc.total_queue_groups > 0 AND mc.total_queue_groups = mc.satisfied_queue_groups) THEN 'QUEUE'
             WHEN (mc.total_create_match_groups > 0 AND mc.total_create_match_groups = mc.satisfied_create_match_groups) THEN 'CREATE_MATCH'
@@ -320,6 +323,7 @@ WITH match_counts AS (
             OR (mc.total_cancel_groups > 0 AND mc.total_cancel_groups = mc.satisfied_cancel_groups)
             OR (mc.total_skip_groups > 0 AND mc.total_skip_groups = mc.satisfied_skip_groups)
             OR (mc.total_create_match_groups > 0 AND mc.total_create_match_groups = mc.satisfied_create_match_groups)
+            OR (mc.total_fail_groups > 0 AND mc.total_fail_groups = mc.satisfied_fail_groups)
         )
 ), locked_conditions AS (
     SELECT
@@ -408,6 +408,50 @@ func (ns NullMessageQueueItemStatus) Value() (driver.Value, error) {
 	return string(ns.MessageQueueItemStatus), nil
 }
 
+type RateLimitAlgorithm string
+
+const (
+	RateLimitAlgorithmFIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	RateLimitAlgorithmSLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+	RateLimitAlgorithmTOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+	RateLimitAlgorithmSEMAPHORE     RateLimitAlgorithm = "SEMAPHORE"
+)
+
+func (e *RateLimitAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitAlgorithm(s)
+	case string:
+		*e = RateLimitAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitAlgorithm struct {
+	RateLimitAlgorithm RateLimitAlgorithm `json:"RateLimitAlgorithm"`
+	Valid              bool               `json:"valid"` // Valid is true if RateLimitAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitAlgorithm), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -415,6 +459,7 @@ const (
 	StepExpressionKindDYNAMICRATELIMITVALUE  StepExpressionKind = "DYNAMIC_RATE_LIMIT_VALUE"
 	StepExpressionKindDYNAMICRATELIMITUNITS  StepExpressionKind = "DYNAMIC_RATE_LIMIT_UNITS"
 	StepExpressionKindDYNAMICRATELIMITWINDOW StepExpressionKind = "DYNAMIC_RATE_LIMIT_WINDOW"
+	StepExpressionKindFAIRNESSKEY            StepExpressionKind = "FAIRNESS_KEY"
 )
 
 func (e *StepExpressionKind) Scan(src interface{}) error {
@@ -856,6 +901,92 @@ func (ns NullTenantResourceLimitAlertType) Value() (driver.Value, error) {
 	return string(ns.TenantResourceLimitAlertType), nil
 }
 
+type V1ApprovalStatus string
+
+const (
+	V1ApprovalStatusPENDING  V1ApprovalStatus = "PENDING"
+	V1ApprovalStatusAPPROVED V1ApprovalStatus = "APPROVED"
+	V1ApprovalStatusREJECTED V1ApprovalStatus = "REJECTED"
+	V1ApprovalStatusEXPIRED  V1ApprovalStatus = "EXPIRED"
+)
+
+func (e *V1ApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1ApprovalStatus(s)
+	case string:
+		*e = V1ApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1ApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullV1ApprovalStatus struct {
+	V1ApprovalStatus V1ApprovalStatus `json:"v1_approval_status"`
+	Valid            bool             `json:"valid"` // Valid is true if V1ApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1ApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1ApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1ApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1ApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1ApprovalStatus), nil
+}
+
+type V1ConcurrencyQueueOverflow string
+
+const (
+	V1ConcurrencyQueueOverflowDROPOLDEST   V1ConcurrencyQueueOverflow = "DROP_OLDEST"
+	V1ConcurrencyQueueOverflowREJECTNEWEST V1ConcurrencyQueueOverflow = "REJECT_NEWEST"
+)
+
+func (e *V1ConcurrencyQueueOverflow) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1ConcurrencyQueueOverflow(s)
+	case string:
+		*e = V1ConcurrencyQueueOverflow(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1ConcurrencyQueueOverflow: %T", src)
+	}
+	return nil
+}
+
+type NullV1ConcurrencyQueueOverflow struct {
+	V1ConcurrencyQueueOverflow V1ConcurrencyQueueOverflow `json:"v1_concurrency_queue_overflow"`
+	Valid                      bool                       `json:"valid"` // Valid is true if V1ConcurrencyQueueOverflow is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1ConcurrencyQueueOverflow) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1ConcurrencyQueueOverflow, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1ConcurrencyQueueOverflow.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1ConcurrencyQueueOverflow) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1ConcurrencyQueueOverflow), nil
+}
+
 type V1ConcurrencyStrategy string
 
 const (
@@ -863,6 +994,7 @@ const (
 	V1ConcurrencyStrategyGROUPROUNDROBIN  V1ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
 	V1ConcurrencyStrategyCANCELINPROGRESS V1ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
 	V1ConcurrencyStrategyCANCELNEWEST     V1ConcurrencyStrategy = "CANCEL_NEWEST"
+	V1ConcurrencyStrategyQUEUENEWEST      V1ConcurrencyStrategy = "QUEUE_NEWEST"
 )
 
 func (e *V1ConcurrencyStrategy) Scan(src interface{}) error {
@@ -965,6 +1097,7 @@ const (
 	V1EventTypeOlapTIMEDOUT           V1EventTypeOlap = "TIMED_OUT"
 	V1EventTypeOlapRATELIMITERROR     V1EventTypeOlap = "RATE_LIMIT_ERROR"
 	V1EventTypeOlapSKIPPED            V1EventTypeOlap = "SKIPPED"
+	V1EventTypeOlapPREEMPTED          V1EventTypeOlap = "PREEMPTED"
 )
 
 func (e *V1EventTypeOlap) Scan(src interface{}) error {
@@ -1054,6 +1187,7 @@ const (
 	V1MatchConditionActionCANCEL      V1MatchConditionAction = "CANCEL"
 	V1MatchConditionActionSKIP        V1MatchConditionAction = "SKIP"
 	V1MatchConditionActionCREATEMATCH V1MatchConditionAction = "CREATE_MATCH"
+	V1MatchConditionActionFAIL        V1MatchConditionAction = "FAIL"
 )
 
 func (e *V1MatchConditionAction) Scan(src interface{}) error {
@@ -1220,6 +1354,53 @@ func (ns NullV1RunKind) Value() (driver.Value, error) {
 	return string(ns.V1RunKind), nil
 }
 
+type V1SchedulingReason string
+
+const (
+	V1SchedulingReasonNOWORKERS               V1SchedulingReason = "NO_WORKERS"
+	V1SchedulingReasonNOSLOTS                 V1SchedulingReason = "NO_SLOTS"
+	V1SchedulingReasonLABELMISMATCH           V1SchedulingReason = "LABEL_MISMATCH"
+	V1SchedulingReasonSTICKYWORKERUNAVAILABLE V1SchedulingReason = "STICKY_WORKER_UNAVAILABLE"
+	V1SchedulingReasonRATELIMITED             V1SchedulingReason = "RATE_LIMITED"
+	V1SchedulingReasonRESOURCESUNAVAILABLE    V1SchedulingReason = "RESOURCES_UNAVAILABLE"
+	V1SchedulingReasonGANGWAITING             V1SchedulingReason = "GANG_WAITING"
+)
+
+func (e *V1SchedulingReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1SchedulingReason(s)
+	case string:
+		*e = V1SchedulingReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1SchedulingReason: %T", src)
+	}
+	return nil
+}
+
+type NullV1SchedulingReason struct {
+	V1SchedulingReason V1SchedulingReason `json:"v1_scheduling_reason"`
+	Valid              bool               `json:"valid"` // Valid is true if V1SchedulingReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1SchedulingReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1SchedulingReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1SchedulingReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1SchedulingReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1SchedulingReason), nil
+}
+
 type V1StatusKind string
 
 const (
@@ -1268,6 +1449,7 @@ const (
 	V1StepMatchConditionKindPARENTOVERRIDE V1StepMatchConditionKind = "PARENT_OVERRIDE"
 	V1StepMatchConditionKindUSEREVENT      V1StepMatchConditionKind = "USER_EVENT"
 	V1StepMatchConditionKindSLEEP          V1StepMatchConditionKind = "SLEEP"
+	V1StepMatchConditionKindAPPROVAL       V1StepMatchConditionKind = "APPROVAL"
 )
 
 func (e *V1StepMatchConditionKind) Scan(src interface{}) error {
@@ -2066,12 +2248,18 @@ type QueueItem struct {
 }
 
 type RateLimit struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId      pgtype.UUID        `json:"tenantId"`
+	Key           string             `json:"key"`
+	LimitValue    int32              `json:"limitValue"`
+	Value         int32              `json:"value"`
+	Window        string             `json:"window"`
+	LastRefill    pgtype.Timestamp   `json:"lastRefill"`
+	Algorithm     RateLimitAlgorithm `json:"algorithm"`
+	Burst         pgtype.Int4        `json:"burst"`
+	RefillRate    pgtype.Float8      `json:"refillRate"`
+	PreviousUsage int32              `json:"previousUsage"`
+	IsDynamic     bool               `json:"isDynamic"`
+	LastUsedAt    pgtype.Timestamp   `json:"lastUsedAt"`
 }
 
 type RetryQueueItem struct {
@@ -2151,6 +2339,12 @@ type Step struct {
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	SlotWeight         int32            `json:"slotWeight"`
+	Preemptible        bool             `json:"preemptible"`
+	GangName           pgtype.Text      `json:"gangName"`
+	GangTimeout        pgtype.Text      `json:"gangTimeout"`
+	PlacementStrategy  pgtype.Text      `json:"placementStrategy"`
+	TotalTimeout       pgtype.Text      `json:"totalTimeout"`
 }
 
 type StepDesiredWorkerLabel struct {
@@ -2289,6 +2483,7 @@ type Tenant struct {
 	DataRetentionPeriod   string                   `json:"dataRetentionPeriod"`
 	SchedulerPartitionId  pgtype.Text              `json:"schedulerPartitionId"`
 	CanUpgradeV1          bool                     `json:"canUpgradeV1"`
+	PriorityAgingSeconds  pgtype.Int4              `json:"priorityAgingSeconds"`
 }
 
 type TenantAlertEmailGroup struct {
@@ -2446,6 +2641,33 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type V1Approval struct {
+	ID              int64              `json:"id"`
+	ExternalID      pgtype.UUID        `json:"external_id"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	TaskExternalID  pgtype.UUID        `json:"task_external_id"`
+	WorkflowRunID   pgtype.UUID        `json:"workflow_run_id"`
+	StepID          pgtype.UUID        `json:"step_id"`
+	ReadableDataKey string             `json:"readable_data_key"`
+	Status          V1ApprovalStatus   `json:"status"`
+	ExpiresAt       pgtype.Timestamptz `json:"expires_at"`
+	DecidedAt       pgtype.Timestamptz `json:"decided_at"`
+	DecidedBy       pgtype.UUID        `json:"decided_by"`
+	DecidedByEmail  pgtype.Text        `json:"decided_by_email"`
+	Comment         pgtype.Text        `json:"comment"`
+	Payload         []byte             `json:"payload"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+type V1ConcurrencyKeyLimit struct {
+	TenantID   pgtype.UUID        `json:"tenant_id"`
+	WorkflowID pgtype.UUID        `json:"workflow_id"`
+	Key        string             `json:"key"`
+	MaxRuns    int32              `json:"max_runs"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
 type V1ConcurrencySlot struct {
 	SortID                pgtype.Int8        `json:"sort_id"`
 	TaskID                int64              `json:"task_id"`
@@ -2515,6 +2737,32 @@ type V1DagsOlap struct {
 	TotalTasks           int32                `json:"total_tasks"`
 }
 
+type V1DebouncedRun struct {
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	WorkflowID         pgtype.UUID        `json:"workflow_id"`
+	Key                string             `json:"key"`
+	WorkflowVersionID  pgtype.UUID        `json:"workflow_version_id"`
+	ExternalID         pgtype.UUID        `json:"external_id"`
+	Input              []byte             `json:"input"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	Priority           pgtype.Int4        `json:"priority"`
+	TriggerCount       int32              `json:"trigger_count"`
+	FirstTriggeredAt   pgtype.Timestamptz `json:"first_triggered_at"`
+	FireAt             pgtype.Timestamptz `json:"fire_at"`
+}
+
+type V1DurableSignal struct {
+	ID               int64              `json:"id"`
+	TenantID         pgtype.UUID        `json:"tenant_id"`
+	TaskExternalID   pgtype.UUID        `json:"task_external_id"`
+	Name             string             `json:"name"`
+	Data             []byte             `json:"data"`
+	V1MatchID        pgtype.Int8        `json:"v1_match_id"`
+	MatchConditionID pgtype.Int8        `json:"match_condition_id"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	DeliveredAt      pgtype.Timestamptz `json:"delivered_at"`
+}
+
 type V1DurableSleep struct {
 	ID            int64              `json:"id"`
 	TenantID      pgtype.UUID        `json:"tenant_id"`
@@ -2561,6 +2809,15 @@ type V1Filter struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
+type V1IdempotencyKey struct {
+	TenantID    pgtype.UUID        `json:"tenant_id"`
+	Key         string             `json:"key"`
+	ExternalID  pgtype.UUID        `json:"external_id"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	TriggeredAt pgtype.Timestamptz `json:"triggered_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type V1LogLine struct {
 	ID             int64              `json:"id"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
@@ -2637,22 +2894,45 @@ type V1Queue struct {
 }
 
 type V1QueueItem struct {
-	ID                int64              `json:"id"`
-	TenantID          pgtype.UUID        `json:"tenant_id"`
-	Queue             string             `json:"queue"`
-	TaskID            int64              `json:"task_id"`
-	TaskInsertedAt    pgtype.Timestamptz `json:"task_inserted_at"`
-	ExternalID        pgtype.UUID        `json:"external_id"`
-	ActionID          string             `json:"action_id"`
-	StepID            pgtype.UUID        `json:"step_id"`
-	WorkflowID        pgtype.UUID        `json:"workflow_id"`
-	WorkflowRunID     pgtype.UUID        `json:"workflow_run_id"`
-	ScheduleTimeoutAt pgtype.Timestamp   `json:"schedule_timeout_at"`
-	StepTimeout       pgtype.Text        `json:"step_timeout"`
-	Priority          int32              `json:"priority"`
-	Sticky            V1StickyStrategy   `json:"sticky"`
-	DesiredWorkerID   pgtype.UUID        `json:"desired_worker_id"`
-	RetryCount        int32              `json:"retry_count"`
+	ID                    int64                  `json:"id"`
+	TenantID              pgtype.UUID            `json:"tenant_id"`
+	Queue                 string                 `json:"queue"`
+	TaskID                int64                  `json:"task_id"`
+	TaskInsertedAt        pgtype.Timestamptz     `json:"task_inserted_at"`
+	ExternalID            pgtype.UUID            `json:"external_id"`
+	ActionID              string                 `json:"action_id"`
+	StepID                pgtype.UUID            `json:"step_id"`
+	WorkflowID            pgtype.UUID            `json:"workflow_id"`
+	WorkflowRunID         pgtype.UUID            `json:"workflow_run_id"`
+	ScheduleTimeoutAt     pgtype.Timestamp       `json:"schedule_timeout_at"`
+	StepTimeout           pgtype.Text            `json:"step_timeout"`
+	Priority              int32                  `json:"priority"`
+	Sticky                V1StickyStrategy       `json:"sticky"`
+	DesiredWorkerID       pgtype.UUID            `json:"desired_worker_id"`
+	RetryCount            int32                  `json:"retry_count"`
+	InsertedAt            pgtype.Timestamptz     `json:"inserted_at"`
+	FairnessKey           pgtype.Text            `json:"fairness_key"`
+	SchedulingReason      NullV1SchedulingReason `json:"scheduling_reason"`
+	SchedulingMessage     pgtype.Text            `json:"scheduling_message"`
+	SchedulingEvaluatedAt pgtype.Timestamptz     `json:"scheduling_evaluated_at"`
+}
+
+type V1RateLimitUsageOlap struct {
+	TenantID            pgtype.UUID        `json:"tenant_id"`
+	Key                 string             `json:"key"`
+	Bucket              pgtype.Timestamptz `json:"bucket"`
+	UnitsConsumed       int64              `json:"units_consumed"`
+	TasksRateLimited    int64              `json:"tasks_rate_limited"`
+	RateLimitedAttempts int64              `json:"rate_limited_attempts"`
+}
+
+type V1RateLimitedTaskOlap struct {
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Key            string             `json:"key"`
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	RateLimitedOn  pgtype.Date        `json:"rate_limited_on"`
+	RateLimitedAt  pgtype.Timestamptz `json:"rate_limited_at"`
 }
 
 type V1RetryQueueItem struct {
@@ -2686,30 +2966,51 @@ type V1StatusesOlap struct {
 }
 
 type V1StepConcurrency struct {
-	ID                int64                 `json:"id"`
-	ParentStrategyID  pgtype.Int8           `json:"parent_strategy_id"`
-	WorkflowID        pgtype.UUID           `json:"workflow_id"`
-	WorkflowVersionID pgtype.UUID           `json:"workflow_version_id"`
-	StepID            pgtype.UUID           `json:"step_id"`
-	IsActive          bool                  `json:"is_active"`
-	Strategy          V1ConcurrencyStrategy `json:"strategy"`
-	Expression        string                `json:"expression"`
-	TenantID          pgtype.UUID           `json:"tenant_id"`
-	MaxConcurrency    int32                 `json:"max_concurrency"`
+	ID                int64                      `json:"id"`
+	ParentStrategyID  pgtype.Int8                `json:"parent_strategy_id"`
+	WorkflowID        pgtype.UUID                `json:"workflow_id"`
+	WorkflowVersionID pgtype.UUID                `json:"workflow_version_id"`
+	StepID            pgtype.UUID                `json:"step_id"`
+	IsActive          bool                       `json:"is_active"`
+	Strategy          V1ConcurrencyStrategy      `json:"strategy"`
+	Expression        string                     `json:"expression"`
+	TenantID          pgtype.UUID                `json:"tenant_id"`
+	MaxConcurrency    int32                      `json:"max_concurrency"`
+	MaxQueued         pgtype.Int4                `json:"max_queued"`
+	QueueOverflow     V1ConcurrencyQueueOverflow `json:"queue_overflow"`
+	ConcurrencyGroup  pgtype.Text                `json:"concurrency_group"`
 }
 
 type V1StepMatchCondition struct {
-	ID               int64                    `json:"id"`
-	TenantID         pgtype.UUID              `json:"tenant_id"`
-	StepID           pgtype.UUID              `json:"step_id"`
-	ReadableDataKey  string                   `json:"readable_data_key"`
-	Action           V1MatchConditionAction   `json:"action"`
-	OrGroupID        pgtype.UUID              `json:"or_group_id"`
-	Expression       pgtype.Text              `json:"expression"`
-	Kind             V1StepMatchConditionKind `json:"kind"`
-	SleepDuration    pgtype.Text              `json:"sleep_duration"`
-	EventKey         pgtype.Text              `json:"event_key"`
-	ParentReadableID pgtype.Text              `json:"parent_readable_id"`
+	ID                   int64                      `json:"id"`
+	TenantID             pgtype.UUID                `json:"tenant_id"`
+	StepID               pgtype.UUID                `json:"step_id"`
+	ReadableDataKey      string                     `json:"readable_data_key"`
+	Action               V1MatchConditionAction     `json:"action"`
+	OrGroupID            pgtype.UUID                `json:"or_group_id"`
+	Expression           pgtype.Text                `json:"expression"`
+	Kind                 V1StepMatchConditionKind   `json:"kind"`
+	SleepDuration        pgtype.Text                `json:"sleep_duration"`
+	EventKey             pgtype.Text                `json:"event_key"`
+	ParentReadableID     pgtype.Text                `json:"parent_readable_id"`
+	ApprovalExpiresIn    pgtype.Text                `json:"approval_expires_in"`
+	ApprovalExpiryAction NullV1MatchConditionAction `json:"approval_expiry_action"`
+}
+
+type V1StepResourceRequest struct {
+	TenantID pgtype.UUID `json:"tenant_id"`
+	StepID   pgtype.UUID `json:"step_id"`
+	Key      string      `json:"key"`
+	Units    int32       `json:"units"`
+}
+
+type V1StepRetryPolicy struct {
+	TenantID            pgtype.UUID `json:"tenant_id"`
+	StepID              pgtype.UUID `json:"step_id"`
+	ErrorCodes          []string    `json:"error_codes"`
+	ErrorCodeMaxRetries []int32     `json:"error_code_max_retries"`
+	Jitter              float64     `json:"jitter"`
+	MinBackoffSeconds   int32       `json:"min_backoff_seconds"`
 }
 
 type V1Task struct {
@@ -2750,6 +3051,8 @@ type V1Task struct {
 	ConcurrencyKeys              []string           `json:"concurrency_keys"`
 	RetryBackoffFactor           pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff              pgtype.Int4        `json:"retry_max_backoff"`
+	FairnessKey                  pgtype.Text        `json:"fairness_key"`
+	RetryAfter                   pgtype.Timestamptz `json:"retry_after"`
 }
 
 type V1TaskEvent struct {
@@ -2780,6 +3083,7 @@ type V1TaskEventsOlap struct {
 	WorkerID               pgtype.UUID          `json:"worker_id"`
 	AdditionalEventData    pgtype.Text          `json:"additional__event_data"`
 	AdditionalEventMessage pgtype.Text          `json:"additional__event_message"`
+	ErrorDetails           []byte               `json:"error_details"`
 }
 
 type V1TaskEventsOlapTmp struct {
@@ -2795,6 +3099,15 @@ type V1TaskEventsOlapTmp struct {
 	WorkerID       pgtype.UUID          `json:"worker_id"`
 }
 
+type V1TaskExecutionTimeout struct {
+	TaskID            int64              `json:"task_id"`
+	TaskInsertedAt    pgtype.Timestamptz `json:"task_inserted_at"`
+	TenantID          pgtype.UUID        `json:"tenant_id"`
+	TimeoutAt         pgtype.Timestamptz `json:"timeout_at"`
+	Timeout           string             `json:"timeout"`
+	IsWorkflowTimeout bool               `json:"is_workflow_timeout"`
+}
+
 type V1TaskExpressionEval struct {
 	Key            string             `json:"key"`
 	TaskID         int64              `json:"task_id"`
@@ -2811,6 +3124,27 @@ type V1TaskRuntime struct {
 	WorkerID       pgtype.UUID        `json:"worker_id"`
 	TenantID       pgtype.UUID        `json:"tenant_id"`
 	TimeoutAt      pgtype.Timestamp   `json:"timeout_at"`
+	SlotWeight     int32              `json:"slot_weight"`
+	Preemptible    bool               `json:"preemptible"`
+}
+
+type V1TaskRuntimeResource struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount     int32              `json:"retry_count"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	WorkerID       pgtype.UUID        `json:"worker_id"`
+	Key            string             `json:"key"`
+	Units          int32              `json:"units"`
+}
+
+type V1TaskRuntimeSemaphore struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount     int32              `json:"retry_count"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Key            string             `json:"key"`
+	Units          int32              `json:"units"`
 }
 
 type V1TaskStatusUpdatesTmp struct {
@@ -2849,16 +3183,26 @@ type V1TasksOlap struct {
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
 }
 
+type V1WorkerResource struct {
+	TenantID pgtype.UUID `json:"tenant_id"`
+	WorkerID pgtype.UUID `json:"worker_id"`
+	Key      string      `json:"key"`
+	Capacity int32       `json:"capacity"`
+}
+
 type V1WorkflowConcurrency struct {
-	ID                int64                 `json:"id"`
-	WorkflowID        pgtype.UUID           `json:"workflow_id"`
-	WorkflowVersionID pgtype.UUID           `json:"workflow_version_id"`
-	IsActive          bool                  `json:"is_active"`
-	Strategy          V1ConcurrencyStrategy `json:"strategy"`
-	ChildStrategyIds  []int64               `json:"child_strategy_ids"`
-	Expression        string                `json:"expression"`
-	TenantID          pgtype.UUID           `json:"tenant_id"`
-	MaxConcurrency    int32                 `json:"max_concurrency"`
+	ID                int64                      `json:"id"`
+	WorkflowID        pgtype.UUID                `json:"workflow_id"`
+	WorkflowVersionID pgtype.UUID                `json:"workflow_version_id"`
+	IsActive          bool                       `json:"is_active"`
+	Strategy          V1ConcurrencyStrategy      `json:"strategy"`
+	ChildStrategyIds  []int64                    `json:"child_strategy_ids"`
+	Expression        string                     `json:"expression"`
+	TenantID          pgtype.UUID                `json:"tenant_id"`
+	MaxConcurrency    int32                      `json:"max_concurrency"`
+	MaxQueued         pgtype.Int4                `json:"max_queued"`
+	QueueOverflow     V1ConcurrencyQueueOverflow `json:"queue_overflow"`
+	ConcurrencyGroup  pgtype.Text                `json:"concurrency_group"`
 }
 
 type V1WorkflowConcurrencySlot struct {
@@ -2922,6 +3266,7 @@ type Worker struct {
 	Os                      pgtype.Text      `json:"os"`
 	RuntimeExtra            pgtype.Text      `json:"runtimeExtra"`
 	SdkVersion              pgtype.Text      `json:"sdkVersion"`
+	DrainingAt              pgtype.Timestamp `json:"drainingAt"`
 }
 
 type WorkerAssignEvent struct {
@@ -3097,4 +3442,8 @@ type WorkflowVersion struct {
 	Kind                      WorkflowKind       `json:"kind"`
 	DefaultPriority           pgtype.Int4        `json:"defaultPriority"`
 	CreateWorkflowVersionOpts []byte             `json:"createWorkflowVersionOpts"`
+	PriorityAgingSeconds      pgtype.Int4        `json:"priorityAgingSeconds"`
+	DebounceExpression        pgtype.Text        `json:"debounceExpression"`
+	DebounceWindow            pgtype.Text        `json:"debounceWindow"`
+	ExecutionTimeout          pgtype.Text        `json:"executionTimeout"`
 }
@@ -5,7 +5,9 @@ SELECT
     create_v1_olap_partition_with_date_and_status('v1_tasks_olap'::text, @date::date),
     create_v1_olap_partition_with_date_and_status('v1_runs_olap'::text, @date::date),
     create_v1_olap_partition_with_date_and_status('v1_dags_olap'::text, @date::date),
-    create_v1_weekly_range_partition('v1_event_lookup_table_olap'::text, @date::date)
+    create_v1_weekly_range_partition('v1_event_lookup_table_olap'::text, @date::date),
+    create_v1_range_partition('v1_rate_limit_usage_olap'::text, @date::date),
+    create_v1_range_partition('v1_rate_limited_task_olap'::text, @date::date)
 ;
 
 -- name: CreateOLAPEventPartitions :exec
@@ -27,6 +29,10 @@ WITH task_partitions AS (
     SELECT 'v1_event_to_run_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_event_to_run_olap', @date::date) AS p
 ), events_lookup_table_partitions AS (
     SELECT 'v1_event_lookup_table_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_event_lookup_table_olap', @date::date) AS p
+), rate_limit_usage_partitions AS (
+    SELECT 'v1_rate_limit_usage_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_rate_limit_usage_olap', @date::date) AS p
+), rate_limited_task_partitions AS (
+    SELECT 'v1_rate_limited_task_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_rate_limited_task_olap', @date::date) AS p
 ), candidates AS (
     SELECT
         *
@@ -67,6 +73,20 @@ WITH task_partitions AS (
         *
     FROM
         events_lookup_table_partitions
+
+    UNION ALL
+
+    SELECT
+        *
+    FROM
+        rate_limit_usage_partitions
+
+    UNION ALL
+
+    SELECT
+        *
+    FROM
+        rate_limited_task_partitions
 )
 
 SELECT *
@@ -185,7 +205,8 @@ INSERT INTO v1_task_events_olap (
     output,
     worker_id,
     additional__event_data,
-    additional__event_message
+    additional__event_message,
+    error_details
 ) VALUES (
     $1,
     $2,
@@ -199,7 +220,8 @@ INSERT INTO v1_task_events_olap (
     $10,
     $11,
     $12,
-    $13
+    $13,
+    $14
 );
 
 -- name: ReadTaskByExternalID :one
@@ -216,7 +238,8 @@ WITH lookup_task AS (
 SELECT
     t.*,
     e.output,
-    e.error_message
+    e.error_message,
+    e.error_details
 FROM
     v1_tasks_olap t
 JOIN
@@ -306,7 +329,8 @@ SELECT
   t.output,
   t.worker_id,
   t.additional__event_data,
-  t.additional__event_message
+  t.additional__event_message,
+  t.error_details
 FROM aggregated_events a
 JOIN v1_task_events_olap t
   ON t.tenant_id = a.tenant_id
@@ -357,6 +381,7 @@ SELECT
   t.worker_id,
   t.additional__event_data,
   t.additional__event_message,
+  t.error_details,
   tsk.display_name,
   tsk.external_id AS task_external_id
 FROM aggregated_events a
@@ -425,7 +450,8 @@ WITH selected_retry_count AS (
     LIMIT 1
 ), error_message AS (
     SELECT
-        error_message
+        error_message,
+        error_details
     FROM
         relevant_events
     WHERE
@@ -453,6 +479,7 @@ SELECT
     s.started_at::timestamptz as started_at,
     o.output::jsonb as output,
     e.error_message as error_message,
+    e.error_details as error_details,
     sc.spawned_children,
     (SELECT retry_count FROM selected_retry_count) as retry_count
 FROM
@@ -572,7 +599,8 @@ WITH input AS (
 ), error_message AS (
     SELECT
         DISTINCT ON (e.task_id) e.task_id::bigint,
-        e.error_message
+        e.error_message,
+        e.error_details
     FROM
         relevant_events e
     JOIN
@@ -623,6 +651,7 @@ SELECT
     s.started_at::timestamptz as started_at,
     q.queued_at::timestamptz as queued_at,
     e.error_message as error_message,
+    e.error_details as error_details,
     COALESCE(t.latest_retry_count, 0)::int as retry_count,
     CASE
         WHEN @includePayloads::BOOLEAN THEN o.output::JSONB
@@ -1048,7 +1077,8 @@ WITH input AS (
 ), error_message AS (
     SELECT
         DISTINCT ON (e.run_id) e.run_id::bigint,
-        e.error_message
+        e.error_message,
+        e.error_details
     FROM
         relevant_events e
     WHERE
@@ -1071,6 +1101,7 @@ SELECT
     m.started_at,
     m.finished_at,
     e.error_message,
+    e.error_details,
     CASE
         WHEN @includePayloads::BOOLEAN THEN o.output::JSONB
         ELSE '{}'::JSONB
@@ -1228,7 +1259,8 @@ WITH runs AS (
     JOIN max_retry_counts mrc ON (e.task_id, e.retry_count) = (mrc.task_id, mrc.max_retry_count)
 ), error_message AS (
     SELECT
-        e.error_message
+        e.error_message,
+        e.error_details
     FROM
         relevant_events e
     WHERE
@@ -1243,6 +1275,7 @@ SELECT
     m.started_at,
     m.finished_at,
     e.error_message,
+    e.error_details,
     m.task_metadata
 FROM runs r
 LEFT JOIN metadata m ON true
@@ -1684,3 +1717,84 @@ FROM
 LEFT JOIN
     task_times tt ON (td.task_id, td.inserted_at) = (tt.task_id, tt.inserted_at)
 ORDER BY td.task_id, td.inserted_at;
+
+-- name: UpsertRateLimitUsageOLAP :exec
+WITH input AS (
+    SELECT
+        UNNEST(@keys::TEXT[]) AS key,
+        UNNEST(@buckets::TIMESTAMPTZ[]) AS bucket,
+        UNNEST(@unitsConsumed::BIGINT[]) AS units_consumed,
+        UNNEST(@tasksRateLimited::BIGINT[]) AS tasks_rate_limited,
+        UNNEST(@rateLimitedAttempts::BIGINT[]) AS rate_limited_attempts
+)
+INSERT INTO v1_rate_limit_usage_olap (
+    tenant_id,
+    key,
+    bucket,
+    units_consumed,
+    tasks_rate_limited,
+    rate_limited_attempts
+)
+SELECT
+    @tenantId::UUID,
+    key,
+    bucket,
+    units_consumed,
+    tasks_rate_limited,
+    rate_limited_attempts
+FROM
+    input
+ON CONFLICT (tenant_id, key, bucket) DO UPDATE
+SET
+    units_consumed = v1_rate_limit_usage_olap.units_consumed + EXCLUDED.units_consumed,
+    tasks_rate_limited = v1_rate_limit_usage_olap.tasks_rate_limited + EXCLUDED.tasks_rate_limited,
+    rate_limited_attempts = v1_rate_limit_usage_olap.rate_limited_attempts + EXCLUDED.rate_limited_attempts;
+
+-- name: InsertRateLimitedTasksOLAP :many
+-- Records the tasks which were rate limited by each key, and returns the tasks which weren't already rate limited
+-- by the same key on the same day, so that a task which is repeatedly rate limited is only counted once
+WITH input AS (
+    SELECT
+        UNNEST(@keys::TEXT[]) AS key,
+        UNNEST(@taskIds::BIGINT[]) AS task_id,
+        UNNEST(@taskInsertedAts::TIMESTAMPTZ[]) AS task_inserted_at,
+        UNNEST(@rateLimitedAts::TIMESTAMPTZ[]) AS rate_limited_at
+)
+INSERT INTO v1_rate_limited_task_olap (
+    tenant_id,
+    key,
+    task_id,
+    task_inserted_at,
+    rate_limited_on,
+    rate_limited_at
+)
+SELECT
+    @tenantId::UUID,
+    key,
+    task_id,
+    task_inserted_at,
+    (rate_limited_at AT TIME ZONE 'UTC')::DATE,
+    rate_limited_at
+FROM
+    input
+ON CONFLICT DO NOTHING
+RETURNING key, rate_limited_at;
+
+-- name: GetRateLimitUsageOLAP :many
+SELECT
+    DATE_BIN(
+        COALESCE(sqlc.narg('interval')::INTERVAL, '1 minute'),
+        bucket,
+        TIMESTAMPTZ '1970-01-01 00:00:00+00'
+    ) :: TIMESTAMPTZ AS bucket_start,
+    SUM(units_consumed)::BIGINT AS units_consumed,
+    SUM(tasks_rate_limited)::BIGINT AS tasks_rate_limited,
+    SUM(rate_limited_attempts)::BIGINT AS rate_limited_attempts
+FROM
+    v1_rate_limit_usage_olap
+WHERE
+    tenant_id = @tenantId::UUID
+    AND key = @key::TEXT
+    AND bucket BETWEEN @since::TIMESTAMPTZ AND @until::TIMESTAMPTZ
+GROUP BY bucket_start
+ORDER BY bucket_start;
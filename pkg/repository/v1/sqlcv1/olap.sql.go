@@ -138,7 +138,9 @@ SELECT
     create_v1_olap_partition_with_date_and_status('v1_tasks_olap'::text, $2::date),
     create_v1_olap_partition_with_date_and_status('v1_runs_olap'::text, $2::date),
     create_v1_olap_partition_with_date_and_status('v1_dags_olap'::text, $2::date),
-    create_v1_weekly_range_partition('v1_event_lookup_table_olap'::text, $2::date)
+    create_v1_weekly_range_partition('v1_event_lookup_table_olap'::text, $2::date),
+    create_v1_range_partition('v1_rate_limit_usage_olap'::text, $2::date),
+    create_v1_range_partition('v1_rate_limited_task_olap'::text, $2::date)
 `
 
 type CreateOLAPPartitionsParams struct {
@@ -165,6 +167,7 @@ type CreateTaskEventsOLAPParams struct {
 	WorkerID               pgtype.UUID          `json:"worker_id"`
 	AdditionalEventData    pgtype.Text          `json:"additional__event_data"`
 	AdditionalEventMessage pgtype.Text          `json:"additional__event_message"`
+	ErrorDetails           []byte               `json:"error_details"`
 }
 
 type CreateTaskEventsOLAPTmpParams struct {
@@ -387,7 +390,7 @@ WITH input AS (
         dt.dag_id,
         dt.dag_inserted_at,
         dt.external_id,
-        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message
+        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message, e.error_details
     FROM
         dag_tasks dt
     JOIN
@@ -490,6 +493,72 @@ func (q *Queries) GetDagDurationsByDagIds(ctx context.Context, db DBTX, arg GetD
 	return items, nil
 }
 
+const getRateLimitUsageOLAP = `-- name: GetRateLimitUsageOLAP :many
+SELECT
+    DATE_BIN(
+        COALESCE($1::INTERVAL, '1 minute'),
+        bucket,
+        TIMESTAMPTZ '1970-01-01 00:00:00+00'
+    ) :: TIMESTAMPTZ AS bucket_start,
+    SUM(units_consumed)::BIGINT AS units_consumed,
+    SUM(tasks_rate_limited)::BIGINT AS tasks_rate_limited,
+    SUM(rate_limited_attempts)::BIGINT AS rate_limited_attempts
+FROM
+    v1_rate_limit_usage_olap
+WHERE
+    tenant_id = $2::UUID
+    AND key = $3::TEXT
+    AND bucket BETWEEN $4::TIMESTAMPTZ AND $5::TIMESTAMPTZ
+GROUP BY bucket_start
+ORDER BY bucket_start
+`
+
+type GetRateLimitUsageOLAPParams struct {
+	Interval pgtype.Interval    `json:"interval"`
+	Tenantid pgtype.UUID        `json:"tenantid"`
+	Key      string             `json:"key"`
+	Since    pgtype.Timestamptz `json:"since"`
+	Until    pgtype.Timestamptz `json:"until"`
+}
+
+type GetRateLimitUsageOLAPRow struct {
+	BucketStart         pgtype.Timestamptz `json:"bucket_start"`
+	UnitsConsumed       int64              `json:"units_consumed"`
+	TasksRateLimited    int64              `json:"tasks_rate_limited"`
+	RateLimitedAttempts int64              `json:"rate_limited_attempts"`
+}
+
+func (q *Queries) GetRateLimitUsageOLAP(ctx context.Context, db DBTX, arg GetRateLimitUsageOLAPParams) ([]*GetRateLimitUsageOLAPRow, error) {
+	rows, err := db.Query(ctx, getRateLimitUsageOLAP,
+		arg.Interval,
+		arg.Tenantid,
+		arg.Key,
+		arg.Since,
+		arg.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetRateLimitUsageOLAPRow
+	for rows.Next() {
+		var i GetRateLimitUsageOLAPRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.UnitsConsumed,
+			&i.TasksRateLimited,
+			&i.RateLimitedAttempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRunsListRecursive = `-- name: GetRunsListRecursive :many
 WITH RECURSIVE all_runs AS (
   -- seed term
@@ -849,6 +918,76 @@ func (q *Queries) GetWorkflowRunIdFromDagIdInsertedAt(ctx context.Context, db DB
 	return external_id, err
 }
 
+const insertRateLimitedTasksOLAP = `-- name: InsertRateLimitedTasksOLAP :many
+WITH input AS (
+    SELECT
+        UNNEST($1::TEXT[]) AS key,
+        UNNEST($2::BIGINT[]) AS task_id,
+        UNNEST($3::TIMESTAMPTZ[]) AS task_inserted_at,
+        UNNEST($4::TIMESTAMPTZ[]) AS rate_limited_at
+)
+INSERT INTO v1_rate_limited_task_olap (
+    tenant_id,
+    key,
+    task_id,
+    task_inserted_at,
+    rate_limited_on,
+    rate_limited_at
+)
+SELECT
+    $5::UUID,
+    key,
+    task_id,
+    task_inserted_at,
+    (rate_limited_at AT TIME ZONE 'UTC')::DATE,
+    rate_limited_at
+FROM
+    input
+ON CONFLICT DO NOTHING
+RETURNING key, rate_limited_at
+`
+
+type InsertRateLimitedTasksOLAPParams struct {
+	Keys            []string             `json:"keys"`
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Ratelimitedats  []pgtype.Timestamptz `json:"ratelimitedats"`
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+}
+
+type InsertRateLimitedTasksOLAPRow struct {
+	Key           string             `json:"key"`
+	RateLimitedAt pgtype.Timestamptz `json:"rate_limited_at"`
+}
+
+// Records the tasks which were rate limited by each key, and returns the tasks which weren't already rate limited
+// by the same key on the same day, so that a task which is repeatedly rate limited is only counted once
+func (q *Queries) InsertRateLimitedTasksOLAP(ctx context.Context, db DBTX, arg InsertRateLimitedTasksOLAPParams) ([]*InsertRateLimitedTasksOLAPRow, error) {
+	rows, err := db.Query(ctx, insertRateLimitedTasksOLAP,
+		arg.Keys,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Ratelimitedats,
+		arg.Tenantid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InsertRateLimitedTasksOLAPRow
+	for rows.Next() {
+		var i InsertRateLimitedTasksOLAPRow
+		if err := rows.Scan(&i.Key, &i.RateLimitedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEventKeys = `-- name: ListEventKeys :many
 SELECT DISTINCT key
 FROM
@@ -1002,6 +1141,10 @@ WITH task_partitions AS (
     SELECT 'v1_event_to_run_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_event_to_run_olap', $2::date) AS p
 ), events_lookup_table_partitions AS (
     SELECT 'v1_event_lookup_table_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_event_lookup_table_olap', $2::date) AS p
+), rate_limit_usage_partitions AS (
+    SELECT 'v1_rate_limit_usage_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_rate_limit_usage_olap', $2::date) AS p
+), rate_limited_task_partitions AS (
+    SELECT 'v1_rate_limited_task_olap' AS parent_table, p::TEXT AS partition_name FROM get_v1_partitions_before_date('v1_rate_limited_task_olap', $2::date) AS p
 ), candidates AS (
     SELECT
         parent_table, partition_name
@@ -1042,6 +1185,20 @@ WITH task_partitions AS (
         parent_table, partition_name
     FROM
         events_lookup_table_partitions
+
+    UNION ALL
+
+    SELECT
+        parent_table, partition_name
+    FROM
+        rate_limit_usage_partitions
+
+    UNION ALL
+
+    SELECT
+        parent_table, partition_name
+    FROM
+        rate_limited_task_partitions
 )
 
 SELECT parent_table, partition_name
@@ -1118,7 +1275,8 @@ SELECT
   t.output,
   t.worker_id,
   t.additional__event_data,
-  t.additional__event_message
+  t.additional__event_message,
+  t.error_details
 FROM aggregated_events a
 JOIN v1_task_events_olap t
   ON t.tenant_id = a.tenant_id
@@ -1151,6 +1309,7 @@ type ListTaskEventsRow struct {
 	WorkerID               pgtype.UUID          `json:"worker_id"`
 	AdditionalEventData    pgtype.Text          `json:"additional__event_data"`
 	AdditionalEventMessage pgtype.Text          `json:"additional__event_message"`
+	ErrorDetails           []byte               `json:"error_details"`
 }
 
 func (q *Queries) ListTaskEvents(ctx context.Context, db DBTX, arg ListTaskEventsParams) ([]*ListTaskEventsRow, error) {
@@ -1179,6 +1338,7 @@ func (q *Queries) ListTaskEvents(ctx context.Context, db DBTX, arg ListTaskEvent
 			&i.WorkerID,
 			&i.AdditionalEventData,
 			&i.AdditionalEventMessage,
+			&i.ErrorDetails,
 		); err != nil {
 			return nil, err
 		}
@@ -1232,6 +1392,7 @@ SELECT
   t.worker_id,
   t.additional__event_data,
   t.additional__event_message,
+  t.error_details,
   tsk.display_name,
   tsk.external_id AS task_external_id
 FROM aggregated_events a
@@ -1267,6 +1428,7 @@ type ListTaskEventsForWorkflowRunRow struct {
 	WorkerID               pgtype.UUID          `json:"worker_id"`
 	AdditionalEventData    pgtype.Text          `json:"additional__event_data"`
 	AdditionalEventMessage pgtype.Text          `json:"additional__event_message"`
+	ErrorDetails           []byte               `json:"error_details"`
 	DisplayName            string               `json:"display_name"`
 	TaskExternalID         pgtype.UUID          `json:"task_external_id"`
 }
@@ -1297,6 +1459,7 @@ func (q *Queries) ListTaskEventsForWorkflowRun(ctx context.Context, db DBTX, arg
 			&i.WorkerID,
 			&i.AdditionalEventData,
 			&i.AdditionalEventMessage,
+			&i.ErrorDetails,
 			&i.DisplayName,
 			&i.TaskExternalID,
 		); err != nil {
@@ -1486,7 +1649,7 @@ WITH input AS (
 ), relevant_events AS (
     SELECT
         r.run_id,
-        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message
+        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message, e.error_details
     FROM runs r
     JOIN v1_dag_to_task_olap dt ON (r.dag_id, r.inserted_at) = (dt.dag_id, dt.dag_inserted_at)
     JOIN v1_task_events_olap e ON (e.task_id, e.task_inserted_at) = (dt.task_id, dt.task_inserted_at)
@@ -1508,7 +1671,8 @@ WITH input AS (
 ), error_message AS (
     SELECT
         DISTINCT ON (e.run_id) e.run_id::bigint,
-        e.error_message
+        e.error_message,
+        e.error_details
     FROM
         relevant_events e
     WHERE
@@ -1531,6 +1695,7 @@ SELECT
     m.started_at,
     m.finished_at,
     e.error_message,
+    e.error_details,
     CASE
         WHEN $1::BOOLEAN THEN o.output::JSONB
         ELSE '{}'::JSONB
@@ -1569,6 +1734,7 @@ type PopulateDAGMetadataRow struct {
 	StartedAt            pgtype.Timestamptz   `json:"started_at"`
 	FinishedAt           pgtype.Timestamptz   `json:"finished_at"`
 	ErrorMessage         pgtype.Text          `json:"error_message"`
+	ErrorDetails         []byte               `json:"error_details"`
 	Output               []byte               `json:"output"`
 	RetryCount           int32                `json:"retry_count"`
 }
@@ -1605,6 +1771,7 @@ func (q *Queries) PopulateDAGMetadata(ctx context.Context, db DBTX, arg Populate
 			&i.StartedAt,
 			&i.FinishedAt,
 			&i.ErrorMessage,
+			&i.ErrorDetails,
 			&i.Output,
 			&i.RetryCount,
 		); err != nil {
@@ -1696,7 +1863,7 @@ WITH selected_retry_count AS (
     LIMIT 1
 ), relevant_events AS (
     SELECT
-        tenant_id, id, inserted_at, task_id, task_inserted_at, event_type, workflow_id, event_timestamp, readable_status, retry_count, error_message, output, worker_id, additional__event_data, additional__event_message
+        tenant_id, id, inserted_at, task_id, task_inserted_at, event_type, workflow_id, event_timestamp, readable_status, retry_count, error_message, output, worker_id, additional__event_data, additional__event_message, error_details
     FROM
         v1_task_events_olap
     WHERE
@@ -1736,7 +1903,8 @@ WITH selected_retry_count AS (
     LIMIT 1
 ), error_message AS (
     SELECT
-        error_message
+        error_message,
+        error_details
     FROM
         relevant_events
     WHERE
@@ -1764,6 +1932,7 @@ SELECT
     s.started_at::timestamptz as started_at,
     o.output::jsonb as output,
     e.error_message as error_message,
+    e.error_details as error_details,
     sc.spawned_children,
     (SELECT retry_count FROM selected_retry_count) as retry_count
 FROM
@@ -1821,6 +1990,7 @@ type PopulateSingleTaskRunDataRow struct {
 	StartedAt            pgtype.Timestamptz   `json:"started_at"`
 	Output               []byte               `json:"output"`
 	ErrorMessage         pgtype.Text          `json:"error_message"`
+	ErrorDetails         []byte               `json:"error_details"`
 	SpawnedChildren      pgtype.Int8          `json:"spawned_children"`
 	RetryCount           int32                `json:"retry_count"`
 }
@@ -1863,6 +2033,7 @@ func (q *Queries) PopulateSingleTaskRunData(ctx context.Context, db DBTX, arg Po
 		&i.StartedAt,
 		&i.Output,
 		&i.ErrorMessage,
+		&i.ErrorDetails,
 		&i.SpawnedChildren,
 		&i.RetryCount,
 	)
@@ -1906,7 +2077,7 @@ WITH input AS (
         t.tenant_id = $4::uuid
 ), relevant_events AS (
     SELECT
-        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message
+        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message, e.error_details
     FROM
         v1_task_events_olap e
     JOIN
@@ -1969,7 +2140,8 @@ WITH input AS (
 ), error_message AS (
     SELECT
         DISTINCT ON (e.task_id) e.task_id::bigint,
-        e.error_message
+        e.error_message,
+        e.error_details
     FROM
         relevant_events e
     JOIN
@@ -2020,6 +2192,7 @@ SELECT
     s.started_at::timestamptz as started_at,
     q.queued_at::timestamptz as queued_at,
     e.error_message as error_message,
+    e.error_details as error_details,
     COALESCE(t.latest_retry_count, 0)::int as retry_count,
     CASE
         WHEN $1::BOOLEAN THEN o.output::JSONB
@@ -2071,6 +2244,7 @@ type PopulateTaskRunDataRow struct {
 	StartedAt            pgtype.Timestamptz   `json:"started_at"`
 	QueuedAt             pgtype.Timestamptz   `json:"queued_at"`
 	ErrorMessage         pgtype.Text          `json:"error_message"`
+	ErrorDetails         []byte               `json:"error_details"`
 	RetryCount           int32                `json:"retry_count"`
 	Output               []byte               `json:"output"`
 }
@@ -2113,6 +2287,7 @@ func (q *Queries) PopulateTaskRunData(ctx context.Context, db DBTX, arg Populate
 			&i.StartedAt,
 			&i.QueuedAt,
 			&i.ErrorMessage,
+			&i.ErrorDetails,
 			&i.RetryCount,
 			&i.Output,
 		); err != nil {
@@ -2179,7 +2354,8 @@ WITH lookup_task AS (
 SELECT
     t.tenant_id, t.id, t.inserted_at, t.external_id, t.queue, t.action_id, t.step_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.display_name, t.input, t.additional_metadata, t.readable_status, t.latest_retry_count, t.latest_worker_id, t.dag_id, t.dag_inserted_at, t.parent_task_external_id,
     e.output,
-    e.error_message
+    e.error_message,
+    e.error_details
 FROM
     v1_tasks_olap t
 JOIN
@@ -2215,6 +2391,7 @@ type ReadTaskByExternalIDRow struct {
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
 	Output               []byte               `json:"output"`
 	ErrorMessage         pgtype.Text          `json:"error_message"`
+	ErrorDetails         []byte               `json:"error_details"`
 }
 
 func (q *Queries) ReadTaskByExternalID(ctx context.Context, db DBTX, externalid pgtype.UUID) (*ReadTaskByExternalIDRow, error) {
@@ -2247,6 +2424,7 @@ func (q *Queries) ReadTaskByExternalID(ctx context.Context, db DBTX, externalid
 		&i.ParentTaskExternalID,
 		&i.Output,
 		&i.ErrorMessage,
+		&i.ErrorDetails,
 	)
 	return &i, err
 }
@@ -2306,7 +2484,7 @@ WITH runs AS (
         AND lt.task_id IS NOT NULL
 ), relevant_events AS (
     SELECT
-        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message
+        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message, e.error_details
     FROM runs r
     JOIN v1_dag_to_task_olap dt ON r.dag_id = dt.dag_id AND r.inserted_at = dt.dag_inserted_at
     JOIN v1_task_events_olap e ON (e.task_id, e.task_inserted_at) = (dt.task_id, dt.task_inserted_at)
@@ -2315,7 +2493,7 @@ WITH runs AS (
     UNION ALL
 
     SELECT
-        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message
+        e.tenant_id, e.id, e.inserted_at, e.task_id, e.task_inserted_at, e.event_type, e.workflow_id, e.event_timestamp, e.readable_status, e.retry_count, e.error_message, e.output, e.worker_id, e.additional__event_data, e.additional__event_message, e.error_details
     FROM runs r
     JOIN v1_task_events_olap e ON e.task_id = r.task_id AND e.task_inserted_at = r.inserted_at
     WHERE r.task_id IS NOT NULL
@@ -2334,7 +2512,8 @@ WITH runs AS (
     JOIN max_retry_counts mrc ON (e.task_id, e.retry_count) = (mrc.task_id, mrc.max_retry_count)
 ), error_message AS (
     SELECT
-        e.error_message
+        e.error_message,
+        e.error_details
     FROM
         relevant_events e
     WHERE
@@ -2349,6 +2528,7 @@ SELECT
     m.started_at,
     m.finished_at,
     e.error_message,
+    e.error_details,
     m.task_metadata
 FROM runs r
 LEFT JOIN metadata m ON true
@@ -2375,6 +2555,7 @@ type ReadWorkflowRunByExternalIdRow struct {
 	StartedAt            pgtype.Timestamptz   `json:"started_at"`
 	FinishedAt           pgtype.Timestamptz   `json:"finished_at"`
 	ErrorMessage         pgtype.Text          `json:"error_message"`
+	ErrorDetails         []byte               `json:"error_details"`
 	TaskMetadata         []byte               `json:"task_metadata"`
 }
 
@@ -2400,6 +2581,7 @@ func (q *Queries) ReadWorkflowRunByExternalId(ctx context.Context, db DBTX, work
 		&i.StartedAt,
 		&i.FinishedAt,
 		&i.ErrorMessage,
+		&i.ErrorDetails,
 		&i.TaskMetadata,
 	)
 	return &i, err
@@ -2822,3 +3004,57 @@ func (q *Queries) UpdateTaskStatuses(ctx context.Context, db DBTX, arg UpdateTas
 	}
 	return items, nil
 }
+
+const upsertRateLimitUsageOLAP = `-- name: UpsertRateLimitUsageOLAP :exec
+WITH input AS (
+    SELECT
+        UNNEST($2::TEXT[]) AS key,
+        UNNEST($3::TIMESTAMPTZ[]) AS bucket,
+        UNNEST($4::BIGINT[]) AS units_consumed,
+        UNNEST($5::BIGINT[]) AS tasks_rate_limited,
+        UNNEST($6::BIGINT[]) AS rate_limited_attempts
+)
+INSERT INTO v1_rate_limit_usage_olap (
+    tenant_id,
+    key,
+    bucket,
+    units_consumed,
+    tasks_rate_limited,
+    rate_limited_attempts
+)
+SELECT
+    $1::UUID,
+    key,
+    bucket,
+    units_consumed,
+    tasks_rate_limited,
+    rate_limited_attempts
+FROM
+    input
+ON CONFLICT (tenant_id, key, bucket) DO UPDATE
+SET
+    units_consumed = v1_rate_limit_usage_olap.units_consumed + EXCLUDED.units_consumed,
+    tasks_rate_limited = v1_rate_limit_usage_olap.tasks_rate_limited + EXCLUDED.tasks_rate_limited,
+    rate_limited_attempts = v1_rate_limit_usage_olap.rate_limited_attempts + EXCLUDED.rate_limited_attempts
+`
+
+type UpsertRateLimitUsageOLAPParams struct {
+	Tenantid            pgtype.UUID          `json:"tenantid"`
+	Keys                []string             `json:"keys"`
+	Buckets             []pgtype.Timestamptz `json:"buckets"`
+	Unitsconsumed       []int64              `json:"unitsconsumed"`
+	Tasksratelimited    []int64              `json:"tasksratelimited"`
+	Ratelimitedattempts []int64              `json:"ratelimitedattempts"`
+}
+
+func (q *Queries) UpsertRateLimitUsageOLAP(ctx context.Context, db DBTX, arg UpsertRateLimitUsageOLAPParams) error {
+	_, err := db.Exec(ctx, upsertRateLimitUsageOLAP,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Buckets,
+		arg.Unitsconsumed,
+		arg.Tasksratelimited,
+		arg.Ratelimitedattempts,
+	)
+	return err
+}
@@ -34,7 +34,8 @@ WHERE
     AND w."dispatcherId" IS NOT NULL
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
-    AND w."isPaused" = false;
+    AND w."isPaused" = false
+    AND w."drainingAt" IS NULL;
 
 -- name: ListAvailableSlotsForWorkers :many
 WITH worker_max_runs AS (
@@ -49,7 +50,7 @@ WITH worker_max_runs AS (
 ), worker_filled_slots AS (
     SELECT
         worker_id,
-        COUNT(task_id) AS "filledSlots"
+        SUM(slot_weight) AS "filledSlots"
     FROM
         v1_task_runtime
     WHERE
@@ -90,12 +91,183 @@ WHERE
     )
     -- Added to ensure that the index is used
     AND qi.priority >= 1 AND qi.priority <= 4
+    AND (
+        sqlc.narg('unkeyedOnly')::boolean IS NOT TRUE OR
+        qi.fairness_key IS NULL
+    )
 ORDER BY
     qi.priority DESC,
     qi.id ASC
 LIMIT
     COALESCE(sqlc.narg('limit')::integer, 100);
 
+-- name: ListQueueFairnessKeys :many
+-- Lists the distinct fairness keys in the queue which sort after the given key. This walks the fairness
+-- index one key at a time, so it doesn't need to scan every queue item.
+WITH RECURSIVE fairness_keys AS (
+    (
+        SELECT
+            qi.fairness_key
+        FROM
+            v1_queue_item qi
+        WHERE
+            qi.tenant_id = @tenantId::uuid
+            AND qi.queue = @queue::text
+            AND qi.fairness_key > @afterKey::text
+        ORDER BY
+            qi.fairness_key ASC
+        LIMIT 1
+    )
+    UNION ALL
+    SELECT
+        (
+            SELECT
+                qi.fairness_key
+            FROM
+                v1_queue_item qi
+            WHERE
+                qi.tenant_id = @tenantId::uuid
+                AND qi.queue = @queue::text
+                AND qi.fairness_key > fk.fairness_key
+            ORDER BY
+                qi.fairness_key ASC
+            LIMIT 1
+        )
+    FROM
+        fairness_keys fk
+    WHERE
+        fk.fairness_key IS NOT NULL
+)
+SELECT
+    fairness_key::text
+FROM
+    fairness_keys
+WHERE
+    fairness_key IS NOT NULL
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 100);
+
+-- name: ListQueueItemsForFairnessKeys :many
+-- Lists up to @perKeyLimit queue items for each of the given fairness keys.
+SELECT
+    *
+FROM
+    v1_queue_item
+WHERE
+    id IN (
+        SELECT
+            items.id
+        FROM
+            unnest(@fairnessKeys::text[]) AS keys(fairness_key)
+        CROSS JOIN LATERAL (
+            SELECT
+                qi.id
+            FROM
+                v1_queue_item qi
+            WHERE
+                qi.tenant_id = @tenantId::uuid
+                AND qi.queue = @queue::text
+                AND qi.fairness_key = keys.fairness_key
+                AND (
+                    sqlc.narg('gtId')::bigint IS NULL OR
+                    qi.id >= sqlc.narg('gtId')::bigint
+                )
+                -- Added to ensure that the index is used
+                AND qi.priority >= 1 AND qi.priority <= 4
+            ORDER BY
+                qi.priority DESC,
+                qi.id ASC
+            LIMIT
+                @perKeyLimit::integer
+        ) items
+    )
+ORDER BY
+    priority DESC,
+    id ASC;
+
+-- name: AgeQueueItems :many
+-- Raises the priority of queue items which have been waiting for longer than the aging interval of
+-- their workflow version (or tenant, if the workflow version does not set one). Priority is raised by
+-- 1 for each elapsed interval, capped at the maximum priority of 3.
+WITH items AS (
+    SELECT
+        qi.id,
+        qi.priority,
+        LEAST(
+            3,
+            COALESCE(t.priority, 1) + FLOOR(
+                EXTRACT(EPOCH FROM (NOW() - qi.inserted_at)) / COALESCE(wv."priorityAgingSeconds", tn."priorityAgingSeconds")
+            )::integer
+        ) AS effective_priority
+    FROM
+        v1_queue_item qi
+    JOIN
+        v1_task t ON t.id = qi.task_id AND t.inserted_at = qi.task_inserted_at
+    JOIN
+        "WorkflowVersion" wv ON wv."id" = t.workflow_version_id
+    JOIN
+        "Tenant" tn ON tn."id" = qi.tenant_id
+    WHERE
+        qi.tenant_id = @tenantId::uuid
+        AND qi.queue = @queue::text
+        AND qi.priority >= 1 AND qi.priority < 3
+        AND COALESCE(wv."priorityAgingSeconds", tn."priorityAgingSeconds") > 0
+    ORDER BY
+        qi.id ASC
+    LIMIT
+        COALESCE(sqlc.narg('limit')::integer, 1000)
+    FOR UPDATE OF qi SKIP LOCKED
+)
+UPDATE
+    v1_queue_item qi
+SET
+    priority = items.effective_priority
+FROM
+    items
+WHERE
+    qi.id = items.id
+    AND items.effective_priority > qi.priority
+RETURNING
+    qi.id, qi.task_id, qi.priority;
+
+-- name: UpdateQueueItemSchedulingReasons :exec
+-- Records the reason that the scheduler could not assign each queue item. Queue items which are locked, for
+-- example because they are being assigned, are skipped.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@ids::bigint[]) AS id,
+                unnest(cast(@reasons::text[] as v1_scheduling_reason[])) AS reason,
+                unnest(@messages::text[]) AS message
+        ) AS subquery
+), locked_qis AS (
+    SELECT
+        qi.id
+    FROM
+        v1_queue_item qi
+    WHERE
+        qi.tenant_id = @tenantId::uuid
+        AND qi.id = ANY(@ids::bigint[])
+    ORDER BY
+        qi.id ASC
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    v1_queue_item qi
+SET
+    scheduling_reason = input.reason,
+    scheduling_message = NULLIF(input.message, ''),
+    scheduling_evaluated_at = NOW()
+FROM
+    input
+JOIN
+    locked_qis ON locked_qis.id = input.id
+WHERE
+    qi.id = input.id;
+
 -- name: GetMinUnprocessedQueueItemId :one
 WITH priority_1 AS (
     SELECT
@@ -199,11 +371,16 @@ WITH input AS (
         t.retry_count,
         input.worker_id,
         t.tenant_id,
-        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at
+        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at,
+        COALESCE(s."slotWeight", 1) AS slot_weight,
+        COALESCE(s."preemptible", false) AS preemptible,
+        t.step_id
     FROM
         input
     JOIN
         v1_task t ON t.id = input.id
+    LEFT JOIN
+        "Step" s ON s."id" = t.step_id
     ORDER BY t.id
 ), assigned_tasks AS (
     INSERT INTO v1_task_runtime (
@@ -212,7 +389,9 @@ WITH input AS (
         retry_count,
         worker_id,
         tenant_id,
-        timeout_at
+        timeout_at,
+        slot_weight,
+        preemptible
     )
     SELECT
         t.id,
@@ -220,12 +399,86 @@ WITH input AS (
         t.retry_count,
         t.worker_id,
         @tenantId::uuid,
-        t.timeout_at
+        t.timeout_at,
+        t.slot_weight,
+        t.preemptible
     FROM
         updated_tasks t
     ON CONFLICT (task_id, task_inserted_at, retry_count) DO NOTHING
     -- only return the task ids that were successfully assigned
-    RETURNING task_id, worker_id
+    RETURNING task_id, task_inserted_at, retry_count, worker_id
+), assigned_resources AS (
+    INSERT INTO v1_task_runtime_resource (
+        task_id,
+        task_inserted_at,
+        retry_count,
+        tenant_id,
+        worker_id,
+        key,
+        units
+    )
+    SELECT
+        asr.task_id,
+        asr.task_inserted_at,
+        asr.retry_count,
+        @tenantId::uuid,
+        asr.worker_id,
+        srr.key,
+        srr.units
+    FROM
+        assigned_tasks asr
+    JOIN
+        updated_tasks t ON t.id = asr.task_id AND t.inserted_at = asr.task_inserted_at
+    JOIN
+        v1_step_resource_request srr ON srr.step_id = t.step_id
+    ON CONFLICT (task_id, task_inserted_at, retry_count, key) DO NOTHING
+), assigned_semaphores AS (
+    -- tasks hold the units of SEMAPHORE rate limits until they are released, for both static keys and keys
+    -- from expressions
+    INSERT INTO v1_task_runtime_semaphore (
+        task_id,
+        task_inserted_at,
+        retry_count,
+        tenant_id,
+        key,
+        units
+    )
+    SELECT
+        asr.task_id,
+        asr.task_inserted_at,
+        asr.retry_count,
+        @tenantId::uuid,
+        srl."rateLimitKey",
+        srl."units"
+    FROM
+        assigned_tasks asr
+    JOIN
+        updated_tasks t ON t.id = asr.task_id AND t.inserted_at = asr.task_inserted_at
+    JOIN
+        "StepRateLimit" srl ON srl."stepId" = t.step_id AND srl."tenantId" = @tenantId::uuid
+    JOIN
+        "RateLimit" rl ON rl."tenantId" = srl."tenantId" AND rl."key" = srl."rateLimitKey"
+    WHERE
+        rl."algorithm" = 'SEMAPHORE'
+    UNION ALL
+    SELECT
+        asr.task_id,
+        asr.task_inserted_at,
+        asr.retry_count,
+        @tenantId::uuid,
+        k.value_str,
+        COALESCE(u.value_int, 1)
+    FROM
+        assigned_tasks asr
+    JOIN
+        v1_task_expression_eval k ON k.task_id = asr.task_id AND k.task_inserted_at = asr.task_inserted_at AND k.kind = 'DYNAMIC_RATE_LIMIT_KEY'
+    LEFT JOIN
+        v1_task_expression_eval u ON u.task_id = k.task_id AND u.task_inserted_at = k.task_inserted_at AND u.key = k.key AND u.kind = 'DYNAMIC_RATE_LIMIT_UNITS'
+    JOIN
+        "RateLimit" rl ON rl."tenantId" = @tenantId::uuid AND rl."key" = k.value_str
+    WHERE
+        rl."algorithm" = 'SEMAPHORE'
+    ON CONFLICT (task_id, task_inserted_at, retry_count, key) DO NOTHING
 )
 SELECT
     asr.task_id,
@@ -247,6 +500,91 @@ FROM
 WHERE
     "stepId" = ANY(@stepIds::uuid[]);
 
+-- name: ListStepSlotWeights :many
+SELECT
+    "id",
+    "slotWeight"
+FROM
+    "Step"
+WHERE
+    "id" = ANY(@stepIds::uuid[]);
+
+-- name: ListStepGangs :many
+SELECT
+    s."id",
+    s."gangName"::text AS "gangName",
+    s."gangTimeout",
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            "Step" s2
+        WHERE
+            s2."jobId" = s."jobId"
+            AND s2."gangName" = s."gangName"
+            AND s2."deletedAt" IS NULL
+    )::int AS "gangSize"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY(@stepIds::uuid[])
+    AND s."gangName" IS NOT NULL;
+
+-- name: ListStepPlacementStrategies :many
+SELECT
+    s."id",
+    s."placementStrategy"::text AS "placementStrategy"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY(@stepIds::uuid[])
+    AND s."placementStrategy" IS NOT NULL;
+
+-- name: ListStepResourceRequests :many
+SELECT
+    step_id,
+    key,
+    units
+FROM
+    v1_step_resource_request
+WHERE
+    step_id = ANY(@stepIds::uuid[]);
+
+-- name: ListResourceUsageForWorkers :many
+SELECT
+    worker_id,
+    key,
+    SUM(units)::int AS "units"
+FROM
+    v1_task_runtime_resource
+WHERE
+    tenant_id = @tenantId::uuid
+    AND worker_id = ANY(@workerIds::uuid[])
+GROUP BY
+    worker_id, key;
+
+-- name: ListPreemptibleTasksForWorkers :many
+-- Lists the preemptible tasks running on the given workers with a priority lower than @maxPriority.
+SELECT
+    r.task_id,
+    r.task_inserted_at,
+    r.retry_count,
+    r.worker_id,
+    r.slot_weight,
+    t.priority,
+    t.external_id
+FROM
+    v1_task_runtime r
+JOIN
+    v1_task t ON t.id = r.task_id AND t.inserted_at = r.task_inserted_at AND t.retry_count = r.retry_count
+WHERE
+    r.tenant_id = @tenantId::uuid
+    AND r.worker_id = ANY(@workerIds::uuid[])
+    AND r.preemptible
+    AND COALESCE(t.priority, 1) < @maxPriority::integer
+ORDER BY
+    COALESCE(t.priority, 1) ASC, r.task_id DESC;
+
 -- name: GetQueuedCounts :many
 SELECT
     queue,
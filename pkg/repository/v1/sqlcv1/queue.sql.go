@@ -11,6 +11,84 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const ageQueueItems = `-- name: AgeQueueItems :many
+WITH items AS (
+    SELECT
+        qi.id,
+        qi.priority,
+        LEAST(
+            3,
+            COALESCE(t.priority, 1) + FLOOR(
+                EXTRACT(EPOCH FROM (NOW() - qi.inserted_at)) / COALESCE(wv."priorityAgingSeconds", tn."priorityAgingSeconds")
+            )::integer
+        ) AS effective_priority
+    FROM
+        v1_queue_item qi
+    JOIN
+        v1_task t ON t.id = qi.task_id AND t.inserted_at = qi.task_inserted_at
+    JOIN
+        "WorkflowVersion" wv ON wv."id" = t.workflow_version_id
+    JOIN
+        "Tenant" tn ON tn."id" = qi.tenant_id
+    WHERE
+        qi.tenant_id = $1::uuid
+        AND qi.queue = $2::text
+        AND qi.priority >= 1 AND qi.priority < 3
+        AND COALESCE(wv."priorityAgingSeconds", tn."priorityAgingSeconds") > 0
+    ORDER BY
+        qi.id ASC
+    LIMIT
+        COALESCE($3::integer, 1000)
+    FOR UPDATE OF qi SKIP LOCKED
+)
+UPDATE
+    v1_queue_item qi
+SET
+    priority = items.effective_priority
+FROM
+    items
+WHERE
+    qi.id = items.id
+    AND items.effective_priority > qi.priority
+RETURNING
+    qi.id, qi.task_id, qi.priority
+`
+
+type AgeQueueItemsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Queue    string      `json:"queue"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+type AgeQueueItemsRow struct {
+	ID       int64 `json:"id"`
+	TaskID   int64 `json:"task_id"`
+	Priority int32 `json:"priority"`
+}
+
+// Raises the priority of queue items which have been waiting for longer than the aging interval of
+// their workflow version (or tenant, if the workflow version does not set one). Priority is raised by
+// 1 for each elapsed interval, capped at the maximum priority of 3.
+func (q *Queries) AgeQueueItems(ctx context.Context, db DBTX, arg AgeQueueItemsParams) ([]*AgeQueueItemsRow, error) {
+	rows, err := db.Query(ctx, ageQueueItems, arg.Tenantid, arg.Queue, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AgeQueueItemsRow
+	for rows.Next() {
+		var i AgeQueueItemsRow
+		if err := rows.Scan(&i.ID, &i.TaskID, &i.Priority); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const bulkQueueItems = `-- name: BulkQueueItems :many
 WITH locked_qis AS (
     SELECT
@@ -273,6 +351,7 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false
+    AND w."drainingAt" IS NULL
 `
 
 type ListActionsForWorkersParams struct {
@@ -318,7 +397,7 @@ WITH worker_max_runs AS (
 ), worker_filled_slots AS (
     SELECT
         worker_id,
-        COUNT(task_id) AS "filledSlots"
+        SUM(slot_weight) AS "filledSlots"
     FROM
         v1_task_runtime
     WHERE
@@ -367,9 +446,250 @@ func (q *Queries) ListAvailableSlotsForWorkers(ctx context.Context, db DBTX, arg
 	return items, nil
 }
 
+const listPreemptibleTasksForWorkers = `-- name: ListPreemptibleTasksForWorkers :many
+SELECT
+    r.task_id,
+    r.task_inserted_at,
+    r.retry_count,
+    r.worker_id,
+    r.slot_weight,
+    t.priority,
+    t.external_id
+FROM
+    v1_task_runtime r
+JOIN
+    v1_task t ON t.id = r.task_id AND t.inserted_at = r.task_inserted_at AND t.retry_count = r.retry_count
+WHERE
+    r.tenant_id = $1::uuid
+    AND r.worker_id = ANY($2::uuid[])
+    AND r.preemptible
+    AND COALESCE(t.priority, 1) < $3::integer
+ORDER BY
+    COALESCE(t.priority, 1) ASC, r.task_id DESC
+`
+
+type ListPreemptibleTasksForWorkersParams struct {
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+	Workerids   []pgtype.UUID `json:"workerids"`
+	Maxpriority int32         `json:"maxpriority"`
+}
+
+type ListPreemptibleTasksForWorkersRow struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount     int32              `json:"retry_count"`
+	WorkerID       pgtype.UUID        `json:"worker_id"`
+	SlotWeight     int32              `json:"slot_weight"`
+	Priority       pgtype.Int4        `json:"priority"`
+	ExternalID     pgtype.UUID        `json:"external_id"`
+}
+
+// Lists the preemptible tasks running on the given workers with a priority lower than @maxPriority.
+func (q *Queries) ListPreemptibleTasksForWorkers(ctx context.Context, db DBTX, arg ListPreemptibleTasksForWorkersParams) ([]*ListPreemptibleTasksForWorkersRow, error) {
+	rows, err := db.Query(ctx, listPreemptibleTasksForWorkers, arg.Tenantid, arg.Workerids, arg.Maxpriority)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListPreemptibleTasksForWorkersRow
+	for rows.Next() {
+		var i ListPreemptibleTasksForWorkersRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.RetryCount,
+			&i.WorkerID,
+			&i.SlotWeight,
+			&i.Priority,
+			&i.ExternalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQueueFairnessKeys = `-- name: ListQueueFairnessKeys :many
+WITH RECURSIVE fairness_keys AS (
+    (
+        SELECT
+            qi.fairness_key
+        FROM
+            v1_queue_item qi
+        WHERE
+            qi.tenant_id = $2::uuid
+            AND qi.queue = $3::text
+            AND qi.fairness_key > $4::text
+        ORDER BY
+            qi.fairness_key ASC
+        LIMIT 1
+    )
+    UNION ALL
+    SELECT
+        (
+            SELECT
+                qi.fairness_key
+            FROM
+                v1_queue_item qi
+            WHERE
+                qi.tenant_id = $2::uuid
+                AND qi.queue = $3::text
+                AND qi.fairness_key > fk.fairness_key
+            ORDER BY
+                qi.fairness_key ASC
+            LIMIT 1
+        )
+    FROM
+        fairness_keys fk
+    WHERE
+        fk.fairness_key IS NOT NULL
+)
+SELECT
+    fairness_key::text
+FROM
+    fairness_keys
+WHERE
+    fairness_key IS NOT NULL
+LIMIT
+    COALESCE($1::integer, 100)
+`
+
+type ListQueueFairnessKeysParams struct {
+	Limit    pgtype.Int4 `json:"limit"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Queue    string      `json:"queue"`
+	Afterkey string      `json:"afterkey"`
+}
+
+// Lists the distinct fairness keys in the queue which sort after the given key. This walks the fairness
+// index one key at a time, so it doesn't need to scan every queue item.
+func (q *Queries) ListQueueFairnessKeys(ctx context.Context, db DBTX, arg ListQueueFairnessKeysParams) ([]string, error) {
+	rows, err := db.Query(ctx, listQueueFairnessKeys,
+		arg.Limit,
+		arg.Tenantid,
+		arg.Queue,
+		arg.Afterkey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var fairness_key string
+		if err := rows.Scan(&fairness_key); err != nil {
+			return nil, err
+		}
+		items = append(items, fairness_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQueueItemsForFairnessKeys = `-- name: ListQueueItemsForFairnessKeys :many
+SELECT
+    id, tenant_id, queue, task_id, task_inserted_at, external_id, action_id, step_id, workflow_id, workflow_run_id, schedule_timeout_at, step_timeout, priority, sticky, desired_worker_id, retry_count, inserted_at, fairness_key, scheduling_reason, scheduling_message, scheduling_evaluated_at
+FROM
+    v1_queue_item
+WHERE
+    id IN (
+        SELECT
+            items.id
+        FROM
+            unnest($1::text[]) AS keys(fairness_key)
+        CROSS JOIN LATERAL (
+            SELECT
+                qi.id
+            FROM
+                v1_queue_item qi
+            WHERE
+                qi.tenant_id = $2::uuid
+                AND qi.queue = $3::text
+                AND qi.fairness_key = keys.fairness_key
+                AND (
+                    $4::bigint IS NULL OR
+                    qi.id >= $4::bigint
+                )
+                -- Added to ensure that the index is used
+                AND qi.priority >= 1 AND qi.priority <= 4
+            ORDER BY
+                qi.priority DESC,
+                qi.id ASC
+            LIMIT
+                $5::integer
+        ) items
+    )
+ORDER BY
+    priority DESC,
+    id ASC
+`
+
+type ListQueueItemsForFairnessKeysParams struct {
+	Fairnesskeys []string    `json:"fairnesskeys"`
+	Tenantid     pgtype.UUID `json:"tenantid"`
+	Queue        string      `json:"queue"`
+	GtId         pgtype.Int8 `json:"gtId"`
+	Perkeylimit  int32       `json:"perkeylimit"`
+}
+
+// Lists up to @perKeyLimit queue items for each of the given fairness keys.
+func (q *Queries) ListQueueItemsForFairnessKeys(ctx context.Context, db DBTX, arg ListQueueItemsForFairnessKeysParams) ([]*V1QueueItem, error) {
+	rows, err := db.Query(ctx, listQueueItemsForFairnessKeys,
+		arg.Fairnesskeys,
+		arg.Tenantid,
+		arg.Queue,
+		arg.GtId,
+		arg.Perkeylimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1QueueItem
+	for rows.Next() {
+		var i V1QueueItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Queue,
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.ExternalID,
+			&i.ActionID,
+			&i.StepID,
+			&i.WorkflowID,
+			&i.WorkflowRunID,
+			&i.ScheduleTimeoutAt,
+			&i.StepTimeout,
+			&i.Priority,
+			&i.Sticky,
+			&i.DesiredWorkerID,
+			&i.RetryCount,
+			&i.InsertedAt,
+			&i.FairnessKey,
+			&i.SchedulingReason,
+			&i.SchedulingMessage,
+			&i.SchedulingEvaluatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listQueueItemsForQueue = `-- name: ListQueueItemsForQueue :many
 SELECT
-    id, tenant_id, queue, task_id, task_inserted_at, external_id, action_id, step_id, workflow_id, workflow_run_id, schedule_timeout_at, step_timeout, priority, sticky, desired_worker_id, retry_count
+    id, tenant_id, queue, task_id, task_inserted_at, external_id, action_id, step_id, workflow_id, workflow_run_id, schedule_timeout_at, step_timeout, priority, sticky, desired_worker_id, retry_count, inserted_at, fairness_key, scheduling_reason, scheduling_message, scheduling_evaluated_at
 FROM
     v1_queue_item qi
 WHERE
@@ -381,18 +701,23 @@ WHERE
     )
     -- Added to ensure that the index is used
     AND qi.priority >= 1 AND qi.priority <= 4
+    AND (
+        $4::boolean IS NOT TRUE OR
+        qi.fairness_key IS NULL
+    )
 ORDER BY
     qi.priority DESC,
     qi.id ASC
 LIMIT
-    COALESCE($4::integer, 100)
+    COALESCE($5::integer, 100)
 `
 
 type ListQueueItemsForQueueParams struct {
-	Tenantid pgtype.UUID `json:"tenantid"`
-	Queue    string      `json:"queue"`
-	GtId     pgtype.Int8 `json:"gtId"`
-	Limit    pgtype.Int4 `json:"limit"`
+	Tenantid    pgtype.UUID `json:"tenantid"`
+	Queue       string      `json:"queue"`
+	GtId        pgtype.Int8 `json:"gtId"`
+	UnkeyedOnly pgtype.Bool `json:"unkeyedOnly"`
+	Limit       pgtype.Int4 `json:"limit"`
 }
 
 func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQueueItemsForQueueParams) ([]*V1QueueItem, error) {
@@ -400,6 +725,7 @@ func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQ
 		arg.Tenantid,
 		arg.Queue,
 		arg.GtId,
+		arg.UnkeyedOnly,
 		arg.Limit,
 	)
 	if err != nil {
@@ -426,6 +752,11 @@ func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQ
 			&i.Sticky,
 			&i.DesiredWorkerID,
 			&i.RetryCount,
+			&i.InsertedAt,
+			&i.FairnessKey,
+			&i.SchedulingReason,
+			&i.SchedulingMessage,
+			&i.SchedulingEvaluatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -467,6 +798,269 @@ func (q *Queries) ListQueues(ctx context.Context, db DBTX, tenantid pgtype.UUID)
 	return items, nil
 }
 
+const listResourceUsageForWorkers = `-- name: ListResourceUsageForWorkers :many
+SELECT
+    worker_id,
+    key,
+    SUM(units)::int AS "units"
+FROM
+    v1_task_runtime_resource
+WHERE
+    tenant_id = $1::uuid
+    AND worker_id = ANY($2::uuid[])
+GROUP BY
+    worker_id, key
+`
+
+type ListResourceUsageForWorkersParams struct {
+	Tenantid  pgtype.UUID   `json:"tenantid"`
+	Workerids []pgtype.UUID `json:"workerids"`
+}
+
+type ListResourceUsageForWorkersRow struct {
+	WorkerID pgtype.UUID `json:"worker_id"`
+	Key      string      `json:"key"`
+	Units    int32       `json:"units"`
+}
+
+func (q *Queries) ListResourceUsageForWorkers(ctx context.Context, db DBTX, arg ListResourceUsageForWorkersParams) ([]*ListResourceUsageForWorkersRow, error) {
+	rows, err := db.Query(ctx, listResourceUsageForWorkers, arg.Tenantid, arg.Workerids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListResourceUsageForWorkersRow
+	for rows.Next() {
+		var i ListResourceUsageForWorkersRow
+		if err := rows.Scan(&i.WorkerID, &i.Key, &i.Units); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepGangs = `-- name: ListStepGangs :many
+SELECT
+    s."id",
+    s."gangName"::text AS "gangName",
+    s."gangTimeout",
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            "Step" s2
+        WHERE
+            s2."jobId" = s."jobId"
+            AND s2."gangName" = s."gangName"
+            AND s2."deletedAt" IS NULL
+    )::int AS "gangSize"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY($1::uuid[])
+    AND s."gangName" IS NOT NULL
+`
+
+type ListStepGangsRow struct {
+	ID          pgtype.UUID `json:"id"`
+	GangName    string      `json:"gangName"`
+	GangTimeout pgtype.Text `json:"gangTimeout"`
+	GangSize    int32       `json:"gangSize"`
+}
+
+func (q *Queries) ListStepGangs(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*ListStepGangsRow, error) {
+	rows, err := db.Query(ctx, listStepGangs, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepGangsRow
+	for rows.Next() {
+		var i ListStepGangsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GangName,
+			&i.GangTimeout,
+			&i.GangSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepPlacementStrategies = `-- name: ListStepPlacementStrategies :many
+SELECT
+    s."id",
+    s."placementStrategy"::text AS "placementStrategy"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY($1::uuid[])
+    AND s."placementStrategy" IS NOT NULL
+`
+
+type ListStepPlacementStrategiesRow struct {
+	ID                pgtype.UUID `json:"id"`
+	PlacementStrategy string      `json:"placementStrategy"`
+}
+
+func (q *Queries) ListStepPlacementStrategies(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*ListStepPlacementStrategiesRow, error) {
+	rows, err := db.Query(ctx, listStepPlacementStrategies, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepPlacementStrategiesRow
+	for rows.Next() {
+		var i ListStepPlacementStrategiesRow
+		if err := rows.Scan(&i.ID, &i.PlacementStrategy); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepResourceRequests = `-- name: ListStepResourceRequests :many
+SELECT
+    step_id,
+    key,
+    units
+FROM
+    v1_step_resource_request
+WHERE
+    step_id = ANY($1::uuid[])
+`
+
+type ListStepResourceRequestsRow struct {
+	StepID pgtype.UUID `json:"step_id"`
+	Key    string      `json:"key"`
+	Units  int32       `json:"units"`
+}
+
+func (q *Queries) ListStepResourceRequests(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*ListStepResourceRequestsRow, error) {
+	rows, err := db.Query(ctx, listStepResourceRequests, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepResourceRequestsRow
+	for rows.Next() {
+		var i ListStepResourceRequestsRow
+		if err := rows.Scan(&i.StepID, &i.Key, &i.Units); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepSlotWeights = `-- name: ListStepSlotWeights :many
+SELECT
+    "id",
+    "slotWeight"
+FROM
+    "Step"
+WHERE
+    "id" = ANY($1::uuid[])
+`
+
+type ListStepSlotWeightsRow struct {
+	ID         pgtype.UUID `json:"id"`
+	SlotWeight int32       `json:"slotWeight"`
+}
+
+func (q *Queries) ListStepSlotWeights(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*ListStepSlotWeightsRow, error) {
+	rows, err := db.Query(ctx, listStepSlotWeights, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepSlotWeightsRow
+	for rows.Next() {
+		var i ListStepSlotWeightsRow
+		if err := rows.Scan(&i.ID, &i.SlotWeight); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateQueueItemSchedulingReasons = `-- name: UpdateQueueItemSchedulingReasons :exec
+WITH input AS (
+    SELECT
+        id, reason, message
+    FROM
+        (
+            SELECT
+                unnest($1::bigint[]) AS id,
+                unnest(cast($2::text[] as v1_scheduling_reason[])) AS reason,
+                unnest($3::text[]) AS message
+        ) AS subquery
+), locked_qis AS (
+    SELECT
+        qi.id
+    FROM
+        v1_queue_item qi
+    WHERE
+        qi.tenant_id = $4::uuid
+        AND qi.id = ANY($1::bigint[])
+    ORDER BY
+        qi.id ASC
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    v1_queue_item qi
+SET
+    scheduling_reason = input.reason,
+    scheduling_message = NULLIF(input.message, ''),
+    scheduling_evaluated_at = NOW()
+FROM
+    input
+JOIN
+    locked_qis ON locked_qis.id = input.id
+WHERE
+    qi.id = input.id
+`
+
+type UpdateQueueItemSchedulingReasonsParams struct {
+	Ids      []int64     `json:"ids"`
+	Reasons  []string    `json:"reasons"`
+	Messages []string    `json:"messages"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+// Records the reason that the scheduler could not assign each queue item. Queue items which are locked, for
+// example because they are being assigned, are skipped.
+func (q *Queries) UpdateQueueItemSchedulingReasons(ctx context.Context, db DBTX, arg UpdateQueueItemSchedulingReasonsParams) error {
+	_, err := db.Exec(ctx, updateQueueItemSchedulingReasons,
+		arg.Ids,
+		arg.Reasons,
+		arg.Messages,
+		arg.Tenantid,
+	)
+	return err
+}
+
 const updateTasksToAssigned = `-- name: UpdateTasksToAssigned :many
 WITH input AS (
     SELECT
@@ -486,11 +1080,16 @@ WITH input AS (
         t.retry_count,
         input.worker_id,
         t.tenant_id,
-        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at
+        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at,
+        COALESCE(s."slotWeight", 1) AS slot_weight,
+        COALESCE(s."preemptible", false) AS preemptible,
+        t.step_id
     FROM
         input
     JOIN
         v1_task t ON t.id = input.id
+    LEFT JOIN
+        "Step" s ON s."id" = t.step_id
     ORDER BY t.id
 ), assigned_tasks AS (
     INSERT INTO v1_task_runtime (
@@ -499,7 +1098,9 @@ WITH input AS (
         retry_count,
         worker_id,
         tenant_id,
-        timeout_at
+        timeout_at,
+        slot_weight,
+        preemptible
     )
     SELECT
         t.id,
@@ -507,12 +1108,86 @@ WITH input AS (
         t.retry_count,
         t.worker_id,
         $3::uuid,
-        t.timeout_at
+        t.timeout_at,
+        t.slot_weight,
+        t.preemptible
     FROM
         updated_tasks t
     ON CONFLICT (task_id, task_inserted_at, retry_count) DO NOTHING
     -- only return the task ids that were successfully assigned
-    RETURNING task_id, worker_id
+    RETURNING task_id, task_inserted_at, retry_count, worker_id
+), assigned_resources AS (
+    INSERT INTO v1_task_runtime_resource (
+        task_id,
+        task_inserted_at,
+        retry_count,
+        tenant_id,
+        worker_id,
+        key,
+        units
+    )
+    SELECT
+        asr.task_id,
+        asr.task_inserted_at,
+        asr.retry_count,
+        $3::uuid,
+        asr.worker_id,
+        srr.key,
+        srr.units
+    FROM
+        assigned_tasks asr
+    JOIN
+        updated_tasks t ON t.id = asr.task_id AND t.inserted_at = asr.task_inserted_at
+    JOIN
+        v1_step_resource_request srr ON srr.step_id = t.step_id
+    ON CONFLICT (task_id, task_inserted_at, retry_count, key) DO NOTHING
+), assigned_semaphores AS (
+    -- tasks hold the units of SEMAPHORE rate limits until they are released, for both static keys and keys
+    -- from expressions
+    INSERT INTO v1_task_runtime_semaphore (
+        task_id,
+        task_inserted_at,
+        retry_count,
+        tenant_id,
+        key,
+        units
+    )
+    SELECT
+        asr.task_id,
+        asr.task_inserted_at,
+        asr.retry_count,
+        $3::uuid,
+        srl."rateLimitKey",
+        srl."units"
+    FROM
+        assigned_tasks asr
+    JOIN
+        updated_tasks t ON t.id = asr.task_id AND t.inserted_at = asr.task_inserted_at
+    JOIN
+        "StepRateLimit" srl ON srl."stepId" = t.step_id AND srl."tenantId" = $3::uuid
+    JOIN
+        "RateLimit" rl ON rl."tenantId" = srl."tenantId" AND rl."key" = srl."rateLimitKey"
+    WHERE
+        rl."algorithm" = 'SEMAPHORE'
+    UNION ALL
+    SELECT
+        asr.task_id,
+        asr.task_inserted_at,
+        asr.retry_count,
+        $3::uuid,
+        k.value_str,
+        COALESCE(u.value_int, 1)
+    FROM
+        assigned_tasks asr
+    JOIN
+        v1_task_expression_eval k ON k.task_id = asr.task_id AND k.task_inserted_at = asr.task_inserted_at AND k.kind = 'DYNAMIC_RATE_LIMIT_KEY'
+    LEFT JOIN
+        v1_task_expression_eval u ON u.task_id = k.task_id AND u.task_inserted_at = k.task_inserted_at AND u.key = k.key AND u.kind = 'DYNAMIC_RATE_LIMIT_UNITS'
+    JOIN
+        "RateLimit" rl ON rl."tenantId" = $3::uuid AND rl."key" = k.value_str
+    WHERE
+        rl."algorithm" = 'SEMAPHORE'
+    ON CONFLICT (task_id, task_inserted_at, retry_count, key) DO NOTHING
 )
 SELECT
     asr.task_id,
@@ -17,20 +17,26 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "isDynamic"
 )
 SELECT
     @tenantId::uuid,
     iv."key",
     iv."limitValue",
     iv."limitValue",
-    iv."window"
+    iv."window",
+    true
 FROM
     input_values iv
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END;
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    "lastUsedAt" = CURRENT_TIMESTAMP
+-- semaphores are only configured through the API, so dynamic limits don't overwrite them
+WHERE
+    "RateLimit"."algorithm" <> 'SEMAPHORE';
 
 -- name: ListRateLimitsForTenantNoMutate :many
 -- Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
@@ -38,19 +44,10 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_last_refill(rl)::timestamp AS "lastRefill",
+    "algorithm"
 FROM
     "RateLimit" rl
 WHERE
@@ -74,7 +71,7 @@ WITH rls_to_update AS (
         "RateLimit" rl
     WHERE
         rl."tenantId" = @tenantId::uuid
-        AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds')
+        AND get_refill_last_refill(rl) IS DISTINCT FROM rl."lastRefill"
     ORDER BY
         rl."tenantId" ASC, rl."key" ASC
     FOR UPDATE
@@ -83,17 +80,23 @@ WITH rls_to_update AS (
         "RateLimit" rl
     SET
         "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
+        "lastRefill" = get_refill_last_refill(rl),
+        "previousUsage" = get_refill_previous_usage(rl)
     FROM
         rls_to_update
     WHERE
         rl."tenantId" = rls_to_update."tenantId"
         AND rl."key" = rls_to_update."key"
-    RETURNING rl.*
+    -- return the units which are available now and the next refill time
+    RETURNING
+        rl.*,
+        get_available_value(rl)::int AS "availableValue",
+        get_next_refill_at(rl)::timestamp AS "nextRefillAt"
 )
 SELECT
     rl.*,
-    (rl."lastRefill" + rl."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    get_available_value(rl)::int AS "availableValue",
+    get_next_refill_at(rl)::timestamp AS "nextRefillAt"
 FROM
     "RateLimit" rl
 WHERE
@@ -103,9 +106,7 @@ WHERE
 UNION ALL
 
 SELECT
-    refill.*,
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    refill.*
 FROM
     refill;
 
@@ -144,15 +145,126 @@ UPDATE
     "RateLimit" rl
 SET
     "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "lastRefill" = get_consumed_last_refill(rl, (SELECT "units" FROM input WHERE "key" = rl."key")),
+    "previousUsage" = get_refill_previous_usage(rl),
+    "lastUsedAt" = CASE WHEN (SELECT "units" FROM input WHERE "key" = rl."key") > 0 THEN CURRENT_TIMESTAMP ELSE rl."lastUsedAt" END
 FROM
     rls_to_update rl2
 WHERE
     rl2."tenantId" = rl."tenantId"
     AND rl2."key" = rl."key"
 RETURNING rl.*;
+
+-- name: GetRateLimit :one
+SELECT
+    *
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = @key::text;
+
+-- name: DeleteRateLimits :many
+-- Deletes the rate limits with the given keys or key prefix. Rate limits which are referenced by a workflow
+-- step or by tasks waiting to be scheduled can't be deleted, so they are skipped.
+WITH pending_tasks AS (
+    SELECT task_id, task_inserted_at FROM v1_queue_item WHERE tenant_id = @tenantId::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_retry_queue_item WHERE tenant_id = @tenantId::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_concurrency_slot WHERE tenant_id = @tenantId::uuid
+), pending_keys AS (
+    -- deleting a dynamic key would leave the tasks waiting for it queued forever
+    SELECT DISTINCT
+        e.value_str AS "key"
+    FROM
+        pending_tasks pt
+    JOIN
+        v1_task_expression_eval e ON e.task_id = pt.task_id AND e.task_inserted_at = pt.task_inserted_at
+    WHERE
+        e.kind = 'DYNAMIC_RATE_LIMIT_KEY'
+        AND e.value_str IS NOT NULL
+        AND (
+            e.value_str = ANY(@keys::text[])
+            OR (sqlc.narg('prefix')::text IS NOT NULL AND starts_with(e.value_str, sqlc.narg('prefix')::text))
+        )
+)
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND (
+        rl."key" = ANY(@keys::text[])
+        OR (sqlc.narg('prefix')::text IS NOT NULL AND starts_with(rl."key", sqlc.narg('prefix')::text))
+    )
+    AND rl."key" NOT IN (SELECT "key" FROM pending_keys)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE srl."tenantId" = rl."tenantId" AND srl."rateLimitKey" = rl."key"
+    )
+RETURNING rl."key";
+
+-- name: ResetRateLimit :one
+-- Refills a rate limit to its limit. Semaphores are refilled to the units which aren't held by running tasks.
+UPDATE
+    "RateLimit" rl
+SET
+    "value" = CASE
+        WHEN rl."algorithm" = 'SEMAPHORE' THEN
+            rl."limitValue" - COALESCE((
+                SELECT SUM(s.units)
+                FROM v1_task_runtime_semaphore s
+                WHERE s.tenant_id = rl."tenantId" AND s.key = rl."key"
+            ), 0)::int
+        WHEN rl."algorithm" = 'TOKEN_BUCKET' THEN get_token_bucket_capacity(rl)
+        ELSE rl."limitValue"
+    END,
+    "lastRefill" = CURRENT_TIMESTAMP,
+    "previousUsage" = 0
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = @key::text
+RETURNING rl.*;
+
+-- name: DeleteUnusedDynamicRateLimits :many
+-- Deletes rate limits created from dynamic key expressions which haven't been used since @usedBefore. Keys
+-- which are still referenced by tasks waiting to be scheduled or which are held by running tasks are skipped.
+WITH pending_tasks AS (
+    SELECT task_id, task_inserted_at FROM v1_queue_item WHERE tenant_id = @tenantId::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_retry_queue_item WHERE tenant_id = @tenantId::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_concurrency_slot WHERE tenant_id = @tenantId::uuid
+), pending_keys AS (
+    SELECT DISTINCT
+        e.value_str AS "key"
+    FROM
+        pending_tasks pt
+    JOIN
+        v1_task_expression_eval e ON e.task_id = pt.task_id AND e.task_inserted_at = pt.task_inserted_at
+    WHERE
+        e.kind = 'DYNAMIC_RATE_LIMIT_KEY'
+        AND e.value_str IS NOT NULL
+), held_keys AS (
+    SELECT DISTINCT
+        s.key
+    FROM
+        v1_task_runtime_semaphore s
+    WHERE
+        s.tenant_id = @tenantId::uuid
+)
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."isDynamic"
+    AND rl."lastUsedAt" < @usedBefore::timestamp
+    AND rl."key" NOT IN (SELECT "key" FROM pending_keys)
+    AND rl."key" NOT IN (SELECT key FROM held_keys)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE srl."tenantId" = rl."tenantId" AND srl."rateLimitKey" = rl."key"
+    )
+RETURNING rl."key";
@@ -23,7 +23,7 @@ WITH input AS (
         ) AS subquery
 ), rls_to_update AS (
     SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
     FROM
         "RateLimit" rl
     WHERE
@@ -37,18 +37,15 @@ UPDATE
     "RateLimit" rl
 SET
     "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "lastRefill" = get_consumed_last_refill(rl, (SELECT "units" FROM input WHERE "key" = rl."key")),
+    "previousUsage" = get_refill_previous_usage(rl),
+    "lastUsedAt" = CASE WHEN (SELECT "units" FROM input WHERE "key" = rl."key") > 0 THEN CURRENT_TIMESTAMP ELSE rl."lastUsedAt" END
 FROM
     rls_to_update rl2
 WHERE
     rl2."tenantId" = rl."tenantId"
     AND rl2."key" = rl."key"
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
 `
 
 type BulkUpdateRateLimitsParams struct {
@@ -73,6 +70,12 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Algorithm,
+			&i.Burst,
+			&i.RefillRate,
+			&i.PreviousUsage,
+			&i.IsDynamic,
+			&i.LastUsedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -84,6 +87,177 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 	return items, nil
 }
 
+const deleteRateLimits = `-- name: DeleteRateLimits :many
+WITH pending_tasks AS (
+    SELECT task_id, task_inserted_at FROM v1_queue_item WHERE tenant_id = $1::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_retry_queue_item WHERE tenant_id = $1::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_concurrency_slot WHERE tenant_id = $1::uuid
+), pending_keys AS (
+    -- deleting a dynamic key would leave the tasks waiting for it queued forever
+    SELECT DISTINCT
+        e.value_str AS "key"
+    FROM
+        pending_tasks pt
+    JOIN
+        v1_task_expression_eval e ON e.task_id = pt.task_id AND e.task_inserted_at = pt.task_inserted_at
+    WHERE
+        e.kind = 'DYNAMIC_RATE_LIMIT_KEY'
+        AND e.value_str IS NOT NULL
+        AND (
+            e.value_str = ANY($2::text[])
+            OR ($3::text IS NOT NULL AND starts_with(e.value_str, $3::text))
+        )
+)
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = $1::uuid
+    AND (
+        rl."key" = ANY($2::text[])
+        OR ($3::text IS NOT NULL AND starts_with(rl."key", $3::text))
+    )
+    AND rl."key" NOT IN (SELECT "key" FROM pending_keys)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE srl."tenantId" = rl."tenantId" AND srl."rateLimitKey" = rl."key"
+    )
+RETURNING rl."key"
+`
+
+type DeleteRateLimitsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Keys     []string    `json:"keys"`
+	Prefix   pgtype.Text `json:"prefix"`
+}
+
+// Deletes the rate limits with the given keys or key prefix. Rate limits which are referenced by a workflow
+// step or by tasks waiting to be scheduled can't be deleted, so they are skipped.
+func (q *Queries) DeleteRateLimits(ctx context.Context, db DBTX, arg DeleteRateLimitsParams) ([]string, error) {
+	rows, err := db.Query(ctx, deleteRateLimits, arg.Tenantid, arg.Keys, arg.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteUnusedDynamicRateLimits = `-- name: DeleteUnusedDynamicRateLimits :many
+WITH pending_tasks AS (
+    SELECT task_id, task_inserted_at FROM v1_queue_item WHERE tenant_id = $1::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_retry_queue_item WHERE tenant_id = $1::uuid
+    UNION
+    SELECT task_id, task_inserted_at FROM v1_concurrency_slot WHERE tenant_id = $1::uuid
+), pending_keys AS (
+    SELECT DISTINCT
+        e.value_str AS "key"
+    FROM
+        pending_tasks pt
+    JOIN
+        v1_task_expression_eval e ON e.task_id = pt.task_id AND e.task_inserted_at = pt.task_inserted_at
+    WHERE
+        e.kind = 'DYNAMIC_RATE_LIMIT_KEY'
+        AND e.value_str IS NOT NULL
+), held_keys AS (
+    SELECT DISTINCT
+        s.key
+    FROM
+        v1_task_runtime_semaphore s
+    WHERE
+        s.tenant_id = $1::uuid
+)
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = $1::uuid
+    AND rl."isDynamic"
+    AND rl."lastUsedAt" < $2::timestamp
+    AND rl."key" NOT IN (SELECT "key" FROM pending_keys)
+    AND rl."key" NOT IN (SELECT key FROM held_keys)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE srl."tenantId" = rl."tenantId" AND srl."rateLimitKey" = rl."key"
+    )
+RETURNING rl."key"
+`
+
+type DeleteUnusedDynamicRateLimitsParams struct {
+	Tenantid   pgtype.UUID      `json:"tenantid"`
+	Usedbefore pgtype.Timestamp `json:"usedbefore"`
+}
+
+// Deletes rate limits created from dynamic key expressions which haven't been used since @usedBefore. Keys
+// which are still referenced by tasks waiting to be scheduled or which are held by running tasks are skipped.
+func (q *Queries) DeleteUnusedDynamicRateLimits(ctx context.Context, db DBTX, arg DeleteUnusedDynamicRateLimitsParams) ([]string, error) {
+	rows, err := db.Query(ctx, deleteUnusedDynamicRateLimits, arg.Tenantid, arg.Usedbefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRateLimit = `-- name: GetRateLimit :one
+SELECT
+    "tenantId", key, "limitValue", value, "window", "lastRefill", algorithm, burst, "refillRate", "previousUsage", "isDynamic", "lastUsedAt"
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = $1::uuid
+    AND rl."key" = $2::text
+`
+
+type GetRateLimitParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) GetRateLimit(ctx context.Context, db DBTX, arg GetRateLimitParams) (*RateLimit, error) {
+	row := db.QueryRow(ctx, getRateLimit, arg.Tenantid, arg.Key)
+	var i RateLimit
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.LimitValue,
+		&i.Value,
+		&i.Window,
+		&i.LastRefill,
+		&i.Algorithm,
+		&i.Burst,
+		&i.RefillRate,
+		&i.PreviousUsage,
+		&i.IsDynamic,
+		&i.LastUsedAt,
+	)
+	return &i, err
+}
+
 const listRateLimitsForSteps = `-- name: ListRateLimitsForSteps :many
 SELECT
     units, "stepId", "rateLimitKey", "tenantId", kind
@@ -130,19 +304,10 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_last_refill(rl)::timestamp AS "lastRefill",
+    "algorithm"
 FROM
     "RateLimit" rl
 WHERE
@@ -167,12 +332,13 @@ type ListRateLimitsForTenantNoMutateParams struct {
 }
 
 type ListRateLimitsForTenantNoMutateRow struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId   pgtype.UUID        `json:"tenantId"`
+	Key        string             `json:"key"`
+	LimitValue int32              `json:"limitValue"`
+	Value      int32              `json:"value"`
+	Window     string             `json:"window"`
+	LastRefill pgtype.Timestamp   `json:"lastRefill"`
+	Algorithm  RateLimitAlgorithm `json:"algorithm"`
 }
 
 // Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
@@ -197,6 +363,7 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Algorithm,
 		); err != nil {
 			return nil, err
 		}
@@ -211,12 +378,12 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 const listRateLimitsForTenantWithMutate = `-- name: ListRateLimitsForTenantWithMutate :many
 WITH rls_to_update AS (
     SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
     FROM
         "RateLimit" rl
     WHERE
         rl."tenantId" = $1::uuid
-        AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds')
+        AND get_refill_last_refill(rl) IS DISTINCT FROM rl."lastRefill"
     ORDER BY
         rl."tenantId" ASC, rl."key" ASC
     FOR UPDATE
@@ -225,17 +392,23 @@ WITH rls_to_update AS (
         "RateLimit" rl
     SET
         "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
+        "lastRefill" = get_refill_last_refill(rl),
+        "previousUsage" = get_refill_previous_usage(rl)
     FROM
         rls_to_update
     WHERE
         rl."tenantId" = rls_to_update."tenantId"
         AND rl."key" = rls_to_update."key"
-    RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+    -- return the units which are available now and the next refill time
+    RETURNING
+        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt",
+        get_available_value(rl)::int AS "availableValue",
+        get_next_refill_at(rl)::timestamp AS "nextRefillAt"
 )
 SELECT
-    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill",
-    (rl."lastRefill" + rl."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt",
+    get_available_value(rl)::int AS "availableValue",
+    get_next_refill_at(rl)::timestamp AS "nextRefillAt"
 FROM
     "RateLimit" rl
 WHERE
@@ -245,21 +418,26 @@ WHERE
 UNION ALL
 
 SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill", refill.algorithm, refill.burst, refill."refillRate", refill."previousUsage", refill."isDynamic", refill."lastUsedAt", refill."availableValue", refill."nextRefillAt"
 FROM
     refill
 `
 
 type ListRateLimitsForTenantWithMutateRow struct {
-	TenantId     pgtype.UUID      `json:"tenantId"`
-	Key          string           `json:"key"`
-	LimitValue   int32            `json:"limitValue"`
-	Value        int32            `json:"value"`
-	Window       string           `json:"window"`
-	LastRefill   pgtype.Timestamp `json:"lastRefill"`
-	NextRefillAt pgtype.Timestamp `json:"nextRefillAt"`
+	TenantId       pgtype.UUID        `json:"tenantId"`
+	Key            string             `json:"key"`
+	LimitValue     int32              `json:"limitValue"`
+	Value          int32              `json:"value"`
+	Window         string             `json:"window"`
+	LastRefill     pgtype.Timestamp   `json:"lastRefill"`
+	Algorithm      RateLimitAlgorithm `json:"algorithm"`
+	Burst          pgtype.Int4        `json:"burst"`
+	RefillRate     pgtype.Float8      `json:"refillRate"`
+	PreviousUsage  int32              `json:"previousUsage"`
+	IsDynamic      bool               `json:"isDynamic"`
+	LastUsedAt     pgtype.Timestamp   `json:"lastUsedAt"`
+	AvailableValue int32              `json:"availableValue"`
+	NextRefillAt   pgtype.Timestamp   `json:"nextRefillAt"`
 }
 
 func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitsForTenantWithMutateRow, error) {
@@ -278,6 +456,13 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Algorithm,
+			&i.Burst,
+			&i.RefillRate,
+			&i.PreviousUsage,
+			&i.IsDynamic,
+			&i.LastUsedAt,
+			&i.AvailableValue,
 			&i.NextRefillAt,
 		); err != nil {
 			return nil, err
@@ -290,6 +475,54 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 	return items, nil
 }
 
+const resetRateLimit = `-- name: ResetRateLimit :one
+UPDATE
+    "RateLimit" rl
+SET
+    "value" = CASE
+        WHEN rl."algorithm" = 'SEMAPHORE' THEN
+            rl."limitValue" - COALESCE((
+                SELECT SUM(s.units)
+                FROM v1_task_runtime_semaphore s
+                WHERE s.tenant_id = rl."tenantId" AND s.key = rl."key"
+            ), 0)::int
+        WHEN rl."algorithm" = 'TOKEN_BUCKET' THEN get_token_bucket_capacity(rl)
+        ELSE rl."limitValue"
+    END,
+    "lastRefill" = CURRENT_TIMESTAMP,
+    "previousUsage" = 0
+WHERE
+    rl."tenantId" = $1::uuid
+    AND rl."key" = $2::text
+RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."refillRate", rl."previousUsage", rl."isDynamic", rl."lastUsedAt"
+`
+
+type ResetRateLimitParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+// Refills a rate limit to its limit. Semaphores are refilled to the units which aren't held by running tasks.
+func (q *Queries) ResetRateLimit(ctx context.Context, db DBTX, arg ResetRateLimitParams) (*RateLimit, error) {
+	row := db.QueryRow(ctx, resetRateLimit, arg.Tenantid, arg.Key)
+	var i RateLimit
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.LimitValue,
+		&i.Value,
+		&i.Window,
+		&i.LastRefill,
+		&i.Algorithm,
+		&i.Burst,
+		&i.RefillRate,
+		&i.PreviousUsage,
+		&i.IsDynamic,
+		&i.LastUsedAt,
+	)
+	return &i, err
+}
+
 const upsertRateLimitsBulk = `-- name: UpsertRateLimitsBulk :exec
 WITH input_values AS (
     SELECT
@@ -309,20 +542,25 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "isDynamic"
 )
 SELECT
     $1::uuid,
     iv."key",
     iv."limitValue",
     iv."limitValue",
-    iv."window"
+    iv."window",
+    true
 FROM
     input_values iv
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    "lastUsedAt" = CURRENT_TIMESTAMP
+WHERE
+    "RateLimit"."algorithm" <> 'SEMAPHORE'
 `
 
 type UpsertRateLimitsBulkParams struct {
@@ -332,6 +570,7 @@ type UpsertRateLimitsBulkParams struct {
 	Windows     []string    `json:"windows"`
 }
 
+// semaphores are only configured through the API, so dynamic limits don't overwrite them
 func (q *Queries) UpsertRateLimitsBulk(ctx context.Context, db DBTX, arg UpsertRateLimitsBulkParams) error {
 	_, err := db.Exec(ctx, upsertRateLimitsBulk,
 		arg.Tenantid,
@@ -0,0 +1,130 @@
+-- name: AssignDurableSignal :one
+-- Assigns the oldest pending signal with the given name to the first match condition of the task which is
+-- waiting on it, if there is one.
+WITH waiting_condition AS (
+    SELECT
+        mc.v1_match_id,
+        mc.id
+    FROM
+        v1_match_condition mc
+    WHERE
+        mc.tenant_id = @tenantId::uuid
+        AND mc.event_type = 'INTERNAL'
+        AND mc.event_key = 'durable-signal-' || @name::text
+        AND mc.event_resource_hint = (@taskExternalId::uuid)::text
+        AND mc.is_satisfied = FALSE
+        AND NOT EXISTS (
+            SELECT
+                1
+            FROM
+                v1_durable_signal ds
+            WHERE
+                ds.tenant_id = mc.tenant_id
+                AND ds.task_external_id = @taskExternalId::uuid
+                AND ds.name = @name::text
+                AND ds.v1_match_id = mc.v1_match_id
+                AND ds.match_condition_id = mc.id
+        )
+    ORDER BY
+        mc.id
+    LIMIT 1
+), pending_signal AS (
+    SELECT
+        ds.id
+    FROM
+        v1_durable_signal ds
+    WHERE
+        ds.tenant_id = @tenantId::uuid
+        AND ds.task_external_id = @taskExternalId::uuid
+        AND ds.name = @name::text
+        AND ds.match_condition_id IS NULL
+    ORDER BY
+        ds.id
+    LIMIT 1
+    FOR UPDATE
+)
+UPDATE
+    v1_durable_signal s
+SET
+    v1_match_id = wc.v1_match_id,
+    match_condition_id = wc.id,
+    delivered_at = CURRENT_TIMESTAMP
+FROM
+    waiting_condition wc,
+    pending_signal ps
+WHERE
+    s.tenant_id = @tenantId::uuid
+    AND s.id = ps.id
+RETURNING s.*;
+
+-- name: CreateDurableSignal :one
+INSERT INTO v1_durable_signal (
+    tenant_id,
+    task_external_id,
+    name,
+    data
+)
+VALUES (
+    @tenantId::uuid,
+    @taskExternalId::uuid,
+    @name::text,
+    @data::jsonb
+)
+RETURNING *;
+
+-- name: DeleteDeliveredDurableSignals :exec
+-- Deletes the signals which were delivered to match conditions which no longer exist, because the
+-- condition was satisfied or the task stopped waiting on it.
+DELETE FROM
+    v1_durable_signal ds
+WHERE
+    ds.tenant_id = @tenantId::uuid
+    AND ds.task_external_id = @taskExternalId::uuid
+    AND ds.name = @name::text
+    AND ds.match_condition_id IS NOT NULL
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            v1_match_condition mc
+        WHERE
+            mc.v1_match_id = ds.v1_match_id
+            AND mc.id = ds.match_condition_id
+    );
+
+-- name: DeleteStaleDurableSignals :execrows
+-- Deletes signals which were never delivered before they expired, for example because they were sent to a task
+-- which finished or never waits on them, and delivered signals whose match condition no longer exists.
+WITH stale AS (
+    SELECT
+        ds.tenant_id,
+        ds.id
+    FROM
+        v1_durable_signal ds
+    WHERE
+        (ds.match_condition_id IS NULL AND ds.created_at <= @createdBefore::timestamptz)
+        OR (
+            ds.match_condition_id IS NOT NULL
+            AND NOT EXISTS (
+                SELECT
+                    1
+                FROM
+                    v1_match_condition mc
+                WHERE
+                    mc.v1_match_id = ds.v1_match_id
+                    AND mc.id = ds.match_condition_id
+            )
+        )
+    ORDER BY
+        ds.tenant_id, ds.id
+    LIMIT
+        @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM
+    v1_durable_signal ds
+USING
+    stale s
+WHERE
+    ds.tenant_id = s.tenant_id
+    AND ds.id = s.id;
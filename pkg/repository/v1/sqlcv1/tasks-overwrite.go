@@ -9,7 +9,7 @@ import (
 const createTasks = `-- name: CreateTasks :many
 WITH input AS (
     SELECT
-        tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, additional_metadata, initial_state, dag_id, dag_inserted_at, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, initial_state_reason, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, step_index, retry_backoff_factor, retry_max_backoff, workflow_version_id, workflow_run_id
+        tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, additional_metadata, initial_state, dag_id, dag_inserted_at, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, initial_state_reason, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, step_index, retry_backoff_factor, retry_max_backoff, workflow_version_id, workflow_run_id, fairness_key
     FROM
         (
             SELECT
@@ -46,7 +46,8 @@ WITH input AS (
 				unnest($30::double precision[]) AS retry_backoff_factor,
 				unnest($31::integer[]) AS retry_max_backoff,
 				unnest($32::uuid[]) AS workflow_version_id,
-				unnest($33::uuid[]) AS workflow_run_id
+				unnest($33::uuid[]) AS workflow_run_id,
+				unnest($34::text[]) AS fairness_key
         ) AS subquery
 )
 INSERT INTO v1_task (
@@ -82,7 +83,8 @@ INSERT INTO v1_task (
 	retry_backoff_factor,
 	retry_max_backoff,
 	workflow_version_id,
-	workflow_run_id
+	workflow_run_id,
+	fairness_key
 )
 SELECT
     i.tenant_id,
@@ -117,11 +119,12 @@ SELECT
 	i.retry_backoff_factor,
 	i.retry_max_backoff,
 	i.workflow_version_id,
-	i.workflow_run_id
+	i.workflow_run_id,
+	i.fairness_key
 FROM
     input i
 RETURNING
-    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, additional_metadata, initial_state, dag_id, dag_inserted_at, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, initial_state_reason, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, step_index, retry_backoff_factor, retry_max_backoff, workflow_version_id, workflow_run_id
+    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, additional_metadata, initial_state, dag_id, dag_inserted_at, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, initial_state_reason, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, step_index, retry_backoff_factor, retry_max_backoff, workflow_version_id, workflow_run_id, fairness_key, retry_after
 `
 
 type CreateTasksParams struct {
@@ -161,6 +164,7 @@ type CreateTasksParams struct {
 	RetryMaxBackoff              []pgtype.Int4        `json:"retryMaxBackoff"`
 	WorkflowVersionIds           []pgtype.UUID        `json:"workflowVersionIds"`
 	WorkflowRunIds               []pgtype.UUID        `json:"workflowRunIds"`
+	FairnessKeys                 []pgtype.Text        `json:"fairnessKeys"`
 }
 
 func (q *Queries) CreateTasks(ctx context.Context, db DBTX, arg CreateTasksParams) ([]*V1Task, error) {
@@ -211,6 +215,7 @@ func (q *Queries) CreateTasks(ctx context.Context, db DBTX, arg CreateTasksParam
 		arg.RetryMaxBackoff,
 		arg.WorkflowVersionIds,
 		arg.WorkflowRunIds,
+		arg.FairnessKeys,
 	)
 	if err != nil {
 		return nil, err
@@ -257,6 +262,8 @@ func (q *Queries) CreateTasks(ctx context.Context, db DBTX, arg CreateTasksParam
 			&i.RetryMaxBackoff,
 			&i.WorkflowVersionID,
 			&i.WorkflowRunID,
+			&i.FairnessKey,
+			&i.RetryAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -363,6 +370,7 @@ SET
     retry_count = retry_count + 1,
     app_retry_count = 0,
     internal_retry_count = 0,
+    retry_after = NULL,
     input = CASE WHEN i.input IS NOT NULL THEN i.input ELSE v1_task.input END,
     initial_state = i.initial_state,
     concurrency_keys = i.concurrency_keys,
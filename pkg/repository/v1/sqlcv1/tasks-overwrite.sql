@@ -146,6 +146,7 @@ SET
     retry_count = retry_count + 1,
     app_retry_count = 0,
     internal_retry_count = 0,
+    retry_after = NULL,
     input = CASE WHEN i.input IS NOT NULL THEN i.input ELSE v1_task.input END,
     initial_state = i.initial_state,
     concurrency_strategy_ids = i.concurrency_strategy_ids,
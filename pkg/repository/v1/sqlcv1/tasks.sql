@@ -190,6 +190,11 @@ WITH input AS (
         v1_task_runtime
     WHERE
         (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM runtimes_to_delete)
+), deleted_runtime_resources AS (
+    DELETE FROM
+        v1_task_runtime_resource
+    WHERE
+        (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM runtimes_to_delete)
 ), retry_queue_items_to_delete AS (
     SELECT
         task_id, task_inserted_at, task_retry_count
@@ -240,6 +245,43 @@ WITH input AS (
         v1_concurrency_slot
     WHERE
         (task_id, task_inserted_at, task_retry_count) IN (SELECT task_id, task_inserted_at, task_retry_count FROM concurrency_slots_to_delete)
+), deleted_semaphores AS (
+    DELETE FROM
+        v1_task_runtime_semaphore
+    WHERE
+        (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM input)
+    RETURNING tenant_id, key, units
+), released_semaphore_units AS (
+    SELECT
+        tenant_id, key, SUM(units)::int AS units
+    FROM
+        deleted_semaphores
+    GROUP BY
+        tenant_id, key
+), semaphores_to_release AS (
+    SELECT
+        rl."tenantId", rl."key"
+    FROM
+        "RateLimit" rl
+    WHERE
+        (rl."tenantId", rl."key") IN (SELECT tenant_id, key FROM released_semaphore_units)
+    ORDER BY
+        rl."tenantId" ASC, rl."key" ASC
+    FOR UPDATE
+), released_semaphores AS (
+    -- return the held units to the semaphore. this isn't capped at the limit, since the scheduler may not
+    -- have flushed its usage of the units yet.
+    UPDATE
+        "RateLimit" rl
+    SET
+        "value" = rl."value" + rsu.units
+    FROM
+        released_semaphore_units rsu
+    JOIN
+        semaphores_to_release str ON str."tenantId" = rsu.tenant_id AND str."key" = rsu.key
+    WHERE
+        rl."tenantId" = rsu.tenant_id
+        AND rl."key" = rsu.key
 )
 SELECT
     t.queue,
@@ -259,6 +301,32 @@ JOIN
 LEFT JOIN
     runtimes_to_delete r ON r.task_id = t.id AND r.retry_count = t.retry_count;
 
+-- name: ListTaskRetryPolicies :many
+-- Lists the retry policies of the steps of the given tasks, along with the retry state of each task
+WITH input AS (
+    SELECT
+        unnest(@taskIds::bigint[]) AS task_id,
+        unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at
+)
+SELECT
+    t.id,
+    t.inserted_at,
+    t.app_retry_count,
+    t.retry_backoff_factor,
+    t.retry_max_backoff,
+    rp.error_codes,
+    rp.error_code_max_retries,
+    rp.jitter,
+    rp.min_backoff_seconds
+FROM
+    v1_task t
+JOIN
+    input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at
+JOIN
+    v1_step_retry_policy rp ON rp.step_id = t.step_id
+WHERE
+    t.tenant_id = @tenantId::uuid;
+
 -- name: FailTaskAppFailure :many
 -- Fails a task due to an application-level error
 WITH input AS (
@@ -270,7 +338,11 @@ WITH input AS (
                 unnest(@taskIds::bigint[]) AS task_id,
                 unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
                 unnest(@taskRetryCounts::integer[]) AS task_retry_count,
-                unnest(@isNonRetryables::boolean[]) AS is_non_retryable
+                unnest(@isNonRetryables::boolean[]) AS is_non_retryable,
+                -- the maximum number of retries decided by the retry policy, -1 uses the retries of the step
+                unnest(@maxRetries::integer[]) AS max_retries,
+                -- the retry delay in milliseconds decided by the retry policy, -1 uses the backoff of the task
+                unnest(@retryDelaysMs::integer[]) AS retry_delay_ms
         ) AS subquery
 ), locked_tasks AS (
     SELECT
@@ -290,6 +362,7 @@ WITH input AS (
 ), tasks_to_steps AS (
     SELECT
         t.id,
+        t.inserted_at,
         t.step_id,
         s."retries"
     FROM
@@ -300,24 +373,30 @@ WITH input AS (
 UPDATE
     v1_task
 SET
-    retry_count = retry_count + 1,
-    app_retry_count = app_retry_count + 1
+    retry_count = v1_task.retry_count + 1,
+    app_retry_count = v1_task.app_retry_count + 1,
+    retry_after = CASE
+        WHEN i.retry_delay_ms >= 0 THEN NOW() + (i.retry_delay_ms * interval '1 millisecond')
+        ELSE NULL
+    END
 FROM
-    tasks_to_steps
+    tasks_to_steps ts
+JOIN
+    input i ON i.task_id = ts.id AND i.task_inserted_at = ts.inserted_at
 WHERE
-    (v1_task.id, v1_task.inserted_at, v1_task.retry_count) IN (
-        SELECT task_id, task_inserted_at, task_retry_count
-        FROM input
-        WHERE is_non_retryable = FALSE
-    )
-    AND tasks_to_steps."retries" > v1_task.app_retry_count
+    v1_task.id = ts.id
+    AND v1_task.inserted_at = ts.inserted_at
+    AND v1_task.retry_count = i.task_retry_count
+    AND i.is_non_retryable = FALSE
+    AND (CASE WHEN i.max_retries >= 0 THEN i.max_retries ELSE ts."retries" END) > v1_task.app_retry_count
 RETURNING
     v1_task.id,
     v1_task.inserted_at,
     v1_task.retry_count,
     v1_task.app_retry_count,
     v1_task.retry_backoff_factor,
-    v1_task.retry_max_backoff;
+    v1_task.retry_max_backoff,
+    v1_task.retry_after;
 
 -- name: FailTaskInternalFailure :many
 -- Fails a task due to an application-level error
@@ -352,7 +431,9 @@ UPDATE
     v1_task
 SET
     retry_count = retry_count + 1,
-    internal_retry_count = internal_retry_count + 1
+    internal_retry_count = internal_retry_count + 1,
+    -- the retry delay of a retry policy only applies to the application failure which decided it
+    retry_after = NULL
 FROM
     locked_tasks
 WHERE
@@ -366,6 +447,89 @@ RETURNING
     v1_task.inserted_at,
     v1_task.retry_count;
 
+-- name: PreemptTasks :many
+-- Requeues running tasks by incrementing their retry count, without counting towards the task's retries.
+-- Only tasks which are still running on the given retry count are preempted.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@taskRetryCounts::integer[]) AS task_retry_count
+        ) AS subquery
+), locked_tasks AS (
+    SELECT
+        t.id,
+        t.inserted_at
+    FROM
+        v1_task t
+    JOIN
+        input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
+    JOIN
+        v1_task_runtime r ON r.task_id = t.id AND r.task_inserted_at = t.inserted_at AND r.retry_count = t.retry_count
+    WHERE
+        t.tenant_id = @tenantId::uuid
+    -- order by the task id to get a stable lock order
+    ORDER BY
+        t.id
+    FOR UPDATE OF t
+)
+UPDATE
+    v1_task
+SET
+    retry_count = v1_task.retry_count + 1,
+    retry_after = NULL
+FROM
+    locked_tasks
+WHERE
+    v1_task.id = locked_tasks.id
+    AND v1_task.inserted_at = locked_tasks.inserted_at
+RETURNING
+    v1_task.id,
+    v1_task.inserted_at,
+    v1_task.retry_count,
+    v1_task.priority;
+
+-- name: ResetPreemptedTaskPriorities :exec
+-- Requeued tasks are placed in the queue with the highest priority, which would allow preempted tasks to
+-- preempt the task which replaced them. Preempted tasks are instead requeued with their original priority.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@taskRetryCounts::integer[]) AS task_retry_count,
+                unnest(@priorities::integer[]) AS priority
+        ) AS subquery
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot cs
+    SET
+        priority = i.priority
+    FROM
+        input i
+    WHERE
+        cs.task_id = i.task_id
+        AND cs.task_inserted_at = i.task_inserted_at
+        AND cs.task_retry_count = i.task_retry_count
+)
+UPDATE
+    v1_queue_item qi
+SET
+    priority = i.priority
+FROM
+    input i
+WHERE
+    qi.task_id = i.task_id
+    AND qi.task_inserted_at = i.task_inserted_at
+    AND qi.retry_count = i.task_retry_count;
+
 -- name: ListTasksToTimeout :many
 WITH expired_runtimes AS (
     SELECT
@@ -401,6 +565,74 @@ FROM
 JOIN
     expired_runtimes ON expired_runtimes.task_id = v1_task.id AND expired_runtimes.task_inserted_at = v1_task.inserted_at;
 
+-- name: CreateTaskExecutionTimeouts :exec
+INSERT INTO v1_task_execution_timeout (
+    task_id,
+    task_inserted_at,
+    tenant_id,
+    timeout_at,
+    timeout,
+    is_workflow_timeout
+)
+SELECT
+    unnest(@taskIds::bigint[]),
+    unnest(@taskInsertedAts::timestamptz[]),
+    @tenantId::uuid,
+    unnest(@timeoutAts::timestamptz[]),
+    unnest(@timeouts::text[]),
+    unnest(@isWorkflowTimeouts::boolean[])
+ON CONFLICT (task_id, task_inserted_at) DO UPDATE
+SET
+    -- replayed tasks restart their execution timeouts
+    timeout_at = EXCLUDED.timeout_at,
+    timeout = EXCLUDED.timeout,
+    is_workflow_timeout = EXCLUDED.is_workflow_timeout;
+
+-- name: ListTasksToExecutionTimeout :many
+-- Removes execution timeouts which have passed and returns their tasks, along with whether the current
+-- attempt of the task has already finished.
+WITH expired_timeouts AS (
+    SELECT
+        task_id,
+        task_inserted_at
+    FROM
+        v1_task_execution_timeout
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND timeout_at <= NOW()
+    ORDER BY
+        timeout_at
+    LIMIT
+        COALESCE(sqlc.narg('limit')::integer, 1000)
+    FOR UPDATE SKIP LOCKED
+), deleted_timeouts AS (
+    DELETE FROM
+        v1_task_execution_timeout
+    WHERE
+        (task_id, task_inserted_at) IN (SELECT task_id, task_inserted_at FROM expired_timeouts)
+    RETURNING
+        task_id, task_inserted_at, timeout, is_workflow_timeout
+)
+SELECT
+    t.id,
+    t.inserted_at,
+    t.retry_count,
+    t.external_id,
+    t.workflow_run_id,
+    d.timeout,
+    d.is_workflow_timeout,
+    EXISTS (
+        SELECT 1
+        FROM v1_task_event e
+        WHERE
+            (e.task_id, e.task_inserted_at, e.retry_count) = (t.id, t.inserted_at, t.retry_count)
+            AND e.event_type = ANY('{COMPLETED, FAILED, CANCELLED}'::v1_task_event_type[])
+    )::boolean AS is_finalized
+FROM
+    deleted_timeouts d
+JOIN
+    v1_task t ON t.id = d.task_id AND t.inserted_at = d.task_inserted_at;
+
 -- name: ListTasksToReassign :many
 WITH tasks_on_inactive_workers AS (
     SELECT
@@ -961,6 +1193,11 @@ WITH task AS (
     ORDER BY
         task_id, task_inserted_at, retry_count
     FOR UPDATE
+), released_resources AS (
+    DELETE FROM
+        v1_task_runtime_resource
+    WHERE
+        (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM locked_runtime)
 )
 UPDATE
     v1_task_runtime
@@ -972,3 +1209,58 @@ WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
     v1_task_runtime.*;
+
+-- name: GetTaskPriorities :one
+-- Returns the priority of the task along with its effective priority. The effective priority differs from the
+-- task's priority when the task's queue item has been aged.
+SELECT
+    COALESCE(t.priority, 1)::integer AS priority,
+    COALESCE(qi.priority, t.priority, 1)::integer AS effective_priority
+FROM
+    v1_task t
+LEFT JOIN
+    v1_queue_item qi ON qi.task_id = t.id AND qi.task_inserted_at = t.inserted_at
+WHERE
+    t.tenant_id = @tenantId::uuid
+    AND t.id = @taskId::bigint
+    AND t.inserted_at = @taskInsertedAt::timestamptz;
+
+-- name: GetTaskSchedulingState :one
+-- Returns the state which determines why a task has not started running: its queue item (along with the
+-- reason that the scheduler last failed to assign it), an unfilled concurrency slot, or a pending retry.
+SELECT
+    t.id,
+    t.inserted_at,
+    t.retry_count,
+    (qi.id IS NOT NULL)::boolean AS is_queued,
+    qi.scheduling_reason,
+    qi.scheduling_message,
+    qi.scheduling_evaluated_at,
+    (cs.key IS NOT NULL)::boolean AS is_concurrency_limited,
+    COALESCE(cs.key, '')::text AS concurrency_key,
+    rqi.retry_after,
+    (rt.task_id IS NOT NULL)::boolean AS is_running
+FROM
+    v1_task t
+LEFT JOIN
+    v1_queue_item qi ON qi.task_id = t.id AND qi.task_inserted_at = t.inserted_at AND qi.retry_count = t.retry_count
+LEFT JOIN LATERAL (
+    SELECT
+        cs.key
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        cs.task_id = t.id
+        AND cs.task_inserted_at = t.inserted_at
+        AND cs.task_retry_count = t.retry_count
+        AND NOT cs.is_filled
+    LIMIT 1
+) cs ON TRUE
+LEFT JOIN
+    v1_retry_queue_item rqi ON rqi.task_id = t.id AND rqi.task_inserted_at = t.inserted_at AND rqi.task_retry_count = t.retry_count
+LEFT JOIN
+    v1_task_runtime rt ON rt.task_id = t.id AND rt.task_inserted_at = t.inserted_at AND rt.retry_count = t.retry_count
+WHERE
+    t.tenant_id = @tenantId::uuid
+    AND t.id = @taskId::bigint
+    AND t.inserted_at = @taskInsertedAt::timestamptz;
@@ -24,6 +24,51 @@ func (q *Queries) CreatePartitions(ctx context.Context, db DBTX, date pgtype.Dat
 	return err
 }
 
+const createTaskExecutionTimeouts = `-- name: CreateTaskExecutionTimeouts :exec
+INSERT INTO v1_task_execution_timeout (
+    task_id,
+    task_inserted_at,
+    tenant_id,
+    timeout_at,
+    timeout,
+    is_workflow_timeout
+)
+SELECT
+    unnest($1::bigint[]),
+    unnest($2::timestamptz[]),
+    $3::uuid,
+    unnest($4::timestamptz[]),
+    unnest($5::text[]),
+    unnest($6::boolean[])
+ON CONFLICT (task_id, task_inserted_at) DO UPDATE
+SET
+    -- replayed tasks restart their execution timeouts
+    timeout_at = EXCLUDED.timeout_at,
+    timeout = EXCLUDED.timeout,
+    is_workflow_timeout = EXCLUDED.is_workflow_timeout
+`
+
+type CreateTaskExecutionTimeoutsParams struct {
+	Taskids            []int64              `json:"taskids"`
+	Taskinsertedats    []pgtype.Timestamptz `json:"taskinsertedats"`
+	Tenantid           pgtype.UUID          `json:"tenantid"`
+	Timeoutats         []pgtype.Timestamptz `json:"timeoutats"`
+	Timeouts           []string             `json:"timeouts"`
+	Isworkflowtimeouts []bool               `json:"isworkflowtimeouts"`
+}
+
+func (q *Queries) CreateTaskExecutionTimeouts(ctx context.Context, db DBTX, arg CreateTaskExecutionTimeoutsParams) error {
+	_, err := db.Exec(ctx, createTaskExecutionTimeouts,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Tenantid,
+		arg.Timeoutats,
+		arg.Timeouts,
+		arg.Isworkflowtimeouts,
+	)
+	return err
+}
+
 const deleteMatchingSignalEvents = `-- name: DeleteMatchingSignalEvents :exec
 WITH input AS (
     SELECT
@@ -111,14 +156,18 @@ func (q *Queries) EnsureTablePartitionsExist(ctx context.Context, db DBTX) (bool
 const failTaskAppFailure = `-- name: FailTaskAppFailure :many
 WITH input AS (
     SELECT
-        task_id, task_inserted_at, task_retry_count, is_non_retryable
+        *
     FROM
         (
             SELECT
                 unnest($1::bigint[]) AS task_id,
                 unnest($2::timestamptz[]) AS task_inserted_at,
                 unnest($3::integer[]) AS task_retry_count,
-                unnest($4::boolean[]) AS is_non_retryable
+                unnest($4::boolean[]) AS is_non_retryable,
+                -- the maximum number of retries decided by the retry policy, -1 uses the retries of the step
+                unnest($5::integer[]) AS max_retries,
+                -- the retry delay in milliseconds decided by the retry policy, -1 uses the backoff of the task
+                unnest($6::integer[]) AS retry_delay_ms
         ) AS subquery
 ), locked_tasks AS (
     SELECT
@@ -130,7 +179,7 @@ WITH input AS (
     JOIN
         input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
     WHERE
-        t.tenant_id = $5::uuid
+        t.tenant_id = $7::uuid
     -- order by the task id to get a stable lock order
     ORDER BY
         id
@@ -138,6 +187,7 @@ WITH input AS (
 ), tasks_to_steps AS (
     SELECT
         t.id,
+        t.inserted_at,
         t.step_id,
         s."retries"
     FROM
@@ -148,24 +198,30 @@ WITH input AS (
 UPDATE
     v1_task
 SET
-    retry_count = retry_count + 1,
-    app_retry_count = app_retry_count + 1
+    retry_count = v1_task.retry_count + 1,
+    app_retry_count = v1_task.app_retry_count + 1,
+    retry_after = CASE
+        WHEN i.retry_delay_ms >= 0 THEN NOW() + (i.retry_delay_ms * interval '1 millisecond')
+        ELSE NULL
+    END
 FROM
-    tasks_to_steps
+    tasks_to_steps ts
+JOIN
+    input i ON i.task_id = ts.id AND i.task_inserted_at = ts.inserted_at
 WHERE
-    (v1_task.id, v1_task.inserted_at, v1_task.retry_count) IN (
-        SELECT task_id, task_inserted_at, task_retry_count
-        FROM input
-        WHERE is_non_retryable = FALSE
-    )
-    AND tasks_to_steps."retries" > v1_task.app_retry_count
+    v1_task.id = ts.id
+    AND v1_task.inserted_at = ts.inserted_at
+    AND v1_task.retry_count = i.task_retry_count
+    AND i.is_non_retryable = FALSE
+    AND (CASE WHEN i.max_retries >= 0 THEN i.max_retries ELSE ts."retries" END) > v1_task.app_retry_count
 RETURNING
     v1_task.id,
     v1_task.inserted_at,
     v1_task.retry_count,
     v1_task.app_retry_count,
     v1_task.retry_backoff_factor,
-    v1_task.retry_max_backoff
+    v1_task.retry_max_backoff,
+    v1_task.retry_after
 `
 
 type FailTaskAppFailureParams struct {
@@ -173,6 +229,8 @@ type FailTaskAppFailureParams struct {
 	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
 	Taskretrycounts []int32              `json:"taskretrycounts"`
 	Isnonretryables []bool               `json:"isnonretryables"`
+	Maxretries      []int32              `json:"maxretries"`
+	Retrydelaysms   []int32              `json:"retrydelaysms"`
 	Tenantid        pgtype.UUID          `json:"tenantid"`
 }
 
@@ -183,6 +241,7 @@ type FailTaskAppFailureRow struct {
 	AppRetryCount      int32              `json:"app_retry_count"`
 	RetryBackoffFactor pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff    pgtype.Int4        `json:"retry_max_backoff"`
+	RetryAfter         pgtype.Timestamptz `json:"retry_after"`
 }
 
 // Fails a task due to an application-level error
@@ -192,6 +251,8 @@ func (q *Queries) FailTaskAppFailure(ctx context.Context, db DBTX, arg FailTaskA
 		arg.Taskinsertedats,
 		arg.Taskretrycounts,
 		arg.Isnonretryables,
+		arg.Maxretries,
+		arg.Retrydelaysms,
 		arg.Tenantid,
 	)
 	if err != nil {
@@ -208,6 +269,7 @@ func (q *Queries) FailTaskAppFailure(ctx context.Context, db DBTX, arg FailTaskA
 			&i.AppRetryCount,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.RetryAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -251,7 +313,9 @@ UPDATE
     v1_task
 SET
     retry_count = retry_count + 1,
-    internal_retry_count = internal_retry_count + 1
+    internal_retry_count = internal_retry_count + 1,
+    -- the retry delay of a retry policy only applies to the application failure which decided it
+    retry_after = NULL
 FROM
     locked_tasks
 WHERE
@@ -423,6 +487,120 @@ func (q *Queries) FlattenExternalIds(ctx context.Context, db DBTX, arg FlattenEx
 	return items, nil
 }
 
+const getTaskPriorities = `-- name: GetTaskPriorities :one
+SELECT
+    COALESCE(t.priority, 1)::integer AS priority,
+    COALESCE(qi.priority, t.priority, 1)::integer AS effective_priority
+FROM
+    v1_task t
+LEFT JOIN
+    v1_queue_item qi ON qi.task_id = t.id AND qi.task_inserted_at = t.inserted_at
+WHERE
+    t.tenant_id = $1::uuid
+    AND t.id = $2::bigint
+    AND t.inserted_at = $3::timestamptz
+`
+
+type GetTaskPrioritiesParams struct {
+	Tenantid       pgtype.UUID        `json:"tenantid"`
+	Taskid         int64              `json:"taskid"`
+	Taskinsertedat pgtype.Timestamptz `json:"taskinsertedat"`
+}
+
+type GetTaskPrioritiesRow struct {
+	Priority          int32 `json:"priority"`
+	EffectivePriority int32 `json:"effective_priority"`
+}
+
+// Returns the priority of the task along with its effective priority. The effective priority differs from the
+// task's priority when the task's queue item has been aged.
+func (q *Queries) GetTaskPriorities(ctx context.Context, db DBTX, arg GetTaskPrioritiesParams) (*GetTaskPrioritiesRow, error) {
+	row := db.QueryRow(ctx, getTaskPriorities, arg.Tenantid, arg.Taskid, arg.Taskinsertedat)
+	var i GetTaskPrioritiesRow
+	err := row.Scan(&i.Priority, &i.EffectivePriority)
+	return &i, err
+}
+
+const getTaskSchedulingState = `-- name: GetTaskSchedulingState :one
+SELECT
+    t.id,
+    t.inserted_at,
+    t.retry_count,
+    (qi.id IS NOT NULL)::boolean AS is_queued,
+    qi.scheduling_reason,
+    qi.scheduling_message,
+    qi.scheduling_evaluated_at,
+    (cs.key IS NOT NULL)::boolean AS is_concurrency_limited,
+    COALESCE(cs.key, '')::text AS concurrency_key,
+    rqi.retry_after,
+    (rt.task_id IS NOT NULL)::boolean AS is_running
+FROM
+    v1_task t
+LEFT JOIN
+    v1_queue_item qi ON qi.task_id = t.id AND qi.task_inserted_at = t.inserted_at AND qi.retry_count = t.retry_count
+LEFT JOIN LATERAL (
+    SELECT
+        cs.key
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        cs.task_id = t.id
+        AND cs.task_inserted_at = t.inserted_at
+        AND cs.task_retry_count = t.retry_count
+        AND NOT cs.is_filled
+    LIMIT 1
+) cs ON TRUE
+LEFT JOIN
+    v1_retry_queue_item rqi ON rqi.task_id = t.id AND rqi.task_inserted_at = t.inserted_at AND rqi.task_retry_count = t.retry_count
+LEFT JOIN
+    v1_task_runtime rt ON rt.task_id = t.id AND rt.task_inserted_at = t.inserted_at AND rt.retry_count = t.retry_count
+WHERE
+    t.tenant_id = $1::uuid
+    AND t.id = $2::bigint
+    AND t.inserted_at = $3::timestamptz
+`
+
+type GetTaskSchedulingStateParams struct {
+	Tenantid       pgtype.UUID        `json:"tenantid"`
+	Taskid         int64              `json:"taskid"`
+	Taskinsertedat pgtype.Timestamptz `json:"taskinsertedat"`
+}
+
+type GetTaskSchedulingStateRow struct {
+	ID                    int64                  `json:"id"`
+	InsertedAt            pgtype.Timestamptz     `json:"inserted_at"`
+	RetryCount            int32                  `json:"retry_count"`
+	IsQueued              bool                   `json:"is_queued"`
+	SchedulingReason      NullV1SchedulingReason `json:"scheduling_reason"`
+	SchedulingMessage     pgtype.Text            `json:"scheduling_message"`
+	SchedulingEvaluatedAt pgtype.Timestamptz     `json:"scheduling_evaluated_at"`
+	IsConcurrencyLimited  bool                   `json:"is_concurrency_limited"`
+	ConcurrencyKey        string                 `json:"concurrency_key"`
+	RetryAfter            pgtype.Timestamptz     `json:"retry_after"`
+	IsRunning             bool                   `json:"is_running"`
+}
+
+// Returns the state which determines why a task has not started running: its queue item (along with the
+// reason that the scheduler last failed to assign it), an unfilled concurrency slot, or a pending retry.
+func (q *Queries) GetTaskSchedulingState(ctx context.Context, db DBTX, arg GetTaskSchedulingStateParams) (*GetTaskSchedulingStateRow, error) {
+	row := db.QueryRow(ctx, getTaskSchedulingState, arg.Tenantid, arg.Taskid, arg.Taskinsertedat)
+	var i GetTaskSchedulingStateRow
+	err := row.Scan(
+		&i.ID,
+		&i.InsertedAt,
+		&i.RetryCount,
+		&i.IsQueued,
+		&i.SchedulingReason,
+		&i.SchedulingMessage,
+		&i.SchedulingEvaluatedAt,
+		&i.IsConcurrencyLimited,
+		&i.ConcurrencyKey,
+		&i.RetryAfter,
+		&i.IsRunning,
+	)
+	return &i, err
+}
+
 const listAllTasksInDags = `-- name: ListAllTasksInDags :many
 SELECT
     t.id,
@@ -933,9 +1111,84 @@ func (q *Queries) ListTaskParentOutputs(ctx context.Context, db DBTX, arg ListTa
 	return items, nil
 }
 
+const listTaskRetryPolicies = `-- name: ListTaskRetryPolicies :many
+WITH input AS (
+    SELECT
+        unnest($1::bigint[]) AS task_id,
+        unnest($2::timestamptz[]) AS task_inserted_at
+)
+SELECT
+    t.id,
+    t.inserted_at,
+    t.app_retry_count,
+    t.retry_backoff_factor,
+    t.retry_max_backoff,
+    rp.error_codes,
+    rp.error_code_max_retries,
+    rp.jitter,
+    rp.min_backoff_seconds
+FROM
+    v1_task t
+JOIN
+    input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at
+JOIN
+    v1_step_retry_policy rp ON rp.step_id = t.step_id
+WHERE
+    t.tenant_id = $3::uuid
+`
+
+type ListTaskRetryPoliciesParams struct {
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+}
+
+type ListTaskRetryPoliciesRow struct {
+	ID                  int64              `json:"id"`
+	InsertedAt          pgtype.Timestamptz `json:"inserted_at"`
+	AppRetryCount       int32              `json:"app_retry_count"`
+	RetryBackoffFactor  pgtype.Float8      `json:"retry_backoff_factor"`
+	RetryMaxBackoff     pgtype.Int4        `json:"retry_max_backoff"`
+	ErrorCodes          []string           `json:"error_codes"`
+	ErrorCodeMaxRetries []int32            `json:"error_code_max_retries"`
+	Jitter              float64            `json:"jitter"`
+	MinBackoffSeconds   int32              `json:"min_backoff_seconds"`
+}
+
+// Lists the retry policies of the steps of the given tasks, along with the retry state of each task
+func (q *Queries) ListTaskRetryPolicies(ctx context.Context, db DBTX, arg ListTaskRetryPoliciesParams) ([]*ListTaskRetryPoliciesRow, error) {
+	rows, err := db.Query(ctx, listTaskRetryPolicies, arg.Taskids, arg.Taskinsertedats, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTaskRetryPoliciesRow
+	for rows.Next() {
+		var i ListTaskRetryPoliciesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.AppRetryCount,
+			&i.RetryBackoffFactor,
+			&i.RetryMaxBackoff,
+			&i.ErrorCodes,
+			&i.ErrorCodeMaxRetries,
+			&i.Jitter,
+			&i.MinBackoffSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTasks = `-- name: ListTasks :many
 SELECT
-    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff
+    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, fairness_key, retry_after
 FROM
     v1_task
 WHERE
@@ -995,6 +1248,8 @@ func (q *Queries) ListTasks(ctx context.Context, db DBTX, arg ListTasksParams) (
 			&i.ConcurrencyKeys,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.FairnessKey,
+			&i.RetryAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -1194,6 +1449,97 @@ func (q *Queries) ListTasksForReplay(ctx context.Context, db DBTX, arg ListTasks
 	return items, nil
 }
 
+const listTasksToExecutionTimeout = `-- name: ListTasksToExecutionTimeout :many
+WITH expired_timeouts AS (
+    SELECT
+        task_id,
+        task_inserted_at
+    FROM
+        v1_task_execution_timeout
+    WHERE
+        tenant_id = $1::uuid
+        AND timeout_at <= NOW()
+    ORDER BY
+        timeout_at
+    LIMIT
+        COALESCE($2::integer, 1000)
+    FOR UPDATE SKIP LOCKED
+), deleted_timeouts AS (
+    DELETE FROM
+        v1_task_execution_timeout
+    WHERE
+        (task_id, task_inserted_at) IN (SELECT task_id, task_inserted_at FROM expired_timeouts)
+    RETURNING
+        task_id, task_inserted_at, timeout, is_workflow_timeout
+)
+SELECT
+    t.id,
+    t.inserted_at,
+    t.retry_count,
+    t.external_id,
+    t.workflow_run_id,
+    d.timeout,
+    d.is_workflow_timeout,
+    EXISTS (
+        SELECT 1
+        FROM v1_task_event e
+        WHERE
+            (e.task_id, e.task_inserted_at, e.retry_count) = (t.id, t.inserted_at, t.retry_count)
+            AND e.event_type = ANY('{COMPLETED, FAILED, CANCELLED}'::v1_task_event_type[])
+    )::boolean AS is_finalized
+FROM
+    deleted_timeouts d
+JOIN
+    v1_task t ON t.id = d.task_id AND t.inserted_at = d.task_inserted_at
+`
+
+type ListTasksToExecutionTimeoutParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+type ListTasksToExecutionTimeoutRow struct {
+	ID                int64              `json:"id"`
+	InsertedAt        pgtype.Timestamptz `json:"inserted_at"`
+	RetryCount        int32              `json:"retry_count"`
+	ExternalID        pgtype.UUID        `json:"external_id"`
+	WorkflowRunID     pgtype.UUID        `json:"workflow_run_id"`
+	Timeout           string             `json:"timeout"`
+	IsWorkflowTimeout bool               `json:"is_workflow_timeout"`
+	IsFinalized       bool               `json:"is_finalized"`
+}
+
+// Removes execution timeouts which have passed and returns their tasks, along with whether the current
+// attempt of the task has already finished.
+func (q *Queries) ListTasksToExecutionTimeout(ctx context.Context, db DBTX, arg ListTasksToExecutionTimeoutParams) ([]*ListTasksToExecutionTimeoutRow, error) {
+	rows, err := db.Query(ctx, listTasksToExecutionTimeout, arg.Tenantid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTasksToExecutionTimeoutRow
+	for rows.Next() {
+		var i ListTasksToExecutionTimeoutRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.RetryCount,
+			&i.ExternalID,
+			&i.WorkflowRunID,
+			&i.Timeout,
+			&i.IsWorkflowTimeout,
+			&i.IsFinalized,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTasksToReassign = `-- name: ListTasksToReassign :many
 WITH tasks_on_inactive_workers AS (
     SELECT
@@ -1530,6 +1876,11 @@ WITH task AS (
     ORDER BY
         task_id, task_inserted_at, retry_count
     FOR UPDATE
+), released_resources AS (
+    DELETE FROM
+        v1_task_runtime_resource
+    WHERE
+        (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM locked_runtime)
 )
 UPDATE
     v1_task_runtime
@@ -1540,7 +1891,7 @@ FROM
 WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
-    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at
+    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at, v1_task_runtime.slot_weight, v1_task_runtime.preemptible
 `
 
 type ManualSlotReleaseParams struct {
@@ -1558,10 +1909,103 @@ func (q *Queries) ManualSlotRelease(ctx context.Context, db DBTX, arg ManualSlot
 		&i.WorkerID,
 		&i.TenantID,
 		&i.TimeoutAt,
+		&i.SlotWeight,
+		&i.Preemptible,
 	)
 	return &i, err
 }
 
+const preemptTasks = `-- name: PreemptTasks :many
+WITH input AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count
+    FROM
+        (
+            SELECT
+                unnest($1::bigint[]) AS task_id,
+                unnest($2::timestamptz[]) AS task_inserted_at,
+                unnest($3::integer[]) AS task_retry_count
+        ) AS subquery
+), locked_tasks AS (
+    SELECT
+        t.id,
+        t.inserted_at
+    FROM
+        v1_task t
+    JOIN
+        input i ON i.task_id = t.id AND i.task_inserted_at = t.inserted_at AND i.task_retry_count = t.retry_count
+    JOIN
+        v1_task_runtime r ON r.task_id = t.id AND r.task_inserted_at = t.inserted_at AND r.retry_count = t.retry_count
+    WHERE
+        t.tenant_id = $4::uuid
+    -- order by the task id to get a stable lock order
+    ORDER BY
+        t.id
+    FOR UPDATE OF t
+)
+UPDATE
+    v1_task
+SET
+    retry_count = v1_task.retry_count + 1,
+    retry_after = NULL
+FROM
+    locked_tasks
+WHERE
+    v1_task.id = locked_tasks.id
+    AND v1_task.inserted_at = locked_tasks.inserted_at
+RETURNING
+    v1_task.id,
+    v1_task.inserted_at,
+    v1_task.retry_count,
+    v1_task.priority
+`
+
+type PreemptTasksParams struct {
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Taskretrycounts []int32              `json:"taskretrycounts"`
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+}
+
+type PreemptTasksRow struct {
+	ID         int64              `json:"id"`
+	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
+	RetryCount int32              `json:"retry_count"`
+	Priority   pgtype.Int4        `json:"priority"`
+}
+
+// Requeues running tasks by incrementing their retry count, without counting towards the task's retries.
+// Only tasks which are still running on the given retry count are preempted.
+func (q *Queries) PreemptTasks(ctx context.Context, db DBTX, arg PreemptTasksParams) ([]*PreemptTasksRow, error) {
+	rows, err := db.Query(ctx, preemptTasks,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Taskretrycounts,
+		arg.Tenantid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PreemptTasksRow
+	for rows.Next() {
+		var i PreemptTasksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.RetryCount,
+			&i.Priority,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const preflightCheckDAGsForReplay = `-- name: PreflightCheckDAGsForReplay :many
 WITH dags_to_step_counts AS (
     SELECT
@@ -1646,7 +2090,7 @@ WITH input AS (
         UNNEST($3::bigint[]) AS task_id,
         UNNEST($4::timestamptz[]) AS task_inserted_at
 ), relevant_tasks AS (
-    SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, task_id, task_inserted_at
+    SELECT id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, fairness_key, retry_after, task_id, task_inserted_at
     FROM
         v1_task t
     JOIN
@@ -1807,7 +2251,7 @@ FROM
 WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
-    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at
+    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at, v1_task_runtime.slot_weight, v1_task_runtime.preemptible
 `
 
 type RefreshTimeoutByParams struct {
@@ -1826,6 +2270,8 @@ func (q *Queries) RefreshTimeoutBy(ctx context.Context, db DBTX, arg RefreshTime
 		&i.WorkerID,
 		&i.TenantID,
 		&i.TimeoutAt,
+		&i.SlotWeight,
+		&i.Preemptible,
 	)
 	return &i, err
 }
@@ -1859,6 +2305,11 @@ WITH input AS (
         v1_task_runtime
     WHERE
         (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM runtimes_to_delete)
+), deleted_runtime_resources AS (
+    DELETE FROM
+        v1_task_runtime_resource
+    WHERE
+        (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM runtimes_to_delete)
 ), retry_queue_items_to_delete AS (
     SELECT
         task_id, task_inserted_at, task_retry_count
@@ -1909,6 +2360,43 @@ WITH input AS (
         v1_concurrency_slot
     WHERE
         (task_id, task_inserted_at, task_retry_count) IN (SELECT task_id, task_inserted_at, task_retry_count FROM concurrency_slots_to_delete)
+), deleted_semaphores AS (
+    DELETE FROM
+        v1_task_runtime_semaphore
+    WHERE
+        (task_id, task_inserted_at, retry_count) IN (SELECT task_id, task_inserted_at, retry_count FROM input)
+    RETURNING tenant_id, key, units
+), released_semaphore_units AS (
+    SELECT
+        tenant_id, key, SUM(units)::int AS units
+    FROM
+        deleted_semaphores
+    GROUP BY
+        tenant_id, key
+), semaphores_to_release AS (
+    SELECT
+        rl."tenantId", rl."key"
+    FROM
+        "RateLimit" rl
+    WHERE
+        (rl."tenantId", rl."key") IN (SELECT tenant_id, key FROM released_semaphore_units)
+    ORDER BY
+        rl."tenantId" ASC, rl."key" ASC
+    FOR UPDATE
+), released_semaphores AS (
+    -- return the held units to the semaphore. this isn't capped at the limit, since the scheduler may not
+    -- have flushed its usage of the units yet.
+    UPDATE
+        "RateLimit" rl
+    SET
+        "value" = rl."value" + rsu.units
+    FROM
+        released_semaphore_units rsu
+    JOIN
+        semaphores_to_release str ON str."tenantId" = rsu.tenant_id AND str."key" = rsu.key
+    WHERE
+        rl."tenantId" = rsu.tenant_id
+        AND rl."key" = rsu.key
 )
 SELECT
     t.queue,
@@ -1978,3 +2466,58 @@ func (q *Queries) ReleaseTasks(ctx context.Context, db DBTX, arg ReleaseTasksPar
 	}
 	return items, nil
 }
+
+const resetPreemptedTaskPriorities = `-- name: ResetPreemptedTaskPriorities :exec
+WITH input AS (
+    SELECT
+        task_id, task_inserted_at, task_retry_count, priority
+    FROM
+        (
+            SELECT
+                unnest($1::bigint[]) AS task_id,
+                unnest($2::timestamptz[]) AS task_inserted_at,
+                unnest($3::integer[]) AS task_retry_count,
+                unnest($4::integer[]) AS priority
+        ) AS subquery
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot cs
+    SET
+        priority = i.priority
+    FROM
+        input i
+    WHERE
+        cs.task_id = i.task_id
+        AND cs.task_inserted_at = i.task_inserted_at
+        AND cs.task_retry_count = i.task_retry_count
+)
+UPDATE
+    v1_queue_item qi
+SET
+    priority = i.priority
+FROM
+    input i
+WHERE
+    qi.task_id = i.task_id
+    AND qi.task_inserted_at = i.task_inserted_at
+    AND qi.retry_count = i.task_retry_count
+`
+
+type ResetPreemptedTaskPrioritiesParams struct {
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Taskretrycounts []int32              `json:"taskretrycounts"`
+	Priorities      []int32              `json:"priorities"`
+}
+
+// Requeued tasks are placed in the queue with the highest priority, which would allow preempted tasks to
+// preempt the task which replaced them. Preempted tasks are instead requeued with their original priority.
+func (q *Queries) ResetPreemptedTaskPriorities(ctx context.Context, db DBTX, arg ResetPreemptedTaskPrioritiesParams) error {
+	_, err := db.Exec(ctx, resetPreemptedTaskPriorities,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Taskretrycounts,
+		arg.Priorities,
+	)
+	return err
+}
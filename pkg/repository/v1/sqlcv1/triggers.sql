@@ -48,3 +48,307 @@ WHERE
     AND workflowVersions."deletedAt" IS NULL
     AND workflow."name" = ANY(@workflowNames::text[])
 ORDER BY "workflowId", "order" DESC;
+
+-- name: ListWorkflowDebouncesByNames :many
+-- Lists the debounce settings of the latest versions of the named workflows, for the workflows which are debounced.
+SELECT
+    latest."workflowId",
+    latest."workflowVersionId",
+    latest."workflowName",
+    latest."debounceExpression"::text AS "debounceExpression",
+    latest."debounceWindow"::text AS "debounceWindow"
+FROM (
+    SELECT DISTINCT ON(workflowVersions."workflowId")
+        workflowVersions."workflowId",
+        workflowVersions."id" AS "workflowVersionId",
+        workflow."name" AS "workflowName",
+        workflowVersions."debounceExpression",
+        workflowVersions."debounceWindow"
+    FROM
+        "WorkflowVersion" as workflowVersions
+    JOIN
+        "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+    WHERE
+        workflow."tenantId" = @tenantId::uuid
+        AND workflowVersions."deletedAt" IS NULL
+        AND workflow."name" = ANY(@workflowNames::text[])
+    ORDER BY workflowVersions."workflowId", workflowVersions."order" DESC
+) AS latest
+WHERE
+    latest."debounceExpression" IS NOT NULL
+    AND latest."debounceWindow" IS NOT NULL;
+
+-- name: ListWorkflowVersionDebounces :many
+SELECT
+    "id" AS "workflowVersionId",
+    "workflowId",
+    "debounceExpression"::text AS "debounceExpression",
+    "debounceWindow"::text AS "debounceWindow"
+FROM
+    "WorkflowVersion"
+WHERE
+    "id" = ANY(@workflowVersionIds::uuid[])
+    AND "debounceExpression" IS NOT NULL
+    AND "debounceWindow" IS NOT NULL;
+
+-- name: UpsertDebouncedRuns :exec
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@workflowIds::uuid[]) AS workflow_id,
+                unnest(@keys::text[]) AS key,
+                unnest(@workflowVersionIds::uuid[]) AS workflow_version_id,
+                unnest(@externalIds::uuid[]) AS external_id,
+                unnest(@inputs::jsonb[]) AS input,
+                unnest(@additionalMetadatas::jsonb[]) AS additional_metadata,
+                -- priorities of 0 are treated as unset
+                unnest(@priorities::integer[]) AS priority,
+                unnest(@triggerCounts::integer[]) AS trigger_count,
+                unnest(@windows::text[]) AS debounce_window
+        ) AS subquery
+)
+INSERT INTO v1_debounced_run (
+    tenant_id,
+    workflow_id,
+    key,
+    workflow_version_id,
+    external_id,
+    input,
+    additional_metadata,
+    priority,
+    trigger_count,
+    fire_at
+)
+SELECT
+    @tenantId::uuid,
+    i.workflow_id,
+    i.key,
+    i.workflow_version_id,
+    i.external_id,
+    i.input,
+    i.additional_metadata,
+    NULLIF(i.priority, 0),
+    i.trigger_count,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(i.debounce_window)
+FROM
+    input i
+WHERE
+    -- a trigger which adopted the id of a pending run that has since fired is coalesced into that run
+    NOT EXISTS (
+        SELECT 1
+        FROM v1_lookup_table lt
+        WHERE lt.tenant_id = @tenantId::uuid AND lt.external_id = i.external_id
+    )
+ORDER BY
+    i.workflow_id, i.key
+ON CONFLICT (tenant_id, workflow_id, key) DO UPDATE
+SET
+    -- the pending run keeps the external id of its first trigger, which was returned to every coalesced trigger
+    workflow_version_id = EXCLUDED.workflow_version_id,
+    input = EXCLUDED.input,
+    additional_metadata = EXCLUDED.additional_metadata,
+    priority = EXCLUDED.priority,
+    trigger_count = v1_debounced_run.trigger_count + EXCLUDED.trigger_count,
+    fire_at = EXCLUDED.fire_at;
+
+-- name: ListDueDebouncedRuns :many
+SELECT
+    d.*,
+    w."name" AS "workflowName"
+FROM
+    v1_debounced_run d
+JOIN
+    "Workflow" w ON w."id" = d.workflow_id
+WHERE
+    d.tenant_id = @tenantId::uuid
+    AND d.fire_at <= CURRENT_TIMESTAMP
+ORDER BY
+    d.fire_at ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 1000);
+
+-- name: ClaimDebouncedRunIds :many
+-- Claims the external ids of the pending runs for the given keys, so that every trigger which is coalesced into
+-- a pending run is given the id of that run. Keys without a pending run are claimed with the given external id,
+-- and the placeholder is removed without creating a run if the trigger never arrives within the window.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@workflowIds::uuid[]) AS workflow_id,
+                unnest(@keys::text[]) AS key,
+                unnest(@workflowVersionIds::uuid[]) AS workflow_version_id,
+                unnest(@externalIds::uuid[]) AS external_id,
+                unnest(@windows::text[]) AS debounce_window
+        ) AS subquery
+)
+INSERT INTO v1_debounced_run (
+    tenant_id,
+    workflow_id,
+    key,
+    workflow_version_id,
+    external_id,
+    input,
+    trigger_count,
+    fire_at
+)
+SELECT
+    @tenantId::uuid,
+    i.workflow_id,
+    i.key,
+    i.workflow_version_id,
+    i.external_id,
+    '{}'::jsonb,
+    0,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(i.debounce_window)
+FROM
+    input i
+ORDER BY
+    i.workflow_id, i.key
+ON CONFLICT (tenant_id, workflow_id, key) DO UPDATE
+SET
+    -- no-op update so that the existing row is returned
+    external_id = v1_debounced_run.external_id
+RETURNING
+    workflow_id,
+    key,
+    external_id;
+
+-- name: ClaimDebouncedRuns :many
+-- Deletes the pending runs which are due and haven't been triggered again since they were listed, returning the
+-- external ids of the claimed runs.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@workflowIds::uuid[]) AS workflow_id,
+                unnest(@keys::text[]) AS key,
+                unnest(@externalIds::uuid[]) AS external_id,
+                unnest(@triggerCounts::integer[]) AS trigger_count
+        ) AS subquery
+)
+DELETE FROM
+    v1_debounced_run d
+USING
+    input i
+WHERE
+    d.tenant_id = @tenantId::uuid
+    AND d.workflow_id = i.workflow_id
+    AND d.key = i.key
+    AND d.external_id = i.external_id
+    AND d.trigger_count = i.trigger_count
+    AND d.fire_at <= CURRENT_TIMESTAMP
+RETURNING
+    d.external_id;
+
+-- name: ClaimIdempotencyKeys :many
+-- Claims the idempotency keys for the given external ids. Keys which are already claimed and haven't expired
+-- keep their original external id, which is returned instead. A claimed key is only a duplicate once its run
+-- has been triggered, as the trigger which claimed it may have failed before the run was created.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@keys::text[]) AS key,
+                unnest(@externalIds::uuid[]) AS external_id,
+                unnest(@expiresAts::timestamptz[]) AS expires_at
+        ) AS subquery
+)
+INSERT INTO v1_idempotency_key (
+    tenant_id,
+    key,
+    external_id,
+    expires_at
+)
+SELECT
+    @tenantId::uuid,
+    i.key,
+    i.external_id,
+    i.expires_at
+FROM
+    input i
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    external_id = CASE WHEN v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP THEN EXCLUDED.external_id ELSE v1_idempotency_key.external_id END,
+    triggered_at = CASE WHEN v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP THEN NULL ELSE v1_idempotency_key.triggered_at END,
+    expires_at = CASE WHEN v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP THEN EXCLUDED.expires_at ELSE v1_idempotency_key.expires_at END
+RETURNING
+    key,
+    external_id,
+    triggered_at;
+
+-- name: TriggerIdempotencyKeys :many
+-- Marks the idempotency keys as triggered for the given external ids, returning the external ids which
+-- should be created. Keys which were claimed by a different external id or have already been triggered
+-- are not returned.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@keys::text[]) AS key,
+                unnest(@externalIds::uuid[]) AS external_id,
+                unnest(@expiresAts::timestamptz[]) AS expires_at
+        ) AS subquery
+)
+INSERT INTO v1_idempotency_key (
+    tenant_id,
+    key,
+    external_id,
+    expires_at,
+    triggered_at
+)
+SELECT
+    @tenantId::uuid,
+    i.key,
+    i.external_id,
+    i.expires_at,
+    CURRENT_TIMESTAMP
+FROM
+    input i
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    external_id = EXCLUDED.external_id,
+    expires_at = EXCLUDED.expires_at,
+    triggered_at = CURRENT_TIMESTAMP
+WHERE
+    v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP
+    OR (
+        v1_idempotency_key.external_id = EXCLUDED.external_id
+        AND v1_idempotency_key.triggered_at IS NULL
+    )
+RETURNING
+    external_id;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+WITH expired AS (
+    SELECT
+        tenant_id,
+        key
+    FROM
+        v1_idempotency_key
+    WHERE
+        expires_at <= CURRENT_TIMESTAMP
+    ORDER BY
+        expires_at
+    LIMIT
+        @batchSize::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM
+    v1_idempotency_key k
+USING
+    expired e
+WHERE
+    k.tenant_id = e.tenant_id
+    AND k.key = e.key;
@@ -11,6 +11,460 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimDebouncedRunIds = `-- name: ClaimDebouncedRunIds :many
+WITH input AS (
+    SELECT
+        workflow_id, key, workflow_version_id, external_id, debounce_window
+    FROM
+        (
+            SELECT
+                unnest($2::uuid[]) AS workflow_id,
+                unnest($3::text[]) AS key,
+                unnest($4::uuid[]) AS workflow_version_id,
+                unnest($5::uuid[]) AS external_id,
+                unnest($6::text[]) AS debounce_window
+        ) AS subquery
+)
+INSERT INTO v1_debounced_run (
+    tenant_id,
+    workflow_id,
+    key,
+    workflow_version_id,
+    external_id,
+    input,
+    trigger_count,
+    fire_at
+)
+SELECT
+    $1::uuid,
+    i.workflow_id,
+    i.key,
+    i.workflow_version_id,
+    i.external_id,
+    '{}'::jsonb,
+    0,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(i.debounce_window)
+FROM
+    input i
+ORDER BY
+    i.workflow_id, i.key
+ON CONFLICT (tenant_id, workflow_id, key) DO UPDATE
+SET
+    -- no-op update so that the existing row is returned
+    external_id = v1_debounced_run.external_id
+RETURNING
+    workflow_id,
+    key,
+    external_id
+`
+
+type ClaimDebouncedRunIdsParams struct {
+	Tenantid           pgtype.UUID   `json:"tenantid"`
+	Workflowids        []pgtype.UUID `json:"workflowids"`
+	Keys               []string      `json:"keys"`
+	Workflowversionids []pgtype.UUID `json:"workflowversionids"`
+	Externalids        []pgtype.UUID `json:"externalids"`
+	Windows            []string      `json:"windows"`
+}
+
+type ClaimDebouncedRunIdsRow struct {
+	WorkflowID pgtype.UUID `json:"workflow_id"`
+	Key        string      `json:"key"`
+	ExternalID pgtype.UUID `json:"external_id"`
+}
+
+// Claims the external ids of the pending runs for the given keys, so that every trigger which is coalesced into
+// a pending run is given the id of that run. Keys without a pending run are claimed with the given external id,
+// and the placeholder is removed without creating a run if the trigger never arrives within the window.
+func (q *Queries) ClaimDebouncedRunIds(ctx context.Context, db DBTX, arg ClaimDebouncedRunIdsParams) ([]*ClaimDebouncedRunIdsRow, error) {
+	rows, err := db.Query(ctx, claimDebouncedRunIds,
+		arg.Tenantid,
+		arg.Workflowids,
+		arg.Keys,
+		arg.Workflowversionids,
+		arg.Externalids,
+		arg.Windows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimDebouncedRunIdsRow
+	for rows.Next() {
+		var i ClaimDebouncedRunIdsRow
+		if err := rows.Scan(
+			&i.WorkflowID,
+			&i.Key,
+			&i.ExternalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimDebouncedRuns = `-- name: ClaimDebouncedRuns :many
+WITH input AS (
+    SELECT
+        workflow_id, key, external_id, trigger_count
+    FROM
+        (
+            SELECT
+                unnest($2::uuid[]) AS workflow_id,
+                unnest($3::text[]) AS key,
+                unnest($4::uuid[]) AS external_id,
+                unnest($5::integer[]) AS trigger_count
+        ) AS subquery
+)
+DELETE FROM
+    v1_debounced_run d
+USING
+    input i
+WHERE
+    d.tenant_id = $1::uuid
+    AND d.workflow_id = i.workflow_id
+    AND d.key = i.key
+    AND d.external_id = i.external_id
+    AND d.trigger_count = i.trigger_count
+    AND d.fire_at <= CURRENT_TIMESTAMP
+RETURNING
+    d.external_id
+`
+
+type ClaimDebouncedRunsParams struct {
+	Tenantid      pgtype.UUID   `json:"tenantid"`
+	Workflowids   []pgtype.UUID `json:"workflowids"`
+	Keys          []string      `json:"keys"`
+	Externalids   []pgtype.UUID `json:"externalids"`
+	Triggercounts []int32       `json:"triggercounts"`
+}
+
+// Deletes the pending runs which are due and haven't been triggered again since they were listed, returning the
+// external ids of the claimed runs.
+func (q *Queries) ClaimDebouncedRuns(ctx context.Context, db DBTX, arg ClaimDebouncedRunsParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, claimDebouncedRuns,
+		arg.Tenantid,
+		arg.Workflowids,
+		arg.Keys,
+		arg.Externalids,
+		arg.Triggercounts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var external_id pgtype.UUID
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimIdempotencyKeys = `-- name: ClaimIdempotencyKeys :many
+WITH input AS (
+    SELECT
+        key, external_id, expires_at
+    FROM
+        (
+            SELECT
+                unnest($2::text[]) AS key,
+                unnest($3::uuid[]) AS external_id,
+                unnest($4::timestamptz[]) AS expires_at
+        ) AS subquery
+)
+INSERT INTO v1_idempotency_key (
+    tenant_id,
+    key,
+    external_id,
+    expires_at
+)
+SELECT
+    $1::uuid,
+    i.key,
+    i.external_id,
+    i.expires_at
+FROM
+    input i
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    external_id = CASE WHEN v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP THEN EXCLUDED.external_id ELSE v1_idempotency_key.external_id END,
+    triggered_at = CASE WHEN v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP THEN NULL ELSE v1_idempotency_key.triggered_at END,
+    expires_at = CASE WHEN v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP THEN EXCLUDED.expires_at ELSE v1_idempotency_key.expires_at END
+RETURNING
+    key,
+    external_id,
+    triggered_at
+`
+
+type ClaimIdempotencyKeysParams struct {
+	Tenantid    pgtype.UUID          `json:"tenantid"`
+	Keys        []string             `json:"keys"`
+	Externalids []pgtype.UUID        `json:"externalids"`
+	Expiresats  []pgtype.Timestamptz `json:"expiresats"`
+}
+
+type ClaimIdempotencyKeysRow struct {
+	Key         string             `json:"key"`
+	ExternalID  pgtype.UUID        `json:"external_id"`
+	TriggeredAt pgtype.Timestamptz `json:"triggered_at"`
+}
+
+// Claims the idempotency keys for the given external ids. Keys which are already claimed and haven't expired
+// keep their original external id, which is returned instead. A claimed key is only a duplicate once its run
+// has been triggered, as the trigger which claimed it may have failed before the run was created.
+func (q *Queries) ClaimIdempotencyKeys(ctx context.Context, db DBTX, arg ClaimIdempotencyKeysParams) ([]*ClaimIdempotencyKeysRow, error) {
+	rows, err := db.Query(ctx, claimIdempotencyKeys,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Externalids,
+		arg.Expiresats,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimIdempotencyKeysRow
+	for rows.Next() {
+		var i ClaimIdempotencyKeysRow
+		if err := rows.Scan(&i.Key, &i.ExternalID, &i.TriggeredAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+WITH expired AS (
+    SELECT
+        tenant_id,
+        key
+    FROM
+        v1_idempotency_key
+    WHERE
+        expires_at <= CURRENT_TIMESTAMP
+    ORDER BY
+        expires_at
+    LIMIT
+        $1::int
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM
+    v1_idempotency_key k
+USING
+    expired e
+WHERE
+    k.tenant_id = e.tenant_id
+    AND k.key = e.key
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, db DBTX, batchsize int32) (int64, error) {
+	result, err := db.Exec(ctx, deleteExpiredIdempotencyKeys, batchsize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listDueDebouncedRuns = `-- name: ListDueDebouncedRuns :many
+SELECT
+    d.tenant_id, d.workflow_id, d.key, d.workflow_version_id, d.external_id, d.input, d.additional_metadata, d.priority, d.trigger_count, d.first_triggered_at, d.fire_at,
+    w."name" AS "workflowName"
+FROM
+    v1_debounced_run d
+JOIN
+    "Workflow" w ON w."id" = d.workflow_id
+WHERE
+    d.tenant_id = $1::uuid
+    AND d.fire_at <= CURRENT_TIMESTAMP
+ORDER BY
+    d.fire_at ASC
+LIMIT
+    COALESCE($2::integer, 1000)
+`
+
+type ListDueDebouncedRunsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+type ListDueDebouncedRunsRow struct {
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	WorkflowID         pgtype.UUID        `json:"workflow_id"`
+	Key                string             `json:"key"`
+	WorkflowVersionID  pgtype.UUID        `json:"workflow_version_id"`
+	ExternalID         pgtype.UUID        `json:"external_id"`
+	Input              []byte             `json:"input"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	Priority           pgtype.Int4        `json:"priority"`
+	TriggerCount       int32              `json:"trigger_count"`
+	FirstTriggeredAt   pgtype.Timestamptz `json:"first_triggered_at"`
+	FireAt             pgtype.Timestamptz `json:"fire_at"`
+	WorkflowName       string             `json:"workflowName"`
+}
+
+func (q *Queries) ListDueDebouncedRuns(ctx context.Context, db DBTX, arg ListDueDebouncedRunsParams) ([]*ListDueDebouncedRunsRow, error) {
+	rows, err := db.Query(ctx, listDueDebouncedRuns, arg.Tenantid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListDueDebouncedRunsRow
+	for rows.Next() {
+		var i ListDueDebouncedRunsRow
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.WorkflowID,
+			&i.Key,
+			&i.WorkflowVersionID,
+			&i.ExternalID,
+			&i.Input,
+			&i.AdditionalMetadata,
+			&i.Priority,
+			&i.TriggerCount,
+			&i.FirstTriggeredAt,
+			&i.FireAt,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowDebouncesByNames = `-- name: ListWorkflowDebouncesByNames :many
+SELECT
+    latest."workflowId",
+    latest."workflowVersionId",
+    latest."workflowName",
+    latest."debounceExpression"::text AS "debounceExpression",
+    latest."debounceWindow"::text AS "debounceWindow"
+FROM (
+    SELECT DISTINCT ON(workflowVersions."workflowId")
+        workflowVersions."workflowId",
+        workflowVersions."id" AS "workflowVersionId",
+        workflow."name" AS "workflowName",
+        workflowVersions."debounceExpression",
+        workflowVersions."debounceWindow"
+    FROM
+        "WorkflowVersion" as workflowVersions
+    JOIN
+        "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+    WHERE
+        workflow."tenantId" = $1::uuid
+        AND workflowVersions."deletedAt" IS NULL
+        AND workflow."name" = ANY($2::text[])
+    ORDER BY workflowVersions."workflowId", workflowVersions."order" DESC
+) AS latest
+WHERE
+    latest."debounceExpression" IS NOT NULL
+    AND latest."debounceWindow" IS NOT NULL
+`
+
+type ListWorkflowDebouncesByNamesParams struct {
+	Tenantid      pgtype.UUID `json:"tenantid"`
+	Workflownames []string    `json:"workflownames"`
+}
+
+type ListWorkflowDebouncesByNamesRow struct {
+	WorkflowId         pgtype.UUID `json:"workflowId"`
+	WorkflowVersionId  pgtype.UUID `json:"workflowVersionId"`
+	WorkflowName       string      `json:"workflowName"`
+	DebounceExpression string      `json:"debounceExpression"`
+	DebounceWindow     string      `json:"debounceWindow"`
+}
+
+// Lists the debounce settings of the latest versions of the named workflows, for the workflows which are debounced.
+func (q *Queries) ListWorkflowDebouncesByNames(ctx context.Context, db DBTX, arg ListWorkflowDebouncesByNamesParams) ([]*ListWorkflowDebouncesByNamesRow, error) {
+	rows, err := db.Query(ctx, listWorkflowDebouncesByNames, arg.Tenantid, arg.Workflownames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowDebouncesByNamesRow
+	for rows.Next() {
+		var i ListWorkflowDebouncesByNamesRow
+		if err := rows.Scan(
+			&i.WorkflowId,
+			&i.WorkflowVersionId,
+			&i.WorkflowName,
+			&i.DebounceExpression,
+			&i.DebounceWindow,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowVersionDebounces = `-- name: ListWorkflowVersionDebounces :many
+SELECT
+    "id" AS "workflowVersionId",
+    "workflowId",
+    "debounceExpression"::text AS "debounceExpression",
+    "debounceWindow"::text AS "debounceWindow"
+FROM
+    "WorkflowVersion"
+WHERE
+    "id" = ANY($1::uuid[])
+    AND "debounceExpression" IS NOT NULL
+    AND "debounceWindow" IS NOT NULL
+`
+
+type ListWorkflowVersionDebouncesRow struct {
+	WorkflowVersionId  pgtype.UUID `json:"workflowVersionId"`
+	WorkflowId         pgtype.UUID `json:"workflowId"`
+	DebounceExpression string      `json:"debounceExpression"`
+	DebounceWindow     string      `json:"debounceWindow"`
+}
+
+func (q *Queries) ListWorkflowVersionDebounces(ctx context.Context, db DBTX, workflowversionids []pgtype.UUID) ([]*ListWorkflowVersionDebouncesRow, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionDebounces, workflowversionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowVersionDebouncesRow
+	for rows.Next() {
+		var i ListWorkflowVersionDebouncesRow
+		if err := rows.Scan(
+			&i.WorkflowVersionId,
+			&i.WorkflowId,
+			&i.DebounceExpression,
+			&i.DebounceWindow,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflowsByNames = `-- name: ListWorkflowsByNames :many
 SELECT DISTINCT ON("workflowId")
     "workflowId",
@@ -132,3 +586,173 @@ func (q *Queries) ListWorkflowsForEvents(ctx context.Context, db DBTX, arg ListW
 	}
 	return items, nil
 }
+
+const triggerIdempotencyKeys = `-- name: TriggerIdempotencyKeys :many
+WITH input AS (
+    SELECT
+        key, external_id, expires_at
+    FROM
+        (
+            SELECT
+                unnest($2::text[]) AS key,
+                unnest($3::uuid[]) AS external_id,
+                unnest($4::timestamptz[]) AS expires_at
+        ) AS subquery
+)
+INSERT INTO v1_idempotency_key (
+    tenant_id,
+    key,
+    external_id,
+    expires_at,
+    triggered_at
+)
+SELECT
+    $1::uuid,
+    i.key,
+    i.external_id,
+    i.expires_at,
+    CURRENT_TIMESTAMP
+FROM
+    input i
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    external_id = EXCLUDED.external_id,
+    expires_at = EXCLUDED.expires_at,
+    triggered_at = CURRENT_TIMESTAMP
+WHERE
+    v1_idempotency_key.expires_at <= CURRENT_TIMESTAMP
+    OR (
+        v1_idempotency_key.external_id = EXCLUDED.external_id
+        AND v1_idempotency_key.triggered_at IS NULL
+    )
+RETURNING
+    external_id
+`
+
+type TriggerIdempotencyKeysParams struct {
+	Tenantid    pgtype.UUID          `json:"tenantid"`
+	Keys        []string             `json:"keys"`
+	Externalids []pgtype.UUID        `json:"externalids"`
+	Expiresats  []pgtype.Timestamptz `json:"expiresats"`
+}
+
+// Marks the idempotency keys as triggered for the given external ids, returning the external ids which
+// should be created. Keys which were claimed by a different external id or have already been triggered
+// are not returned.
+func (q *Queries) TriggerIdempotencyKeys(ctx context.Context, db DBTX, arg TriggerIdempotencyKeysParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, triggerIdempotencyKeys,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Externalids,
+		arg.Expiresats,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var external_id pgtype.UUID
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertDebouncedRuns = `-- name: UpsertDebouncedRuns :exec
+WITH input AS (
+    SELECT
+        workflow_id, key, workflow_version_id, external_id, input, additional_metadata, priority, trigger_count, debounce_window
+    FROM
+        (
+            SELECT
+                unnest($2::uuid[]) AS workflow_id,
+                unnest($3::text[]) AS key,
+                unnest($4::uuid[]) AS workflow_version_id,
+                unnest($5::uuid[]) AS external_id,
+                unnest($6::jsonb[]) AS input,
+                unnest($7::jsonb[]) AS additional_metadata,
+                -- priorities of 0 are treated as unset
+                unnest($8::integer[]) AS priority,
+                unnest($9::integer[]) AS trigger_count,
+                unnest($10::text[]) AS debounce_window
+        ) AS subquery
+)
+INSERT INTO v1_debounced_run (
+    tenant_id,
+    workflow_id,
+    key,
+    workflow_version_id,
+    external_id,
+    input,
+    additional_metadata,
+    priority,
+    trigger_count,
+    fire_at
+)
+SELECT
+    $1::uuid,
+    i.workflow_id,
+    i.key,
+    i.workflow_version_id,
+    i.external_id,
+    i.input,
+    i.additional_metadata,
+    NULLIF(i.priority, 0),
+    i.trigger_count,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(i.debounce_window)
+FROM
+    input i
+WHERE
+    -- a trigger which adopted the id of a pending run that has since fired is coalesced into that run
+    NOT EXISTS (
+        SELECT 1
+        FROM v1_lookup_table lt
+        WHERE lt.tenant_id = $1::uuid AND lt.external_id = i.external_id
+    )
+ORDER BY
+    i.workflow_id, i.key
+ON CONFLICT (tenant_id, workflow_id, key) DO UPDATE
+SET
+    -- the pending run keeps the external id of its first trigger, which was returned to every coalesced trigger
+    workflow_version_id = EXCLUDED.workflow_version_id,
+    input = EXCLUDED.input,
+    additional_metadata = EXCLUDED.additional_metadata,
+    priority = EXCLUDED.priority,
+    trigger_count = v1_debounced_run.trigger_count + EXCLUDED.trigger_count,
+    fire_at = EXCLUDED.fire_at
+`
+
+type UpsertDebouncedRunsParams struct {
+	Tenantid            pgtype.UUID   `json:"tenantid"`
+	Workflowids         []pgtype.UUID `json:"workflowids"`
+	Keys                []string      `json:"keys"`
+	Workflowversionids  []pgtype.UUID `json:"workflowversionids"`
+	Externalids         []pgtype.UUID `json:"externalids"`
+	Inputs              [][]byte      `json:"inputs"`
+	Additionalmetadatas [][]byte      `json:"additionalmetadatas"`
+	Priorities          []int32       `json:"priorities"`
+	Triggercounts       []int32       `json:"triggercounts"`
+	Windows             []string      `json:"windows"`
+}
+
+func (q *Queries) UpsertDebouncedRuns(ctx context.Context, db DBTX, arg UpsertDebouncedRunsParams) error {
+	_, err := db.Exec(ctx, upsertDebouncedRuns,
+		arg.Tenantid,
+		arg.Workflowids,
+		arg.Keys,
+		arg.Workflowversionids,
+		arg.Externalids,
+		arg.Inputs,
+		arg.Additionalmetadatas,
+		arg.Priorities,
+		arg.Triggercounts,
+		arg.Windows,
+	)
+	return err
+}
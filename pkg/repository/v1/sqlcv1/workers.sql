@@ -16,7 +16,7 @@ SELECT
     ww."url" AS "webhookUrl",
     ww."id" AS "webhookId",
     workers."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slot_weight), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = workers."tenantId" AND
@@ -58,7 +58,7 @@ SELECT
     sqlc.embed(w),
     ww."url" AS "webhookUrl",
     w."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slot_weight), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = w."tenantId" AND
@@ -83,3 +83,81 @@ WHERE
     AND runtime.worker_id = @workerId::uuid
 LIMIT
     COALESCE(sqlc.narg('limit')::int, 100);
+
+-- name: UpsertWorkerResources :exec
+INSERT INTO v1_worker_resource (
+    tenant_id,
+    worker_id,
+    key,
+    capacity
+)
+SELECT
+    @tenantId::uuid,
+    @workerId::uuid,
+    unnest(@keys::text[]),
+    unnest(@capacities::integer[])
+ON CONFLICT (worker_id, key) DO UPDATE
+SET
+    capacity = EXCLUDED.capacity;
+
+-- name: DrainWorker :one
+-- Marks a worker as draining, which stops new tasks from being assigned to it. Draining an already
+-- draining worker keeps the original drain time.
+UPDATE
+    "Worker"
+SET
+    "drainingAt" = COALESCE("drainingAt", CURRENT_TIMESTAMP),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" = @workerId::uuid
+RETURNING *;
+
+-- name: ListInFlightTasksForWorker :many
+SELECT
+    t.id,
+    t.inserted_at,
+    t.external_id,
+    runtime.retry_count
+FROM
+    v1_task_runtime runtime
+JOIN
+    v1_task t ON runtime.task_id = t.id AND runtime.task_inserted_at = t.inserted_at
+WHERE
+    runtime.tenant_id = @tenantId::uuid
+    AND runtime.worker_id = @workerId::uuid
+ORDER BY
+    t.id ASC;
+
+-- name: ListActionSlotWeights :many
+-- Lists the largest slot weight of the steps of each action in the latest versions of the tenant's
+-- workflows, for actions with a slot weight greater than 1.
+WITH latest_versions AS (
+    SELECT DISTINCT ON("workflowId")
+        wv."id"
+    FROM
+        "WorkflowVersion" wv
+    JOIN
+        "Workflow" w ON w."id" = wv."workflowId"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."deletedAt" IS NULL
+        AND wv."deletedAt" IS NULL
+    ORDER BY
+        "workflowId", wv."order" DESC
+)
+SELECT
+    s."actionId" AS action_id,
+    MAX(s."slotWeight")::integer AS slot_weight
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    latest_versions lv ON lv."id" = j."workflowVersionId"
+WHERE
+    s."tenantId" = @tenantId::uuid
+    AND s."actionId" = ANY(@actionIds::text[])
+    AND s."slotWeight" > 1
+GROUP BY
+    s."actionId";
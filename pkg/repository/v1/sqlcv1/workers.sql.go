@@ -11,12 +11,59 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const drainWorker = `-- name: DrainWorker :one
+UPDATE
+    "Worker"
+SET
+    "drainingAt" = COALESCE("drainingAt", CURRENT_TIMESTAMP),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = $1::uuid
+    AND "id" = $2::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "drainingAt"
+`
+
+type DrainWorkerParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+// Marks a worker as draining, which stops new tasks from being assigned to it. Draining an already
+// draining worker keeps the original drain time.
+func (q *Queries) DrainWorker(ctx context.Context, db DBTX, arg DrainWorkerParams) (*Worker, error) {
+	row := db.QueryRow(ctx, drainWorker, arg.Tenantid, arg.Workerid)
+	var i Worker
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.LastHeartbeatAt,
+		&i.Name,
+		&i.DispatcherId,
+		&i.MaxRuns,
+		&i.IsActive,
+		&i.LastListenerEstablished,
+		&i.IsPaused,
+		&i.Type,
+		&i.WebhookId,
+		&i.Language,
+		&i.LanguageVersion,
+		&i.Os,
+		&i.RuntimeExtra,
+		&i.SdkVersion,
+		&i.DrainingAt,
+	)
+	return &i, err
+}
+
 const getWorkerById = `-- name: GetWorkerById :one
 SELECT
-    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion",
+    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion", w."drainingAt",
     ww."url" AS "webhookUrl",
     w."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slot_weight), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = w."tenantId" AND
@@ -59,12 +106,131 @@ func (q *Queries) GetWorkerById(ctx context.Context, db DBTX, id pgtype.UUID) (*
 		&i.Worker.Os,
 		&i.Worker.RuntimeExtra,
 		&i.Worker.SdkVersion,
+		&i.Worker.DrainingAt,
 		&i.WebhookUrl,
 		&i.RemainingSlots,
 	)
 	return &i, err
 }
 
+const listActionSlotWeights = `-- name: ListActionSlotWeights :many
+WITH latest_versions AS (
+    SELECT DISTINCT ON("workflowId")
+        wv."id"
+    FROM
+        "WorkflowVersion" wv
+    JOIN
+        "Workflow" w ON w."id" = wv."workflowId"
+    WHERE
+        w."tenantId" = $1::uuid
+        AND w."deletedAt" IS NULL
+        AND wv."deletedAt" IS NULL
+    ORDER BY
+        "workflowId", wv."order" DESC
+)
+SELECT
+    s."actionId" AS action_id,
+    MAX(s."slotWeight")::integer AS slot_weight
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    latest_versions lv ON lv."id" = j."workflowVersionId"
+WHERE
+    s."tenantId" = $1::uuid
+    AND s."actionId" = ANY($2::text[])
+    AND s."slotWeight" > 1
+GROUP BY
+    s."actionId"
+`
+
+type ListActionSlotWeightsParams struct {
+	Tenantid  pgtype.UUID `json:"tenantid"`
+	Actionids []string    `json:"actionids"`
+}
+
+type ListActionSlotWeightsRow struct {
+	ActionID   string `json:"action_id"`
+	SlotWeight int32  `json:"slot_weight"`
+}
+
+// Lists the largest slot weight of the steps of each action in the latest versions of the tenant's
+// workflows, for actions with a slot weight greater than 1.
+func (q *Queries) ListActionSlotWeights(ctx context.Context, db DBTX, arg ListActionSlotWeightsParams) ([]*ListActionSlotWeightsRow, error) {
+	rows, err := db.Query(ctx, listActionSlotWeights, arg.Tenantid, arg.Actionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListActionSlotWeightsRow
+	for rows.Next() {
+		var i ListActionSlotWeightsRow
+		if err := rows.Scan(&i.ActionID, &i.SlotWeight); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInFlightTasksForWorker = `-- name: ListInFlightTasksForWorker :many
+SELECT
+    t.id,
+    t.inserted_at,
+    t.external_id,
+    runtime.retry_count
+FROM
+    v1_task_runtime runtime
+JOIN
+    v1_task t ON runtime.task_id = t.id AND runtime.task_inserted_at = t.inserted_at
+WHERE
+    runtime.tenant_id = $1::uuid
+    AND runtime.worker_id = $2::uuid
+ORDER BY
+    t.id ASC
+`
+
+type ListInFlightTasksForWorkerParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+type ListInFlightTasksForWorkerRow struct {
+	ID         int64              `json:"id"`
+	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
+	ExternalID pgtype.UUID        `json:"external_id"`
+	RetryCount int32              `json:"retry_count"`
+}
+
+func (q *Queries) ListInFlightTasksForWorker(ctx context.Context, db DBTX, arg ListInFlightTasksForWorkerParams) ([]*ListInFlightTasksForWorkerRow, error) {
+	rows, err := db.Query(ctx, listInFlightTasksForWorker, arg.Tenantid, arg.Workerid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListInFlightTasksForWorkerRow
+	for rows.Next() {
+		var i ListInFlightTasksForWorkerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.ExternalID,
+			&i.RetryCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listManyWorkerLabels = `-- name: ListManyWorkerLabels :many
 SELECT
     "id",
@@ -118,7 +284,7 @@ func (q *Queries) ListManyWorkerLabels(ctx context.Context, db DBTX, workerids [
 
 const listSemaphoreSlotsWithStateForWorker = `-- name: ListSemaphoreSlotsWithStateForWorker :many
 SELECT
-    task_id, task_inserted_at, runtime.retry_count, worker_id, runtime.tenant_id, timeout_at, id, inserted_at, v1_task.tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, v1_task.retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff
+    task_id, task_inserted_at, runtime.retry_count, worker_id, runtime.tenant_id, timeout_at, slot_weight, preemptible, id, inserted_at, v1_task.tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, v1_task.retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, fairness_key, retry_after
 FROM
     v1_task_runtime runtime
 JOIN
@@ -143,6 +309,8 @@ type ListSemaphoreSlotsWithStateForWorkerRow struct {
 	WorkerID                     pgtype.UUID        `json:"worker_id"`
 	TenantID                     pgtype.UUID        `json:"tenant_id"`
 	TimeoutAt                    pgtype.Timestamp   `json:"timeout_at"`
+	SlotWeight                   int32              `json:"slot_weight"`
+	Preemptible                  bool               `json:"preemptible"`
 	ID                           int64              `json:"id"`
 	InsertedAt                   pgtype.Timestamptz `json:"inserted_at"`
 	TenantID_2                   pgtype.UUID        `json:"tenant_id_2"`
@@ -180,6 +348,8 @@ type ListSemaphoreSlotsWithStateForWorkerRow struct {
 	ConcurrencyKeys              []string           `json:"concurrency_keys"`
 	RetryBackoffFactor           pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff              pgtype.Int4        `json:"retry_max_backoff"`
+	FairnessKey                  pgtype.Text        `json:"fairness_key"`
+	RetryAfter                   pgtype.Timestamptz `json:"retry_after"`
 }
 
 func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db DBTX, arg ListSemaphoreSlotsWithStateForWorkerParams) ([]*ListSemaphoreSlotsWithStateForWorkerRow, error) {
@@ -198,6 +368,8 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 			&i.WorkerID,
 			&i.TenantID,
 			&i.TimeoutAt,
+			&i.SlotWeight,
+			&i.Preemptible,
 			&i.ID,
 			&i.InsertedAt,
 			&i.TenantID_2,
@@ -235,6 +407,8 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 			&i.ConcurrencyKeys,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.FairnessKey,
+			&i.RetryAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -248,11 +422,11 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 
 const listWorkersWithSlotCount = `-- name: ListWorkersWithSlotCount :many
 SELECT
-    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion",
+    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion", workers."drainingAt",
     ww."url" AS "webhookUrl",
     ww."id" AS "webhookId",
     workers."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slot_weight), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = workers."tenantId" AND
@@ -338,6 +512,7 @@ func (q *Queries) ListWorkersWithSlotCount(ctx context.Context, db DBTX, arg Lis
 			&i.Worker.Os,
 			&i.Worker.RuntimeExtra,
 			&i.Worker.SdkVersion,
+			&i.Worker.DrainingAt,
 			&i.WebhookUrl,
 			&i.WebhookId,
 			&i.RemainingSlots,
@@ -351,3 +526,37 @@ func (q *Queries) ListWorkersWithSlotCount(ctx context.Context, db DBTX, arg Lis
 	}
 	return items, nil
 }
+
+const upsertWorkerResources = `-- name: UpsertWorkerResources :exec
+INSERT INTO v1_worker_resource (
+    tenant_id,
+    worker_id,
+    key,
+    capacity
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    unnest($3::text[]),
+    unnest($4::integer[])
+ON CONFLICT (worker_id, key) DO UPDATE
+SET
+    capacity = EXCLUDED.capacity
+`
+
+type UpsertWorkerResourcesParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workerid   pgtype.UUID `json:"workerid"`
+	Keys       []string    `json:"keys"`
+	Capacities []int32     `json:"capacities"`
+}
+
+func (q *Queries) UpsertWorkerResources(ctx context.Context, db DBTX, arg UpsertWorkerResourcesParams) error {
+	_, err := db.Exec(ctx, upsertWorkerResources,
+		arg.Tenantid,
+		arg.Workerid,
+		arg.Keys,
+		arg.Capacities,
+	)
+	return err
+}
@@ -51,6 +51,7 @@ SELECT
     w."name" as "workflowName",
     w."id" as "workflowId",
     COALESCE(wv."defaultPriority", 1) AS "defaultPriority",
+    wv."executionTimeout" as "workflowExecutionTimeout",
     COUNT(se."stepId") as "exprCount",
     COUNT(sc.id) as "concurrencyCount"
 FROM
@@ -120,7 +121,11 @@ INSERT INTO "WorkflowVersion" (
     "sticky",
     "kind",
     "defaultPriority",
-    "createWorkflowVersionOpts"
+    "createWorkflowVersionOpts",
+    "priorityAgingSeconds",
+    "debounceExpression",
+    "debounceWindow",
+    "executionTimeout"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -134,7 +139,11 @@ INSERT INTO "WorkflowVersion" (
     sqlc.narg('sticky')::"StickyStrategy",
     coalesce(sqlc.narg('kind')::"WorkflowKind", 'DAG'),
     sqlc.narg('defaultPriority') :: integer,
-    sqlc.narg('createWorkflowVersionOpts')::jsonb
+    sqlc.narg('createWorkflowVersionOpts')::jsonb,
+    sqlc.narg('priorityAgingSeconds')::integer,
+    sqlc.narg('debounceExpression')::text,
+    sqlc.narg('debounceWindow')::text,
+    sqlc.narg('executionTimeout')::text
 ) RETURNING *;
 
 -- name: CreateJob :one
@@ -270,7 +279,13 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "slotWeight",
+    "preemptible",
+    "gangName",
+    "gangTimeout",
+    "placementStrategy",
+    "totalTimeout"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -285,7 +300,13 @@ INSERT INTO "Step" (
     coalesce(sqlc.narg('retries')::integer, 0),
     coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
     sqlc.narg('retryBackoffFactor'),
-    sqlc.narg('retryMaxBackoff')
+    sqlc.narg('retryMaxBackoff'),
+    coalesce(sqlc.narg('slotWeight')::integer, 1),
+    coalesce(sqlc.narg('preemptible')::boolean, false),
+    sqlc.narg('gangName')::text,
+    sqlc.narg('gangTimeout')::text,
+    sqlc.narg('placementStrategy')::text,
+    sqlc.narg('totalTimeout')::text
 ) RETURNING *;
 
 -- name: AddStepParents :exec
@@ -448,7 +469,10 @@ WITH inserted_wcs AS (
       strategy,
       expression,
       tenant_id,
-      max_concurrency
+      max_concurrency,
+      max_queued,
+      queue_overflow,
+      concurrency_group
     )
     VALUES (
       @workflowId::uuid,
@@ -456,7 +480,10 @@ WITH inserted_wcs AS (
       @limitStrategy::v1_concurrency_strategy,
       @expression,
       @tenantId::uuid,
-      COALESCE(sqlc.narg('maxRuns')::integer, 1)
+      COALESCE(sqlc.narg('maxRuns')::integer, 1),
+      sqlc.narg('maxQueued')::integer,
+      COALESCE(sqlc.narg('queueOverflow')::v1_concurrency_queue_overflow, 'DROP_OLDEST'),
+      sqlc.narg('concurrencyGroup')::text
     )
     RETURNING *
 ), inserted_scs AS (
@@ -468,7 +495,10 @@ WITH inserted_wcs AS (
       strategy,
       expression,
       tenant_id,
-      max_concurrency
+      max_concurrency,
+      max_queued,
+      queue_overflow,
+      concurrency_group
     )
     SELECT
       wcs.id,
@@ -478,7 +508,10 @@ WITH inserted_wcs AS (
       @limitStrategy::v1_concurrency_strategy,
       @expression,
       s."tenantId",
-      COALESCE(sqlc.narg('maxRuns')::integer, 1)
+      COALESCE(sqlc.narg('maxRuns')::integer, 1),
+      wcs.max_queued,
+      wcs.queue_overflow,
+      wcs.concurrency_group
     FROM (
         SELECT
           s."id",
@@ -521,7 +554,10 @@ INSERT INTO v1_step_concurrency (
     strategy,
     expression,
     tenant_id,
-    max_concurrency
+    max_concurrency,
+    max_queued,
+    queue_overflow,
+    concurrency_group
 )
 VALUES (
     @workflowId::uuid,
@@ -530,9 +566,68 @@ VALUES (
     @strategy::v1_concurrency_strategy,
     @expression::text,
     @tenantId::uuid,
-    @maxConcurrency::integer
+    @maxConcurrency::integer,
+    sqlc.narg('maxQueued')::integer,
+    COALESCE(sqlc.narg('queueOverflow')::v1_concurrency_queue_overflow, 'DROP_OLDEST'),
+    sqlc.narg('concurrencyGroup')::text
 ) RETURNING *;
 
+-- name: ListConcurrencyGroupMembers :many
+-- Lists the strategies of the latest workflow versions which belong to a tenant-level concurrency group
+WITH members AS (
+    SELECT
+        workflow_id,
+        workflow_version_id,
+        TRUE AS is_workflow_level,
+        expression,
+        max_concurrency
+    FROM
+        v1_workflow_concurrency
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND concurrency_group = @concurrencyGroup::text
+    UNION ALL
+    SELECT
+        workflow_id,
+        workflow_version_id,
+        FALSE AS is_workflow_level,
+        expression,
+        max_concurrency
+    FROM
+        v1_step_concurrency
+    WHERE
+        tenant_id = @tenantId::uuid
+        AND concurrency_group = @concurrencyGroup::text
+        -- child strategies belong to the workflow-level strategy they were copied from
+        AND parent_strategy_id IS NULL
+), latest_versions AS (
+    SELECT DISTINCT ON (wv."workflowId")
+        wv."workflowId" AS workflow_id,
+        wv."id" AS workflow_version_id
+    FROM
+        "WorkflowVersion" wv
+    WHERE
+        wv."workflowId" IN (SELECT workflow_id FROM members)
+        AND wv."deletedAt" IS NULL
+    ORDER BY
+        wv."workflowId", wv."order" DESC
+)
+SELECT
+    w."name" AS "workflowName",
+    m.is_workflow_level::boolean AS "isWorkflowLevel",
+    m.expression,
+    m.max_concurrency
+FROM
+    members m
+JOIN
+    latest_versions lv ON (lv.workflow_id, lv.workflow_version_id) = (m.workflow_id, m.workflow_version_id)
+JOIN
+    "Workflow" w ON w."id" = m.workflow_id
+WHERE
+    w."deletedAt" IS NULL
+    -- other versions of the workflow which is being registered are replaced by the new version
+    AND (m.workflow_id != @workflowId::uuid OR m.workflow_version_id = @workflowVersionId::uuid);
+
 -- name: CreateStepMatchCondition :one
 INSERT INTO v1_step_match_condition (
     tenant_id,
@@ -544,7 +639,9 @@ INSERT INTO v1_step_match_condition (
     kind,
     sleep_duration,
     event_key,
-    parent_readable_id
+    parent_readable_id,
+    approval_expires_in,
+    approval_expiry_action
 )
 VALUES (
     @tenantId::uuid,
@@ -556,7 +653,9 @@ VALUES (
     @kind::v1_step_match_condition_kind,
     sqlc.narg('sleepDuration')::text,
     sqlc.narg('eventKey')::text,
-    sqlc.narg('parentReadableId')::text
+    sqlc.narg('parentReadableId')::text,
+    sqlc.narg('approvalExpiresIn')::text,
+    sqlc.narg('approvalExpiryAction')::v1_match_condition_action
 ) RETURNING *;
 
 -- name: ListStepMatchConditions :many
@@ -580,3 +679,33 @@ ORDER BY
     "order" DESC
 LIMIT 1
 FOR UPDATE;
+
+-- name: CreateStepResourceRequests :exec
+INSERT INTO v1_step_resource_request (
+    tenant_id,
+    step_id,
+    key,
+    units
+)
+SELECT
+    @tenantId::uuid,
+    @stepId::uuid,
+    unnest(@keys::text[]),
+    unnest(@units::integer[]);
+
+-- name: CreateStepRetryPolicy :exec
+INSERT INTO v1_step_retry_policy (
+    tenant_id,
+    step_id,
+    error_codes,
+    error_code_max_retries,
+    jitter,
+    min_backoff_seconds
+) VALUES (
+    @tenantId::uuid,
+    @stepId::uuid,
+    @errorCodes::text[],
+    @errorCodeMaxRetries::integer[],
+    @jitter::double precision,
+    @minBackoffSeconds::integer
+);
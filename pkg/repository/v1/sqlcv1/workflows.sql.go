@@ -115,7 +115,13 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "slotWeight",
+    "preemptible",
+    "gangName",
+    "gangTimeout",
+    "placementStrategy",
+    "totalTimeout"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -130,8 +136,14 @@ INSERT INTO "Step" (
     coalesce($11::integer, 0),
     coalesce($12::text, '5m'),
     $13,
-    $14
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout"
+    $14,
+    coalesce($15::integer, 1),
+    coalesce($16::boolean, false),
+    $17::text,
+    $18::text,
+    $19::text,
+    $20::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout", "slotWeight", preemptible, "gangName", "gangTimeout", "placementStrategy", "totalTimeout"
 `
 
 type CreateStepParams struct {
@@ -149,6 +161,12 @@ type CreateStepParams struct {
 	ScheduleTimeout    pgtype.Text      `json:"scheduleTimeout"`
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
+	SlotWeight         pgtype.Int4      `json:"slotWeight"`
+	Preemptible        pgtype.Bool      `json:"preemptible"`
+	GangName           pgtype.Text      `json:"gangName"`
+	GangTimeout        pgtype.Text      `json:"gangTimeout"`
+	PlacementStrategy  pgtype.Text      `json:"placementStrategy"`
+	TotalTimeout       pgtype.Text      `json:"totalTimeout"`
 }
 
 func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams) (*Step, error) {
@@ -167,6 +185,12 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		arg.ScheduleTimeout,
 		arg.RetryBackoffFactor,
 		arg.RetryMaxBackoff,
+		arg.SlotWeight,
+		arg.Preemptible,
+		arg.GangName,
+		arg.GangTimeout,
+		arg.PlacementStrategy,
+		arg.TotalTimeout,
 	)
 	var i Step
 	err := row.Scan(
@@ -184,6 +208,12 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.RetryBackoffFactor,
 		&i.RetryMaxBackoff,
 		&i.ScheduleTimeout,
+		&i.SlotWeight,
+		&i.Preemptible,
+		&i.GangName,
+		&i.GangTimeout,
+		&i.PlacementStrategy,
+		&i.TotalTimeout,
 	)
 	return &i, err
 }
@@ -196,7 +226,10 @@ INSERT INTO v1_step_concurrency (
     strategy,
     expression,
     tenant_id,
-    max_concurrency
+    max_concurrency,
+    max_queued,
+    queue_overflow,
+    concurrency_group
 )
 VALUES (
     $1::uuid,
@@ -205,18 +238,24 @@ VALUES (
     $4::v1_concurrency_strategy,
     $5::text,
     $6::uuid,
-    $7::integer
-) RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    $7::integer,
+    $8::integer,
+    COALESCE($9::v1_concurrency_queue_overflow, 'DROP_OLDEST'),
+    $10::text
+) RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, max_queued, queue_overflow, concurrency_group
 `
 
 type CreateStepConcurrencyParams struct {
-	Workflowid        pgtype.UUID           `json:"workflowid"`
-	Workflowversionid pgtype.UUID           `json:"workflowversionid"`
-	Stepid            pgtype.UUID           `json:"stepid"`
-	Strategy          V1ConcurrencyStrategy `json:"strategy"`
-	Expression        string                `json:"expression"`
-	Tenantid          pgtype.UUID           `json:"tenantid"`
-	Maxconcurrency    int32                 `json:"maxconcurrency"`
+	Workflowid        pgtype.UUID                    `json:"workflowid"`
+	Workflowversionid pgtype.UUID                    `json:"workflowversionid"`
+	Stepid            pgtype.UUID                    `json:"stepid"`
+	Strategy          V1ConcurrencyStrategy          `json:"strategy"`
+	Expression        string                         `json:"expression"`
+	Tenantid          pgtype.UUID                    `json:"tenantid"`
+	Maxconcurrency    int32                          `json:"maxconcurrency"`
+	MaxQueued         pgtype.Int4                    `json:"maxQueued"`
+	QueueOverflow     NullV1ConcurrencyQueueOverflow `json:"queueOverflow"`
+	ConcurrencyGroup  pgtype.Text                    `json:"concurrencyGroup"`
 }
 
 func (q *Queries) CreateStepConcurrency(ctx context.Context, db DBTX, arg CreateStepConcurrencyParams) (*V1StepConcurrency, error) {
@@ -228,6 +267,9 @@ func (q *Queries) CreateStepConcurrency(ctx context.Context, db DBTX, arg Create
 		arg.Expression,
 		arg.Tenantid,
 		arg.Maxconcurrency,
+		arg.MaxQueued,
+		arg.QueueOverflow,
+		arg.ConcurrencyGroup,
 	)
 	var i V1StepConcurrency
 	err := row.Scan(
@@ -241,6 +283,9 @@ func (q *Queries) CreateStepConcurrency(ctx context.Context, db DBTX, arg Create
 		&i.Expression,
 		&i.TenantID,
 		&i.MaxConcurrency,
+		&i.MaxQueued,
+		&i.QueueOverflow,
+		&i.ConcurrencyGroup,
 	)
 	return &i, err
 }
@@ -289,7 +334,9 @@ INSERT INTO v1_step_match_condition (
     kind,
     sleep_duration,
     event_key,
-    parent_readable_id
+    parent_readable_id,
+    approval_expires_in,
+    approval_expiry_action
 )
 VALUES (
     $1::uuid,
@@ -301,21 +348,25 @@ VALUES (
     $7::v1_step_match_condition_kind,
     $8::text,
     $9::text,
-    $10::text
-) RETURNING id, tenant_id, step_id, readable_data_key, action, or_group_id, expression, kind, sleep_duration, event_key, parent_readable_id
+    $10::text,
+    $11::text,
+    $12::v1_match_condition_action
+) RETURNING id, tenant_id, step_id, readable_data_key, action, or_group_id, expression, kind, sleep_duration, event_key, parent_readable_id, approval_expires_in, approval_expiry_action
 `
 
 type CreateStepMatchConditionParams struct {
-	Tenantid         pgtype.UUID              `json:"tenantid"`
-	Stepid           pgtype.UUID              `json:"stepid"`
-	Readabledatakey  string                   `json:"readabledatakey"`
-	Action           V1MatchConditionAction   `json:"action"`
-	Orgroupid        pgtype.UUID              `json:"orgroupid"`
-	Expression       pgtype.Text              `json:"expression"`
-	Kind             V1StepMatchConditionKind `json:"kind"`
-	SleepDuration    pgtype.Text              `json:"sleepDuration"`
-	EventKey         pgtype.Text              `json:"eventKey"`
-	ParentReadableId pgtype.Text              `json:"parentReadableId"`
+	Tenantid             pgtype.UUID                `json:"tenantid"`
+	Stepid               pgtype.UUID                `json:"stepid"`
+	Readabledatakey      string                     `json:"readabledatakey"`
+	Action               V1MatchConditionAction     `json:"action"`
+	Orgroupid            pgtype.UUID                `json:"orgroupid"`
+	Expression           pgtype.Text                `json:"expression"`
+	Kind                 V1StepMatchConditionKind   `json:"kind"`
+	SleepDuration        pgtype.Text                `json:"sleepDuration"`
+	EventKey             pgtype.Text                `json:"eventKey"`
+	ParentReadableId     pgtype.Text                `json:"parentReadableId"`
+	ApprovalExpiresIn    pgtype.Text                `json:"approvalExpiresIn"`
+	ApprovalExpiryAction NullV1MatchConditionAction `json:"approvalExpiryAction"`
 }
 
 func (q *Queries) CreateStepMatchCondition(ctx context.Context, db DBTX, arg CreateStepMatchConditionParams) (*V1StepMatchCondition, error) {
@@ -330,6 +381,8 @@ func (q *Queries) CreateStepMatchCondition(ctx context.Context, db DBTX, arg Cre
 		arg.SleepDuration,
 		arg.EventKey,
 		arg.ParentReadableId,
+		arg.ApprovalExpiresIn,
+		arg.ApprovalExpiryAction,
 	)
 	var i V1StepMatchCondition
 	err := row.Scan(
@@ -344,6 +397,8 @@ func (q *Queries) CreateStepMatchCondition(ctx context.Context, db DBTX, arg Cre
 		&i.SleepDuration,
 		&i.EventKey,
 		&i.ParentReadableID,
+		&i.ApprovalExpiresIn,
+		&i.ApprovalExpiryAction,
 	)
 	return &i, err
 }
@@ -391,6 +446,76 @@ func (q *Queries) CreateStepRateLimit(ctx context.Context, db DBTX, arg CreateSt
 	return &i, err
 }
 
+const createStepResourceRequests = `-- name: CreateStepResourceRequests :exec
+INSERT INTO v1_step_resource_request (
+    tenant_id,
+    step_id,
+    key,
+    units
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    unnest($3::text[]),
+    unnest($4::integer[])
+`
+
+type CreateStepResourceRequestsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Stepid   pgtype.UUID `json:"stepid"`
+	Keys     []string    `json:"keys"`
+	Units    []int32     `json:"units"`
+}
+
+func (q *Queries) CreateStepResourceRequests(ctx context.Context, db DBTX, arg CreateStepResourceRequestsParams) error {
+	_, err := db.Exec(ctx, createStepResourceRequests,
+		arg.Tenantid,
+		arg.Stepid,
+		arg.Keys,
+		arg.Units,
+	)
+	return err
+}
+
+const createStepRetryPolicy = `-- name: CreateStepRetryPolicy :exec
+INSERT INTO v1_step_retry_policy (
+    tenant_id,
+    step_id,
+    error_codes,
+    error_code_max_retries,
+    jitter,
+    min_backoff_seconds
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::text[],
+    $4::integer[],
+    $5::double precision,
+    $6::integer
+)
+`
+
+type CreateStepRetryPolicyParams struct {
+	Tenantid            pgtype.UUID `json:"tenantid"`
+	Stepid              pgtype.UUID `json:"stepid"`
+	Errorcodes          []string    `json:"errorcodes"`
+	Errorcodemaxretries []int32     `json:"errorcodemaxretries"`
+	Jitter              float64     `json:"jitter"`
+	Minbackoffseconds   int32       `json:"minbackoffseconds"`
+}
+
+func (q *Queries) CreateStepRetryPolicy(ctx context.Context, db DBTX, arg CreateStepRetryPolicyParams) error {
+	_, err := db.Exec(ctx, createStepRetryPolicy,
+		arg.Tenantid,
+		arg.Stepid,
+		arg.Errorcodes,
+		arg.Errorcodemaxretries,
+		arg.Jitter,
+		arg.Minbackoffseconds,
+	)
+	return err
+}
+
 const createWorkflow = `-- name: CreateWorkflow :one
 INSERT INTO "Workflow" (
     "id",
@@ -509,7 +634,10 @@ WITH inserted_wcs AS (
       strategy,
       expression,
       tenant_id,
-      max_concurrency
+      max_concurrency,
+      max_queued,
+      queue_overflow,
+      concurrency_group
     )
     VALUES (
       $1::uuid,
@@ -517,9 +645,12 @@ WITH inserted_wcs AS (
       $3::v1_concurrency_strategy,
       $4,
       $5::uuid,
-      COALESCE($6::integer, 1)
+      COALESCE($6::integer, 1),
+      $7::integer,
+      COALESCE($8::v1_concurrency_queue_overflow, 'DROP_OLDEST'),
+      $9::text
     )
-    RETURNING id, workflow_id, workflow_version_id, is_active, strategy, child_strategy_ids, expression, tenant_id, max_concurrency
+    RETURNING id, workflow_id, workflow_version_id, is_active, strategy, child_strategy_ids, expression, tenant_id, max_concurrency, max_queued, queue_overflow, concurrency_group
 ), inserted_scs AS (
     INSERT INTO v1_step_concurrency (
       parent_strategy_id,
@@ -529,7 +660,10 @@ WITH inserted_wcs AS (
       strategy,
       expression,
       tenant_id,
-      max_concurrency
+      max_concurrency,
+      max_queued,
+      queue_overflow,
+      concurrency_group
     )
     SELECT
       wcs.id,
@@ -539,7 +673,10 @@ WITH inserted_wcs AS (
       $3::v1_concurrency_strategy,
       $4,
       s."tenantId",
-      COALESCE($6::integer, 1)
+      COALESCE($6::integer, 1),
+      wcs.max_queued,
+      wcs.queue_overflow,
+      wcs.concurrency_group
     FROM (
         SELECT
           s."id",
@@ -554,7 +691,7 @@ WITH inserted_wcs AS (
           wv."id" = $2::uuid
           AND j."kind" = 'DEFAULT'
     ) s, inserted_wcs wcs
-    RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    RETURNING id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, max_queued, queue_overflow, concurrency_group
 )
 SELECT
     wcs.id,
@@ -568,12 +705,15 @@ GROUP BY
 `
 
 type CreateWorkflowConcurrencyV1Params struct {
-	Workflowid        pgtype.UUID           `json:"workflowid"`
-	Workflowversionid pgtype.UUID           `json:"workflowversionid"`
-	Limitstrategy     V1ConcurrencyStrategy `json:"limitstrategy"`
-	Expression        string                `json:"expression"`
-	Tenantid          pgtype.UUID           `json:"tenantid"`
-	MaxRuns           pgtype.Int4           `json:"maxRuns"`
+	Workflowid        pgtype.UUID                    `json:"workflowid"`
+	Workflowversionid pgtype.UUID                    `json:"workflowversionid"`
+	Limitstrategy     V1ConcurrencyStrategy          `json:"limitstrategy"`
+	Expression        string                         `json:"expression"`
+	Tenantid          pgtype.UUID                    `json:"tenantid"`
+	MaxRuns           pgtype.Int4                    `json:"maxRuns"`
+	MaxQueued         pgtype.Int4                    `json:"maxQueued"`
+	QueueOverflow     NullV1ConcurrencyQueueOverflow `json:"queueOverflow"`
+	ConcurrencyGroup  pgtype.Text                    `json:"concurrencyGroup"`
 }
 
 type CreateWorkflowConcurrencyV1Row struct {
@@ -589,6 +729,9 @@ func (q *Queries) CreateWorkflowConcurrencyV1(ctx context.Context, db DBTX, arg
 		arg.Expression,
 		arg.Tenantid,
 		arg.MaxRuns,
+		arg.MaxQueued,
+		arg.QueueOverflow,
+		arg.ConcurrencyGroup,
 	)
 	var i CreateWorkflowConcurrencyV1Row
 	err := row.Scan(&i.ID, &i.ChildStrategyIds)
@@ -729,7 +872,11 @@ INSERT INTO "WorkflowVersion" (
     "sticky",
     "kind",
     "defaultPriority",
-    "createWorkflowVersionOpts"
+    "createWorkflowVersionOpts",
+    "priorityAgingSeconds",
+    "debounceExpression",
+    "debounceWindow",
+    "executionTimeout"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -743,8 +890,12 @@ INSERT INTO "WorkflowVersion" (
     $8::"StickyStrategy",
     coalesce($9::"WorkflowKind", 'DAG'),
     $10 :: integer,
-    $11::jsonb
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts"
+    $11::jsonb,
+    $12::integer,
+    $13::text,
+    $14::text,
+    $15::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "priorityAgingSeconds", "debounceExpression", "debounceWindow", "executionTimeout"
 `
 
 type CreateWorkflowVersionParams struct {
@@ -759,6 +910,10 @@ type CreateWorkflowVersionParams struct {
 	Kind                      NullWorkflowKind   `json:"kind"`
 	DefaultPriority           pgtype.Int4        `json:"defaultPriority"`
 	CreateWorkflowVersionOpts []byte             `json:"createWorkflowVersionOpts"`
+	PriorityAgingSeconds      pgtype.Int4        `json:"priorityAgingSeconds"`
+	DebounceExpression        pgtype.Text        `json:"debounceExpression"`
+	DebounceWindow            pgtype.Text        `json:"debounceWindow"`
+	ExecutionTimeout          pgtype.Text        `json:"executionTimeout"`
 }
 
 func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg CreateWorkflowVersionParams) (*WorkflowVersion, error) {
@@ -774,6 +929,10 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		arg.Kind,
 		arg.DefaultPriority,
 		arg.CreateWorkflowVersionOpts,
+		arg.PriorityAgingSeconds,
+		arg.DebounceExpression,
+		arg.DebounceWindow,
+		arg.ExecutionTimeout,
 	)
 	var i WorkflowVersion
 	err := row.Scan(
@@ -791,6 +950,10 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.Kind,
 		&i.DefaultPriority,
 		&i.CreateWorkflowVersionOpts,
+		&i.PriorityAgingSeconds,
+		&i.DebounceExpression,
+		&i.DebounceWindow,
+		&i.ExecutionTimeout,
 	)
 	return &i, err
 }
@@ -884,7 +1047,7 @@ func (q *Queries) GetWorkflowByName(ctx context.Context, db DBTX, arg GetWorkflo
 
 const getWorkflowVersionForEngine = `-- name: GetWorkflowVersionForEngine :many
 SELECT
-    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."createWorkflowVersionOpts",
+    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions."createWorkflowVersionOpts", workflowversions."priorityAgingSeconds", workflowversions."debounceExpression", workflowversions."debounceWindow", workflowversions."executionTimeout",
     w."name" as "workflowName",
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -941,6 +1104,10 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
 			&i.WorkflowVersion.CreateWorkflowVersionOpts,
+			&i.WorkflowVersion.PriorityAgingSeconds,
+			&i.WorkflowVersion.DebounceExpression,
+			&i.WorkflowVersion.DebounceWindow,
+			&i.WorkflowVersion.ExecutionTimeout,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -961,7 +1128,7 @@ const linkOnFailureJob = `-- name: LinkOnFailureJob :one
 UPDATE "WorkflowVersion"
 SET "onFailureJobId" = $1::uuid
 WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", "createWorkflowVersionOpts", "priorityAgingSeconds", "debounceExpression", "debounceWindow", "executionTimeout"
 `
 
 type LinkOnFailureJobParams struct {
@@ -987,10 +1154,115 @@ func (q *Queries) LinkOnFailureJob(ctx context.Context, db DBTX, arg LinkOnFailu
 		&i.Kind,
 		&i.DefaultPriority,
 		&i.CreateWorkflowVersionOpts,
+		&i.PriorityAgingSeconds,
+		&i.DebounceExpression,
+		&i.DebounceWindow,
+		&i.ExecutionTimeout,
 	)
 	return &i, err
 }
 
+const listConcurrencyGroupMembers = `-- name: ListConcurrencyGroupMembers :many
+WITH members AS (
+    SELECT
+        workflow_id,
+        workflow_version_id,
+        TRUE AS is_workflow_level,
+        expression,
+        max_concurrency
+    FROM
+        v1_workflow_concurrency
+    WHERE
+        tenant_id = $1::uuid
+        AND concurrency_group = $2::text
+    UNION ALL
+    SELECT
+        workflow_id,
+        workflow_version_id,
+        FALSE AS is_workflow_level,
+        expression,
+        max_concurrency
+    FROM
+        v1_step_concurrency
+    WHERE
+        tenant_id = $1::uuid
+        AND concurrency_group = $2::text
+        -- child strategies belong to the workflow-level strategy they were copied from
+        AND parent_strategy_id IS NULL
+), latest_versions AS (
+    SELECT DISTINCT ON (wv."workflowId")
+        wv."workflowId" AS workflow_id,
+        wv."id" AS workflow_version_id
+    FROM
+        "WorkflowVersion" wv
+    WHERE
+        wv."workflowId" IN (SELECT workflow_id FROM members)
+        AND wv."deletedAt" IS NULL
+    ORDER BY
+        wv."workflowId", wv."order" DESC
+)
+SELECT
+    w."name" AS "workflowName",
+    m.is_workflow_level::boolean AS "isWorkflowLevel",
+    m.expression,
+    m.max_concurrency
+FROM
+    members m
+JOIN
+    latest_versions lv ON (lv.workflow_id, lv.workflow_version_id) = (m.workflow_id, m.workflow_version_id)
+JOIN
+    "Workflow" w ON w."id" = m.workflow_id
+WHERE
+    w."deletedAt" IS NULL
+    -- other versions of the workflow which is being registered are replaced by the new version
+    AND (m.workflow_id != $3::uuid OR m.workflow_version_id = $4::uuid);
+`
+
+type ListConcurrencyGroupMembersParams struct {
+	Tenantid          pgtype.UUID `json:"tenantid"`
+	Concurrencygroup  string      `json:"concurrencygroup"`
+	Workflowid        pgtype.UUID `json:"workflowid"`
+	Workflowversionid pgtype.UUID `json:"workflowversionid"`
+}
+
+type ListConcurrencyGroupMembersRow struct {
+	WorkflowName    string `json:"workflowName"`
+	IsWorkflowLevel bool   `json:"isWorkflowLevel"`
+	Expression      string `json:"expression"`
+	MaxConcurrency  int32  `json:"max_concurrency"`
+}
+
+// Lists the strategies of the latest workflow versions which belong to a tenant-level concurrency group
+func (q *Queries) ListConcurrencyGroupMembers(ctx context.Context, db DBTX, arg ListConcurrencyGroupMembersParams) ([]*ListConcurrencyGroupMembersRow, error) {
+	rows, err := db.Query(ctx, listConcurrencyGroupMembers,
+		arg.Tenantid,
+		arg.Concurrencygroup,
+		arg.Workflowid,
+		arg.Workflowversionid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListConcurrencyGroupMembersRow
+	for rows.Next() {
+		var i ListConcurrencyGroupMembersRow
+		if err := rows.Scan(
+			&i.WorkflowName,
+			&i.IsWorkflowLevel,
+			&i.Expression,
+			&i.MaxConcurrency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepExpressions = `-- name: ListStepExpressions :many
 SELECT
     key, "stepId", expression, kind
@@ -1027,7 +1299,7 @@ func (q *Queries) ListStepExpressions(ctx context.Context, db DBTX, stepids []pg
 
 const listStepMatchConditions = `-- name: ListStepMatchConditions :many
 SELECT
-    id, tenant_id, step_id, readable_data_key, action, or_group_id, expression, kind, sleep_duration, event_key, parent_readable_id
+    id, tenant_id, step_id, readable_data_key, action, or_group_id, expression, kind, sleep_duration, event_key, parent_readable_id, approval_expires_in, approval_expiry_action
 FROM
     v1_step_match_condition
 WHERE
@@ -1061,6 +1333,8 @@ func (q *Queries) ListStepMatchConditions(ctx context.Context, db DBTX, arg List
 			&i.SleepDuration,
 			&i.EventKey,
 			&i.ParentReadableID,
+			&i.ApprovalExpiresIn,
+			&i.ApprovalExpiryAction,
 		); err != nil {
 			return nil, err
 		}
@@ -1074,12 +1348,13 @@ func (q *Queries) ListStepMatchConditions(ctx context.Context, db DBTX, arg List
 
 const listStepsByIds = `-- name: ListStepsByIds :many
 SELECT
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."slotWeight", s.preemptible, s."gangName", s."gangTimeout", s."placementStrategy", s."totalTimeout",
     wv."id" as "workflowVersionId",
     wv."sticky" as "workflowVersionSticky",
     w."name" as "workflowName",
     w."id" as "workflowId",
     COALESCE(wv."defaultPriority", 1) AS "defaultPriority",
+    wv."executionTimeout" as "workflowExecutionTimeout",
     COUNT(se."stepId") as "exprCount",
     COUNT(sc.id) as "concurrencyCount"
 FROM
@@ -1109,27 +1384,34 @@ type ListStepsByIdsParams struct {
 }
 
 type ListStepsByIdsRow struct {
-	ID                    pgtype.UUID        `json:"id"`
-	CreatedAt             pgtype.Timestamp   `json:"createdAt"`
-	UpdatedAt             pgtype.Timestamp   `json:"updatedAt"`
-	DeletedAt             pgtype.Timestamp   `json:"deletedAt"`
-	ReadableId            pgtype.Text        `json:"readableId"`
-	TenantId              pgtype.UUID        `json:"tenantId"`
-	JobId                 pgtype.UUID        `json:"jobId"`
-	ActionId              string             `json:"actionId"`
-	Timeout               pgtype.Text        `json:"timeout"`
-	CustomUserData        []byte             `json:"customUserData"`
-	Retries               int32              `json:"retries"`
-	RetryBackoffFactor    pgtype.Float8      `json:"retryBackoffFactor"`
-	RetryMaxBackoff       pgtype.Int4        `json:"retryMaxBackoff"`
-	ScheduleTimeout       string             `json:"scheduleTimeout"`
-	WorkflowVersionId     pgtype.UUID        `json:"workflowVersionId"`
-	WorkflowVersionSticky NullStickyStrategy `json:"workflowVersionSticky"`
-	WorkflowName          string             `json:"workflowName"`
-	WorkflowId            pgtype.UUID        `json:"workflowId"`
-	DefaultPriority       int32              `json:"defaultPriority"`
-	ExprCount             int64              `json:"exprCount"`
-	ConcurrencyCount      int64              `json:"concurrencyCount"`
+	ID                       pgtype.UUID        `json:"id"`
+	CreatedAt                pgtype.Timestamp   `json:"createdAt"`
+	UpdatedAt                pgtype.Timestamp   `json:"updatedAt"`
+	DeletedAt                pgtype.Timestamp   `json:"deletedAt"`
+	ReadableId               pgtype.Text        `json:"readableId"`
+	TenantId                 pgtype.UUID        `json:"tenantId"`
+	JobId                    pgtype.UUID        `json:"jobId"`
+	ActionId                 string             `json:"actionId"`
+	Timeout                  pgtype.Text        `json:"timeout"`
+	CustomUserData           []byte             `json:"customUserData"`
+	Retries                  int32              `json:"retries"`
+	RetryBackoffFactor       pgtype.Float8      `json:"retryBackoffFactor"`
+	RetryMaxBackoff          pgtype.Int4        `json:"retryMaxBackoff"`
+	ScheduleTimeout          string             `json:"scheduleTimeout"`
+	SlotWeight               int32              `json:"slotWeight"`
+	Preemptible              bool               `json:"preemptible"`
+	GangName                 pgtype.Text        `json:"gangName"`
+	GangTimeout              pgtype.Text        `json:"gangTimeout"`
+	PlacementStrategy        pgtype.Text        `json:"placementStrategy"`
+	TotalTimeout             pgtype.Text        `json:"totalTimeout"`
+	WorkflowVersionId        pgtype.UUID        `json:"workflowVersionId"`
+	WorkflowVersionSticky    NullStickyStrategy `json:"workflowVersionSticky"`
+	WorkflowName             string             `json:"workflowName"`
+	WorkflowId               pgtype.UUID        `json:"workflowId"`
+	DefaultPriority          int32              `json:"defaultPriority"`
+	WorkflowExecutionTimeout pgtype.Text        `json:"workflowExecutionTimeout"`
+	ExprCount                int64              `json:"exprCount"`
+	ConcurrencyCount         int64              `json:"concurrencyCount"`
 }
 
 func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsByIdsParams) ([]*ListStepsByIdsRow, error) {
@@ -1156,11 +1438,18 @@ func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsById
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.SlotWeight,
+			&i.Preemptible,
+			&i.GangName,
+			&i.GangTimeout,
+			&i.PlacementStrategy,
+			&i.TotalTimeout,
 			&i.WorkflowVersionId,
 			&i.WorkflowVersionSticky,
 			&i.WorkflowName,
 			&i.WorkflowId,
 			&i.DefaultPriority,
+			&i.WorkflowExecutionTimeout,
 			&i.ExprCount,
 			&i.ConcurrencyCount,
 		); err != nil {
@@ -1177,7 +1466,7 @@ func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsById
 const listStepsByWorkflowVersionIds = `-- name: ListStepsByWorkflowVersionIds :many
 WITH steps AS (
     SELECT
-        s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+        s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."slotWeight", s.preemptible, s."gangName", s."gangTimeout", s."placementStrategy", s."totalTimeout",
         wv."id" as "workflowVersionId",
         w."name" as "workflowName",
         w."id" as "workflowId",
@@ -1212,7 +1501,7 @@ WITH steps AS (
         so."B"
 )
 SELECT
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."workflowVersionId", s."workflowName", s."workflowId", s."jobKind", s."matchConditionCount",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."slotWeight", s.preemptible, s."gangName", s."gangTimeout", s."placementStrategy", s."totalTimeout", s."workflowVersionId", s."workflowName", s."workflowId", s."jobKind", s."matchConditionCount",
     COALESCE(so."parents", '{}'::uuid[]) as "parents"
 FROM
     steps s
@@ -1240,6 +1529,12 @@ type ListStepsByWorkflowVersionIdsRow struct {
 	RetryBackoffFactor  pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff     pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout     string           `json:"scheduleTimeout"`
+	SlotWeight          int32            `json:"slotWeight"`
+	Preemptible         bool             `json:"preemptible"`
+	GangName            pgtype.Text      `json:"gangName"`
+	GangTimeout         pgtype.Text      `json:"gangTimeout"`
+	PlacementStrategy   pgtype.Text      `json:"placementStrategy"`
+	TotalTimeout        pgtype.Text      `json:"totalTimeout"`
 	WorkflowVersionId   pgtype.UUID      `json:"workflowVersionId"`
 	WorkflowName        string           `json:"workflowName"`
 	WorkflowId          pgtype.UUID      `json:"workflowId"`
@@ -1272,6 +1567,12 @@ func (q *Queries) ListStepsByWorkflowVersionIds(ctx context.Context, db DBTX, ar
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.SlotWeight,
+			&i.Preemptible,
+			&i.GangName,
+			&i.GangTimeout,
+			&i.PlacementStrategy,
+			&i.TotalTimeout,
 			&i.WorkflowVersionId,
 			&i.WorkflowName,
 			&i.WorkflowId,
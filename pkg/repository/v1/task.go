@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"hash/fnv"
+	"math"
+	"math/rand"
 	"sort"
 	"time"
 
@@ -52,6 +54,9 @@ type CreateTaskOpts struct {
 	// (required) the initial state for the task
 	InitialState sqlcv1.V1TaskInitialState
 
+	// (optional) the reason for the initial state, only used if the initial state is FAILED
+	InitialStateReason *string
+
 	// (optional) the parent task external id
 	ParentTaskExternalId *string
 
@@ -98,6 +103,9 @@ type ReplayTaskOpts struct {
 	// (required) the initial state for the task
 	InitialState sqlcv1.V1TaskInitialState
 
+	// (optional) the reason for the initial state, only used if the initial state is FAILED
+	InitialStateReason *string
+
 	// (optional) the additional metadata for the task
 	AdditionalMetadata []byte
 }
@@ -131,6 +139,10 @@ type CompleteTaskOpts struct {
 	Output []byte
 }
 
+// ErrorCodeTimeout is the error code of failures caused by a task exceeding its timeout, which retry
+// policies can match like any error code reported by a worker.
+const ErrorCodeTimeout = "TIMEOUT"
+
 type FailTaskOpts struct {
 	*TaskIdInsertedAtRetryCount
 
@@ -142,6 +154,12 @@ type FailTaskOpts struct {
 
 	// (optional) A boolean flag to indicate whether the error is non-retryable, meaning it should _not_ be retried. Defaults to false.
 	IsNonRetryable bool
+
+	// (optional) the error code of the failure, matched against the retry policy of the step
+	ErrorCode string
+
+	// (optional) the JSON-encoded structured error of the failure
+	ErrorDetails []byte
 }
 
 type TaskIdEventKeyTuple struct {
@@ -178,6 +196,10 @@ type RetriedTask struct {
 	RetryBackoffFactor pgtype.Float8
 
 	RetryMaxBackoff pgtype.Int4
+
+	// (optional) the time after which the task is retried, set when the retry policy of the step
+	// decided the retry delay
+	RetryAfter pgtype.Timestamptz
 }
 
 type FailTasksResponse struct {
@@ -192,6 +214,11 @@ type TimeoutTasksResponse struct {
 	TimeoutTasks []*sqlcv1.ListTasksToTimeoutRow
 }
 
+type PreemptTasksResponse struct {
+	// ReleasedTasks are the tasks which were preempted, on the retry count that they were running on
+	ReleasedTasks []*sqlcv1.ReleaseTasksRow
+}
+
 type ListFinalizedWorkflowRunsResponse struct {
 	WorkflowRunId string
 
@@ -221,6 +248,10 @@ type TaskRepository interface {
 
 	CancelTasks(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtRetryCount) (*FinalizedTaskResponse, error)
 
+	// PreemptTasks releases running tasks and requeues them with their original priority, without counting
+	// towards the task's retries. Tasks which are no longer running on the given retry count are skipped.
+	PreemptTasks(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtRetryCount) (*PreemptTasksResponse, error)
+
 	ListTasks(ctx context.Context, tenantId string, tasks []int64) ([]*sqlcv1.V1Task, error)
 
 	ListTaskMetas(ctx context.Context, tenantId string, tasks []int64) ([]*sqlcv1.ListTaskMetasRow, error)
@@ -233,6 +264,10 @@ type TaskRepository interface {
 
 	ProcessTaskTimeouts(ctx context.Context, tenantId string) (*TimeoutTasksResponse, bool, error)
 
+	// ProcessTaskExecutionTimeouts cancels the unfinished tasks which have exceeded their total timeout or the
+	// execution timeout of their workflow run with cancel. The timeouts are only removed if cancel succeeds.
+	ProcessTaskExecutionTimeouts(ctx context.Context, tenantId string, cancel func(ctx context.Context, tasks []*sqlcv1.ListTasksToExecutionTimeoutRow) error) (bool, error)
+
 	ProcessTaskReassignments(ctx context.Context, tenantId string) (*FailTasksResponse, bool, error)
 
 	ProcessTaskRetryQueueItems(ctx context.Context, tenantId string) ([]*sqlcv1.V1RetryQueueItem, bool, error)
@@ -248,6 +283,14 @@ type TaskRepository interface {
 	ReleaseSlot(ctx context.Context, tenantId string, externalId string) (*sqlcv1.V1TaskRuntime, error)
 
 	ListSignalCompletedEvents(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtSignalKey) ([]*sqlcv1.V1TaskEvent, error)
+
+	// GetTaskPriorities returns the priority of a task along with its effective priority, which includes any
+	// priority aging applied while the task is queued.
+	GetTaskPriorities(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*sqlcv1.GetTaskPrioritiesRow, error)
+
+	// GetTaskSchedulingState returns the state of a task which determines why it has not started running, including
+	// the reason that the scheduler last failed to assign the task's queue item.
+	GetTaskSchedulingState(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*sqlcv1.GetTaskSchedulingStateRow, error)
 }
 
 type TaskRepositoryImpl struct {
@@ -327,6 +370,35 @@ func (r *TaskRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
 		}
 	}
 
+	// remove idempotency keys which have expired, in batches
+	for {
+		deleted, err := r.queries.DeleteExpiredIdempotencyKeys(ctx, r.pool, 10000)
+
+		if err != nil {
+			return err
+		}
+
+		if deleted < 10000 {
+			break
+		}
+	}
+
+	// remove signals which expired before they were delivered, and signals whose condition no longer exists
+	for {
+		deleted, err := r.queries.DeleteStaleDurableSignals(ctx, r.pool, sqlcv1.DeleteStaleDurableSignalsParams{
+			Createdbefore: sqlchelpers.TimestamptzFromTime(today.Add(-1 * DurableSignalRetention)),
+			Batchsize:     10000,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if deleted < 10000 {
+			break
+		}
+	}
+
 	return nil
 }
 
@@ -624,6 +696,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 	appFailureTaskInsertedAts := make([]pgtype.Timestamptz, 0)
 	appFailureTaskRetryCounts := make([]int32, 0)
 	appFailureIsNonRetryableStatuses := make([]bool, 0)
+	appFailureErrorCodes := make([]string, 0)
 
 	internalFailureTaskIds := make([]int64, 0)
 	internalFailureInsertedAts := make([]pgtype.Timestamptz, 0)
@@ -637,6 +710,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 			appFailureTaskInsertedAts = append(appFailureTaskInsertedAts, failureOpt.InsertedAt)
 			appFailureTaskRetryCounts = append(appFailureTaskRetryCounts, failureOpt.RetryCount)
 			appFailureIsNonRetryableStatuses = append(appFailureIsNonRetryableStatuses, failureOpt.IsNonRetryable)
+			appFailureErrorCodes = append(appFailureErrorCodes, failureOpt.ErrorCode)
 		} else {
 			internalFailureTaskIds = append(internalFailureTaskIds, failureOpt.Id)
 			internalFailureInsertedAts = append(internalFailureInsertedAts, failureOpt.InsertedAt)
@@ -650,12 +724,27 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 
 	// write app failures
 	if len(appFailureTaskIds) > 0 {
+		appFailureMaxRetries, appFailureRetryDelays, err := r.evaluateRetryPolicies(
+			ctx,
+			tx,
+			tenantId,
+			appFailureTaskIds,
+			appFailureTaskInsertedAts,
+			appFailureErrorCodes,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not evaluate retry policies: %w", err)
+		}
+
 		appFailureRetries, err := r.queries.FailTaskAppFailure(ctx, tx, sqlcv1.FailTaskAppFailureParams{
 			Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
 			Taskids:         appFailureTaskIds,
 			Taskinsertedats: appFailureTaskInsertedAts,
 			Taskretrycounts: appFailureTaskRetryCounts,
 			Isnonretryables: appFailureIsNonRetryableStatuses,
+			Maxretries:      appFailureMaxRetries,
+			Retrydelaysms:   appFailureRetryDelays,
 		})
 
 		if err != nil {
@@ -673,6 +762,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 				AppRetryCount:      task.AppRetryCount,
 				RetryBackoffFactor: task.RetryBackoffFactor,
 				RetryMaxBackoff:    task.RetryMaxBackoff,
+				RetryAfter:         task.RetryAfter,
 			},
 			)
 		}
@@ -715,7 +805,7 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 	outputs := make([][]byte, len(releasedTasks))
 
 	for i, releasedTask := range releasedTasks {
-		out := NewFailedTaskOutputEvent(releasedTask, failureOpts[i].ErrorMessage).Bytes()
+		out := NewFailedTaskOutputEvent(releasedTask, failureOpts[i].ErrorMessage, failureOpts[i].ErrorDetails).Bytes()
 
 		outputs[i] = out
 	}
@@ -743,6 +833,91 @@ func (r *TaskRepositoryImpl) failTasksTx(ctx context.Context, tx sqlcv1.DBTX, te
 	}, nil
 }
 
+// evaluateRetryPolicies decides the maximum number of retries and the retry delay in milliseconds for each
+// application failure, based on the retry policy of the step and the error code of the failure. A value of -1
+// means the task falls back to the retries and backoff of the step.
+func (r *TaskRepositoryImpl) evaluateRetryPolicies(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId string,
+	taskIds []int64,
+	taskInsertedAts []pgtype.Timestamptz,
+	errorCodes []string,
+) (maxRetries []int32, retryDelaysMs []int32, err error) {
+	maxRetries = make([]int32, len(taskIds))
+	retryDelaysMs = make([]int32, len(taskIds))
+
+	for i := range taskIds {
+		maxRetries[i] = -1
+		retryDelaysMs[i] = -1
+	}
+
+	policies, err := r.queries.ListTaskRetryPolicies(ctx, tx, sqlcv1.ListTaskRetryPoliciesParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(policies) == 0 {
+		return maxRetries, retryDelaysMs, nil
+	}
+
+	taskIdsToPolicies := make(map[int64]*sqlcv1.ListTaskRetryPoliciesRow, len(policies))
+
+	for _, policy := range policies {
+		taskIdsToPolicies[policy.ID] = policy
+	}
+
+	for i, taskId := range taskIds {
+		policy, ok := taskIdsToPolicies[taskId]
+
+		if !ok {
+			continue
+		}
+
+		maxRetries[i] = retryPolicyMaxRetries(policy, errorCodes[i])
+		retryDelaysMs[i] = retryPolicyDelayMs(policy, rand.Float64()) // nolint: gosec
+	}
+
+	return maxRetries, retryDelaysMs, nil
+}
+
+// retryPolicyMaxRetries returns the maximum number of retries of the policy for the given error code, or -1 if
+// the policy doesn't match the error code.
+func retryPolicyMaxRetries(policy *sqlcv1.ListTaskRetryPoliciesRow, errorCode string) int32 {
+	for j, code := range policy.ErrorCodes {
+		if code == errorCode && j < len(policy.ErrorCodeMaxRetries) {
+			return policy.ErrorCodeMaxRetries[j]
+		}
+	}
+
+	return -1
+}
+
+// retryPolicyDelayMs computes the delay before the next retry of a task: the backoff of the task, randomized
+// by the jitter of the policy and raised to the minimum backoff of the policy.
+func retryPolicyDelayMs(policy *sqlcv1.ListTaskRetryPoliciesRow, random float64) int32 {
+	var delaySeconds float64
+
+	// NOTE: this mirrors the backoff in v1_task_update_function, which is based on the retry count after the failure
+	if policy.RetryBackoffFactor.Valid {
+		delaySeconds = math.Pow(policy.RetryBackoffFactor.Float64, float64(policy.AppRetryCount+1))
+
+		if policy.RetryMaxBackoff.Valid {
+			delaySeconds = math.Min(delaySeconds, float64(policy.RetryMaxBackoff.Int32))
+		}
+	}
+
+	delaySeconds *= 1 + policy.Jitter*(2*random-1)
+	delaySeconds = math.Max(delaySeconds, float64(policy.MinBackoffSeconds))
+
+	return int32(math.Min(delaySeconds*1000, math.MaxInt32))
+}
+
 func (r *TaskRepositoryImpl) ListFinalizedWorkflowRuns(ctx context.Context, tenantId string, rootExternalIds []string) ([]*ListFinalizedWorkflowRunsResponse, error) {
 	start := time.Now()
 	checkpoint := time.Now()
@@ -945,6 +1120,91 @@ func (r *sharedRepository) cancelTasks(ctx context.Context, dbtx sqlcv1.DBTX, te
 	}, nil
 }
 
+func (r *TaskRepositoryImpl) PreemptTasks(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtRetryCount) (*PreemptTasksResponse, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	tasks = uniqueSet(tasks)
+
+	taskIds := make([]int64, len(tasks))
+	taskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
+	retryCounts := make([]int32, len(tasks))
+
+	for i, task := range tasks {
+		taskIds[i] = task.Id
+		taskInsertedAts[i] = task.InsertedAt
+		retryCounts[i] = task.RetryCount
+	}
+
+	preempted, err := r.queries.PreemptTasks(ctx, tx, sqlcv1.PreemptTasksParams{
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Taskretrycounts: retryCounts,
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not preempt tasks: %w", err)
+	}
+
+	if len(preempted) == 0 {
+		return &PreemptTasksResponse{}, nil
+	}
+
+	newTaskIds := make([]int64, len(preempted))
+	newTaskInsertedAts := make([]pgtype.Timestamptz, len(preempted))
+	newRetryCounts := make([]int32, len(preempted))
+	priorities := make([]int32, len(preempted))
+	tasksToRelease := make([]TaskIdInsertedAtRetryCount, len(preempted))
+
+	for i, task := range preempted {
+		newTaskIds[i] = task.ID
+		newTaskInsertedAts[i] = task.InsertedAt
+		newRetryCounts[i] = task.RetryCount
+		priorities[i] = 1
+
+		if task.Priority.Valid {
+			priorities[i] = task.Priority.Int32
+		}
+
+		tasksToRelease[i] = TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount - 1,
+		}
+	}
+
+	err = r.queries.ResetPreemptedTaskPriorities(ctx, tx, sqlcv1.ResetPreemptedTaskPrioritiesParams{
+		Taskids:         newTaskIds,
+		Taskinsertedats: newTaskInsertedAts,
+		Taskretrycounts: newRetryCounts,
+		Priorities:      priorities,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not reset preempted task priorities: %w", err)
+	}
+
+	releasedTasks, err := r.releaseTasks(ctx, tx, tenantId, tasksToRelease)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &PreemptTasksResponse{
+		ReleasedTasks: releasedTasks,
+	}, nil
+}
+
 func (r *TaskRepositoryImpl) ListTasks(ctx context.Context, tenantId string, tasks []int64) ([]*sqlcv1.V1Task, error) {
 	return r.listTasks(ctx, r.pool, tenantId, tasks)
 }
@@ -1052,6 +1312,7 @@ func (r *TaskRepositoryImpl) ProcessTaskTimeouts(ctx context.Context, tenantId s
 			IsAppError:     true,
 			ErrorMessage:   fmt.Sprintf("Task exceeded timeout of %s", task.StepTimeout.String),
 			IsNonRetryable: false,
+			ErrorCode:      ErrorCodeTimeout,
 		})
 	}
 
@@ -1073,6 +1334,53 @@ func (r *TaskRepositoryImpl) ProcessTaskTimeouts(ctx context.Context, tenantId s
 	}, len(toTimeout) == limit, nil
 }
 
+func (r *TaskRepositoryImpl) ProcessTaskExecutionTimeouts(ctx context.Context, tenantId string, cancel func(ctx context.Context, tasks []*sqlcv1.ListTasksToExecutionTimeoutRow) error) (bool, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rollback()
+
+	// TODO: make limit configurable
+	limit := 1000
+
+	expired, err := r.queries.ListTasksToExecutionTimeout(ctx, tx, sqlcv1.ListTasksToExecutionTimeoutParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit: pgtype.Int4{
+			Int32: int32(limit),
+			Valid: true,
+		},
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	// tasks which have already finished don't need to be cancelled
+	toCancel := make([]*sqlcv1.ListTasksToExecutionTimeoutRow, 0, len(expired))
+
+	for _, task := range expired {
+		if !task.IsFinalized {
+			toCancel = append(toCancel, task)
+		}
+	}
+
+	// the expired timeouts are only removed once the tasks have been cancelled, so they're retried on failure
+	if len(toCancel) > 0 {
+		if err := cancel(ctx, toCancel); err != nil {
+			return false, err
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, err
+	}
+
+	return len(expired) == limit, nil
+}
+
 func (r *TaskRepositoryImpl) ProcessTaskReassignments(ctx context.Context, tenantId string) (*FailTasksResponse, bool, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
 
@@ -1220,6 +1528,13 @@ func (r *TaskRepositoryImpl) ProcessDurableSleeps(ctx context.Context, tenantId
 		return nil, false, err
 	}
 
+	// approvals expire through durable sleeps, so we give expired approvals a terminal status here
+	err = r.queries.ExpireApprovals(ctx, tx, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to expire approvals: %w", err)
+	}
+
 	if err := commit(ctx); err != nil {
 		return nil, false, err
 	}
@@ -1360,6 +1675,22 @@ func (r *TaskRepositoryImpl) ReleaseSlot(ctx context.Context, tenantId, external
 	return resp, nil
 }
 
+func (r *TaskRepositoryImpl) GetTaskPriorities(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*sqlcv1.GetTaskPrioritiesRow, error) {
+	return r.queries.GetTaskPriorities(ctx, r.pool, sqlcv1.GetTaskPrioritiesParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Taskid:         taskId,
+		Taskinsertedat: taskInsertedAt,
+	})
+}
+
+func (r *TaskRepositoryImpl) GetTaskSchedulingState(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz) (*sqlcv1.GetTaskSchedulingStateRow, error) {
+	return r.queries.GetTaskSchedulingState(ctx, r.pool, sqlcv1.GetTaskSchedulingStateParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Taskid:         taskId,
+		Taskinsertedat: taskInsertedAt,
+	})
+}
+
 func (r *sharedRepository) releaseTasks(ctx context.Context, tx sqlcv1.DBTX, tenantId string, tasks []TaskIdInsertedAtRetryCount) ([]*sqlcv1.ReleaseTasksRow, error) {
 	taskIds := make([]int64, len(tasks))
 	taskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
@@ -1452,6 +1783,17 @@ func getQueueCacheKey(tenantId string, queue string) string {
 	return fmt.Sprintf("%s:%s", tenantId, queue)
 }
 
+// getTaskQueue returns the queue for tasks of the given step. Tasks are queued by action id, except for members
+// of a gang, which share a queue per job so that the whole gang is read by a single queuer and can be assigned
+// together.
+func getTaskQueue(step *sqlcv1.ListStepsByIdsRow) string {
+	if step.GangName.Valid {
+		return fmt.Sprintf("gang:%s:%s", sqlchelpers.UUIDToStr(step.JobId), step.GangName.String)
+	}
+
+	return step.ActionId // FIXME: make the queue name dynamic
+}
+
 func (r *sharedRepository) createTasks(
 	ctx context.Context,
 	tx sqlcv1.DBTX,
@@ -1546,13 +1888,16 @@ func (r *sharedRepository) insertTasks(
 	createExpressionOpts := make(map[string][]createTaskExpressionEvalOpt, 0)
 	workflowVersionIds := make([]pgtype.UUID, len(tasks))
 	workflowRunIds := make([]pgtype.UUID, len(tasks))
+	fairnessKeys := make([]pgtype.Text, len(tasks))
+	executionTimeouts := make(map[string]*taskExecutionTimeout)
 
-	unix := time.Now().UnixMilli()
+	now := time.Now()
+	unix := now.UnixMilli()
 
 	for i, task := range tasks {
 		stepConfig := stepIdsToConfig[task.StepId]
 		tenantIds[i] = sqlchelpers.UUIDFromStr(tenantId)
-		queues[i] = stepConfig.ActionId // FIXME: make the queue name dynamic
+		queues[i] = getTaskQueue(stepConfig)
 		actionIds[i] = stepConfig.ActionId
 		stepIds[i] = sqlchelpers.UUIDFromStr(task.StepId)
 		stepReadableIds[i] = stepConfig.ReadableId.String
@@ -1600,6 +1945,10 @@ func (r *sharedRepository) insertTasks(
 			initialStates[i] = string(sqlcv1.V1TaskInitialStateQUEUED)
 		}
 
+		if task.InitialStateReason != nil {
+			initialStateReasons[i] = sqlchelpers.TextFromStr(*task.InitialStateReason)
+		}
+
 		if len(task.AdditionalMetadata) > 0 {
 			additionalMetadatas[i] = task.AdditionalMetadata
 		}
@@ -1769,6 +2118,15 @@ func (r *sharedRepository) insertTasks(
 						break
 					}
 
+					// fairness keys are written to the task directly, so they can be copied to the queue item
+					if expr.Kind == sqlcv1.StepExpressionKindFAIRNESSKEY {
+						if *res.String != "" {
+							fairnessKeys[i] = sqlchelpers.TextFromStr(*res.String)
+						}
+
+						continue
+					}
+
 					opts = append(opts, createTaskExpressionEvalOpt{
 						Key:      expr.Key,
 						Kind:     expr.Kind,
@@ -1785,13 +2143,27 @@ func (r *sharedRepository) insertTasks(
 						String: failTaskError.Error(),
 						Valid:  true,
 					}
-				} else {
+				} else if len(opts) > 0 {
 					createExpressionOpts[task.ExternalId] = opts
 				}
 			} else {
 				r.l.Warn().Msgf("no expressions found for step %s", task.StepId)
 			}
 		}
+
+		if initialStates[i] == string(sqlcv1.V1TaskInitialStateQUEUED) {
+			// the execution timeout of the workflow run is measured from the creation of the run, which is
+			// the creation of its DAG if the task belongs to one
+			runCreatedAt := now
+
+			if task.DagInsertedAt.Valid {
+				runCreatedAt = task.DagInsertedAt.Time
+			}
+
+			if timeout := getTaskExecutionTimeout(stepConfig, now, runCreatedAt); timeout != nil {
+				executionTimeouts[task.ExternalId] = timeout
+			}
+		}
 	}
 
 	saveQueueCache, err := r.upsertQueues(ctx, tx, tenantId, queues)
@@ -1841,6 +2213,7 @@ func (r *sharedRepository) insertTasks(
 				RetryMaxBackoff:              make([]pgtype.Int4, 0),
 				WorkflowVersionIds:           make([]pgtype.UUID, 0),
 				WorkflowRunIds:               make([]pgtype.UUID, 0),
+				FairnessKeys:                 make([]pgtype.Text, 0),
 			}
 		}
 
@@ -1877,6 +2250,7 @@ func (r *sharedRepository) insertTasks(
 		params.RetryMaxBackoff = append(params.RetryMaxBackoff, retryMaxBackoffs[i])
 		params.WorkflowVersionIds = append(params.WorkflowVersionIds, workflowVersionIds[i])
 		params.WorkflowRunIds = append(params.WorkflowRunIds, workflowRunIds[i])
+		params.FairnessKeys = append(params.FairnessKeys, fairnessKeys[i])
 
 		stepIdsToParams[task.StepId] = params
 	}
@@ -1948,12 +2322,91 @@ func (r *sharedRepository) insertTasks(
 		}
 	}
 
+	if len(executionTimeouts) > 0 {
+		err = r.createTaskExecutionTimeouts(ctx, tx, tenantId, res, executionTimeouts)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create execution timeouts: %w", err)
+		}
+	}
+
 	// TODO: this should be moved to after the transaction commits
 	saveQueueCache()
 
 	return res, nil
 }
 
+// taskExecutionTimeout is the deadline for the total execution time of a task across all of its attempts
+type taskExecutionTimeout struct {
+	timeoutAt         time.Time
+	timeout           string
+	isWorkflowTimeout bool
+}
+
+// getTaskExecutionTimeout returns the earlier of the deadlines set by the total timeout of the step and the
+// execution timeout of the workflow, or nil if neither is set.
+func getTaskExecutionTimeout(stepConfig *sqlcv1.ListStepsByIdsRow, taskCreatedAt, runCreatedAt time.Time) *taskExecutionTimeout {
+	var res *taskExecutionTimeout
+
+	// durations are validated when the workflow version is created
+	if stepConfig.TotalTimeout.Valid {
+		if d, err := time.ParseDuration(stepConfig.TotalTimeout.String); err == nil {
+			res = &taskExecutionTimeout{
+				timeoutAt: taskCreatedAt.Add(d),
+				timeout:   stepConfig.TotalTimeout.String,
+			}
+		}
+	}
+
+	if stepConfig.WorkflowExecutionTimeout.Valid {
+		if d, err := time.ParseDuration(stepConfig.WorkflowExecutionTimeout.String); err == nil {
+			timeoutAt := runCreatedAt.Add(d)
+
+			if res == nil || timeoutAt.Before(res.timeoutAt) {
+				res = &taskExecutionTimeout{
+					timeoutAt:         timeoutAt,
+					timeout:           stepConfig.WorkflowExecutionTimeout.String,
+					isWorkflowTimeout: true,
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+func (r *sharedRepository) createTaskExecutionTimeouts(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId string,
+	tasks []*sqlcv1.V1Task,
+	externalIdsToTimeouts map[string]*taskExecutionTimeout,
+) error {
+	params := sqlcv1.CreateTaskExecutionTimeoutsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	for _, task := range tasks {
+		timeout, ok := externalIdsToTimeouts[sqlchelpers.UUIDToStr(task.ExternalID)]
+
+		if !ok {
+			continue
+		}
+
+		params.Taskids = append(params.Taskids, task.ID)
+		params.Taskinsertedats = append(params.Taskinsertedats, task.InsertedAt)
+		params.Timeoutats = append(params.Timeoutats, sqlchelpers.TimestamptzFromTime(timeout.timeoutAt))
+		params.Timeouts = append(params.Timeouts, timeout.timeout)
+		params.Isworkflowtimeouts = append(params.Isworkflowtimeouts, timeout.isWorkflowTimeout)
+	}
+
+	if len(params.Taskids) == 0 {
+		return nil
+	}
+
+	return r.queries.CreateTaskExecutionTimeouts(ctx, tx, params)
+}
+
 // replayTasks updates tasks into the database. note that we're using Postgres rules to automatically insert the created
 // tasks into the queue_items table.
 func (r *sharedRepository) replayTasks(
@@ -2008,7 +2461,7 @@ func (r *sharedRepository) replayTasks(
 
 	for i, task := range tasks {
 		stepConfig := stepIdsToConfig[task.StepId]
-		queues[i] = stepConfig.ActionId // FIXME: make the queue name dynamic
+		queues[i] = getTaskQueue(stepConfig)
 
 		taskIds[i] = task.TaskId
 		taskInsertedAts[i] = task.InsertedAt
@@ -2023,6 +2476,10 @@ func (r *sharedRepository) replayTasks(
 			initialStates[i] = string(sqlcv1.V1TaskInitialStateQUEUED)
 		}
 
+		if task.InitialStateReason != nil {
+			initialStateReasons[i] = sqlchelpers.TextFromStr(*task.InitialStateReason)
+		}
+
 		if len(task.AdditionalMetadata) > 0 {
 			additionalMetadatas[i] = task.AdditionalMetadata
 		}
@@ -2094,6 +2551,20 @@ func (r *sharedRepository) replayTasks(
 		}
 	}
 
+	// replayed tasks start a new execution, so their execution timeouts are measured from the replay
+	now := time.Now()
+	executionTimeouts := make(map[string]*taskExecutionTimeout)
+
+	for i, task := range tasks {
+		if initialStates[i] != string(sqlcv1.V1TaskInitialStateQUEUED) {
+			continue
+		}
+
+		if timeout := getTaskExecutionTimeout(stepIdsToConfig[task.StepId], now, now); timeout != nil {
+			executionTimeouts[task.ExternalId] = timeout
+		}
+	}
+
 	saveQueueCache, err := r.upsertQueues(ctx, tx, tenantId, queues)
 
 	if err != nil {
@@ -2185,6 +2656,14 @@ func (r *sharedRepository) replayTasks(
 		return nil, fmt.Errorf("failed to create task events: %w", err)
 	}
 
+	if len(executionTimeouts) > 0 {
+		err = r.createTaskExecutionTimeouts(ctx, tx, tenantId, res, executionTimeouts)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create execution timeouts: %w", err)
+		}
+	}
+
 	// TODO: this should be moved to after the transaction commits
 	saveQueueCache()
 
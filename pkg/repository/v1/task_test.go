@@ -0,0 +1,204 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestGetTaskQueue(t *testing.T) {
+	jobId := uuid.NewString()
+
+	step := func(actionId string, gangName *string) *sqlcv1.ListStepsByIdsRow {
+		row := &sqlcv1.ListStepsByIdsRow{
+			JobId:    sqlchelpers.UUIDFromStr(jobId),
+			ActionId: actionId,
+		}
+
+		if gangName != nil {
+			row.GangName = sqlchelpers.TextFromStr(*gangName)
+		}
+
+		return row
+	}
+
+	shards := "shards"
+
+	// tasks without a gang are queued by action id
+	assert.Equal(t, "step-a", getTaskQueue(step("step-a", nil)))
+
+	// gang members with different actions share a single queue
+	assert.Equal(t, getTaskQueue(step("step-a", &shards)), getTaskQueue(step("step-b", &shards)))
+	assert.Equal(t, "gang:"+jobId+":shards", getTaskQueue(step("step-a", &shards)))
+}
+
+func TestGetTaskExecutionTimeout(t *testing.T) {
+	runCreatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	taskCreatedAt := runCreatedAt.Add(10 * time.Minute)
+
+	step := func(totalTimeout, workflowExecutionTimeout string) *sqlcv1.ListStepsByIdsRow {
+		row := &sqlcv1.ListStepsByIdsRow{}
+
+		if totalTimeout != "" {
+			row.TotalTimeout = sqlchelpers.TextFromStr(totalTimeout)
+		}
+
+		if workflowExecutionTimeout != "" {
+			row.WorkflowExecutionTimeout = sqlchelpers.TextFromStr(workflowExecutionTimeout)
+		}
+
+		return row
+	}
+
+	tests := []struct {
+		name     string
+		step     *sqlcv1.ListStepsByIdsRow
+		expected *taskExecutionTimeout
+	}{
+		{
+			name:     "no timeouts",
+			step:     step("", ""),
+			expected: nil,
+		},
+		{
+			name: "total timeout is measured from the creation of the task",
+			step: step("30m", ""),
+			expected: &taskExecutionTimeout{
+				timeoutAt: taskCreatedAt.Add(30 * time.Minute),
+				timeout:   "30m",
+			},
+		},
+		{
+			name: "workflow timeout is measured from the creation of the run",
+			step: step("", "1h"),
+			expected: &taskExecutionTimeout{
+				timeoutAt:         runCreatedAt.Add(time.Hour),
+				timeout:           "1h",
+				isWorkflowTimeout: true,
+			},
+		},
+		{
+			name: "total timeout is earlier",
+			step: step("30m", "1h"),
+			expected: &taskExecutionTimeout{
+				timeoutAt: taskCreatedAt.Add(30 * time.Minute),
+				timeout:   "30m",
+			},
+		},
+		{
+			name: "workflow timeout is earlier",
+			step: step("1h", "15m"),
+			expected: &taskExecutionTimeout{
+				timeoutAt:         runCreatedAt.Add(15 * time.Minute),
+				timeout:           "15m",
+				isWorkflowTimeout: true,
+			},
+		},
+		{
+			name: "sub-second timeouts are kept",
+			step: step("500ms", ""),
+			expected: &taskExecutionTimeout{
+				timeoutAt: taskCreatedAt.Add(500 * time.Millisecond),
+				timeout:   "500ms",
+			},
+		},
+		{
+			name: "invalid durations are ignored",
+			step: step("not-a-duration", "1h"),
+			expected: &taskExecutionTimeout{
+				timeoutAt:         runCreatedAt.Add(time.Hour),
+				timeout:           "1h",
+				isWorkflowTimeout: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getTaskExecutionTimeout(tt.step, taskCreatedAt, runCreatedAt))
+		})
+	}
+}
+
+func TestRetryPolicyMaxRetries(t *testing.T) {
+	policy := &sqlcv1.ListTaskRetryPoliciesRow{
+		ErrorCodes:          []string{"RATE_LIMITED", "INVALID_INPUT"},
+		ErrorCodeMaxRetries: []int32{10, 0},
+	}
+
+	tests := []struct {
+		name      string
+		errorCode string
+		expected  int32
+	}{
+		{name: "matching error code", errorCode: "RATE_LIMITED", expected: 10},
+		{name: "matching error code without retries", errorCode: "INVALID_INPUT", expected: 0},
+		{name: "other error code falls back to the step retries", errorCode: "TIMEOUT", expected: -1},
+		{name: "no error code falls back to the step retries", errorCode: "", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, retryPolicyMaxRetries(policy, tt.errorCode))
+		})
+	}
+
+	// error codes without a matching max retries are ignored
+	assert.Equal(t, int32(-1), retryPolicyMaxRetries(&sqlcv1.ListTaskRetryPoliciesRow{
+		ErrorCodes: []string{"RATE_LIMITED"},
+	}, "RATE_LIMITED"))
+}
+
+func TestRetryPolicyDelayMs(t *testing.T) {
+	policy := func(appRetryCount int32, maxBackoff *int32, jitter float64, minBackoffSeconds int32) *sqlcv1.ListTaskRetryPoliciesRow {
+		row := &sqlcv1.ListTaskRetryPoliciesRow{
+			AppRetryCount:      appRetryCount,
+			RetryBackoffFactor: pgtype.Float8{Float64: 2, Valid: true},
+			Jitter:             jitter,
+			MinBackoffSeconds:  minBackoffSeconds,
+		}
+
+		if maxBackoff != nil {
+			row.RetryMaxBackoff = pgtype.Int4{Int32: *maxBackoff, Valid: true}
+		}
+
+		return row
+	}
+
+	maxBackoff := int32(5)
+
+	tests := []struct {
+		name     string
+		policy   *sqlcv1.ListTaskRetryPoliciesRow
+		random   float64
+		expected int32
+	}{
+		{name: "backoff without jitter", policy: policy(1, nil, 0, 0), random: 0, expected: 4000},
+		{name: "backoff is capped at the max backoff", policy: policy(3, &maxBackoff, 0, 0), random: 0.9, expected: 5000},
+		{name: "lower bound of the jitter", policy: policy(1, nil, 0.5, 0), random: 0, expected: 2000},
+		{name: "middle of the jitter", policy: policy(1, nil, 0.5, 0), random: 0.5, expected: 4000},
+		{name: "upper bound of the jitter", policy: policy(1, nil, 0.5, 0), random: 1, expected: 6000},
+		{name: "backoff is raised to the min backoff", policy: policy(0, nil, 0, 10), random: 0, expected: 10000},
+		{name: "jitter is raised to the min backoff", policy: policy(1, nil, 0.5, 3), random: 0, expected: 3000},
+		{
+			name:     "min backoff without a backoff factor",
+			policy:   &sqlcv1.ListTaskRetryPoliciesRow{Jitter: 0.5, MinBackoffSeconds: 7},
+			random:   1,
+			expected: 7000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, retryPolicyDelayMs(tt.policy, tt.random))
+		})
+	}
+}
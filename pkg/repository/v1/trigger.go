@@ -30,6 +30,8 @@ type EventTriggerOpts struct {
 	Priority *int32
 
 	Scope *string
+
+	IdempotencyKey *IdempotencyKeyOpts
 }
 
 type TriggerTaskData struct {
@@ -61,6 +63,9 @@ type TriggerTaskData struct {
 	ChildKey *string `json:"child_key"`
 
 	Priority *int32 `json:"priority"`
+
+	// (optional) the idempotency key for the run
+	IdempotencyKey *IdempotencyKeyOpts `json:"idempotency_key,omitempty"`
 }
 
 type createDAGOpts struct {
@@ -96,6 +101,14 @@ type TriggerRepository interface {
 	PopulateExternalIdsForWorkflow(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error
 
 	PreflightVerifyWorkflowNameOpts(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error
+
+	ProcessDebouncedRuns(ctx context.Context, tenantId string) ([]*sqlcv1.V1Task, []*DAGWithData, bool, error)
+
+	// ClaimEventIdempotencyKey claims an idempotency key for an event, and returns the external id of the event
+	// which holds the key and whether that event has triggered its runs. If the external id differs from
+	// eventExternalId, the event is only a duplicate once it has triggered its runs; until then, the event
+	// should be sent again with the returned external id.
+	ClaimEventIdempotencyKey(ctx context.Context, tenantId, eventExternalId string, opts *IdempotencyKeyOpts) (string, bool, error)
 }
 
 type TriggerRepositoryImpl struct {
@@ -354,16 +367,27 @@ func (r *TriggerRepositoryImpl) TriggerFromEvents(ctx context.Context, tenantId
 
 				additionalMetadata := triggerConverter.ToMetadata(opt.AdditionalMetadata)
 				externalId := uuid.NewString()
+				workflowId := sqlchelpers.UUIDToStr(workflow.WorkflowId)
+
+				var idempotencyKey *IdempotencyKeyOpts
+
+				if opt.IdempotencyKey != nil {
+					idempotencyKey = &IdempotencyKeyOpts{
+						Key:       eventRunIdempotencyKey(opt.IdempotencyKey.Key, workflowId, decision.FilterId),
+						ExpiresAt: opt.IdempotencyKey.ExpiresAt,
+					}
+				}
 
 				triggerOpts = append(triggerOpts, triggerTuple{
 					workflowVersionId:  sqlchelpers.UUIDToStr(workflow.WorkflowVersionId),
-					workflowId:         sqlchelpers.UUIDToStr(workflow.WorkflowId),
+					workflowId:         workflowId,
 					workflowName:       workflow.WorkflowName,
 					externalId:         externalId,
 					input:              opt.Data,
 					additionalMetadata: additionalMetadata,
 					priority:           opt.Priority,
 					filterPayload:      decision.FilterPayload,
+					idempotencyKey:     idempotencyKey,
 				})
 
 				externalIdToEventIdAndFilterId[externalId] = EventExternalIdFilterId{
@@ -380,6 +404,12 @@ func (r *TriggerRepositoryImpl) TriggerFromEvents(ctx context.Context, tenantId
 		return nil, fmt.Errorf("failed to trigger workflows: %w", err)
 	}
 
+	// the runs of each event are deduplicated by their own idempotency keys, so if this fails, the event can
+	// safely be sent again
+	if err := r.triggerEventIdempotencyKeys(ctx, tenantId, opts); err != nil {
+		return nil, fmt.Errorf("failed to mark event idempotency keys as triggered: %w", err)
+	}
+
 	for _, task := range tasks {
 		externalId := task.ExternalID
 
@@ -458,6 +488,15 @@ func (r *TriggerRepositoryImpl) TriggerFromWorkflowNames(ctx context.Context, te
 		}
 
 		for _, opt := range opts {
+			var idempotencyKey *IdempotencyKeyOpts
+
+			if opt.IdempotencyKey != nil {
+				idempotencyKey = &IdempotencyKeyOpts{
+					Key:       workflowRunIdempotencyKey(workflowVersion.WorkflowName, opt.IdempotencyKey.Key),
+					ExpiresAt: opt.IdempotencyKey.ExpiresAt,
+				}
+			}
+
 			triggerOpts = append(triggerOpts, triggerTuple{
 				workflowVersionId:    sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersionId),
 				workflowId:           sqlchelpers.UUIDToStr(workflowVersion.WorkflowId),
@@ -472,6 +511,7 @@ func (r *TriggerRepositoryImpl) TriggerFromWorkflowNames(ctx context.Context, te
 				childIndex:           opt.ChildIndex,
 				childKey:             opt.ChildKey,
 				priority:             opt.Priority,
+				idempotencyKey:       idempotencyKey,
 			})
 		}
 	}
@@ -621,6 +661,13 @@ type triggerTuple struct {
 	parentTaskInsertedAt *time.Time
 	childIndex           *int64
 	childKey             *string
+
+	// set when the tuple fires a pending debounced run
+	debounceKey          *string
+	debounceTriggerCount int32
+
+	// set when the run should only be triggered once for the key
+	idempotencyKey *IdempotencyKeyOpts
 }
 
 func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId string, tuples []triggerTuple) ([]*sqlcv1.V1Task, []*DAGWithData, error) {
@@ -638,6 +685,17 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 		workflowVersionIds = append(workflowVersionIds, sqlchelpers.UUIDFromStr(id))
 	}
 
+	// debounced workflows are stored as pending runs and triggered once their debounce window elapses
+	tuples, err := r.debounceTuples(ctx, tenantId, workflowVersionIds, tuples)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(tuples) == 0 {
+		return nil, nil, nil
+	}
+
 	// get steps for the workflow versions
 	steps, err := r.queries.ListStepsByWorkflowVersionIds(ctx, r.pool, sqlcv1.ListStepsByWorkflowVersionIdsParams{
 		Ids:      workflowVersionIds,
@@ -792,6 +850,32 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 		return nil, nil, fmt.Errorf("failed to register child workflows: %w", err)
 	}
 
+	// skip any debounced runs which were replaced by a newer trigger after they were listed
+	debouncedTuplesToSkip, err := r.claimDebouncedRuns(ctx, tx, tenantId, tuples)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to claim debounced runs: %w", err)
+	}
+
+	if tuplesToSkip == nil {
+		tuplesToSkip = make(map[string]struct{})
+	}
+
+	for externalId := range debouncedTuplesToSkip {
+		tuplesToSkip[externalId] = struct{}{}
+	}
+
+	// skip any runs which were already triggered with the same idempotency key
+	idempotentTuplesToSkip, err := r.triggerIdempotencyKeys(ctx, tx, tenantId, tuples)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to trigger idempotency keys: %w", err)
+	}
+
+	for externalId := range idempotentTuplesToSkip {
+		tuplesToSkip[externalId] = struct{}{}
+	}
+
 	for i, tuple := range tuples {
 		if _, ok := tuplesToSkip[tuple.externalId]; ok {
 			continue
@@ -927,6 +1011,21 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 								condition.Expression.String,
 								condition.Action,
 							))
+						case sqlcv1.V1StepMatchConditionKindAPPROVAL:
+							cs, err := r.approvalConditions(
+								ctx,
+								tx,
+								tenantId,
+								condition,
+								taskExternalId,
+								tupleExternalId,
+							)
+
+							if err != nil {
+								return nil, nil, fmt.Errorf("failed to create approval condition: %w", err)
+							}
+
+							groupConditions = append(groupConditions, cs...)
 						default:
 							// PARENT_OVERRIDE is another kind, but it isn't processed here
 							continue
@@ -18,6 +18,20 @@ type WorkerRepository interface {
 	ListWorkers(tenantId string, opts *repository.ListWorkersOpts) ([]*sqlcv1.ListWorkersWithSlotCountRow, error)
 	GetWorkerById(workerId string) (*sqlcv1.GetWorkerByIdRow, error)
 	ListWorkerState(tenantId, workerId string, maxRuns int) ([]*sqlcv1.ListSemaphoreSlotsWithStateForWorkerRow, []*dbsqlc.GetStepRunForEngineRow, error)
+
+	// UpsertWorkerResources sets the named resource capacities for a worker
+	UpsertWorkerResources(ctx context.Context, tenantId, workerId string, capacities map[string]int32) error
+
+	// DrainWorker marks a worker as draining, so that it is no longer assigned new tasks but finishes its
+	// in-flight tasks.
+	DrainWorker(ctx context.Context, tenantId, workerId string) (*sqlcv1.Worker, error)
+
+	// ListInFlightTasks lists the tasks which are currently assigned to a worker.
+	ListInFlightTasks(ctx context.Context, tenantId, workerId string) ([]*sqlcv1.ListInFlightTasksForWorkerRow, error)
+
+	// GetActionSlotWeights returns the largest slot weight of the tasks of each action, for the actions whose
+	// tasks consume more than one slot.
+	GetActionSlotWeights(ctx context.Context, tenantId string, actionIds []string) (map[string]int32, error)
 }
 
 type workerRepository struct {
@@ -89,3 +103,72 @@ func (w *workerRepository) ListWorkerState(tenantId, workerId string, maxRuns in
 
 	return slots, []*dbsqlc.GetStepRunForEngineRow{}, nil
 }
+
+func (w *workerRepository) UpsertWorkerResources(ctx context.Context, tenantId, workerId string, capacities map[string]int32) error {
+	if len(capacities) == 0 {
+		return nil
+	}
+
+	params := sqlcv1.UpsertWorkerResourcesParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workerid:   sqlchelpers.UUIDFromStr(workerId),
+		Keys:       make([]string, 0, len(capacities)),
+		Capacities: make([]int32, 0, len(capacities)),
+	}
+
+	for key, capacity := range capacities {
+		if key == "" || capacity < 0 {
+			return fmt.Errorf("invalid resource capacity for key %q: %d", key, capacity)
+		}
+
+		params.Keys = append(params.Keys, key)
+		params.Capacities = append(params.Capacities, capacity)
+	}
+
+	err := w.queries.UpsertWorkerResources(ctx, w.pool, params)
+
+	if err != nil {
+		return fmt.Errorf("could not upsert worker resources: %w", err)
+	}
+
+	return nil
+}
+
+func (w *workerRepository) DrainWorker(ctx context.Context, tenantId, workerId string) (*sqlcv1.Worker, error) {
+	worker, err := w.queries.DrainWorker(ctx, w.pool, sqlcv1.DrainWorkerParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Workerid: sqlchelpers.UUIDFromStr(workerId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not drain worker: %w", err)
+	}
+
+	return worker, nil
+}
+
+func (w *workerRepository) ListInFlightTasks(ctx context.Context, tenantId, workerId string) ([]*sqlcv1.ListInFlightTasksForWorkerRow, error) {
+	return w.queries.ListInFlightTasksForWorker(ctx, w.pool, sqlcv1.ListInFlightTasksForWorkerParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Workerid: sqlchelpers.UUIDFromStr(workerId),
+	})
+}
+
+func (w *workerRepository) GetActionSlotWeights(ctx context.Context, tenantId string, actionIds []string) (map[string]int32, error) {
+	rows, err := w.queries.ListActionSlotWeights(ctx, w.pool, sqlcv1.ListActionSlotWeightsParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Actionids: actionIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list action slot weights: %w", err)
+	}
+
+	res := make(map[string]int32, len(rows))
+
+	for _, row := range rows {
+		res[row.ActionID] = row.SlotWeight
+	}
+
+	return res, nil
+}
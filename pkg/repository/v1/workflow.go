@@ -22,6 +22,8 @@ import (
 
 var ErrDagParentNotFound = errors.New("dag parent not found")
 
+var ErrInvalidGang = errors.New("invalid gang")
+
 type CreateWorkflowVersionOpts struct {
 	// (required) the workflow name
 	Name string `validate:"required,hatchetName"`
@@ -51,7 +53,19 @@ type CreateWorkflowVersionOpts struct {
 
 	DefaultPriority *int32 `validate:"omitempty,min=1,max=3"`
 
+	// (optional) the number of seconds a queued task waits before its priority is raised by 1. Overrides
+	// the tenant's priority aging interval.
+	PriorityAgingSeconds *int32 `json:"priorityAgingSeconds,omitempty" validate:"omitnil,min=1"`
+
 	DefaultFilters []types.DefaultFilter `json:"defaultFilters,omitempty" validate:"omitempty,dive"`
+
+	// (optional) coalesces triggers with the same debounce key into a single run, which starts once no new
+	// triggers have arrived for the debounce window
+	Debounce *CreateDebounceOpts `validate:"omitnil"`
+
+	// (optional) the maximum wall-clock time of a run of the workflow, including retries, backoff and durable
+	// sleeps. Remaining tasks are cancelled once it is exceeded.
+	ExecutionTimeout *string `json:"executionTimeout,omitempty" validate:"omitnil,duration"`
 }
 
 type CreateCronWorkflowTriggerOpts struct {
@@ -67,12 +81,31 @@ type CreateCronWorkflowTriggerOpts struct {
 	AdditionalMetadata map[string]interface{}
 }
 
+type CreateDebounceOpts struct {
+	// (required) a CEL expression for evaluating the debounce key
+	Expression string `validate:"required,celworkflowrunstr"`
+
+	// (required) the duration without new triggers for a key before its run is started
+	Window string `validate:"required,duration"`
+}
+
 type CreateConcurrencyOpts struct {
 	// (optional) the maximum number of concurrent workflow runs, default 1
 	MaxRuns *int32
 
 	// (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
-	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST"`
+	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST QUEUE_NEWEST"`
+
+	// (optional) for QUEUE_NEWEST, the maximum number of queued runs per key, default unbounded
+	MaxQueued *int32 `validate:"omitnil,gte=0"`
+
+	// (optional) for QUEUE_NEWEST, the run to cancel once the queue is full, default DROP_OLDEST
+	QueueOverflow *string `validate:"omitnil,oneof=DROP_OLDEST REJECT_NEWEST"`
+
+	// (optional) for GROUP_ROUND_ROBIN, the name of a tenant-level concurrency group. Strategies
+	// in the same group share their slots across workflows, so they must be defined at the same
+	// level and use the same max runs and expression.
+	Group *string `validate:"omitnil,min=1,max=255"`
 
 	// (required) a concurrency expression for evaluating the concurrency key
 	Expression string `validate:"celworkflowrunstr"`
@@ -109,16 +142,53 @@ type CreateStepOpts struct {
 	// (optional) the step retry backoff max seconds (can't be greater than 86400)
 	RetryBackoffMaxSeconds *int `validate:"omitnil,min=1,max=86400"`
 
+	// (optional) the maximum number of retries for application failures with the given error code, overriding
+	// Retries. A value of 0 means failures with the error code are never retried.
+	RetryErrorCodes map[string]int32 `validate:"omitempty,dive,keys,required,endkeys,min=0"`
+
+	// (optional) randomizes the retry delay by up to this fraction of the delay
+	RetryJitter *float64 `validate:"omitnil,min=0,max=1"`
+
+	// (optional) the minimum delay before a retry, in seconds (can't be greater than 86400)
+	RetryMinBackoffSeconds *int32 `validate:"omitnil,min=0,max=86400"`
+
 	// (optional) a list of additional trigger conditions
 	TriggerConditions []CreateStepMatchConditionOpt `validate:"omitempty,dive"`
 
 	// (optional) the step concurrency options
 	Concurrency []CreateConcurrencyOpts `json:"concurrency,omitempty" validator:"omitnil"`
+
+	// (optional) the number of worker slots a run of this step consumes, defaults to 1
+	SlotWeight *int `validate:"omitnil,min=1"`
+
+	// (optional) the named worker resources a run of this step consumes
+	ResourceRequests map[string]int32 `validate:"omitempty,dive,keys,required,endkeys,min=1"`
+
+	// (optional) a CEL expression for the fairness key of this step. Queued tasks with different fairness keys
+	// share worker slots in a round-robin fashion.
+	FairnessKey *string `json:"fairnessKey,omitempty" validate:"omitnil,min=1"`
+
+	// (optional) whether runs of this step can be cancelled and requeued to make room for higher-priority tasks
+	Preemptible *bool `json:"preemptible,omitempty"`
+
+	// (optional) the name of the gang this step belongs to. Steps in the same gang are only assigned to workers
+	// when every step in the gang can be assigned, and must have the same parents.
+	Gang *string `json:"gang,omitempty" validate:"omitnil,min=1"`
+
+	// (optional) the maximum amount of time to wait for every step in the gang to be assigned
+	GangTimeout *string `json:"gangTimeout,omitempty" validate:"omitnil,duration"`
+
+	// (optional) how runs of this step are placed across workers, defaults to SPREAD
+	PlacementStrategy *string `json:"placementStrategy,omitempty" validate:"omitnil,oneof=SPREAD PACK LEAST_LOADED"`
+
+	// (optional) the maximum wall-clock time of a run of this step across all of its attempts, including retries,
+	// backoff and durable sleeps. Unlike Timeout, which applies to each attempt, the task is cancelled once it is exceeded.
+	TotalTimeout *string `json:"totalTimeout,omitempty" validate:"omitnil,duration"`
 }
 
 type CreateStepMatchConditionOpt struct {
 	// (required) the type of match condition for triggering the step
-	MatchConditionKind string `validate:"required,oneof=PARENT_OVERRIDE USER_EVENT SLEEP"`
+	MatchConditionKind string `validate:"required,oneof=PARENT_OVERRIDE USER_EVENT SLEEP APPROVAL"`
 
 	// (required) the key for the event data when the workflow is triggered
 	ReadableDataKey string `validate:"required"`
@@ -146,6 +216,13 @@ type CreateStepMatchConditionOpt struct {
 	// (optional) if this is a PARENT_OVERRIDE condition, this will be set to the parent readable_id for
 	// the parent whose trigger behavior we're overriding
 	ParentReadableId *string `validate:"omitempty"`
+
+	// (optional) the duration after which the approval expires, only set if this is an APPROVAL
+	ApprovalExpiresIn *string `json:"approvalExpiresIn,omitempty" validate:"omitnil,duration"`
+
+	// (optional) the action to take when the approval expires, only set if this is an APPROVAL with an expiry.
+	// Defaults to FAIL.
+	ApprovalExpiryAction *string `json:"approvalExpiryAction,omitempty" validate:"omitnil,oneof=SKIP FAIL"`
 }
 
 type DesiredWorkerLabelOpts struct {
@@ -396,6 +473,20 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 			Valid: true,
 		}
 	}
+
+	if opts.PriorityAgingSeconds != nil {
+		createParams.PriorityAgingSeconds = sqlchelpers.ToInt(*opts.PriorityAgingSeconds)
+	}
+
+	if opts.Debounce != nil {
+		createParams.DebounceExpression = sqlchelpers.TextFromStr(opts.Debounce.Expression)
+		createParams.DebounceWindow = sqlchelpers.TextFromStr(opts.Debounce.Window)
+	}
+
+	if opts.ExecutionTimeout != nil {
+		createParams.ExecutionTimeout = sqlchelpers.TextFromStr(*opts.ExecutionTimeout)
+	}
+
 	sqlcWorkflowVersion, err := r.queries.CreateWorkflowVersion(
 		ctx,
 		tx,
@@ -458,6 +549,40 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 
 		params.Limitstrategy = ls
 
+		if wfConcurrency.MaxQueued != nil {
+			params.MaxQueued = pgtype.Int4{
+				Int32: *wfConcurrency.MaxQueued,
+				Valid: true,
+			}
+		}
+
+		if wfConcurrency.QueueOverflow != nil {
+			params.QueueOverflow = sqlcv1.NullV1ConcurrencyQueueOverflow{
+				V1ConcurrencyQueueOverflow: sqlcv1.V1ConcurrencyQueueOverflow(*wfConcurrency.QueueOverflow),
+				Valid:                      true,
+			}
+		}
+
+		if wfConcurrency.Group != nil {
+			if ls != sqlcv1.V1ConcurrencyStrategyGROUPROUNDROBIN {
+				return "", fmt.Errorf("concurrency group %s can only be used with the GROUP_ROUND_ROBIN strategy", *wfConcurrency.Group)
+			}
+
+			var maxRuns int32 = 1
+
+			if wfConcurrency.MaxRuns != nil {
+				maxRuns = *wfConcurrency.MaxRuns
+			}
+
+			err = r.checkConcurrencyGroupMembers(ctx, tx, tenantId, workflowId, sqlcWorkflowVersion.ID, *wfConcurrency.Group, true, wfConcurrency.Expression, maxRuns)
+
+			if err != nil {
+				return "", err
+			}
+
+			params.ConcurrencyGroup = sqlchelpers.TextFromStr(*wfConcurrency.Group)
+		}
+
 		wcs, err := r.queries.CreateWorkflowConcurrencyV1(
 			ctx,
 			tx,
@@ -621,6 +746,45 @@ func (r *workflowRepository) createWorkflowVersionTxs(ctx context.Context, tx sq
 	return workflowVersionId, nil
 }
 
+// checkConcurrencyGroupMembers makes sure that a strategy can join a tenant-level concurrency group. The members
+// of a group fill the same slots, so they must all be defined at the same level, and use the same max runs and
+// expression.
+func (r *workflowRepository) checkConcurrencyGroupMembers(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId, workflowId, workflowVersionId pgtype.UUID,
+	group string,
+	isWorkflowLevel bool,
+	expression string,
+	maxRuns int32,
+) error {
+	members, err := r.queries.ListConcurrencyGroupMembers(ctx, tx, sqlcv1.ListConcurrencyGroupMembersParams{
+		Tenantid:          tenantId,
+		Concurrencygroup:  group,
+		Workflowid:        workflowId,
+		Workflowversionid: workflowVersionId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list members of concurrency group %s: %w", group, err)
+	}
+
+	for _, member := range members {
+		if member.IsWorkflowLevel != isWorkflowLevel {
+			return fmt.Errorf("concurrency group %s is already used by workflow %s at a different level, a group can't be shared between workflow-level and task-level concurrency", group, member.WorkflowName)
+		}
+
+		if member.MaxConcurrency != maxRuns || member.Expression != expression {
+			return fmt.Errorf(
+				"concurrency group %s is already used by workflow %s with max runs %d and expression %s, all members of a group must use the same max runs and expression",
+				group, member.WorkflowName, member.MaxConcurrency, member.Expression,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, tenantId, workflowId, workflowVersionId pgtype.UUID, jobKind sqlcv1.JobKind, steps []CreateStepOpts) (string, error) {
 	if len(steps) == 0 {
 		return "", errors.New("no steps provided")
@@ -711,6 +875,36 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 			}
 		}
 
+		if stepOpts.SlotWeight != nil {
+			createStepParams.SlotWeight = pgtype.Int4{
+				Int32: int32(*stepOpts.SlotWeight), // nolint: gosec
+				Valid: true,
+			}
+		}
+
+		if stepOpts.Preemptible != nil {
+			createStepParams.Preemptible = pgtype.Bool{
+				Bool:  *stepOpts.Preemptible,
+				Valid: true,
+			}
+		}
+
+		if stepOpts.Gang != nil {
+			createStepParams.GangName = sqlchelpers.TextFromStr(*stepOpts.Gang)
+		}
+
+		if stepOpts.GangTimeout != nil {
+			createStepParams.GangTimeout = sqlchelpers.TextFromStr(*stepOpts.GangTimeout)
+		}
+
+		if stepOpts.PlacementStrategy != nil {
+			createStepParams.PlacementStrategy = sqlchelpers.TextFromStr(*stepOpts.PlacementStrategy)
+		}
+
+		if stepOpts.TotalTimeout != nil {
+			createStepParams.TotalTimeout = sqlchelpers.TextFromStr(*stepOpts.TotalTimeout)
+		}
+
 		_, err = r.queries.CreateStep(
 			ctx,
 			tx,
@@ -770,6 +964,52 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 			}
 		}
 
+		if len(stepOpts.ResourceRequests) > 0 {
+			createResourcesParams := sqlcv1.CreateStepResourceRequestsParams{
+				Tenantid: tenantId,
+				Stepid:   sqlchelpers.UUIDFromStr(stepId),
+			}
+
+			for key, units := range stepOpts.ResourceRequests {
+				createResourcesParams.Keys = append(createResourcesParams.Keys, key)
+				createResourcesParams.Units = append(createResourcesParams.Units, units)
+			}
+
+			err = r.queries.CreateStepResourceRequests(ctx, tx, createResourcesParams)
+
+			if err != nil {
+				return "", err
+			}
+		}
+
+		if len(stepOpts.RetryErrorCodes) > 0 || stepOpts.RetryJitter != nil || stepOpts.RetryMinBackoffSeconds != nil {
+			createRetryPolicyParams := sqlcv1.CreateStepRetryPolicyParams{
+				Tenantid:            tenantId,
+				Stepid:              sqlchelpers.UUIDFromStr(stepId),
+				Errorcodes:          make([]string, 0, len(stepOpts.RetryErrorCodes)),
+				Errorcodemaxretries: make([]int32, 0, len(stepOpts.RetryErrorCodes)),
+			}
+
+			for code, maxRetries := range stepOpts.RetryErrorCodes {
+				createRetryPolicyParams.Errorcodes = append(createRetryPolicyParams.Errorcodes, code)
+				createRetryPolicyParams.Errorcodemaxretries = append(createRetryPolicyParams.Errorcodemaxretries, maxRetries)
+			}
+
+			if stepOpts.RetryJitter != nil {
+				createRetryPolicyParams.Jitter = *stepOpts.RetryJitter
+			}
+
+			if stepOpts.RetryMinBackoffSeconds != nil {
+				createRetryPolicyParams.Minbackoffseconds = *stepOpts.RetryMinBackoffSeconds
+			}
+
+			err = r.queries.CreateStepRetryPolicy(ctx, tx, createRetryPolicyParams)
+
+			if err != nil {
+				return "", fmt.Errorf("could not create retry policy: %w", err)
+			}
+		}
+
 		if len(stepOpts.Parents) > 0 {
 			err := r.queries.AddStepParents(
 				ctx,
@@ -876,6 +1116,23 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 			}
 		}
 
+		if stepOpts.FairnessKey != nil {
+			err := r.queries.CreateStepExpressions(
+				ctx,
+				tx,
+				sqlcv1.CreateStepExpressionsParams{
+					Stepid:      sqlchelpers.UUIDFromStr(stepId),
+					Kinds:       []string{string(sqlcv1.StepExpressionKindFAIRNESSKEY)},
+					Keys:        []string{"fairness"},
+					Expressions: []string{*stepOpts.FairnessKey},
+				},
+			)
+
+			if err != nil {
+				return "", fmt.Errorf("could not create fairness key expression: %w", err)
+			}
+		}
+
 		if len(stepOpts.Concurrency) > 0 {
 			for _, concurrency := range stepOpts.Concurrency {
 				var maxRuns int32 = 1
@@ -890,18 +1147,48 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 					strategy = sqlcv1.ConcurrencyLimitStrategy(*concurrency.LimitStrategy)
 				}
 
+				params := sqlcv1.CreateStepConcurrencyParams{
+					Workflowid:        workflowId,
+					Workflowversionid: workflowVersionId,
+					Stepid:            sqlchelpers.UUIDFromStr(stepId),
+					Tenantid:          tenantId,
+					Expression:        concurrency.Expression,
+					Maxconcurrency:    maxRuns,
+					Strategy:          sqlcv1.V1ConcurrencyStrategy(strategy),
+				}
+
+				if concurrency.MaxQueued != nil {
+					params.MaxQueued = pgtype.Int4{
+						Int32: *concurrency.MaxQueued,
+						Valid: true,
+					}
+				}
+
+				if concurrency.QueueOverflow != nil {
+					params.QueueOverflow = sqlcv1.NullV1ConcurrencyQueueOverflow{
+						V1ConcurrencyQueueOverflow: sqlcv1.V1ConcurrencyQueueOverflow(*concurrency.QueueOverflow),
+						Valid:                      true,
+					}
+				}
+
+				if concurrency.Group != nil {
+					if strategy != sqlcv1.ConcurrencyLimitStrategyGROUPROUNDROBIN {
+						return "", fmt.Errorf("concurrency group %s can only be used with the GROUP_ROUND_ROBIN strategy", *concurrency.Group)
+					}
+
+					err := r.checkConcurrencyGroupMembers(ctx, tx, tenantId, workflowId, workflowVersionId, *concurrency.Group, false, concurrency.Expression, maxRuns)
+
+					if err != nil {
+						return "", err
+					}
+
+					params.ConcurrencyGroup = sqlchelpers.TextFromStr(*concurrency.Group)
+				}
+
 				_, err := r.queries.CreateStepConcurrency(
 					ctx,
 					tx,
-					sqlcv1.CreateStepConcurrencyParams{
-						Workflowid:        workflowId,
-						Workflowversionid: workflowVersionId,
-						Stepid:            sqlchelpers.UUIDFromStr(stepId),
-						Tenantid:          tenantId,
-						Expression:        concurrency.Expression,
-						Maxconcurrency:    maxRuns,
-						Strategy:          sqlcv1.V1ConcurrencyStrategy(strategy),
-					},
+					params,
 				)
 
 				if err != nil {
@@ -930,20 +1217,37 @@ func (r *workflowRepository) createJobTx(ctx context.Context, tx sqlcv1.DBTX, te
 					sleepDuration = sqlchelpers.TextFromStr(*condition.SleepDuration)
 				}
 
+				var approvalExpiresIn pgtype.Text
+
+				if condition.ApprovalExpiresIn != nil {
+					approvalExpiresIn = sqlchelpers.TextFromStr(*condition.ApprovalExpiresIn)
+				}
+
+				var approvalExpiryAction sqlcv1.NullV1MatchConditionAction
+
+				if condition.ApprovalExpiryAction != nil {
+					approvalExpiryAction = sqlcv1.NullV1MatchConditionAction{
+						V1MatchConditionAction: sqlcv1.V1MatchConditionAction(*condition.ApprovalExpiryAction),
+						Valid:                  true,
+					}
+				}
+
 				_, err := r.queries.CreateStepMatchCondition(
 					ctx,
 					tx,
 					sqlcv1.CreateStepMatchConditionParams{
-						Tenantid:         tenantId,
-						Stepid:           sqlchelpers.UUIDFromStr(stepId),
-						Readabledatakey:  condition.ReadableDataKey,
-						Action:           sqlcv1.V1MatchConditionAction(condition.Action),
-						Orgroupid:        sqlchelpers.UUIDFromStr(condition.OrGroupId),
-						Expression:       sqlchelpers.TextFromStr(condition.Expression),
-						Kind:             sqlcv1.V1StepMatchConditionKind(condition.MatchConditionKind),
-						ParentReadableId: parentReadableId,
-						EventKey:         eventKey,
-						SleepDuration:    sleepDuration,
+						Tenantid:             tenantId,
+						Stepid:               sqlchelpers.UUIDFromStr(stepId),
+						Readabledatakey:      condition.ReadableDataKey,
+						Action:               sqlcv1.V1MatchConditionAction(condition.Action),
+						Orgroupid:            sqlchelpers.UUIDFromStr(condition.OrGroupId),
+						Expression:           sqlchelpers.TextFromStr(condition.Expression),
+						Kind:                 sqlcv1.V1StepMatchConditionKind(condition.MatchConditionKind),
+						ParentReadableId:     parentReadableId,
+						EventKey:             eventKey,
+						SleepDuration:        sleepDuration,
+						ApprovalExpiresIn:    approvalExpiresIn,
+						ApprovalExpiryAction: approvalExpiryAction,
 					},
 				)
 
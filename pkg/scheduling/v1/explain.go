@@ -0,0 +1,115 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+var comparatorSymbols = map[sqlcv1.WorkerLabelComparator]string{
+	sqlcv1.WorkerLabelComparatorEQUAL:              "=",
+	sqlcv1.WorkerLabelComparatorNOTEQUAL:           "!=",
+	sqlcv1.WorkerLabelComparatorGREATERTHAN:        ">",
+	sqlcv1.WorkerLabelComparatorGREATERTHANOREQUAL: ">=",
+	sqlcv1.WorkerLabelComparatorLESSTHAN:           "<",
+	sqlcv1.WorkerLabelComparatorLESSTHANOREQUAL:    "<=",
+}
+
+// explainNoSlots determines why a queue item could not be assigned to any of the candidate slots. The candidate
+// slots must already be filtered by the queue item's sticky strategy and desired labels.
+func explainNoSlots(
+	qi *sqlcv1.V1QueueItem,
+	candidateSlots []*slot,
+	labels []*sqlcv1.GetDesiredLabelsRow,
+	slotWeight int,
+	resources map[string]int32,
+) (sqlcv1.V1SchedulingReason, string) {
+	if len(candidateSlots) == 0 {
+		if qi.Sticky == sqlcv1.V1StickyStrategyHARD && qi.DesiredWorkerID.Valid {
+			return sqlcv1.V1SchedulingReasonSTICKYWORKERUNAVAILABLE, fmt.Sprintf(
+				"task is sticky to worker %s, which is not available", sqlchelpers.UUIDToStr(qi.DesiredWorkerID),
+			)
+		}
+
+		if len(labels) > 0 {
+			return sqlcv1.V1SchedulingReasonLABELMISMATCH, fmt.Sprintf(
+				"no worker matches the required labels: %s", describeLabels(labels),
+			)
+		}
+
+		return sqlcv1.V1SchedulingReasonNOSLOTS, "no worker slots are available"
+	}
+
+	if len(resources) > 0 {
+		workerActiveSlots := make(map[string]int)
+
+		for _, slot := range candidateSlots {
+			if slot.active() {
+				workerActiveSlots[slot.getWorkerId()]++
+			}
+		}
+
+		for _, count := range workerActiveSlots {
+			if count >= slotWeight {
+				return sqlcv1.V1SchedulingReasonRESOURCESUNAVAILABLE, fmt.Sprintf(
+					"no worker has the requested resources available: %s", describeResources(resources),
+				)
+			}
+		}
+	}
+
+	if slotWeight > 1 {
+		return sqlcv1.V1SchedulingReasonNOSLOTS, fmt.Sprintf("no worker has %d free slots", slotWeight)
+	}
+
+	return sqlcv1.V1SchedulingReasonNOSLOTS, "no worker slots are available"
+}
+
+func explainRateLimited(r *scheduleRateLimitResult) (sqlcv1.V1SchedulingReason, string) {
+	return sqlcv1.V1SchedulingReasonRATELIMITED, fmt.Sprintf(
+		"rate limit %s exceeded: requested %d units", r.exceededKey, r.exceededUnits,
+	)
+}
+
+func describeLabels(labels []*sqlcv1.GetDesiredLabelsRow) string {
+	descs := make([]string, 0, len(labels))
+
+	for _, label := range labels {
+		if !label.Required {
+			continue
+		}
+
+		var value string
+
+		if label.StrValue.Valid {
+			value = label.StrValue.String
+		} else if label.IntValue.Valid {
+			value = fmt.Sprintf("%d", label.IntValue.Int32)
+		}
+
+		descs = append(descs, fmt.Sprintf("%s%s%s", label.Key, comparatorSymbols[label.Comparator], value))
+	}
+
+	return strings.Join(descs, ", ")
+}
+
+func describeResources(resources map[string]int32) string {
+	keys := make([]string, 0, len(resources))
+
+	for key := range resources {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	descs := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		descs = append(descs, fmt.Sprintf("%s=%d", key, resources[key]))
+	}
+
+	return strings.Join(descs, ", ")
+}
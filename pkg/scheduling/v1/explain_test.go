@@ -0,0 +1,111 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestExplainNoSlots(t *testing.T) {
+	workerId := uuid.NewString()
+	desiredWorkerId := uuid.NewString()
+
+	activeSlot := func() *slot {
+		return newSlot(&worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: workerId}}, []string{})
+	}
+
+	usedSlot := func() *slot {
+		s := activeSlot()
+		s.use(nil, nil)
+		return s
+	}
+
+	tests := []struct {
+		name            string
+		qi              *sqlcv1.V1QueueItem
+		labels          []*sqlcv1.GetDesiredLabelsRow
+		slots           []*slot
+		slotWeight      int
+		resources       map[string]int32
+		expectedReason  sqlcv1.V1SchedulingReason
+		expectedMessage string
+	}{
+		{
+			name: "HARD sticky worker is gone",
+			qi: &sqlcv1.V1QueueItem{
+				Sticky:          sqlcv1.V1StickyStrategyHARD,
+				DesiredWorkerID: sqlchelpers.UUIDFromStr(desiredWorkerId),
+			},
+			slotWeight:      1,
+			expectedReason:  sqlcv1.V1SchedulingReasonSTICKYWORKERUNAVAILABLE,
+			expectedMessage: "task is sticky to worker " + desiredWorkerId + ", which is not available",
+		},
+		{
+			name: "no worker matches required labels",
+			qi:   &sqlcv1.V1QueueItem{Sticky: sqlcv1.V1StickyStrategyNONE},
+			labels: []*sqlcv1.GetDesiredLabelsRow{
+				{
+					Key:        "gpu",
+					StrValue:   sqlchelpers.TextFromStr("a100"),
+					Required:   true,
+					Comparator: sqlcv1.WorkerLabelComparatorEQUAL,
+				},
+				{
+					Key:        "memory",
+					IntValue:   sqlchelpers.ToInt(4),
+					Required:   true,
+					Comparator: sqlcv1.WorkerLabelComparatorGREATERTHANOREQUAL,
+				},
+				{
+					Key:        "region",
+					StrValue:   sqlchelpers.TextFromStr("us-east-1"),
+					Comparator: sqlcv1.WorkerLabelComparatorEQUAL,
+				},
+			},
+			slotWeight:      1,
+			expectedReason:  sqlcv1.V1SchedulingReasonLABELMISMATCH,
+			expectedMessage: "no worker matches the required labels: gpu=a100, memory>=4",
+		},
+		{
+			name:            "all slots in use",
+			qi:              &sqlcv1.V1QueueItem{Sticky: sqlcv1.V1StickyStrategyNONE},
+			slots:           []*slot{usedSlot()},
+			slotWeight:      1,
+			expectedReason:  sqlcv1.V1SchedulingReasonNOSLOTS,
+			expectedMessage: "no worker slots are available",
+		},
+		{
+			name:            "not enough slots for slot weight",
+			qi:              &sqlcv1.V1QueueItem{Sticky: sqlcv1.V1StickyStrategyNONE},
+			slots:           []*slot{activeSlot(), usedSlot()},
+			slotWeight:      2,
+			expectedReason:  sqlcv1.V1SchedulingReasonNOSLOTS,
+			expectedMessage: "no worker has 2 free slots",
+		},
+		{
+			name:            "slots free but resources unavailable",
+			qi:              &sqlcv1.V1QueueItem{Sticky: sqlcv1.V1StickyStrategyNONE},
+			slots:           []*slot{activeSlot()},
+			slotWeight:      1,
+			resources:       map[string]int32{"memory": 2, "gpu": 1},
+			expectedReason:  sqlcv1.V1SchedulingReasonRESOURCESUNAVAILABLE,
+			expectedMessage: "no worker has the requested resources available: gpu=1, memory=2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, message := explainNoSlots(tt.qi, tt.slots, tt.labels, tt.slotWeight, tt.resources)
+
+			assert.Equal(t, tt.expectedReason, reason)
+			assert.Equal(t, tt.expectedMessage, message)
+		})
+	}
+}
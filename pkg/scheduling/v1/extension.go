@@ -16,6 +16,9 @@ type PostAssignInput struct {
 type SnapshotInput struct {
 	Workers               map[string]*WorkerCp
 	WorkerSlotUtilization map[string]*SlotUtilization
+
+	// WorkerResourceUtilization maps worker ids to the utilization of each named resource on the worker
+	WorkerResourceUtilization map[string]map[string]*ResourceUtilization
 }
 
 type SlotUtilization struct {
@@ -23,10 +26,17 @@ type SlotUtilization struct {
 	NonUtilizedSlots int
 }
 
+type ResourceUtilization struct {
+	Capacity  int
+	Utilized  int
+	Available int
+}
+
 type WorkerCp struct {
-	WorkerId string
-	MaxRuns  int
-	Labels   []*sqlcv1.ListManyWorkerLabelsRow
+	WorkerId  string
+	MaxRuns   int
+	Labels    []*sqlcv1.ListManyWorkerLabelsRow
+	Resources map[string]int32
 }
 
 type SlotCp struct {
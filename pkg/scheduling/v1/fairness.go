@@ -0,0 +1,145 @@
+package v2
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// fairShare orders queue items so that worker slots are shared between fairness keys, instead of being
+// handed out strictly in the order that items were queued. Within each priority, keys are visited in a
+// deficit round-robin, where each key earns one slot unit per round and an item costs its slot weight.
+// Keys which were served least recently are visited first.
+type fairShare struct {
+	seq        uint64
+	lastServed map[string]uint64
+
+	mu sync.Mutex
+}
+
+func newFairShare() *fairShare {
+	return &fairShare{
+		lastServed: make(map[string]uint64),
+	}
+}
+
+func fairnessKey(qi *sqlcv1.V1QueueItem) string {
+	if qi.FairnessKey.Valid {
+		return qi.FairnessKey.String
+	}
+
+	return ""
+}
+
+// order returns the queue items in fair-share order. The queue items must already be sorted by priority.
+// Queue items are returned unchanged if none of them have a fairness key.
+func (f *fairShare) order(qis []*sqlcv1.V1QueueItem, stepIdsToSlotWeights map[string]int32) []*sqlcv1.V1QueueItem {
+	hasKeys := false
+
+	for _, qi := range qis {
+		if qi.FairnessKey.Valid {
+			hasKeys = true
+			break
+		}
+	}
+
+	if !hasKeys {
+		return qis
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// forget keys which are no longer queued, so the map doesn't grow unbounded
+	seen := make(map[string]struct{})
+
+	for _, qi := range qis {
+		seen[fairnessKey(qi)] = struct{}{}
+	}
+
+	for key := range f.lastServed {
+		if _, ok := seen[key]; !ok {
+			delete(f.lastServed, key)
+		}
+	}
+
+	res := make([]*sqlcv1.V1QueueItem, 0, len(qis))
+
+	for start := 0; start < len(qis); {
+		end := start
+
+		for end < len(qis) && qis[end].Priority == qis[start].Priority {
+			end++
+		}
+
+		res = append(res, f.orderPriority(qis[start:end], stepIdsToSlotWeights)...)
+
+		start = end
+	}
+
+	return res
+}
+
+func (f *fairShare) orderPriority(qis []*sqlcv1.V1QueueItem, stepIdsToSlotWeights map[string]int32) []*sqlcv1.V1QueueItem {
+	keys := make([]string, 0)
+	keyToItems := make(map[string][]*sqlcv1.V1QueueItem)
+
+	for _, qi := range qis {
+		key := fairnessKey(qi)
+
+		if _, ok := keyToItems[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		keyToItems[key] = append(keyToItems[key], qi)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return f.lastServed[keys[i]] < f.lastServed[keys[j]]
+	})
+
+	deficits := make(map[string]int, len(keys))
+	res := make([]*sqlcv1.V1QueueItem, 0, len(qis))
+
+	for len(res) < len(qis) {
+		for _, key := range keys {
+			items := keyToItems[key]
+
+			if len(items) == 0 {
+				continue
+			}
+
+			deficits[key]++
+
+			for len(items) > 0 {
+				cost := getSlotWeight(stepIdsToSlotWeights, sqlchelpers.UUIDToStr(items[0].StepID))
+
+				if cost > deficits[key] {
+					break
+				}
+
+				deficits[key] -= cost
+				res = append(res, items[0])
+				items = items[1:]
+			}
+
+			keyToItems[key] = items
+		}
+	}
+
+	return res
+}
+
+// markServed records that the queue items were assigned, which moves their keys to the back of the
+// round-robin.
+func (f *fairShare) markServed(qis []*sqlcv1.V1QueueItem) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, qi := range qis {
+		f.seq++
+		f.lastServed[fairnessKey(qi)] = f.seq
+	}
+}
@@ -0,0 +1,116 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func testFairQueueItem(id int64, priority int32, key string, stepId string) *sqlcv1.V1QueueItem {
+	qi := &sqlcv1.V1QueueItem{
+		ID:       id,
+		Priority: priority,
+		StepID:   sqlchelpers.UUIDFromStr(stepId),
+	}
+
+	if key != "" {
+		qi.FairnessKey = sqlchelpers.TextFromStr(key)
+	}
+
+	return qi
+}
+
+func queueItemIds(qis []*sqlcv1.V1QueueItem) []int64 {
+	ids := make([]int64, 0, len(qis))
+
+	for _, qi := range qis {
+		ids = append(ids, qi.ID)
+	}
+
+	return ids
+}
+
+func TestFairShare_Order(t *testing.T) {
+	stepId := uuid.NewString()
+	heavyStepId := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		qis         []*sqlcv1.V1QueueItem
+		slotWeights map[string]int32
+		served      []string
+		expected    []int64
+	}{
+		{
+			name: "no fairness keys keeps queue order",
+			qis: []*sqlcv1.V1QueueItem{
+				testFairQueueItem(1, 1, "", stepId),
+				testFairQueueItem(2, 1, "", stepId),
+			},
+			expected: []int64{1, 2},
+		},
+		{
+			name: "round-robins across keys",
+			qis: []*sqlcv1.V1QueueItem{
+				testFairQueueItem(1, 1, "a", stepId),
+				testFairQueueItem(2, 1, "a", stepId),
+				testFairQueueItem(3, 1, "a", stepId),
+				testFairQueueItem(4, 1, "b", stepId),
+				testFairQueueItem(5, 1, "", stepId),
+			},
+			expected: []int64{1, 4, 5, 2, 3},
+		},
+		{
+			name: "higher priorities are ordered first",
+			qis: []*sqlcv1.V1QueueItem{
+				testFairQueueItem(3, 3, "a", stepId),
+				testFairQueueItem(4, 3, "a", stepId),
+				testFairQueueItem(1, 1, "b", stepId),
+				testFairQueueItem(2, 1, "a", stepId),
+			},
+			expected: []int64{3, 4, 1, 2},
+		},
+		{
+			name: "slot weights cost multiple rounds",
+			qis: []*sqlcv1.V1QueueItem{
+				testFairQueueItem(1, 1, "a", heavyStepId),
+				testFairQueueItem(2, 1, "b", stepId),
+				testFairQueueItem(3, 1, "b", stepId),
+				testFairQueueItem(4, 1, "b", stepId),
+			},
+			slotWeights: map[string]int32{
+				heavyStepId: 2,
+			},
+			expected: []int64{2, 1, 3, 4},
+		},
+		{
+			name: "least recently served keys go first",
+			qis: []*sqlcv1.V1QueueItem{
+				testFairQueueItem(1, 1, "a", stepId),
+				testFairQueueItem(2, 1, "b", stepId),
+			},
+			served:   []string{"a"},
+			expected: []int64{2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFairShare()
+
+			for _, key := range tt.served {
+				f.markServed([]*sqlcv1.V1QueueItem{testFairQueueItem(0, 1, key, stepId)})
+			}
+
+			res := f.order(tt.qis, tt.slotWeights)
+
+			assert.Equal(t, tt.expected, queueItemIds(res))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// gang is the set of queued tasks in a single workflow run which belong to the same gang.
+type gang struct {
+	name    string
+	size    int
+	timeout *time.Duration
+
+	members []*sqlcv1.V1QueueItem
+}
+
+// splitGangs separates queue items which belong to a gang from the rest of the queue items, preserving the
+// order of both. Retries of gang members are scheduled individually, since the rest of the gang has already
+// been assigned.
+func splitGangs(qis []*sqlcv1.V1QueueItem, stepIdsToGangs map[string]*v1.StepGang) ([]*sqlcv1.V1QueueItem, []*gang) {
+	if len(stepIdsToGangs) == 0 {
+		return qis, nil
+	}
+
+	rest := make([]*sqlcv1.V1QueueItem, 0, len(qis))
+	gangs := make([]*gang, 0)
+	keysToGangs := make(map[string]*gang)
+
+	for _, qi := range qis {
+		stepGang, ok := stepIdsToGangs[sqlchelpers.UUIDToStr(qi.StepID)]
+
+		if !ok || qi.RetryCount > 0 {
+			rest = append(rest, qi)
+			continue
+		}
+
+		key := sqlchelpers.UUIDToStr(qi.WorkflowRunID) + ":" + stepGang.Name
+
+		g, ok := keysToGangs[key]
+
+		if !ok {
+			g = &gang{
+				name: stepGang.Name,
+				size: stepGang.Size,
+			}
+
+			keysToGangs[key] = g
+			gangs = append(gangs, g)
+		}
+
+		if g.timeout == nil {
+			g.timeout = stepGang.Timeout
+		}
+
+		g.members = append(g.members, qi)
+	}
+
+	return rest, gangs
+}
+
+// timedOut returns true if any member of the gang has passed its schedule timeout, or if the gang has been
+// waiting longer than its gang timeout. The gang timeout is measured from when the first member was queued.
+func (g *gang) timedOut() bool {
+	var start time.Time
+
+	for _, qi := range g.members {
+		if isTimedOut(qi) {
+			return true
+		}
+
+		if qi.TaskInsertedAt.Valid && (start.IsZero() || qi.TaskInsertedAt.Time.Before(start)) {
+			start = qi.TaskInsertedAt.Time
+		}
+	}
+
+	return g.timeout != nil && !start.IsZero() && time.Since(start) > *g.timeout
+}
+
+// tryAssignGangs assigns each gang all-or-none. Members are assigned one at a time through tryAssignBatch, and
+// if any member cannot be assigned, the slots held by the members which were already assigned are released.
+func (s *Scheduler) tryAssignGangs(
+	ctx context.Context,
+	gangs []*gang,
+	stepIdsToLabels map[string][]*sqlcv1.GetDesiredLabelsRow,
+	taskIdsToRateLimits map[int64]map[string]int32,
+	stepIdsToSlotWeights map[string]int32,
+	stepIdsToResources map[string]map[string]int32,
+	stepIdsToPlacement map[string]v1.PlacementStrategy,
+) *assignResults {
+	ctx, span := telemetry.NewSpan(ctx, "try-assign-gangs")
+	defer span.End()
+
+	res := &assignResults{}
+
+	waiting := func(qi *sqlcv1.V1QueueItem, message string) {
+		res.unassigned = append(res.unassigned, qi)
+		res.reasons = append(res.reasons, &v1.SchedulingReason{
+			QueueItemId: qi.ID,
+			Reason:      sqlcv1.V1SchedulingReasonGANGWAITING,
+			Message:     message,
+		})
+	}
+
+	for _, g := range gangs {
+		if g.timedOut() {
+			res.schedulingTimedOut = append(res.schedulingTimedOut, g.members...)
+			continue
+		}
+
+		if len(g.members) < g.size {
+			for _, qi := range g.members {
+				waiting(qi, fmt.Sprintf("waiting for %d of %d tasks in gang %s to be queued", g.size-len(g.members), g.size, g.name))
+			}
+
+			continue
+		}
+
+		assigned := make([]*assignedQueueItem, 0, len(g.members))
+		var failed *assignSingleResult
+
+		for _, qi := range g.members {
+			results, _, err := s.tryAssignBatch(
+				ctx,
+				qi.ActionID,
+				[]*sqlcv1.V1QueueItem{qi},
+				0,
+				stepIdsToLabels,
+				taskIdsToRateLimits,
+				stepIdsToSlotWeights,
+				stepIdsToResources,
+				stepIdsToPlacement,
+			)
+
+			if err != nil || len(results) != 1 {
+				s.l.Error().Err(err).Msgf("error assigning gang member %d", qi.ID)
+
+				failed = &assignSingleResult{qi: qi, noSlots: true}
+				break
+			}
+
+			if !results[0].succeeded {
+				failed = results[0]
+				break
+			}
+
+			assigned = append(assigned, &assignedQueueItem{
+				WorkerId:   results[0].workerId,
+				QueueItem:  qi,
+				AckId:      results[0].ackId,
+				RateLimits: taskIdsToRateLimits[qi.TaskID],
+			})
+		}
+
+		if failed == nil {
+			res.assigned = append(res.assigned, assigned...)
+			continue
+		}
+
+		// release the slots held by members which were already assigned
+		nackIds := make([]int, 0, len(assigned))
+
+		for _, a := range assigned {
+			nackIds = append(nackIds, a.AckId)
+		}
+
+		s.nack(nackIds)
+
+		message := fmt.Sprintf("task %s in gang %s could not be assigned", sqlchelpers.UUIDToStr(failed.qi.ExternalID), g.name)
+
+		if failed.message != "" {
+			message += ": " + failed.message
+		}
+
+		for _, qi := range g.members {
+			if qi.ID == failed.qi.ID && failed.rateLimitResult != nil {
+				res.rateLimited = append(res.rateLimited, failed.rateLimitResult)
+
+				reason, rlMessage := explainRateLimited(failed.rateLimitResult)
+
+				res.reasons = append(res.reasons, &v1.SchedulingReason{
+					QueueItemId: qi.ID,
+					Reason:      reason,
+					Message:     rlMessage,
+				})
+
+				continue
+			}
+
+			waiting(qi, message)
+		}
+	}
+
+	return res
+}
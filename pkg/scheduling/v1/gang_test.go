@@ -0,0 +1,204 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func testGangQueueItem(id int64, workflowRunId, stepId, actionId string) *sqlcv1.V1QueueItem {
+	return &sqlcv1.V1QueueItem{
+		ID:             id,
+		TaskID:         id,
+		TaskInsertedAt: sqlchelpers.TimestamptzFromTime(time.Now()),
+		ExternalID:     sqlchelpers.UUIDFromStr(uuid.NewString()),
+		WorkflowRunID:  sqlchelpers.UUIDFromStr(workflowRunId),
+		StepID:         sqlchelpers.UUIDFromStr(stepId),
+		ActionID:       actionId,
+		Sticky:         sqlcv1.V1StickyStrategyNONE,
+	}
+}
+
+// newTestScheduler creates a scheduler where each action is handled by its own worker with the given number of
+// slots, which mirrors gang members with different actions running on different workers.
+func newTestScheduler(actionIdsToSlots map[string]int) *Scheduler {
+	l := zerolog.Nop()
+
+	actions := make(map[string]*action, len(actionIdsToSlots))
+
+	for actionId, numSlots := range actionIdsToSlots {
+		w := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: uuid.NewString()}}
+
+		slots := make([]*slot, 0, numSlots)
+
+		for range numSlots {
+			slots = append(slots, newSlot(w, []string{actionId}))
+		}
+
+		actions[actionId] = &action{
+			actionId: actionId,
+			slots:    slots,
+		}
+	}
+
+	return &Scheduler{
+		l:               &l,
+		actions:         actions,
+		actionsMu:       newRWMu(&l),
+		unackedSlots:    make(map[int][]*slot),
+		unackedMu:       newMu(&l),
+		assignedCountMu: newMu(&l),
+		resources:       make(map[string]*workerResources),
+		resourcesMu:     newMu(&l),
+		exts:            &Extensions{},
+	}
+}
+
+func TestSplitGangs(t *testing.T) {
+	runA := uuid.NewString()
+	runB := uuid.NewString()
+	gangStepA := uuid.NewString()
+	gangStepB := uuid.NewString()
+	otherStep := uuid.NewString()
+
+	stepIdsToGangs := map[string]*v1.StepGang{
+		gangStepA: {Name: "shards", Size: 2},
+		gangStepB: {Name: "shards", Size: 2},
+	}
+
+	retried := testGangQueueItem(6, runB, gangStepA, "a")
+	retried.RetryCount = 1
+
+	qis := []*sqlcv1.V1QueueItem{
+		testGangQueueItem(1, runA, gangStepA, "a"),
+		testGangQueueItem(2, runA, otherStep, "c"),
+		testGangQueueItem(3, runB, gangStepA, "a"),
+		testGangQueueItem(4, runA, gangStepB, "b"),
+		testGangQueueItem(5, runB, otherStep, "c"),
+		retried,
+	}
+
+	rest, gangs := splitGangs(qis, stepIdsToGangs)
+
+	assert.Equal(t, []int64{2, 5, 6}, queueItemIds(rest))
+	require.Len(t, gangs, 2)
+	assert.Equal(t, []int64{1, 4}, queueItemIds(gangs[0].members))
+	assert.Equal(t, []int64{3}, queueItemIds(gangs[1].members))
+}
+
+func TestTryAssignGangs(t *testing.T) {
+	runId := uuid.NewString()
+	stepA := uuid.NewString()
+	stepB := uuid.NewString()
+
+	newGang := func(timeout *time.Duration) *gang {
+		return &gang{
+			name:    "shards",
+			size:    2,
+			timeout: timeout,
+			members: []*sqlcv1.V1QueueItem{
+				testGangQueueItem(1, runId, stepA, "a"),
+				testGangQueueItem(2, runId, stepB, "b"),
+			},
+		}
+	}
+
+	t.Run("assigns the whole gang when every member fits", func(t *testing.T) {
+		s := newTestScheduler(map[string]int{"a": 1, "b": 1})
+
+		res := s.tryAssignGangs(context.Background(), []*gang{newGang(nil)}, nil, nil, nil, nil, nil)
+
+		assert.Len(t, res.assigned, 2)
+		assert.Empty(t, res.unassigned)
+	})
+
+	t.Run("assigns no members when one member does not fit", func(t *testing.T) {
+		s := newTestScheduler(map[string]int{"a": 1, "b": 0})
+
+		res := s.tryAssignGangs(context.Background(), []*gang{newGang(nil)}, nil, nil, nil, nil, nil)
+
+		assert.Empty(t, res.assigned)
+		assert.Equal(t, []int64{1, 2}, queueItemIds(res.unassigned))
+
+		// the slot held by the first member should be released
+		assert.Equal(t, 1, s.actions["a"].activeCount())
+		assert.Empty(t, s.unackedSlots)
+	})
+
+	t.Run("waits for every member to be queued", func(t *testing.T) {
+		s := newTestScheduler(map[string]int{"a": 1, "b": 1})
+
+		g := newGang(nil)
+		g.members = g.members[:1]
+
+		res := s.tryAssignGangs(context.Background(), []*gang{g}, nil, nil, nil, nil, nil)
+
+		assert.Empty(t, res.assigned)
+		require.Len(t, res.reasons, 1)
+		assert.Equal(t, sqlcv1.V1SchedulingReasonGANGWAITING, res.reasons[0].Reason)
+		assert.Equal(t, "waiting for 1 of 2 tasks in gang shards to be queued", res.reasons[0].Message)
+	})
+
+	t.Run("times out the whole gang after the gang timeout", func(t *testing.T) {
+		s := newTestScheduler(map[string]int{"a": 1, "b": 1})
+
+		timeout := time.Minute
+		g := newGang(&timeout)
+		g.members[0].TaskInsertedAt = sqlchelpers.TimestamptzFromTime(time.Now().Add(-2 * time.Minute))
+
+		res := s.tryAssignGangs(context.Background(), []*gang{g}, nil, nil, nil, nil, nil)
+
+		assert.Empty(t, res.assigned)
+		assert.Empty(t, res.unassigned)
+		assert.Equal(t, []int64{1, 2}, queueItemIds(res.schedulingTimedOut))
+	})
+}
+
+// TestTryAssignSharedGangQueue reads a gang whose members have different actions from a single queue, as the
+// queuer for a shared gang queue does, and checks that each member is assigned to a worker for its own action.
+func TestTryAssignSharedGangQueue(t *testing.T) {
+	runId := uuid.NewString()
+	stepA := uuid.NewString()
+	stepB := uuid.NewString()
+
+	stepIdsToGangs := map[string]*v1.StepGang{
+		stepA: {Name: "shards", Size: 2},
+		stepB: {Name: "shards", Size: 2},
+	}
+
+	s := newTestScheduler(map[string]int{"a": 1, "b": 1})
+
+	qis := []*sqlcv1.V1QueueItem{
+		testGangQueueItem(1, runId, stepA, "a"),
+		testGangQueueItem(2, runId, stepB, "b"),
+	}
+
+	assigned := make([]*assignedQueueItem, 0)
+
+	for res := range s.tryAssign(context.Background(), qis, nil, nil, nil, nil, stepIdsToGangs, nil) {
+		assigned = append(assigned, res.assigned...)
+		assert.Empty(t, res.unassigned)
+	}
+
+	require.Len(t, assigned, 2)
+
+	actionIdsToWorkers := make(map[string]string)
+
+	for _, a := range assigned {
+		actionIdsToWorkers[a.QueueItem.ActionID] = sqlchelpers.UUIDToStr(a.WorkerId)
+	}
+
+	assert.Equal(t, s.actions["a"].slots[0].getWorkerId(), actionIdsToWorkers["a"])
+	assert.Equal(t, s.actions["b"].slots[0].getWorkerId(), actionIdsToWorkers["b"])
+}
@@ -106,12 +106,25 @@ func (l *LeaseManager) sendConcurrencyLeases(concurrencyLeases []*sqlcv1.V1StepC
 }
 
 func (l *LeaseManager) acquireWorkerLeases(ctx context.Context) error {
-	activeWorkers, err := l.lr.ListActiveWorkers(ctx, l.tenantId)
+	listedWorkers, err := l.lr.ListActiveWorkers(ctx, l.tenantId)
 
 	if err != nil {
 		return err
 	}
 
+	// draining workers are finishing their in-flight tasks, so we release their leases to stop assigning
+	// new tasks to them
+	activeWorkers := make([]*v1.ListActiveWorkersResult, 0, len(listedWorkers))
+
+	for _, worker := range listedWorkers {
+		if worker.IsDraining {
+			l.conf.l.Debug().Msgf("worker %s is draining, not acquiring lease", worker.ID)
+			continue
+		}
+
+		activeWorkers = append(activeWorkers, worker)
+	}
+
 	currResourceIdsToLease := make(map[string]*sqlcv1.Lease, len(l.workerLeases))
 
 	for _, lease := range l.workerLeases {
@@ -78,6 +78,37 @@ func TestLeaseManager_AcquireWorkerLeases(t *testing.T) {
 	assert.Len(t, leaseManager.workerLeases, 2)
 }
 
+func TestLeaseManager_AcquireWorkerLeasesSkipsDrainingWorkers(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := pgtype.UUID{}
+	mockLeaseRepo := &mockLeaseRepo{}
+	leaseManager := &LeaseManager{
+		lr:       mockLeaseRepo,
+		conf:     &sharedConfig{l: &l},
+		tenantId: tenantId,
+		workerLeases: []*sqlcv1.Lease{
+			{ID: 2, ResourceId: "draining-worker"},
+		},
+	}
+
+	mockWorkers := []*v1.ListActiveWorkersResult{
+		{ID: "active-worker"},
+		{ID: "draining-worker", IsDraining: true},
+	}
+	mockLeases := []*sqlcv1.Lease{
+		{ID: 1, ResourceId: "active-worker"},
+	}
+
+	mockLeaseRepo.On("ListActiveWorkers", mock.Anything, tenantId).Return(mockWorkers, nil)
+	mockLeaseRepo.On("AcquireOrExtendLeases", mock.Anything, sqlcv1.LeaseKindWORKER, []string{"active-worker"}, []*sqlcv1.Lease{}).Return(mockLeases, nil)
+	mockLeaseRepo.On("ReleaseLeases", mock.Anything, []*sqlcv1.Lease{{ID: 2, ResourceId: "draining-worker"}}).Return(nil)
+
+	err := leaseManager.acquireWorkerLeases(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, mockLeases, leaseManager.workerLeases)
+	mockLeaseRepo.AssertExpectations(t)
+}
+
 func TestLeaseManager_AcquireQueueLeases(t *testing.T) {
 	l := zerolog.Nop()
 	tenantId := pgtype.UUID{}
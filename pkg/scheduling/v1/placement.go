@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"sort"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+// addSlotUtilization counts the utilized and non-utilized slots for each worker, skipping slots which have
+// already been counted. Slots are shared between the actions of a worker, so uniqueSlots must be shared
+// between calls for different actions.
+func addSlotUtilization(res map[string]*SlotUtilization, uniqueSlots map[*slot]bool, slots []*slot) {
+	for _, slot := range slots {
+		if _, ok := uniqueSlots[slot]; ok {
+			continue
+		}
+
+		uniqueSlots[slot] = true
+
+		workerId := slot.worker.ID
+
+		if _, ok := res[workerId]; !ok {
+			res[workerId] = &SlotUtilization{}
+		}
+
+		if slot.isUsed() {
+			res[workerId].UtilizedSlots++
+		} else {
+			res[workerId].NonUtilizedSlots++
+		}
+	}
+}
+
+// workerLoad returns the fraction of a worker's slots which are in use. Only available slots are tracked by
+// the scheduler, so slots which are missing from the utilization are counted against the worker's max runs.
+func workerLoad(w *worker, u *SlotUtilization) float64 {
+	total := u.UtilizedSlots + u.NonUtilizedSlots
+
+	if w.MaxRuns > total {
+		total = w.MaxRuns
+	}
+
+	if total == 0 {
+		return 1
+	}
+
+	return float64(total-u.NonUtilizedSlots) / float64(total)
+}
+
+// orderSlotsByPlacement groups the candidate slots by worker and orders the workers by their load: most
+// loaded first for PACK, and least loaded first for LEAST_LOADED. Workers with the same load and the slots of
+// each worker keep their original order. Returns false if the strategy doesn't reorder slots, which is the
+// case for SPREAD, since spreading is handled by the ring offset.
+func orderSlotsByPlacement(slots []*slot, strategy v1.PlacementStrategy) ([]*slot, bool) {
+	if strategy != v1.PLACEMENT_STRATEGY_PACK && strategy != v1.PLACEMENT_STRATEGY_LEAST_LOADED {
+		return slots, false
+	}
+
+	utilization := make(map[string]*SlotUtilization)
+	addSlotUtilization(utilization, make(map[*slot]bool, len(slots)), slots)
+
+	workerIds := make([]string, 0, len(utilization))
+	workerSlots := make(map[string][]*slot, len(utilization))
+	workerLoads := make(map[string]float64, len(utilization))
+
+	for _, slot := range slots {
+		workerId := slot.worker.ID
+
+		if _, ok := workerSlots[workerId]; !ok {
+			workerIds = append(workerIds, workerId)
+			workerLoads[workerId] = workerLoad(slot.worker, utilization[workerId])
+		}
+
+		workerSlots[workerId] = append(workerSlots[workerId], slot)
+	}
+
+	sort.SliceStable(workerIds, func(i, j int) bool {
+		if strategy == v1.PLACEMENT_STRATEGY_PACK {
+			return workerLoads[workerIds[i]] > workerLoads[workerIds[j]]
+		}
+
+		return workerLoads[workerIds[i]] < workerLoads[workerIds[j]]
+	})
+
+	res := make([]*slot, 0, len(slots))
+
+	for _, workerId := range workerIds {
+		res = append(res, workerSlots[workerId]...)
+	}
+
+	return res, true
+}
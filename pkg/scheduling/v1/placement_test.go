@@ -0,0 +1,149 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// testPlacementWorker creates a worker with maxRuns slots, of which free are available and used are
+// assigned in the current scheduling loop. The remaining slots are not tracked by the scheduler.
+func testPlacementWorker(maxRuns, free, used int) []*slot {
+	w := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: uuid.NewString(), MaxRuns: maxRuns}}
+
+	slots := make([]*slot, 0, free+used)
+
+	for range free {
+		slots = append(slots, newSlot(w, []string{"a"}))
+	}
+
+	for range used {
+		s := newSlot(w, []string{"a"})
+		s.use(nil, nil)
+		slots = append(slots, s)
+	}
+
+	return slots
+}
+
+func slotWorkerIds(slots []*slot) []string {
+	res := make([]string, 0)
+
+	for _, s := range slots {
+		if len(res) == 0 || res[len(res)-1] != s.getWorkerId() {
+			res = append(res, s.getWorkerId())
+		}
+	}
+
+	return res
+}
+
+func TestOrderSlotsByPlacement(t *testing.T) {
+	// busy has a load of 0.75, idle has a load of 0.25, and half has a load of 0.5 with one of its tracked
+	// slots used in the current scheduling loop
+	busy := testPlacementWorker(4, 1, 0)
+	idle := testPlacementWorker(4, 3, 0)
+	half := testPlacementWorker(4, 2, 1)
+
+	busyId := busy[0].getWorkerId()
+	idleId := idle[0].getWorkerId()
+	halfId := half[0].getWorkerId()
+
+	slots := make([]*slot, 0)
+	slots = append(slots, idle...)
+	slots = append(slots, busy...)
+	slots = append(slots, half...)
+
+	tests := []struct {
+		name      string
+		strategy  v1.PlacementStrategy
+		reordered bool
+		expected  []string
+	}{
+		{
+			name:      "SPREAD keeps the ring order",
+			strategy:  v1.PLACEMENT_STRATEGY_SPREAD,
+			reordered: false,
+			expected:  []string{idleId, busyId, halfId},
+		},
+		{
+			name:      "unset strategy keeps the ring order",
+			strategy:  "",
+			reordered: false,
+			expected:  []string{idleId, busyId, halfId},
+		},
+		{
+			name:      "PACK orders the most loaded worker first",
+			strategy:  v1.PLACEMENT_STRATEGY_PACK,
+			reordered: true,
+			expected:  []string{busyId, halfId, idleId},
+		},
+		{
+			name:      "LEAST_LOADED orders the least loaded worker first",
+			strategy:  v1.PLACEMENT_STRATEGY_LEAST_LOADED,
+			reordered: true,
+			expected:  []string{idleId, halfId, busyId},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, reordered := orderSlotsByPlacement(slots, tt.strategy)
+
+			assert.Equal(t, tt.reordered, reordered)
+			assert.Len(t, res, len(slots))
+			assert.Equal(t, tt.expected, slotWorkerIds(res))
+		})
+	}
+}
+
+func TestTryAssignSingletonPlacement(t *testing.T) {
+	busy := testPlacementWorker(4, 1, 0)
+	idle := testPlacementWorker(4, 3, 0)
+
+	slots := make([]*slot, 0)
+	slots = append(slots, idle...)
+	slots = append(slots, busy...)
+
+	qi := &sqlcv1.V1QueueItem{ID: 1, Sticky: sqlcv1.V1StickyStrategyNONE}
+
+	tests := []struct {
+		name     string
+		strategy v1.PlacementStrategy
+		expected string
+	}{
+		{
+			name:     "SPREAD uses the ring offset",
+			strategy: v1.PLACEMENT_STRATEGY_SPREAD,
+			expected: idle[0].getWorkerId(),
+		},
+		{
+			name:     "PACK ignores the ring offset",
+			strategy: v1.PLACEMENT_STRATEGY_PACK,
+			expected: busy[0].getWorkerId(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestScheduler(nil)
+
+			res, err := s.tryAssignSingleton(context.Background(), qi, slots, 1, nil, 1, nil, tt.strategy, func() {}, func() {})
+			require.NoError(t, err)
+			require.True(t, res.succeeded)
+
+			assert.Equal(t, tt.expected, sqlchelpers.UUIDToStr(res.workerId))
+
+			s.nack([]int{res.ackId})
+		})
+	}
+}
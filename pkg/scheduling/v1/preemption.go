@@ -0,0 +1,150 @@
+package v2
+
+import (
+	"sort"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// PreemptedTask is a running task which was selected to be cancelled and requeued so that a higher-priority
+// queue item can be assigned to its worker.
+type PreemptedTask struct {
+	Task *sqlcv1.ListPreemptibleTasksForWorkersRow
+
+	// PreemptedBy is the queue item which the task was preempted for
+	PreemptedBy *sqlcv1.V1QueueItem
+}
+
+// preemptionRequest is a queue item which could not be assigned because there were no slots available,
+// along with the workers that the queue item could run on.
+type preemptionRequest struct {
+	qi         *sqlcv1.V1QueueItem
+	slotWeight int
+	workerIds  []string
+}
+
+func taskPriority(t *sqlcv1.ListPreemptibleTasksForWorkersRow) int32 {
+	if t.Priority.Valid {
+		return t.Priority.Int32
+	}
+
+	return 1
+}
+
+// selectPreemptionVictims selects running tasks to preempt so that each request can be assigned. Requests are
+// handled in priority order, and only tasks with a strictly lower priority than the request are preempted. For
+// each request, we pick the worker which requires the fewest victims to free enough slots, breaking ties by
+// preferring the worker whose victims have the lowest priority. Each task is preempted at most once.
+func selectPreemptionVictims(reqs []*preemptionRequest, candidates []*sqlcv1.ListPreemptibleTasksForWorkersRow) []*PreemptedTask {
+	workerCandidates := make(map[string][]*sqlcv1.ListPreemptibleTasksForWorkersRow)
+
+	for _, c := range candidates {
+		workerId := sqlchelpers.UUIDToStr(c.WorkerID)
+		workerCandidates[workerId] = append(workerCandidates[workerId], c)
+	}
+
+	// prefer preempting the lowest priority tasks, and the most recently created tasks within a priority,
+	// since they have likely done the least amount of work
+	for _, cs := range workerCandidates {
+		sort.SliceStable(cs, func(i, j int) bool {
+			if taskPriority(cs[i]) == taskPriority(cs[j]) {
+				return cs[i].TaskID > cs[j].TaskID
+			}
+
+			return taskPriority(cs[i]) < taskPriority(cs[j])
+		})
+	}
+
+	sortedReqs := make([]*preemptionRequest, len(reqs))
+	copy(sortedReqs, reqs)
+
+	sort.SliceStable(sortedReqs, func(i, j int) bool {
+		if sortedReqs[i].qi.Priority == sortedReqs[j].qi.Priority {
+			return sortedReqs[i].qi.ID < sortedReqs[j].qi.ID
+		}
+
+		return sortedReqs[i].qi.Priority > sortedReqs[j].qi.Priority
+	})
+
+	used := make(map[int64]bool)
+	res := make([]*PreemptedTask, 0)
+
+	for _, req := range sortedReqs {
+		var bestVictims []*sqlcv1.ListPreemptibleTasksForWorkersRow
+		var bestMaxPriority int32
+
+		for _, workerId := range req.workerIds {
+			victims := make([]*sqlcv1.ListPreemptibleTasksForWorkersRow, 0)
+			freed := 0
+			var maxPriority int32
+
+			for _, c := range workerCandidates[workerId] {
+				if freed >= req.slotWeight {
+					break
+				}
+
+				if used[c.TaskID] || taskPriority(c) >= req.qi.Priority {
+					continue
+				}
+
+				victims = append(victims, c)
+				freed += int(max(c.SlotWeight, 1))
+				maxPriority = max(maxPriority, taskPriority(c))
+			}
+
+			if freed < req.slotWeight {
+				continue
+			}
+
+			if bestVictims == nil || len(victims) < len(bestVictims) ||
+				(len(victims) == len(bestVictims) && maxPriority < bestMaxPriority) {
+				bestVictims = victims
+				bestMaxPriority = maxPriority
+			}
+		}
+
+		for _, v := range bestVictims {
+			used[v.TaskID] = true
+
+			res = append(res, &PreemptedTask{
+				Task:        v,
+				PreemptedBy: req.qi,
+			})
+		}
+	}
+
+	return res
+}
+
+// getPreemptionWorkers returns the workers which a queue item could be assigned to if a slot were available,
+// respecting hard sticky strategies and required labels. Draining workers are never considered, since tasks
+// preempted on a draining worker would not free up a slot for new work.
+func (s *Scheduler) getPreemptionWorkers(qi *sqlcv1.V1QueueItem, labels []*sqlcv1.GetDesiredLabelsRow) []string {
+	s.actionsMu.RLock()
+	actionWorkerIds := s.actionWorkers[qi.ActionID]
+	s.actionsMu.RUnlock()
+
+	workers := s.getWorkers()
+	res := make([]string, 0, len(actionWorkerIds))
+
+	for _, workerId := range actionWorkerIds {
+		w, ok := workers[workerId]
+
+		if !ok || w.IsDraining {
+			continue
+		}
+
+		if qi.Sticky == sqlcv1.V1StickyStrategyHARD && qi.DesiredWorkerID.Valid && sqlchelpers.UUIDToStr(qi.DesiredWorkerID) != workerId {
+			continue
+		}
+
+		if len(labels) > 0 && w.computeWeight(labels) < 0 {
+			continue
+		}
+
+		res = append(res, workerId)
+	}
+
+	return res
+}
@@ -0,0 +1,131 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func testPreemptibleTask(taskId int64, workerId string, priority int32, slotWeight int32) *sqlcv1.ListPreemptibleTasksForWorkersRow {
+	return &sqlcv1.ListPreemptibleTasksForWorkersRow{
+		TaskID:     taskId,
+		WorkerID:   sqlchelpers.UUIDFromStr(workerId),
+		Priority:   sqlchelpers.ToInt(priority),
+		SlotWeight: slotWeight,
+	}
+}
+
+func preemptedTaskIds(preempted []*PreemptedTask) map[int64]int64 {
+	res := make(map[int64]int64, len(preempted))
+
+	for _, p := range preempted {
+		res[p.Task.TaskID] = p.PreemptedBy.ID
+	}
+
+	return res
+}
+
+func TestSelectPreemptionVictims(t *testing.T) {
+	workerA := uuid.NewString()
+	workerB := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		reqs       []*preemptionRequest
+		candidates []*sqlcv1.ListPreemptibleTasksForWorkersRow
+		expected   map[int64]int64
+	}{
+		{
+			name: "preempts the lowest priority, most recent task",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 3}, slotWeight: 1, workerIds: []string{workerA}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 2, 1),
+				testPreemptibleTask(2, workerA, 1, 1),
+				testPreemptibleTask(3, workerA, 1, 1),
+			},
+			expected: map[int64]int64{3: 100},
+		},
+		{
+			name: "does not preempt tasks with equal priority",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 2}, slotWeight: 1, workerIds: []string{workerA}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 2, 1),
+			},
+			expected: map[int64]int64{},
+		},
+		{
+			name: "only considers eligible workers",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 3}, slotWeight: 1, workerIds: []string{workerB}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 1, 1),
+				testPreemptibleTask(2, workerB, 2, 1),
+			},
+			expected: map[int64]int64{2: 100},
+		},
+		{
+			name: "frees enough slots on a single worker for the slot weight",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 3}, slotWeight: 2, workerIds: []string{workerA, workerB}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 1, 1),
+				testPreemptibleTask(2, workerA, 1, 1),
+				testPreemptibleTask(3, workerB, 2, 2),
+			},
+			expected: map[int64]int64{3: 100},
+		},
+		{
+			name: "prefers the worker with the lowest priority victims",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 3}, slotWeight: 1, workerIds: []string{workerA, workerB}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 2, 1),
+				testPreemptibleTask(2, workerB, 1, 1),
+			},
+			expected: map[int64]int64{2: 100},
+		},
+		{
+			name: "does not preempt if not enough slots can be freed",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 3}, slotWeight: 2, workerIds: []string{workerA, workerB}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 1, 1),
+				testPreemptibleTask(2, workerB, 1, 1),
+			},
+			expected: map[int64]int64{},
+		},
+		{
+			name: "each task is preempted at most once, highest priority first",
+			reqs: []*preemptionRequest{
+				{qi: &sqlcv1.V1QueueItem{ID: 100, Priority: 2}, slotWeight: 1, workerIds: []string{workerA}},
+				{qi: &sqlcv1.V1QueueItem{ID: 101, Priority: 3}, slotWeight: 1, workerIds: []string{workerA}},
+			},
+			candidates: []*sqlcv1.ListPreemptibleTasksForWorkersRow{
+				testPreemptibleTask(1, workerA, 1, 1),
+			},
+			expected: map[int64]int64{1: 101},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := selectPreemptionVictims(tt.reqs, tt.candidates)
+
+			assert.Equal(t, tt.expected, preemptedTaskIds(res))
+		})
+	}
+}
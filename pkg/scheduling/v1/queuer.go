@@ -11,10 +11,18 @@ import (
 
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/integrations/metrics/prometheus"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
 
+// agingInterval is the minimum interval between priority aging runs for a single queue.
+const agingInterval = 5 * time.Second
+
+// preemptionCooldown is the minimum interval between preemptions on behalf of the same queue item, which
+// gives preempted tasks time to be cancelled and release their slots.
+const preemptionCooldown = 10 * time.Second
+
 type Queuer struct {
 	repo      v1.QueueRepository
 	tenantId  pgtype.UUID
@@ -26,6 +34,9 @@ type Queuer struct {
 
 	lastReplenished *time.Time
 
+	// lastAged is the last time that priority aging was applied to the queue
+	lastAged *time.Time
+
 	limit int
 
 	resultsCh chan<- *QueueResults
@@ -43,6 +54,17 @@ type Queuer struct {
 
 	unassigned   map[int64]*sqlcv1.V1QueueItem
 	unassignedMu mutex
+
+	fairShare *fairShare
+
+	// reasons are the scheduling reasons which were last written for each queue item, so that we only write
+	// reasons which have changed
+	reasons   map[int64]string
+	reasonsMu sync.Mutex
+
+	// preempted is the last time that tasks were preempted on behalf of each queue item
+	preempted   map[int64]time.Time
+	preemptedMu sync.Mutex
 }
 
 func newQueuer(conf *sharedConfig, tenantId pgtype.UUID, queueName string, s *Scheduler, resultsCh chan<- *QueueResults) *Queuer {
@@ -70,6 +92,9 @@ func newQueuer(conf *sharedConfig, tenantId pgtype.UUID, queueName string, s *Sc
 		unacked:       make(map[int64]struct{}),
 		unassigned:    make(map[int64]*sqlcv1.V1QueueItem),
 		unassignedMu:  newMu(conf.l),
+		fairShare:     newFairShare(),
+		reasons:       make(map[int64]string),
+		preempted:     make(map[int64]time.Time),
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -182,7 +207,45 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 		desiredLabelsTime := time.Since(checkpoint)
 		checkpoint = time.Now()
 
-		assignCh := q.s.tryAssign(ctx, qis, labels, rls)
+		slotWeights, err := q.repo.GetStepSlotWeights(ctx, stepIds)
+
+		if err != nil {
+			q.l.Error().Err(err).Msg("error getting step slot weights")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
+		resources, err := q.repo.GetStepResourceRequests(ctx, stepIds)
+
+		if err != nil {
+			q.l.Error().Err(err).Msg("error getting step resource requests")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
+		gangs, err := q.repo.GetStepGangs(ctx, stepIds)
+
+		if err != nil {
+			q.l.Error().Err(err).Msg("error getting step gangs")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
+		placementStrategies, err := q.repo.GetStepPlacementStrategies(ctx, stepIds)
+
+		if err != nil {
+			q.l.Error().Err(err).Msg("error getting step placement strategies")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
+		qis = q.fairShare.order(qis, slotWeights)
+
+		assignCh := q.s.tryAssign(ctx, qis, labels, rls, slotWeights, resources, gangs, placementStrategies)
 		count := 0
 
 		countMu := sync.Mutex{}
@@ -202,6 +265,8 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 
 				numFlushed := q.flushToDatabase(ctx, ar)
 
+				q.preempt(ctx, ar, labels, slotWeights, resources)
+
 				countMu.Lock()
 				count += numFlushed
 				processedQiLength += len(ar.assigned) + len(ar.unassigned) + len(ar.schedulingTimedOut) + len(ar.rateLimited)
@@ -214,6 +279,14 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 				if numFlushed > 0 {
 					now := time.Now()
 
+					assignedQis := make([]*sqlcv1.V1QueueItem, 0, len(ar.assigned))
+
+					for _, assignedItem := range ar.assigned {
+						assignedQis = append(assignedQis, assignedItem.QueueItem)
+					}
+
+					q.fairShare.markServed(assignedQis)
+
 					for _, assignedItem := range ar.assigned {
 						prometheus.AssignedTasks.Inc()
 						prometheus.TenantAssignedTasks.WithLabelValues(q.tenantId.String()).Inc()
@@ -293,6 +366,29 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 	}
 }
 
+// ageQueueItems raises the priority of queue items which have been waiting longer than their priority
+// aging interval. This is called before the queue is replenished so that aged items are ordered ahead of
+// lower-priority items, and is throttled since aging intervals are measured in seconds.
+func (q *Queuer) ageQueueItems(ctx context.Context) {
+	if q.lastAged != nil && time.Since(*q.lastAged) < agingInterval {
+		return
+	}
+
+	now := time.Now()
+	q.lastAged = &now
+
+	aged, err := q.repo.AgeQueueItems(ctx)
+
+	if err != nil {
+		q.l.Error().Err(err).Msg("error aging queue items")
+		return
+	}
+
+	if aged > 0 {
+		q.l.Debug().Msgf("aged %d queue items in queue %s", aged, q.queueName)
+	}
+}
+
 func (q *Queuer) refillQueue(ctx context.Context) ([]*sqlcv1.V1QueueItem, error) {
 	q.unackedMu.Lock()
 	defer q.unackedMu.Unlock()
@@ -324,12 +420,16 @@ func (q *Queuer) refillQueue(ctx context.Context) ([]*sqlcv1.V1QueueItem, error)
 		q.lastReplenished = &now
 		limit := 2 * q.limit
 
+		q.ageQueueItems(ctx)
+
 		var err error
 		curr, err = q.repo.ListQueueItems(ctx, limit)
 
 		if err != nil {
 			return nil, err
 		}
+
+		q.pruneReasons(curr)
 	}
 
 	newCurr := make([]*sqlcv1.V1QueueItem, 0, len(curr))
@@ -362,6 +462,10 @@ type QueueResults struct {
 	Unassigned         []*sqlcv1.V1QueueItem
 	SchedulingTimedOut []*sqlcv1.V1QueueItem
 	RateLimited        []*v1.RateLimitResult
+
+	// Preempted are running tasks which should be cancelled and requeued to make room for higher-priority
+	// queue items
+	Preempted []*PreemptedTask
 }
 
 func (q *Queuer) ack(r *assignResults) {
@@ -374,6 +478,7 @@ func (q *Queuer) ack(r *assignResults) {
 	for _, assignedItem := range r.assigned {
 		delete(q.unacked, assignedItem.QueueItem.ID)
 		delete(q.unassigned, assignedItem.QueueItem.ID)
+		q.forgetReason(assignedItem.QueueItem.ID)
 	}
 
 	for _, unassignedItem := range r.unassigned {
@@ -384,6 +489,7 @@ func (q *Queuer) ack(r *assignResults) {
 	for _, schedulingTimedOutItem := range r.schedulingTimedOut {
 		delete(q.unacked, schedulingTimedOutItem.ID)
 		delete(q.unassigned, schedulingTimedOutItem.ID)
+		q.forgetReason(schedulingTimedOutItem.ID)
 	}
 
 	for _, rateLimitedItem := range r.rateLimited {
@@ -433,8 +539,9 @@ func (q *Queuer) flushToDatabase(ctx context.Context, r *assignResults) int {
 		stepRunIdsToAcks[assignedItem.QueueItem.TaskID] = assignedItem.AckId
 
 		opts.Assigned = append(opts.Assigned, &v1.AssignedItem{
-			WorkerId:  assignedItem.WorkerId,
-			QueueItem: assignedItem.QueueItem,
+			WorkerId:   assignedItem.WorkerId,
+			QueueItem:  assignedItem.QueueItem,
+			RateLimits: assignedItem.RateLimits,
 		})
 	}
 
@@ -491,6 +598,8 @@ func (q *Queuer) flushToDatabase(ctx context.Context, r *assignResults) int {
 	ackDuration := time.Since(checkpoint)
 	checkpoint = time.Now()
 
+	q.updateSchedulingReasons(ctx, r.reasons)
+
 	q.resultsCh <- &QueueResults{
 		TenantId:           q.tenantId,
 		Assigned:           succeeded,
@@ -517,3 +626,175 @@ func (q *Queuer) flushToDatabase(ctx context.Context, r *assignResults) int {
 
 	return len(succeeded) + len(r.schedulingTimedOut)
 }
+
+// updateSchedulingReasons writes the reasons that queue items could not be assigned. Since most queue items
+// fail for the same reason on each pass through the queue, only reasons which have changed are written.
+func (q *Queuer) updateSchedulingReasons(ctx context.Context, reasons []*v1.SchedulingReason) {
+	changed := make([]*v1.SchedulingReason, 0, len(reasons))
+
+	q.reasonsMu.Lock()
+
+	for _, r := range reasons {
+		desc := string(r.Reason) + ":" + r.Message
+
+		if q.reasons[r.QueueItemId] == desc {
+			continue
+		}
+
+		q.reasons[r.QueueItemId] = desc
+		changed = append(changed, r)
+	}
+
+	q.reasonsMu.Unlock()
+
+	if len(changed) == 0 {
+		return
+	}
+
+	if err := q.repo.UpdateSchedulingReasons(ctx, changed); err != nil {
+		q.l.Error().Err(err).Msg("error updating scheduling reasons")
+
+		// forget the reasons so that they're written on the next pass
+		for _, r := range changed {
+			q.forgetReason(r.QueueItemId)
+		}
+	}
+}
+
+func (q *Queuer) forgetReason(id int64) {
+	q.reasonsMu.Lock()
+	defer q.reasonsMu.Unlock()
+
+	delete(q.reasons, id)
+}
+
+// pruneReasons forgets the reasons for queue items which are no longer in the queue, for example because
+// the task was cancelled.
+func (q *Queuer) pruneReasons(qis []*sqlcv1.V1QueueItem) {
+	q.reasonsMu.Lock()
+	defer q.reasonsMu.Unlock()
+
+	queued := make(map[int64]struct{}, len(qis))
+
+	for _, qi := range qis {
+		queued[qi.ID] = struct{}{}
+	}
+
+	for id := range q.reasons {
+		if _, ok := queued[id]; !ok {
+			delete(q.reasons, id)
+		}
+	}
+}
+
+// preempt selects lower-priority preemptible tasks to cancel on behalf of queue items which could not be
+// assigned because no slots were available. Queue items which request named resources are not considered,
+// since preempting a task does not guarantee that the requested resources are freed.
+func (q *Queuer) preempt(
+	ctx context.Context,
+	r *assignResults,
+	stepIdsToLabels map[string][]*sqlcv1.GetDesiredLabelsRow,
+	stepIdsToSlotWeights map[string]int32,
+	stepIdsToResources map[string]map[string]int32,
+) {
+	noSlots := make(map[int64]bool, len(r.reasons))
+
+	for _, reason := range r.reasons {
+		if reason.Reason == sqlcv1.V1SchedulingReasonNOSLOTS || reason.Reason == sqlcv1.V1SchedulingReasonNOWORKERS {
+			noSlots[reason.QueueItemId] = true
+		}
+	}
+
+	if len(noSlots) == 0 {
+		return
+	}
+
+	now := time.Now()
+
+	q.preemptedMu.Lock()
+
+	for id, preemptedAt := range q.preempted {
+		if now.Sub(preemptedAt) > preemptionCooldown {
+			delete(q.preempted, id)
+		}
+	}
+
+	reqs := make([]*preemptionRequest, 0)
+	uniqueWorkerIds := make(map[string]bool)
+	var maxPriority int32
+
+	for _, qi := range r.unassigned {
+		stepId := sqlchelpers.UUIDToStr(qi.StepID)
+
+		// priority 1 is the lowest priority, so these queue items can never preempt another task
+		if !noSlots[qi.ID] || qi.Priority <= 1 || len(stepIdsToResources[stepId]) > 0 {
+			continue
+		}
+
+		if _, ok := q.preempted[qi.ID]; ok {
+			continue
+		}
+
+		workerIds := q.s.getPreemptionWorkers(qi, stepIdsToLabels[stepId])
+
+		if len(workerIds) == 0 {
+			continue
+		}
+
+		for _, workerId := range workerIds {
+			uniqueWorkerIds[workerId] = true
+		}
+
+		maxPriority = max(maxPriority, qi.Priority)
+
+		reqs = append(reqs, &preemptionRequest{
+			qi:         qi,
+			slotWeight: getSlotWeight(stepIdsToSlotWeights, stepId),
+			workerIds:  workerIds,
+		})
+	}
+
+	q.preemptedMu.Unlock()
+
+	if len(reqs) == 0 {
+		return
+	}
+
+	workerUUIDs := make([]pgtype.UUID, 0, len(uniqueWorkerIds))
+
+	for workerId := range uniqueWorkerIds {
+		workerUUIDs = append(workerUUIDs, sqlchelpers.UUIDFromStr(workerId))
+	}
+
+	candidates, err := q.repo.ListPreemptibleTasks(ctx, workerUUIDs, maxPriority)
+
+	if err != nil {
+		q.l.Error().Err(err).Msg("error listing preemptible tasks")
+		return
+	}
+
+	if len(candidates) == 0 {
+		return
+	}
+
+	preempted := selectPreemptionVictims(reqs, candidates)
+
+	if len(preempted) == 0 {
+		return
+	}
+
+	q.preemptedMu.Lock()
+
+	for _, p := range preempted {
+		q.preempted[p.PreemptedBy.ID] = now
+	}
+
+	q.preemptedMu.Unlock()
+
+	q.l.Debug().Int("preempted", len(preempted)).Msgf("preempting tasks in queue %s", q.queueName)
+
+	q.resultsCh <- &QueueResults{
+		TenantId:  q.tenantId,
+		Preempted: preempted,
+	}
+}
@@ -0,0 +1,153 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+type mockQueueRepo struct {
+	mock.Mock
+}
+
+func (m *mockQueueRepo) ListQueueItems(ctx context.Context, limit int) ([]*sqlcv1.V1QueueItem, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*sqlcv1.V1QueueItem), args.Error(1)
+}
+
+func (m *mockQueueRepo) MarkQueueItemsProcessed(ctx context.Context, r *v1.AssignResults) ([]*v1.AssignedItem, []*v1.AssignedItem, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).([]*v1.AssignedItem), args.Get(1).([]*v1.AssignedItem), args.Error(2)
+}
+
+func (m *mockQueueRepo) GetTaskRateLimits(ctx context.Context, queueItems []*sqlcv1.V1QueueItem) (map[int64]map[string]int32, error) {
+	args := m.Called(ctx, queueItems)
+	return args.Get(0).(map[int64]map[string]int32), args.Error(1)
+}
+
+func (m *mockQueueRepo) GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) (map[string][]*sqlcv1.GetDesiredLabelsRow, error) {
+	args := m.Called(ctx, stepIds)
+	return args.Get(0).(map[string][]*sqlcv1.GetDesiredLabelsRow), args.Error(1)
+}
+
+func (m *mockQueueRepo) GetStepSlotWeights(ctx context.Context, stepIds []pgtype.UUID) (map[string]int32, error) {
+	args := m.Called(ctx, stepIds)
+	return args.Get(0).(map[string]int32), args.Error(1)
+}
+
+func (m *mockQueueRepo) GetStepResourceRequests(ctx context.Context, stepIds []pgtype.UUID) (map[string]map[string]int32, error) {
+	args := m.Called(ctx, stepIds)
+	return args.Get(0).(map[string]map[string]int32), args.Error(1)
+}
+
+func (m *mockQueueRepo) GetStepGangs(ctx context.Context, stepIds []pgtype.UUID) (map[string]*v1.StepGang, error) {
+	args := m.Called(ctx, stepIds)
+	return args.Get(0).(map[string]*v1.StepGang), args.Error(1)
+}
+
+func (m *mockQueueRepo) GetStepPlacementStrategies(ctx context.Context, stepIds []pgtype.UUID) (map[string]v1.PlacementStrategy, error) {
+	args := m.Called(ctx, stepIds)
+	return args.Get(0).(map[string]v1.PlacementStrategy), args.Error(1)
+}
+
+func (m *mockQueueRepo) AgeQueueItems(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockQueueRepo) UpdateSchedulingReasons(ctx context.Context, reasons []*v1.SchedulingReason) error {
+	args := m.Called(ctx, reasons)
+	return args.Error(0)
+}
+
+func (m *mockQueueRepo) ListPreemptibleTasks(ctx context.Context, workerIds []pgtype.UUID, belowPriority int32) ([]*sqlcv1.ListPreemptibleTasksForWorkersRow, error) {
+	args := m.Called(ctx, workerIds, belowPriority)
+	return args.Get(0).([]*sqlcv1.ListPreemptibleTasksForWorkersRow), args.Error(1)
+}
+
+func (m *mockQueueRepo) Cleanup() {
+	m.Called()
+}
+
+func TestQueuer_AgeQueueItemsIsThrottled(t *testing.T) {
+	l := zerolog.Nop()
+	repo := &mockQueueRepo{}
+
+	q := &Queuer{
+		repo:      repo,
+		queueName: "default",
+		l:         &l,
+	}
+
+	repo.On("AgeQueueItems", mock.Anything).Return(2, nil).Once()
+
+	q.ageQueueItems(context.Background())
+	q.ageQueueItems(context.Background())
+
+	repo.AssertNumberOfCalls(t, "AgeQueueItems", 1)
+
+	// once the aging interval has elapsed, items are aged again
+	lastAged := time.Now().Add(-agingInterval)
+	q.lastAged = &lastAged
+
+	repo.On("AgeQueueItems", mock.Anything).Return(0, errors.New("aging failed")).Once()
+
+	q.ageQueueItems(context.Background())
+
+	repo.AssertNumberOfCalls(t, "AgeQueueItems", 2)
+	assert.WithinDuration(t, time.Now(), *q.lastAged, time.Second)
+}
+
+func TestQueuer_UpdateSchedulingReasonsOnlyWritesChanges(t *testing.T) {
+	l := zerolog.Nop()
+	repo := &mockQueueRepo{}
+
+	q := &Queuer{
+		repo:      repo,
+		queueName: "default",
+		l:         &l,
+		reasons:   make(map[int64]string),
+	}
+
+	noSlots := &v1.SchedulingReason{
+		QueueItemId: 1,
+		Reason:      sqlcv1.V1SchedulingReasonNOSLOTS,
+		Message:     "no worker slots are available",
+	}
+
+	rateLimited := &v1.SchedulingReason{
+		QueueItemId: 1,
+		Reason:      sqlcv1.V1SchedulingReasonRATELIMITED,
+		Message:     "rate limit key exceeded: requested 1 units",
+	}
+
+	repo.On("UpdateSchedulingReasons", mock.Anything, []*v1.SchedulingReason{noSlots}).Return(nil).Once()
+
+	q.updateSchedulingReasons(context.Background(), []*v1.SchedulingReason{noSlots})
+	q.updateSchedulingReasons(context.Background(), []*v1.SchedulingReason{noSlots})
+
+	repo.AssertNumberOfCalls(t, "UpdateSchedulingReasons", 1)
+
+	// a changed reason is written again
+	repo.On("UpdateSchedulingReasons", mock.Anything, []*v1.SchedulingReason{rateLimited}).Return(nil).Once()
+
+	q.updateSchedulingReasons(context.Background(), []*v1.SchedulingReason{rateLimited})
+
+	repo.AssertNumberOfCalls(t, "UpdateSchedulingReasons", 2)
+
+	// reasons are forgotten once the queue item leaves the queue
+	q.pruneReasons([]*sqlcv1.V1QueueItem{})
+
+	assert.Empty(t, q.reasons)
+}
@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"sync"
+)
+
+// workerResources tracks the named resource capacities of a single worker, along with the units
+// which are currently available for assignment.
+type workerResources struct {
+	capacity  map[string]int32
+	available map[string]int32
+
+	// unacked are reservations which have not been written to the database yet. They count towards
+	// the used units until they are acked or nacked.
+	unacked map[*resourceReservation]struct{}
+
+	mu sync.Mutex
+}
+
+type resourceReservation struct {
+	wr    *workerResources
+	units map[string]int32
+}
+
+func newWorkerResources() *workerResources {
+	return &workerResources{
+		capacity:  make(map[string]int32),
+		available: make(map[string]int32),
+		unacked:   make(map[*resourceReservation]struct{}),
+	}
+}
+
+// replenish resets the available units based on the worker's capacity and the units which are in use
+// in the database.
+func (w *workerResources) replenish(capacity map[string]int32, used map[string]int32) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.capacity = capacity
+	w.available = make(map[string]int32, len(capacity))
+
+	for key, c := range capacity {
+		w.available[key] = c - used[key]
+	}
+
+	for r := range w.unacked {
+		for key, units := range r.units {
+			w.available[key] -= units
+		}
+	}
+}
+
+// reserve attempts to reserve the requested units. Reservations fail if the worker does not have
+// the resource, or if any of the resources don't have enough units available.
+func (w *workerResources) reserve(units map[string]int32) (*resourceReservation, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key, u := range units {
+		if available, ok := w.available[key]; !ok || available < u {
+			return nil, false
+		}
+	}
+
+	for key, u := range units {
+		w.available[key] -= u
+	}
+
+	r := &resourceReservation{
+		wr:    w,
+		units: units,
+	}
+
+	w.unacked[r] = struct{}{}
+
+	return r, true
+}
+
+func (w *workerResources) utilization() map[string]*ResourceUtilization {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	res := make(map[string]*ResourceUtilization, len(w.capacity))
+
+	for key, c := range w.capacity {
+		available := w.available[key]
+
+		if available < 0 {
+			available = 0
+		}
+
+		res[key] = &ResourceUtilization{
+			Capacity:  int(c),
+			Utilized:  int(c - available),
+			Available: int(available),
+		}
+	}
+
+	return res
+}
+
+// ack marks the reservation as written to the database. The units remain unavailable until the next
+// replenish, which reads the units from the database.
+func (r *resourceReservation) ack() {
+	r.wr.mu.Lock()
+	defer r.wr.mu.Unlock()
+
+	delete(r.wr.unacked, r)
+}
+
+// nack releases the reserved units.
+func (r *resourceReservation) nack() {
+	r.wr.mu.Lock()
+	defer r.wr.mu.Unlock()
+
+	if _, ok := r.wr.unacked[r]; !ok {
+		return
+	}
+
+	delete(r.wr.unacked, r)
+
+	for key, units := range r.units {
+		if _, ok := r.wr.available[key]; ok {
+			r.wr.available[key] += units
+		}
+	}
+}
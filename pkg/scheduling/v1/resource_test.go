@@ -0,0 +1,105 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func TestWorkerResources_Reserve(t *testing.T) {
+	wr := newWorkerResources()
+	wr.replenish(map[string]int32{"gpu_mem": 16, "db_connections": 4}, map[string]int32{"gpu_mem": 4})
+
+	r, ok := wr.reserve(map[string]int32{"gpu_mem": 8, "db_connections": 2})
+	assert.True(t, ok)
+	assert.NotNil(t, r)
+
+	// only 4 units of gpu_mem are left
+	_, ok = wr.reserve(map[string]int32{"gpu_mem": 8})
+	assert.False(t, ok)
+
+	// the worker doesn't have this resource
+	_, ok = wr.reserve(map[string]int32{"cpu_millis": 1})
+	assert.False(t, ok)
+
+	util := wr.utilization()
+	assert.Equal(t, 16, util["gpu_mem"].Capacity)
+	assert.Equal(t, 12, util["gpu_mem"].Utilized)
+	assert.Equal(t, 4, util["gpu_mem"].Available)
+	assert.Equal(t, 2, util["db_connections"].Available)
+}
+
+func TestWorkerResources_AckNack(t *testing.T) {
+	wr := newWorkerResources()
+	wr.replenish(map[string]int32{"gpu_mem": 16}, nil)
+
+	r1, ok := wr.reserve(map[string]int32{"gpu_mem": 8})
+	assert.True(t, ok)
+
+	r2, ok := wr.reserve(map[string]int32{"gpu_mem": 8})
+	assert.True(t, ok)
+
+	// nacking releases the units
+	r1.nack()
+	assert.Equal(t, 8, wr.utilization()["gpu_mem"].Available)
+
+	// nacking twice is a no-op
+	r1.nack()
+	assert.Equal(t, 8, wr.utilization()["gpu_mem"].Available)
+
+	// acking keeps the units in use until the next replenish
+	r2.ack()
+	assert.Equal(t, 8, wr.utilization()["gpu_mem"].Available)
+
+	wr.replenish(map[string]int32{"gpu_mem": 16}, map[string]int32{"gpu_mem": 8})
+	assert.Equal(t, 8, wr.utilization()["gpu_mem"].Available)
+}
+
+func TestWorkerResources_ReplenishCountsUnacked(t *testing.T) {
+	wr := newWorkerResources()
+	wr.replenish(map[string]int32{"gpu_mem": 16}, nil)
+
+	_, ok := wr.reserve(map[string]int32{"gpu_mem": 10})
+	assert.True(t, ok)
+
+	// the unacked reservation is not in the database yet, so it should still count against the capacity
+	wr.replenish(map[string]int32{"gpu_mem": 16}, nil)
+	assert.Equal(t, 6, wr.utilization()["gpu_mem"].Available)
+}
+
+func TestFindSlotGroup_Resources(t *testing.T) {
+	worker1 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+	worker2 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId2}}
+
+	resources := map[string]*workerResources{
+		stableWorkerId1: newWorkerResources(),
+		stableWorkerId2: newWorkerResources(),
+	}
+
+	resources[stableWorkerId1].replenish(map[string]int32{"gpu_mem": 4}, nil)
+	resources[stableWorkerId2].replenish(map[string]int32{"gpu_mem": 16}, nil)
+
+	reserve := func(workerId string) (*resourceReservation, bool) {
+		return resources[workerId].reserve(map[string]int32{"gpu_mem": 8})
+	}
+
+	slots := []*slot{
+		newSlot(worker1, []string{}),
+		newSlot(worker2, []string{}),
+	}
+
+	group := findSlotGroup(slots, 1, reserve, func() {}, func() {})
+
+	assert.Len(t, group, 1)
+	assert.Equal(t, stableWorkerId2, group[0].getWorkerId())
+	assert.False(t, slots[0].isUsed())
+	assert.Equal(t, 8, resources[stableWorkerId2].utilization()["gpu_mem"].Available)
+
+	// nacking the slot releases the reserved resources
+	group[0].nack()
+	assert.Equal(t, 16, resources[stableWorkerId2].utilization()["gpu_mem"].Available)
+}
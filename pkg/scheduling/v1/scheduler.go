@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
@@ -29,6 +30,10 @@ type Scheduler struct {
 	actionsMu   rwMutex
 	replenishMu mutex
 
+	// actionWorkers are the ids of the active workers for each action, including workers which have
+	// no available slots. This is guarded by actionsMu.
+	actionWorkers map[string][]string
+
 	workersMu mutex
 	workers   map[string]*worker
 
@@ -36,10 +41,15 @@ type Scheduler struct {
 	assignedCountMu mutex
 
 	// unackedSlots are slots which have been assigned to a worker, but have not been flushed
-	// to the database yet. They negatively count towards a worker's available slot count.
-	unackedSlots map[int]*slot
+	// to the database yet. They negatively count towards a worker's available slot count. A single
+	// assignment may hold more than one slot if the task has a slot weight greater than 1.
+	unackedSlots map[int][]*slot
 	unackedMu    mutex
 
+	// resources tracks the named resource capacities of each worker
+	resources   map[string]*workerResources
+	resourcesMu mutex
+
 	rl   *rateLimiter
 	exts *Extensions
 }
@@ -52,13 +62,16 @@ func newScheduler(cf *sharedConfig, tenantId pgtype.UUID, rl *rateLimiter, exts
 		tenantId:        tenantId,
 		l:               &l,
 		actions:         make(map[string]*action),
-		unackedSlots:    make(map[int]*slot),
+		actionWorkers:   make(map[string][]string),
+		unackedSlots:    make(map[int][]*slot),
 		rl:              rl,
 		actionsMu:       newRWMu(cf.l),
 		replenishMu:     newMu(cf.l),
 		workersMu:       newMu(cf.l),
 		assignedCountMu: newMu(cf.l),
 		unackedMu:       newMu(cf.l),
+		resources:       make(map[string]*workerResources),
+		resourcesMu:     newMu(cf.l),
 		exts:            exts,
 	}
 }
@@ -68,8 +81,11 @@ func (s *Scheduler) ack(ids []int) {
 	defer s.unackedMu.Unlock()
 
 	for _, id := range ids {
-		if slot, ok := s.unackedSlots[id]; ok {
-			slot.ack()
+		if slots, ok := s.unackedSlots[id]; ok {
+			for _, slot := range slots {
+				slot.ack()
+			}
+
 			delete(s.unackedSlots, id)
 		}
 	}
@@ -80,8 +96,11 @@ func (s *Scheduler) nack(ids []int) {
 	defer s.unackedMu.Unlock()
 
 	for _, id := range ids {
-		if slot, ok := s.unackedSlots[id]; ok {
-			slot.nack()
+		if slots, ok := s.unackedSlots[id]; ok {
+			for _, slot := range slots {
+				slot.nack()
+			}
+
 			delete(s.unackedSlots, id)
 		}
 	}
@@ -100,6 +119,16 @@ func (s *Scheduler) setWorkers(workers []*v1.ListActiveWorkersResult) {
 	}
 
 	s.workers = newWorkers
+
+	// clean up resource tracking for workers which are no longer active
+	s.resourcesMu.Lock()
+	defer s.resourcesMu.Unlock()
+
+	for workerId := range s.resources {
+		if _, ok := newWorkers[workerId]; !ok {
+			delete(s.resources, workerId)
+		}
+	}
 }
 
 func (s *Scheduler) getWorkers() map[string]*worker {
@@ -109,6 +138,20 @@ func (s *Scheduler) getWorkers() map[string]*worker {
 	return s.workers
 }
 
+func (s *Scheduler) getWorkerResources(workerId string) *workerResources {
+	s.resourcesMu.Lock()
+	defer s.resourcesMu.Unlock()
+
+	wr, ok := s.resources[workerId]
+
+	if !ok {
+		wr = newWorkerResources()
+		s.resources[workerId] = wr
+	}
+
+	return wr
+}
+
 // replenish loads new slots from the database.
 func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	if ok := s.replenishMu.TryLock(); !ok {
@@ -173,6 +216,8 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 		workerIdsToActions[workerId] = append(workerIdsToActions[workerId], actionId)
 	}
 
+	s.actionWorkers = actionsToWorkerIds
+
 	// FUNCTION 1: determine which actions should be replenished. Logic is the following:
 	// - zero or one slots for an action: replenish all slots
 	// - some slots for an action: replenish if 50% of slots have been used, or have expired
@@ -272,19 +317,65 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	}
 
 	s.l.Debug().Msgf("loading available slots took %s", time.Since(checkpoint))
+	checkpoint = time.Now()
+
+	// load the resources in use for workers which have registered resource capacities
+	workerUUIDsWithResources := make([]pgtype.UUID, 0)
+
+	for _, workerUUID := range workerUUIDs {
+		if w, ok := workers[sqlchelpers.UUIDToStr(workerUUID)]; ok && len(w.Resources) > 0 {
+			workerUUIDsWithResources = append(workerUUIDsWithResources, workerUUID)
+		}
+	}
+
+	workersToUsedResources := make(map[string]map[string]int32)
+
+	if len(workerUUIDsWithResources) > 0 {
+		usedResources, err := s.repo.ListResourceUsageForWorkers(ctx, s.tenantId, workerUUIDsWithResources)
+
+		if err != nil {
+			return err
+		}
+
+		for _, row := range usedResources {
+			workerId := sqlchelpers.UUIDToStr(row.WorkerID)
+
+			if _, ok := workersToUsedResources[workerId]; !ok {
+				workersToUsedResources[workerId] = make(map[string]int32)
+			}
+
+			workersToUsedResources[workerId][row.Key] = row.Units
+		}
+
+		s.l.Debug().Msgf("loading used resources took %s", time.Since(checkpoint))
+	}
+
+	for _, workerUUID := range workerUUIDs {
+		workerId := sqlchelpers.UUIDToStr(workerUUID)
+
+		var capacity map[string]int32
+
+		if w, ok := workers[workerId]; ok {
+			capacity = w.Resources
+		}
+
+		s.getWorkerResources(workerId).replenish(capacity, workersToUsedResources[workerId])
+	}
 
 	// FUNCTION 3: list unacked slots (so they're not counted towards the worker slot count)
 	workersToUnackedSlots := make(map[string][]*slot)
 
-	for _, unackedSlot := range s.unackedSlots {
-		s := unackedSlot
-		workerId := s.getWorkerId()
+	for _, unackedSlots := range s.unackedSlots {
+		for _, unackedSlot := range unackedSlots {
+			s := unackedSlot
+			workerId := s.getWorkerId()
 
-		if _, ok := workersToUnackedSlots[workerId]; !ok {
-			workersToUnackedSlots[workerId] = make([]*slot, 0)
-		}
+			if _, ok := workersToUnackedSlots[workerId]; !ok {
+				workersToUnackedSlots[workerId] = make([]*slot, 0)
+			}
 
-		workersToUnackedSlots[workerId] = append(workersToUnackedSlots[workerId], s)
+			workersToUnackedSlots[workerId] = append(workersToUnackedSlots[workerId], s)
+		}
 	}
 
 	// FUNCTION 4: write the new slots to the scheduler and clean up expired slots
@@ -432,6 +523,10 @@ type assignSingleResult struct {
 	noSlots   bool
 	succeeded bool
 
+	// reason and message explain why the queue item could not be assigned, if it wasn't
+	reason  sqlcv1.V1SchedulingReason
+	message string
+
 	rateLimitResult *scheduleRateLimitResult
 }
 
@@ -446,6 +541,9 @@ func (s *Scheduler) tryAssignBatch(
 	ringOffset int,
 	stepIdsToLabels map[string][]*sqlcv1.GetDesiredLabelsRow,
 	taskIdsToRateLimits map[int64]map[string]int32,
+	stepIdsToSlotWeights map[string]int32,
+	stepIdsToResources map[string]map[string]int32,
+	stepIdsToPlacement map[string]v1.PlacementStrategy,
 ) (
 	res []*assignSingleResult, newRingOffset int, err error,
 ) {
@@ -521,6 +619,14 @@ func (s *Scheduler) tryAssignBatch(
 		for i := range res {
 			res[i].noSlots = true
 			rlNacks[i]()
+
+			if !ok {
+				res[i].reason = sqlcv1.V1SchedulingReasonNOWORKERS
+				res[i].message = fmt.Sprintf("no workers are registered for action %s", actionId)
+			} else {
+				res[i].reason = sqlcv1.V1SchedulingReasonNOSLOTS
+				res[i].message = "no worker slots are available"
+			}
 		}
 
 		return res, newRingOffset, nil
@@ -542,6 +648,8 @@ func (s *Scheduler) tryAssignBatch(
 
 		if denom == 0 {
 			res[i].noSlots = true
+			res[i].reason = sqlcv1.V1SchedulingReasonNOSLOTS
+			res[i].message = "no worker slots are available"
 			rlNacks[i]()
 
 			continue
@@ -550,13 +658,17 @@ func (s *Scheduler) tryAssignBatch(
 		childRingOffset := newRingOffset % denom
 
 		qi := qis[i]
+		stepId := sqlchelpers.UUIDToStr(qi.StepID)
 
 		singleRes, err := s.tryAssignSingleton(
 			ctx,
 			qi,
 			candidateSlots,
 			childRingOffset,
-			stepIdsToLabels[sqlchelpers.UUIDToStr(qi.StepID)],
+			stepIdsToLabels[stepId],
+			getSlotWeight(stepIdsToSlotWeights, stepId),
+			stepIdsToResources[stepId],
+			stepIdsToPlacement[stepId],
 			rlAcks[i],
 			rlNacks[i],
 		)
@@ -601,13 +713,120 @@ func findSlot(
 	return assignedSlot
 }
 
-// tryAssignSingleton attempts to assign a singleton step to a worker.
+// findSlotGroup finds a worker with at least weight active slots and reserves weight slots on that worker.
+// Workers are considered in the order in which their first active slot appears in candidateSlots, so ranked
+// slots and the ring offset are respected. If reserve is set, it is called to reserve any additional worker
+// resources before the slots are used, and workers where the reservation fails are skipped. The rate limit
+// and resource callbacks are attached to exactly one slot of the group, so that they are only called once
+// when the group is acked or nacked.
+func findSlotGroup(
+	candidateSlots []*slot,
+	weight int,
+	reserve func(workerId string) (*resourceReservation, bool),
+	rateLimitAck func(),
+	rateLimitNack func(),
+) []*slot {
+	workerOrder := make([]string, 0)
+	workersToSlots := make(map[string][]*slot)
+
+	for _, slot := range candidateSlots {
+		if !slot.active() {
+			continue
+		}
+
+		workerId := slot.getWorkerId()
+
+		if _, ok := workersToSlots[workerId]; !ok {
+			workerOrder = append(workerOrder, workerId)
+		}
+
+		workersToSlots[workerId] = append(workersToSlots[workerId], slot)
+	}
+
+	for _, workerId := range workerOrder {
+		workerSlots := workersToSlots[workerId]
+
+		if len(workerSlots) < weight {
+			continue
+		}
+
+		acks := []func(){rateLimitAck}
+		nacks := []func(){rateLimitNack}
+
+		var reservation *resourceReservation
+
+		if reserve != nil {
+			var ok bool
+
+			if reservation, ok = reserve(workerId); !ok {
+				continue
+			}
+
+			acks = append(acks, reservation.ack)
+			nacks = append(nacks, reservation.nack)
+		}
+
+		group := make([]*slot, 0, weight)
+
+		for _, slot := range workerSlots {
+			if len(group) == weight {
+				break
+			}
+
+			// the last slot in the group carries the rate limit and resource callbacks
+			if len(group) == weight-1 {
+				if slot.use(acks, nacks) {
+					group = append(group, slot)
+				}
+
+				continue
+			}
+
+			if slot.use(nil, nil) {
+				group = append(group, slot)
+			}
+		}
+
+		if len(group) == weight {
+			return group
+		}
+
+		// we couldn't reserve enough slots on this worker, so release the slots we did reserve. none of these
+		// slots carry the rate limit callbacks, since the last slot is the only one which does.
+		for _, slot := range group {
+			slot.nack()
+		}
+
+		if reservation != nil {
+			reservation.nack()
+		}
+	}
+
+	return nil
+}
+
+// getSlotWeight returns the number of slots which a step consumes, defaulting to 1.
+func getSlotWeight(stepIdsToSlotWeights map[string]int32, stepId string) int {
+	if weight, ok := stepIdsToSlotWeights[stepId]; ok && weight > 1 {
+		return int(weight)
+	}
+
+	return 1
+}
+
+// tryAssignSingleton attempts to assign a singleton step to a worker. If the step has a slot weight greater
+// than 1, the step is only assigned if slotWeight slots can be reserved on a single worker. If the step requests
+// named resources, the step is only assigned to a worker where all requested resources fit. The placement
+// strategy determines the order in which workers are considered.
 func (s *Scheduler) tryAssignSingleton(
 	ctx context.Context,
 	qi *sqlcv1.V1QueueItem,
 	candidateSlots []*slot,
 	ringOffset int,
 	labels []*sqlcv1.GetDesiredLabelsRow,
+	slotWeight int,
+	resources map[string]int32,
+	placementStrategy v1.PlacementStrategy,
 	rateLimitAck func(),
 	rateLimitNack func(),
 ) (
@@ -618,19 +837,49 @@ func (s *Scheduler) tryAssignSingleton(
 
 	ringOffset = ringOffset % len(candidateSlots)
 
+	// placement strategies other than SPREAD replace the ring order. this happens before ranking, so sticky
+	// and label ranks still take precedence over worker load.
+	if orderedSlots, ok := orderSlotsByPlacement(candidateSlots, placementStrategy); ok {
+		candidateSlots = orderedSlots
+		ringOffset = 0
+	}
+
 	if (qi.Sticky != sqlcv1.V1StickyStrategyNONE) || len(labels) > 0 {
 		candidateSlots = getRankedSlots(qi, labels, candidateSlots)
 		ringOffset = 0
 	}
 
-	assignedSlot := findSlot(candidateSlots[ringOffset:], rateLimitAck, rateLimitNack)
+	var assignedSlots []*slot
+
+	if slotWeight <= 1 && len(resources) == 0 {
+		assignedSlot := findSlot(candidateSlots[ringOffset:], rateLimitAck, rateLimitNack)
+
+		if assignedSlot == nil {
+			assignedSlot = findSlot(candidateSlots[:ringOffset], rateLimitAck, rateLimitNack)
+		}
 
-	if assignedSlot == nil {
-		assignedSlot = findSlot(candidateSlots[:ringOffset], rateLimitAck, rateLimitNack)
+		if assignedSlot != nil {
+			assignedSlots = []*slot{assignedSlot}
+		}
+	} else {
+		ringSlots := make([]*slot, 0, len(candidateSlots))
+		ringSlots = append(ringSlots, candidateSlots[ringOffset:]...)
+		ringSlots = append(ringSlots, candidateSlots[:ringOffset]...)
+
+		var reserve func(workerId string) (*resourceReservation, bool)
+
+		if len(resources) > 0 {
+			reserve = func(workerId string) (*resourceReservation, bool) {
+				return s.getWorkerResources(workerId).reserve(resources)
+			}
+		}
+
+		assignedSlots = findSlotGroup(ringSlots, max(slotWeight, 1), reserve, rateLimitAck, rateLimitNack)
 	}
 
-	if assignedSlot == nil {
+	if len(assignedSlots) == 0 {
 		res.noSlots = true
+		res.reason, res.message = explainNoSlots(qi, candidateSlots, labels, slotWeight, resources)
 		return res, nil
 	}
 
@@ -640,10 +889,10 @@ func (s *Scheduler) tryAssignSingleton(
 	s.assignedCountMu.Unlock()
 
 	s.unackedMu.Lock()
-	s.unackedSlots[res.ackId] = assignedSlot
+	s.unackedSlots[res.ackId] = assignedSlots
 	s.unackedMu.Unlock()
 
-	res.workerId = sqlchelpers.UUIDFromStr(assignedSlot.getWorkerId())
+	res.workerId = sqlchelpers.UUIDFromStr(assignedSlots[0].getWorkerId())
 	res.succeeded = true
 
 	return res, nil
@@ -654,6 +903,9 @@ type assignedQueueItem struct {
 	WorkerId pgtype.UUID
 
 	QueueItem *sqlcv1.V1QueueItem
+
+	// RateLimits are the units of each rate limit consumed by the assignment
+	RateLimits map[string]int32
 }
 
 type assignResults struct {
@@ -661,6 +913,9 @@ type assignResults struct {
 	unassigned         []*sqlcv1.V1QueueItem
 	schedulingTimedOut []*sqlcv1.V1QueueItem
 	rateLimited        []*scheduleRateLimitResult
+
+	// reasons explains why the unassigned and rate limited queue items could not be assigned
+	reasons []*v1.SchedulingReason
 }
 
 func (s *Scheduler) tryAssign(
@@ -668,9 +923,16 @@ func (s *Scheduler) tryAssign(
 	qis []*sqlcv1.V1QueueItem,
 	stepIdsToLabels map[string][]*sqlcv1.GetDesiredLabelsRow,
 	taskIdsToRateLimits map[int64]map[string]int32,
+	stepIdsToSlotWeights map[string]int32,
+	stepIdsToResources map[string]map[string]int32,
+	stepIdsToGangs map[string]*v1.StepGang,
+	stepIdsToPlacement map[string]v1.PlacementStrategy,
 ) <-chan *assignResults {
 	ctx, span := telemetry.NewSpan(ctx, "try-assign")
 
+	// gang members may span multiple actions, so they're assigned separately from the rest of the queue items
+	qis, gangs := splitGangs(qis, stepIdsToGangs)
+
 	// split into groups based on action ids, and process each action id in parallel
 	actionIdToQueueItems := make(map[string][]*sqlcv1.V1QueueItem)
 
@@ -686,7 +948,7 @@ func (s *Scheduler) tryAssign(
 		actionIdToQueueItems[actionId] = append(actionIdToQueueItems[actionId], qi)
 	}
 
-	resultsCh := make(chan *assignResults, len(actionIdToQueueItems))
+	resultsCh := make(chan *assignResults, len(actionIdToQueueItems)+1)
 
 	go func() {
 		wg := sync.WaitGroup{}
@@ -695,6 +957,22 @@ func (s *Scheduler) tryAssign(
 		extensionResults := make([]*assignResults, 0)
 		extensionResultsMu := sync.Mutex{}
 
+		if len(gangs) > 0 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				r := s.tryAssignGangs(ctx, gangs, stepIdsToLabels, taskIdsToRateLimits, stepIdsToSlotWeights, stepIdsToResources, stepIdsToPlacement)
+
+				extensionResultsMu.Lock()
+				extensionResults = append(extensionResults, r)
+				extensionResultsMu.Unlock()
+
+				resultsCh <- r
+			}()
+		}
+
 		// process each action id in parallel
 		for actionId, qis := range actionIdToQueueItems {
 			wg.Add(1)
@@ -726,10 +1004,11 @@ func (s *Scheduler) tryAssign(
 					batchAssigned := make([]*assignedQueueItem, 0, len(batchQis))
 					batchRateLimited := make([]*scheduleRateLimitResult, 0, len(batchQis))
 					batchUnassigned := make([]*sqlcv1.V1QueueItem, 0, len(batchQis))
+					batchReasons := make([]*v1.SchedulingReason, 0, len(batchQis))
 
 					batchStart := time.Now()
 
-					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, taskIdsToRateLimits)
+					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, taskIdsToRateLimits, stepIdsToSlotWeights, stepIdsToResources, stepIdsToPlacement)
 
 					if err != nil {
 						return err
@@ -741,11 +1020,25 @@ func (s *Scheduler) tryAssign(
 						if !singleRes.succeeded {
 							if singleRes.rateLimitResult != nil {
 								batchRateLimited = append(batchRateLimited, singleRes.rateLimitResult)
+
+								reason, message := explainRateLimited(singleRes.rateLimitResult)
+
+								batchReasons = append(batchReasons, &v1.SchedulingReason{
+									QueueItemId: singleRes.qi.ID,
+									Reason:      reason,
+									Message:     message,
+								})
 							} else {
 								batchUnassigned = append(batchUnassigned, singleRes.qi)
 
 								if !singleRes.noSlots {
 									s.l.Error().Msgf("scheduling failed for queue item %d: expected assignment to fail with either no slots or rate limit exceeded, but failed with neither", singleRes.qi.ID)
+								} else if singleRes.reason != "" {
+									batchReasons = append(batchReasons, &v1.SchedulingReason{
+										QueueItemId: singleRes.qi.ID,
+										Reason:      singleRes.reason,
+										Message:     singleRes.message,
+									})
 								}
 							}
 
@@ -753,9 +1046,10 @@ func (s *Scheduler) tryAssign(
 						}
 
 						batchAssigned = append(batchAssigned, &assignedQueueItem{
-							WorkerId:  singleRes.workerId,
-							QueueItem: singleRes.qi,
-							AckId:     singleRes.ackId,
+							WorkerId:   singleRes.workerId,
+							QueueItem:  singleRes.qi,
+							AckId:      singleRes.ackId,
+							RateLimits: taskIdsToRateLimits[singleRes.qi.TaskID],
 						})
 					}
 
@@ -767,6 +1061,7 @@ func (s *Scheduler) tryAssign(
 						assigned:    batchAssigned,
 						rateLimited: batchRateLimited,
 						unassigned:  batchUnassigned,
+						reasons:     batchReasons,
 					}
 
 					extensionResultsMu.Lock()
@@ -827,14 +1122,23 @@ func (s *Scheduler) getSnapshotInput(mustSnapshot bool) (*SnapshotInput, bool) {
 		Workers: make(map[string]*WorkerCp),
 	}
 
+	workerResourceUtilization := make(map[string]map[string]*ResourceUtilization)
+
 	for workerId, worker := range workers {
 		res.Workers[workerId] = &WorkerCp{
-			WorkerId: workerId,
-			MaxRuns:  worker.MaxRuns,
-			Labels:   worker.Labels,
+			WorkerId:  workerId,
+			MaxRuns:   worker.MaxRuns,
+			Labels:    worker.Labels,
+			Resources: worker.Resources,
+		}
+
+		if len(worker.Resources) > 0 {
+			workerResourceUtilization[workerId] = s.getWorkerResources(workerId).utilization()
 		}
 	}
 
+	res.WorkerResourceUtilization = workerResourceUtilization
+
 	// NOTE: these locks are important because we must acquire locks in the same order as the replenish and tryAssignBatch
 	// functions. we always acquire actionsMu first and then the specific action's lock.
 	actionKeys := make([]string, 0, len(s.actions))
@@ -862,29 +1166,7 @@ func (s *Scheduler) getSnapshotInput(mustSnapshot bool) (*SnapshotInput, bool) {
 		}
 
 		action.mu.RLock()
-		for _, slot := range action.slots {
-			if _, ok := uniqueSlots[slot]; ok {
-				continue
-			}
-
-			workerId := slot.worker.ID
-
-			if _, ok := workerSlotUtilization[workerId]; !ok {
-				// initialize the worker slot utilization
-				workerSlotUtilization[workerId] = &SlotUtilization{
-					UtilizedSlots:    0,
-					NonUtilizedSlots: 0,
-				}
-			}
-
-			uniqueSlots[slot] = true
-
-			if slot.isUsed() {
-				workerSlotUtilization[workerId].UtilizedSlots++
-			} else {
-				workerSlotUtilization[workerId].NonUtilizedSlots++
-			}
-		}
+		addSlotUtilization(workerSlotUtilization, uniqueSlots, action.slots)
 		action.mu.RUnlock()
 	}
 
@@ -0,0 +1,213 @@
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// LatencySummary summarizes a set of latencies. Percentiles use the nearest-rank method.
+type LatencySummary struct {
+	Count int      `json:"count"`
+	Mean  Duration `json:"mean"`
+	P50   Duration `json:"p50"`
+	P90   Duration `json:"p90"`
+	P95   Duration `json:"p95"`
+	P99   Duration `json:"p99"`
+	Max   Duration `json:"max"`
+}
+
+func summarizeLatencies(latencies []time.Duration) *LatencySummary {
+	res := &LatencySummary{
+		Count: len(latencies),
+	}
+
+	if len(latencies) == 0 {
+		return res
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var total time.Duration
+
+	for _, l := range sorted {
+		total += l
+	}
+
+	res.Mean = Duration(total / time.Duration(len(sorted)))
+	res.P50 = Duration(percentile(sorted, 50))
+	res.P90 = Duration(percentile(sorted, 90))
+	res.P95 = Duration(percentile(sorted, 95))
+	res.P99 = Duration(percentile(sorted, 99))
+	res.Max = Duration(sorted[len(sorted)-1])
+
+	return res
+}
+
+// percentile returns the nearest-rank percentile p of a sorted, non-empty slice.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// Report is the result of a simulation. Queue latency is the time between a task being queued and being
+// assigned to a worker, and doesn't include time spent waiting for a concurrency slot before being queued.
+type Report struct {
+	Tasks              int `json:"tasks"`
+	Assigned           int `json:"assigned"`
+	Completed          int `json:"completed"`
+	SchedulingTimedOut int `json:"schedulingTimedOut"`
+
+	// Unassigned is the number of tasks which were still waiting when the simulation ended
+	Unassigned int `json:"unassigned"`
+
+	// UnassignedReasons counts the last scheduling reason of each unassigned task
+	UnassignedReasons map[string]int `json:"unassignedReasons,omitempty"`
+
+	// Incomplete is set when the simulation was stopped before every task completed or timed out
+	Incomplete bool `json:"incomplete"`
+
+	// Makespan is the simulated time at which the last task completed or timed out
+	Makespan Duration `json:"makespan"`
+
+	QueueLatency *LatencySummary `json:"queueLatency"`
+
+	// Actions are the queue latencies of each action
+	Actions map[string]*LatencySummary `json:"actions"`
+}
+
+// Print writes the report as a human-readable table.
+func (r *Report) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "tasks:\t%d\n", r.Tasks)
+	fmt.Fprintf(tw, "assigned:\t%d\n", r.Assigned)
+	fmt.Fprintf(tw, "completed:\t%d\n", r.Completed)
+	fmt.Fprintf(tw, "scheduling timed out:\t%d\n", r.SchedulingTimedOut)
+	fmt.Fprintf(tw, "unassigned:\t%d\n", r.Unassigned)
+
+	reasons := make([]string, 0, len(r.UnassignedReasons))
+
+	for reason := range r.UnassignedReasons {
+		reasons = append(reasons, reason)
+	}
+
+	sort.Strings(reasons)
+
+	for _, reason := range reasons {
+		fmt.Fprintf(tw, "  %s:\t%d\n", reason, r.UnassignedReasons[reason])
+	}
+
+	fmt.Fprintf(tw, "makespan:\t%s\n", time.Duration(r.Makespan))
+
+	if r.Incomplete {
+		fmt.Fprintf(tw, "incomplete:\ttrue\n")
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "queue latency\tcount\tmean\tp50\tp90\tp95\tp99\tmax")
+
+	printLatencySummary(tw, "all", r.QueueLatency)
+
+	actions := make([]string, 0, len(r.Actions))
+
+	for action := range r.Actions {
+		actions = append(actions, action)
+	}
+
+	sort.Strings(actions)
+
+	for _, action := range actions {
+		printLatencySummary(tw, action, r.Actions[action])
+	}
+
+	return tw.Flush()
+}
+
+func printLatencySummary(w io.Writer, name string, s *LatencySummary) {
+	fmt.Fprintf(
+		w,
+		"%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		name,
+		s.Count,
+		time.Duration(s.Mean),
+		time.Duration(s.P50),
+		time.Duration(s.P90),
+		time.Duration(s.P95),
+		time.Duration(s.P99),
+		time.Duration(s.Max),
+	)
+}
+
+// report builds the report from the current state of the repository.
+func (r *memoryRepository) report(incomplete bool) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := &Report{
+		Tasks:      len(r.tasks),
+		Incomplete: incomplete,
+		Actions:    make(map[string]*LatencySummary),
+	}
+
+	latencies := make([]time.Duration, 0, len(r.tasks))
+	actionLatencies := make(map[string][]time.Duration)
+
+	for _, t := range r.tasks {
+		switch t.state {
+		case taskStateRunning, taskStateCompleted:
+			res.Assigned++
+
+			latency := t.assignedAt - t.queuedAt
+			latencies = append(latencies, latency)
+			actionLatencies[t.qi.ActionID] = append(actionLatencies[t.qi.ActionID], latency)
+
+			if t.state == taskStateCompleted {
+				res.Completed++
+			}
+		case taskStateTimedOut:
+			res.SchedulingTimedOut++
+		case taskStateQueued, taskStateConcurrencyWaiting:
+			res.Unassigned++
+
+			reason := "UNKNOWN"
+
+			if t.state == taskStateConcurrencyWaiting {
+				reason = "CONCURRENCY_WAITING"
+			} else if sr, ok := r.reasons[t.qi.ID]; ok {
+				reason = string(sr.Reason)
+			}
+
+			if res.UnassignedReasons == nil {
+				res.UnassignedReasons = make(map[string]int)
+			}
+
+			res.UnassignedReasons[reason]++
+		}
+
+		if t.state == taskStateCompleted || t.state == taskStateTimedOut {
+			res.Makespan = max(res.Makespan, Duration(t.finishedAt))
+		}
+	}
+
+	res.QueueLatency = summarizeLatencies(latencies)
+
+	for action, l := range actionLatencies {
+		res.Actions[action] = summarizeLatencies(l)
+	}
+
+	return res
+}
@@ -0,0 +1,822 @@
+package simulator
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// clock maps between wall-clock time and simulated time. Simulated time starts at zero and advances speed
+// times faster than wall-clock time.
+type clock struct {
+	start time.Time
+	speed float64
+}
+
+func newClock(speed float64) *clock {
+	return &clock{
+		start: time.Now(),
+		speed: speed,
+	}
+}
+
+func (c *clock) now() time.Duration {
+	return time.Duration(float64(time.Since(c.start)) * c.speed)
+}
+
+func (c *clock) wall(at time.Duration) time.Time {
+	return c.start.Add(c.wallDuration(at))
+}
+
+func (c *clock) wallDuration(d time.Duration) time.Duration {
+	return time.Duration(float64(d) / c.speed)
+}
+
+type taskState int
+
+const (
+	taskStatePending taskState = iota
+	taskStateConcurrencyWaiting
+	taskStateQueued
+	taskStateRunning
+	taskStateCompleted
+	taskStateTimedOut
+)
+
+type simStep struct {
+	id          pgtype.UUID
+	trace       *TraceTask
+	labels      []*sqlcv1.GetDesiredLabelsRow
+	strategyId  int64
+	concurrency string
+}
+
+type simTask struct {
+	qi   *sqlcv1.V1QueueItem
+	step *simStep
+
+	state    taskState
+	workerId string
+
+	// queuedAt, assignedAt and finishedAt are in simulated time
+	queuedAt   time.Duration
+	assignedAt time.Duration
+	finishedAt time.Duration
+}
+
+type simWorker struct {
+	*v1.ListActiveWorkersResult
+
+	actions []string
+	joinAt  time.Duration
+	leaveAt *time.Duration
+}
+
+type simRateLimit struct {
+	limit      int
+	window     time.Duration
+	value      int
+	lastRefill time.Duration
+}
+
+// memoryRepository is an in-memory implementation of v1.SchedulerRepository which is backed by a trace.
+// Tasks become visible to the scheduler when they are queued by the simulator, and hold their worker slots
+// until they are completed by the simulator.
+type memoryRepository struct {
+	tenantId pgtype.UUID
+	clock    *clock
+
+	mu sync.Mutex
+
+	workers    []*simWorker
+	steps      map[string]*simStep
+	tasks      []*simTask
+	tasksById  map[int64]*simTask
+	rateLimits map[string]*simRateLimit
+	strategies []*sqlcv1.V1StepConcurrency
+	queues     []string
+
+	// remaining is the number of tasks which have not completed or timed out
+	remaining int
+	doneCh    chan struct{}
+
+	// reasons are the last scheduling reasons for each queue item
+	reasons map[int64]*v1.SchedulingReason
+}
+
+func newMemoryRepository(trace *Trace, c *clock) *memoryRepository {
+	r := &memoryRepository{
+		tenantId:   sqlchelpers.UUIDFromStr(uuid.NewString()),
+		clock:      c,
+		steps:      make(map[string]*simStep),
+		tasksById:  make(map[int64]*simTask),
+		rateLimits: make(map[string]*simRateLimit),
+		doneCh:     make(chan struct{}),
+		reasons:    make(map[int64]*v1.SchedulingReason),
+	}
+
+	for _, w := range trace.Workers {
+		for range max(w.Count, 1) {
+			id := uuid.NewString()
+
+			labels := make([]*sqlcv1.ListManyWorkerLabelsRow, 0, len(w.Labels))
+
+			for key, value := range w.Labels {
+				labels = append(labels, &sqlcv1.ListManyWorkerLabelsRow{
+					Key:      key,
+					StrValue: sqlchelpers.TextFromStr(value),
+					IntValue: intLabelValue(value),
+					WorkerId: sqlchelpers.UUIDFromStr(id),
+				})
+			}
+
+			var leaveAt *time.Duration
+
+			if w.LeaveAt != nil {
+				l := time.Duration(*w.LeaveAt)
+				leaveAt = &l
+			}
+
+			r.workers = append(r.workers, &simWorker{
+				ListActiveWorkersResult: &v1.ListActiveWorkersResult{
+					ID:        id,
+					MaxRuns:   w.MaxRuns,
+					Labels:    labels,
+					Resources: w.Resources,
+				},
+				actions: w.Actions,
+				joinAt:  time.Duration(w.JoinAt),
+				leaveAt: leaveAt,
+			})
+		}
+	}
+
+	for _, rl := range trace.RateLimits {
+		window := time.Duration(rl.Window)
+
+		if window <= 0 {
+			window = time.Second
+		}
+
+		r.rateLimits[rl.Key] = &simRateLimit{
+			limit:  rl.Limit,
+			window: window,
+			value:  rl.Limit,
+		}
+	}
+
+	strategyIds := make(map[string]int64)
+
+	for i, s := range trace.ConcurrencyStrategies {
+		id := int64(i + 1)
+		strategyIds[s.Name] = id
+
+		r.strategies = append(r.strategies, &sqlcv1.V1StepConcurrency{
+			ID:             id,
+			IsActive:       true,
+			Strategy:       sqlcv1.V1ConcurrencyStrategyGROUPROUNDROBIN,
+			Expression:     s.Name,
+			TenantID:       r.tenantId,
+			MaxConcurrency: s.MaxRuns,
+		})
+	}
+
+	queues := make(map[string]bool)
+	var taskId int64
+
+	for i := range trace.Tasks {
+		tt := &trace.Tasks[i]
+
+		step := &simStep{
+			id:    sqlchelpers.UUIDFromStr(uuid.NewString()),
+			trace: tt,
+		}
+
+		for _, l := range tt.DesiredLabels {
+			comparator := l.Comparator
+
+			if comparator == "" {
+				comparator = sqlcv1.WorkerLabelComparatorEQUAL
+			}
+
+			step.labels = append(step.labels, &sqlcv1.GetDesiredLabelsRow{
+				Key:        l.Key,
+				StrValue:   sqlchelpers.TextFromStr(l.Value),
+				IntValue:   intLabelValue(l.Value),
+				Required:   l.Required,
+				Weight:     l.Weight,
+				Comparator: comparator,
+				StepId:     step.id,
+			})
+		}
+
+		if tt.Concurrency != nil {
+			step.strategyId = strategyIds[tt.Concurrency.Strategy]
+			step.concurrency = tt.Concurrency.Key
+		}
+
+		r.steps[sqlchelpers.UUIDToStr(step.id)] = step
+
+		queue := tt.Queue
+
+		if queue == "" {
+			queue = "default"
+		}
+
+		if !queues[queue] {
+			queues[queue] = true
+			r.queues = append(r.queues, queue)
+		}
+
+		priority := tt.Priority
+
+		if priority == 0 {
+			priority = 1
+		}
+
+		for j := range max(tt.Count, 1) {
+			taskId++
+
+			queuedAt := time.Duration(tt.At) + time.Duration(j)*time.Duration(tt.Interval)
+
+			qi := &sqlcv1.V1QueueItem{
+				ID:             taskId,
+				TenantID:       r.tenantId,
+				Queue:          queue,
+				TaskID:         taskId,
+				ExternalID:     sqlchelpers.UUIDFromStr(uuid.NewString()),
+				ActionID:       tt.Action,
+				StepID:         step.id,
+				WorkflowRunID:  sqlchelpers.UUIDFromStr(uuid.NewString()),
+				Priority:       priority,
+				Sticky:         sqlcv1.V1StickyStrategyNONE,
+				FairnessKey:    sqlchelpers.TextFromStr(tt.FairnessKey),
+				TaskInsertedAt: sqlchelpers.TimestamptzFromTime(c.wall(queuedAt)),
+			}
+
+			if tt.FairnessKey == "" {
+				qi.FairnessKey = pgtype.Text{}
+			}
+
+			t := &simTask{
+				qi:       qi,
+				step:     step,
+				queuedAt: queuedAt,
+			}
+
+			r.tasks = append(r.tasks, t)
+			r.tasksById[taskId] = t
+		}
+	}
+
+	// tasks are queued in order, so we keep them sorted by the time they're queued
+	sort.SliceStable(r.tasks, func(i, j int) bool {
+		return r.tasks[i].queuedAt < r.tasks[j].queuedAt
+	})
+
+	r.remaining = len(r.tasks)
+
+	return r
+}
+
+func intLabelValue(value string) pgtype.Int4 {
+	i, err := strconv.ParseInt(value, 10, 32)
+
+	if err != nil {
+		return pgtype.Int4{}
+	}
+
+	return sqlchelpers.ToInt(int32(i))
+}
+
+func (r *memoryRepository) getTask(taskId int64) *simTask {
+	return r.tasksById[taskId]
+}
+
+// queue makes a pending task visible to the scheduler. Tasks with a concurrency strategy wait for the
+// strategy to move them into the queue.
+func (r *memoryRepository) queue(t *simTask) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t.qi.ScheduleTimeoutAt = sqlchelpers.TimestampFromTime(r.clock.wall(t.queuedAt + scheduleTimeout(t.step.trace)))
+
+	if t.step.strategyId != 0 {
+		t.state = taskStateConcurrencyWaiting
+	} else {
+		t.state = taskStateQueued
+	}
+}
+
+func scheduleTimeout(tt *TraceTask) time.Duration {
+	if tt.ScheduleTimeout > 0 {
+		return time.Duration(tt.ScheduleTimeout)
+	}
+
+	return 5 * time.Minute
+}
+
+// complete releases the worker slots held by a running task.
+func (r *memoryRepository) complete(taskId int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t := r.getTask(taskId)
+
+	if t == nil || t.state != taskStateRunning {
+		return
+	}
+
+	t.state = taskStateCompleted
+	r.finish(t)
+}
+
+// finish must be called with the lock held when a task reaches a terminal state.
+func (r *memoryRepository) finish(t *simTask) {
+	t.finishedAt = r.clock.now()
+	r.remaining--
+
+	if r.remaining == 0 {
+		close(r.doneCh)
+	}
+}
+
+func (r *memoryRepository) isActive(w *simWorker, now time.Duration) bool {
+	return w.joinAt <= now && (w.leaveAt == nil || now < *w.leaveAt)
+}
+
+func (r *memoryRepository) getWorker(workerId string) *simWorker {
+	for _, w := range r.workers {
+		if w.ID == workerId {
+			return w
+		}
+	}
+
+	return nil
+}
+
+func slotWeight(tt *TraceTask) int {
+	return int(max(tt.SlotWeight, 1))
+}
+
+// usedSlots returns the number of slots used by running tasks on each worker.
+func (r *memoryRepository) usedSlots() map[string]int {
+	res := make(map[string]int)
+
+	for _, t := range r.tasks {
+		if t.state == taskStateRunning {
+			res[t.workerId] += slotWeight(t.step.trace)
+		}
+	}
+
+	return res
+}
+
+func (r *memoryRepository) Concurrency() v1.ConcurrencyRepository {
+	return r
+}
+
+func (r *memoryRepository) Lease() v1.LeaseRepository {
+	return r
+}
+
+func (r *memoryRepository) QueueFactory() v1.QueueFactoryRepository {
+	return r
+}
+
+func (r *memoryRepository) RateLimit() v1.RateLimitRepository {
+	return r
+}
+
+func (r *memoryRepository) Assignment() v1.AssignmentRepository {
+	return r
+}
+
+func (r *memoryRepository) ListQueues(ctx context.Context, tenantId pgtype.UUID) ([]*sqlcv1.V1Queue, error) {
+	res := make([]*sqlcv1.V1Queue, 0, len(r.queues))
+
+	for _, name := range r.queues {
+		res = append(res, &sqlcv1.V1Queue{
+			TenantID: r.tenantId,
+			Name:     name,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *memoryRepository) ListActiveWorkers(ctx context.Context, tenantId pgtype.UUID) ([]*v1.ListActiveWorkersResult, error) {
+	now := r.clock.now()
+	res := make([]*v1.ListActiveWorkersResult, 0, len(r.workers))
+
+	for _, w := range r.workers {
+		if r.isActive(w, now) {
+			res = append(res, w.ListActiveWorkersResult)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *memoryRepository) ListConcurrencyStrategies(ctx context.Context, tenantId pgtype.UUID) ([]*sqlcv1.V1StepConcurrency, error) {
+	return r.strategies, nil
+}
+
+// AcquireOrExtendLeases always succeeds, since the simulated scheduler is the only lease holder.
+func (r *memoryRepository) AcquireOrExtendLeases(ctx context.Context, tenantId pgtype.UUID, kind sqlcv1.LeaseKind, resourceIds []string, existingLeases []*sqlcv1.Lease) ([]*sqlcv1.Lease, error) {
+	res := make([]*sqlcv1.Lease, 0, len(resourceIds))
+
+	for i, resourceId := range resourceIds {
+		res = append(res, &sqlcv1.Lease{
+			ID:         int64(i + 1),
+			ExpiresAt:  sqlchelpers.TimestampFromTime(time.Now().Add(30 * time.Second)),
+			TenantId:   tenantId,
+			ResourceId: resourceId,
+			Kind:       kind,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *memoryRepository) ReleaseLeases(ctx context.Context, tenantId pgtype.UUID, leases []*sqlcv1.Lease) error {
+	return nil
+}
+
+func (r *memoryRepository) UpdateConcurrencyStrategyIsActive(ctx context.Context, tenantId pgtype.UUID, strategy *sqlcv1.V1StepConcurrency) error {
+	return nil
+}
+
+// RunConcurrencyStrategy moves waiting tasks into the queue, rotating between concurrency keys and only
+// allowing MaxConcurrency queued or running tasks per key.
+func (r *memoryRepository) RunConcurrencyStrategy(ctx context.Context, tenantId pgtype.UUID, strategy *sqlcv1.V1StepConcurrency) (*v1.RunConcurrencyResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	active := make(map[string]int32)
+	waiting := make(map[string][]*simTask)
+	keys := make([]string, 0)
+	now := time.Now()
+
+	for _, t := range r.tasks {
+		if t.step.strategyId != strategy.ID {
+			continue
+		}
+
+		key := t.step.concurrency
+
+		switch t.state {
+		case taskStateQueued, taskStateRunning:
+			active[key]++
+		case taskStateConcurrencyWaiting:
+			// tasks which time out while waiting for a concurrency slot never reach the queue
+			if t.qi.ScheduleTimeoutAt.Time.Before(now) {
+				t.state = taskStateTimedOut
+				r.finish(t)
+				continue
+			}
+
+			if _, ok := waiting[key]; !ok {
+				keys = append(keys, key)
+			}
+
+			waiting[key] = append(waiting[key], t)
+		}
+	}
+
+	res := &v1.RunConcurrencyResult{}
+
+	for progress := true; progress; {
+		progress = false
+
+		for _, key := range keys {
+			if active[key] >= strategy.MaxConcurrency || len(waiting[key]) == 0 {
+				continue
+			}
+
+			t := waiting[key][0]
+			waiting[key] = waiting[key][1:]
+			active[key]++
+			progress = true
+
+			t.state = taskStateQueued
+
+			res.Queued = append(res.Queued, v1.TaskWithQueue{
+				TaskIdInsertedAtRetryCount: &v1.TaskIdInsertedAtRetryCount{
+					Id:         t.qi.TaskID,
+					InsertedAt: t.qi.TaskInsertedAt,
+					RetryCount: t.qi.RetryCount,
+				},
+				Queue: t.qi.Queue,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateRateLimits refills each rate limit at the start of its window and then subtracts the units which
+// were used.
+func (r *memoryRepository) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, *time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.now()
+	nextRefillAt := time.Now().Add(2 * time.Second)
+	res := make(map[string]int, len(r.rateLimits))
+
+	for key, rl := range r.rateLimits {
+		if now-rl.lastRefill >= rl.window {
+			rl.value = rl.limit
+			rl.lastRefill = now
+		}
+
+		rl.value -= updates[key]
+		res[key] = rl.value
+
+		if refillAt := r.clock.wall(rl.lastRefill + rl.window); refillAt.Before(nextRefillAt) {
+			nextRefillAt = refillAt
+		}
+	}
+
+	return res, &nextRefillAt, nil
+}
+
+func (r *memoryRepository) ListActionsForWorkers(ctx context.Context, tenantId pgtype.UUID, workerIds []pgtype.UUID) ([]*sqlcv1.ListActionsForWorkersRow, error) {
+	res := make([]*sqlcv1.ListActionsForWorkersRow, 0)
+
+	for _, workerId := range workerIds {
+		w := r.getWorker(sqlchelpers.UUIDToStr(workerId))
+
+		if w == nil {
+			continue
+		}
+
+		for _, action := range w.actions {
+			res = append(res, &sqlcv1.ListActionsForWorkersRow{
+				WorkerId: workerId,
+				ActionId: sqlchelpers.TextFromStr(action),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (r *memoryRepository) ListAvailableSlotsForWorkers(ctx context.Context, tenantId pgtype.UUID, params sqlcv1.ListAvailableSlotsForWorkersParams) ([]*sqlcv1.ListAvailableSlotsForWorkersRow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	used := r.usedSlots()
+	res := make([]*sqlcv1.ListAvailableSlotsForWorkersRow, 0, len(params.Workerids))
+
+	for _, workerId := range params.Workerids {
+		w := r.getWorker(sqlchelpers.UUIDToStr(workerId))
+
+		if w == nil {
+			continue
+		}
+
+		res = append(res, &sqlcv1.ListAvailableSlotsForWorkersRow{
+			ID:             workerId,
+			AvailableSlots: int32(max(w.MaxRuns-used[w.ID], 0)), // nolint: gosec
+		})
+	}
+
+	return res, nil
+}
+
+func (r *memoryRepository) ListResourceUsageForWorkers(ctx context.Context, tenantId pgtype.UUID, workerIds []pgtype.UUID) ([]*sqlcv1.ListResourceUsageForWorkersRow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	workers := make(map[string]bool, len(workerIds))
+
+	for _, workerId := range workerIds {
+		workers[sqlchelpers.UUIDToStr(workerId)] = true
+	}
+
+	usage := make(map[string]map[string]int32)
+
+	for _, t := range r.tasks {
+		if t.state != taskStateRunning || !workers[t.workerId] {
+			continue
+		}
+
+		for key, units := range t.step.trace.Resources {
+			if _, ok := usage[t.workerId]; !ok {
+				usage[t.workerId] = make(map[string]int32)
+			}
+
+			usage[t.workerId][key] += units
+		}
+	}
+
+	res := make([]*sqlcv1.ListResourceUsageForWorkersRow, 0)
+
+	for workerId, resources := range usage {
+		for key, units := range resources {
+			res = append(res, &sqlcv1.ListResourceUsageForWorkersRow{
+				WorkerID: sqlchelpers.UUIDFromStr(workerId),
+				Key:      key,
+				Units:    units,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (r *memoryRepository) NewQueue(tenantId pgtype.UUID, queueName string) v1.QueueRepository {
+	return &memoryQueue{
+		r:         r,
+		queueName: queueName,
+	}
+}
+
+// memoryQueue is a single queue in the memory repository.
+type memoryQueue struct {
+	r         *memoryRepository
+	queueName string
+}
+
+func (q *memoryQueue) ListQueueItems(ctx context.Context, limit int) ([]*sqlcv1.V1QueueItem, error) {
+	q.r.mu.Lock()
+	defer q.r.mu.Unlock()
+
+	res := make([]*sqlcv1.V1QueueItem, 0)
+
+	for _, t := range q.r.tasks {
+		if t.state == taskStateQueued && t.qi.Queue == q.queueName {
+			res = append(res, t.qi)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Priority == res[j].Priority {
+			return res[i].ID < res[j].ID
+		}
+
+		return res[i].Priority > res[j].Priority
+	})
+
+	if len(res) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+// MarkQueueItemsProcessed starts the assigned tasks on their workers, failing assignments which would
+// exceed the worker's slots, and removes timed out tasks from the queue.
+func (q *memoryQueue) MarkQueueItemsProcessed(ctx context.Context, r *v1.AssignResults) (succeeded []*v1.AssignedItem, failed []*v1.AssignedItem, err error) {
+	q.r.mu.Lock()
+	defer q.r.mu.Unlock()
+
+	used := q.r.usedSlots()
+
+	for _, item := range r.Assigned {
+		t := q.r.getTask(item.QueueItem.TaskID)
+		workerId := sqlchelpers.UUIDToStr(item.WorkerId)
+		w := q.r.getWorker(workerId)
+
+		if t == nil || t.state != taskStateQueued || w == nil || used[workerId]+slotWeight(t.step.trace) > w.MaxRuns {
+			failed = append(failed, item)
+			continue
+		}
+
+		used[workerId] += slotWeight(t.step.trace)
+
+		t.state = taskStateRunning
+		t.workerId = workerId
+		t.assignedAt = q.r.clock.now()
+
+		delete(q.r.reasons, t.qi.ID)
+
+		succeeded = append(succeeded, item)
+	}
+
+	for _, qi := range r.SchedulingTimedOut {
+		t := q.r.getTask(qi.TaskID)
+
+		if t == nil || t.state != taskStateQueued {
+			continue
+		}
+
+		t.state = taskStateTimedOut
+		delete(q.r.reasons, t.qi.ID)
+
+		q.r.finish(t)
+	}
+
+	return succeeded, failed, nil
+}
+
+func (q *memoryQueue) GetTaskRateLimits(ctx context.Context, queueItems []*sqlcv1.V1QueueItem) (map[int64]map[string]int32, error) {
+	res := make(map[int64]map[string]int32)
+
+	for _, qi := range queueItems {
+		step, ok := q.r.steps[sqlchelpers.UUIDToStr(qi.StepID)]
+
+		if ok && len(step.trace.RateLimits) > 0 {
+			res[qi.TaskID] = step.trace.RateLimits
+		}
+	}
+
+	return res, nil
+}
+
+func (q *memoryQueue) eachStep(stepIds []pgtype.UUID, f func(stepId string, step *simStep)) {
+	for _, stepId := range stepIds {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+
+		if step, ok := q.r.steps[stepIdStr]; ok {
+			f(stepIdStr, step)
+		}
+	}
+}
+
+func (q *memoryQueue) GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) (map[string][]*sqlcv1.GetDesiredLabelsRow, error) {
+	res := make(map[string][]*sqlcv1.GetDesiredLabelsRow)
+
+	q.eachStep(stepIds, func(stepId string, step *simStep) {
+		if len(step.labels) > 0 {
+			res[stepId] = step.labels
+		}
+	})
+
+	return res, nil
+}
+
+func (q *memoryQueue) GetStepSlotWeights(ctx context.Context, stepIds []pgtype.UUID) (map[string]int32, error) {
+	res := make(map[string]int32)
+
+	q.eachStep(stepIds, func(stepId string, step *simStep) {
+		if step.trace.SlotWeight > 1 {
+			res[stepId] = step.trace.SlotWeight
+		}
+	})
+
+	return res, nil
+}
+
+func (q *memoryQueue) GetStepResourceRequests(ctx context.Context, stepIds []pgtype.UUID) (map[string]map[string]int32, error) {
+	res := make(map[string]map[string]int32)
+
+	q.eachStep(stepIds, func(stepId string, step *simStep) {
+		if len(step.trace.Resources) > 0 {
+			res[stepId] = step.trace.Resources
+		}
+	})
+
+	return res, nil
+}
+
+// GetStepGangs returns no gangs, since traces replay individual tasks.
+func (q *memoryQueue) GetStepGangs(ctx context.Context, stepIds []pgtype.UUID) (map[string]*v1.StepGang, error) {
+	return map[string]*v1.StepGang{}, nil
+}
+
+func (q *memoryQueue) GetStepPlacementStrategies(ctx context.Context, stepIds []pgtype.UUID) (map[string]v1.PlacementStrategy, error) {
+	res := make(map[string]v1.PlacementStrategy)
+
+	q.eachStep(stepIds, func(stepId string, step *simStep) {
+		if step.trace.PlacementStrategy != "" {
+			res[stepId] = v1.PlacementStrategy(step.trace.PlacementStrategy)
+		}
+	})
+
+	return res, nil
+}
+
+// AgeQueueItems is a no-op, since traces set task priorities explicitly.
+func (q *memoryQueue) AgeQueueItems(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (q *memoryQueue) UpdateSchedulingReasons(ctx context.Context, reasons []*v1.SchedulingReason) error {
+	q.r.mu.Lock()
+	defer q.r.mu.Unlock()
+
+	for _, reason := range reasons {
+		q.r.reasons[reason.QueueItemId] = reason
+	}
+
+	return nil
+}
+
+// ListPreemptibleTasks returns no tasks, since preemption is not simulated.
+func (q *memoryQueue) ListPreemptibleTasks(ctx context.Context, workerIds []pgtype.UUID, belowPriority int32) ([]*sqlcv1.ListPreemptibleTasksForWorkersRow, error) {
+	return nil, nil
+}
+
+func (q *memoryQueue) Cleanup() {}
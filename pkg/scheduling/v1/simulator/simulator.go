@@ -0,0 +1,260 @@
+// Package simulator replays a recorded trace of tasks, workers, rate limits and concurrency strategies
+// against the v1 scheduler, using an in-memory repository in place of the database, and reports queue
+// latency percentiles.
+//
+// Simulated time can run faster than wall-clock time, but the scheduler's internal tickers and the lease
+// manager's polling interval are not scaled, so high speeds overstate queue latency. Preemption, gangs and
+// queue item aging are not simulated.
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v2 "github.com/hatchet-dev/hatchet/pkg/scheduling/v1"
+)
+
+type Simulator struct {
+	trace *Trace
+	speed float64
+	l     *zerolog.Logger
+}
+
+// New creates a simulator for a trace. Speed is the number of simulated seconds per wall-clock second.
+func New(trace *Trace, speed float64, l *zerolog.Logger) (*Simulator, error) {
+	if speed <= 0 {
+		return nil, fmt.Errorf("speed must be positive")
+	}
+
+	if err := trace.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Simulator{
+		trace: trace,
+		speed: speed,
+		l:     l,
+	}, nil
+}
+
+// Run replays the trace until every task has completed or timed out, or until the context is cancelled, in
+// which case the report is marked as incomplete.
+func (s *Simulator) Run(ctx context.Context) (*Report, error) {
+	repo := newMemoryRepository(s.trace, newClock(s.speed))
+
+	pool, cleanup, err := v2.NewSchedulingPool(repo, s.l, 100, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduling pool: %w", err)
+	}
+
+	tenantId := sqlchelpers.UUIDToStr(repo.tenantId)
+
+	pool.SetTenants([]*dbsqlc.Tenant{{ID: repo.tenantId}})
+
+	arrivalsCtx, cancelArrivals := context.WithCancel(ctx)
+	defer cancelArrivals()
+
+	// results are drained until the pool is cleaned up, so the scheduler never blocks on a full channel
+	resultsCtx, cancelResults := context.WithCancel(context.Background())
+	defer cancelResults()
+
+	d := &driver{
+		repo:     repo,
+		pool:     pool,
+		tenantId: tenantId,
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	go d.runArrivals(arrivalsCtx)
+
+	go func() {
+		defer wg.Done()
+		d.runResults(resultsCtx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		d.runConcurrencyResults(resultsCtx)
+	}()
+
+	incomplete := false
+
+	select {
+	case <-repo.doneCh:
+	case <-ctx.Done():
+		incomplete = true
+	}
+
+	cancelArrivals()
+	d.stopCompletions()
+
+	report := repo.report(incomplete)
+
+	err = cleanup()
+
+	cancelResults()
+	wg.Wait()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not clean up scheduling pool: %w", err)
+	}
+
+	return report, nil
+}
+
+// driver moves the trace through the scheduling pool: it queues tasks when they arrive, completes them after
+// their duration, and notifies the pool of every change.
+type driver struct {
+	repo     *memoryRepository
+	pool     *v2.SchedulingPool
+	tenantId string
+
+	timersMu sync.Mutex
+	timers   []*time.Timer
+	stopped  bool
+}
+
+func (d *driver) runArrivals(ctx context.Context) {
+	tasks := d.repo.tasks
+
+	for i := 0; i < len(tasks); {
+		timer := time.NewTimer(time.Until(d.repo.clock.wall(tasks[i].queuedAt)))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		// queue every task which has arrived, so bursts are notified together
+		now := d.repo.clock.now()
+		queues := make(map[string]bool)
+		strategyIds := make(map[int64]bool)
+
+		for ; i < len(tasks) && tasks[i].queuedAt <= now; i++ {
+			d.repo.queue(tasks[i])
+
+			if tasks[i].step.strategyId != 0 {
+				strategyIds[tasks[i].step.strategyId] = true
+			} else {
+				queues[tasks[i].qi.Queue] = true
+			}
+		}
+
+		d.notify(ctx, queues, strategyIds)
+	}
+}
+
+func (d *driver) runResults(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case res := <-d.pool.GetResultsCh():
+			for _, assigned := range res.Assigned {
+				d.scheduleCompletion(assigned.QueueItem.TaskID)
+			}
+		}
+	}
+}
+
+func (d *driver) runConcurrencyResults(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case res := <-d.pool.GetConcurrencyResultsCh():
+			queues := make(map[string]bool)
+
+			for _, task := range res.Queued {
+				queues[task.Queue] = true
+			}
+
+			d.notify(ctx, queues, nil)
+		}
+	}
+}
+
+// scheduleCompletion completes a running task after its duration, which frees its slots on the worker and
+// may queue the next task for its concurrency key.
+func (d *driver) scheduleCompletion(taskId int64) {
+	t := d.repo.getTask(taskId)
+
+	if t == nil {
+		return
+	}
+
+	d.timersMu.Lock()
+	defer d.timersMu.Unlock()
+
+	if d.stopped {
+		return
+	}
+
+	timer := time.AfterFunc(d.repo.clock.wallDuration(time.Duration(t.step.trace.Duration)), func() {
+		d.repo.complete(taskId)
+
+		ctx := context.Background()
+
+		d.pool.Replenish(ctx, d.tenantId)
+
+		strategyIds := make(map[int64]bool)
+
+		if t.step.strategyId != 0 {
+			strategyIds[t.step.strategyId] = true
+		}
+
+		queues := make(map[string]bool, len(d.repo.queues))
+
+		for _, queue := range d.repo.queues {
+			queues[queue] = true
+		}
+
+		d.notify(ctx, queues, strategyIds)
+	})
+
+	d.timers = append(d.timers, timer)
+}
+
+func (d *driver) stopCompletions() {
+	d.timersMu.Lock()
+	defer d.timersMu.Unlock()
+
+	d.stopped = true
+
+	for _, timer := range d.timers {
+		timer.Stop()
+	}
+}
+
+func (d *driver) notify(ctx context.Context, queues map[string]bool, strategyIds map[int64]bool) {
+	if len(strategyIds) > 0 {
+		ids := make([]int64, 0, len(strategyIds))
+
+		for id := range strategyIds {
+			ids = append(ids, id)
+		}
+
+		d.pool.NotifyConcurrency(ctx, d.tenantId, ids)
+	}
+
+	if len(queues) > 0 {
+		names := make([]string, 0, len(queues))
+
+		for name := range queues {
+			names = append(names, name)
+		}
+
+		d.pool.NotifyQueues(ctx, d.tenantId, names)
+	}
+}
@@ -0,0 +1,188 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package simulator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestSummarizeLatencies(t *testing.T) {
+	latencies := make([]time.Duration, 0, 100)
+
+	// reversed, to check that the input is sorted
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	res := summarizeLatencies(latencies)
+
+	assert.Equal(t, 100, res.Count)
+	assert.Equal(t, Duration(50500*time.Microsecond), res.Mean)
+	assert.Equal(t, Duration(50*time.Millisecond), res.P50)
+	assert.Equal(t, Duration(90*time.Millisecond), res.P90)
+	assert.Equal(t, Duration(95*time.Millisecond), res.P95)
+	assert.Equal(t, Duration(99*time.Millisecond), res.P99)
+	assert.Equal(t, Duration(100*time.Millisecond), res.Max)
+
+	assert.Equal(t, &LatencySummary{}, summarizeLatencies(nil))
+	assert.Equal(t, Duration(time.Second), summarizeLatencies([]time.Duration{time.Second}).P99)
+}
+
+func TestParseTrace(t *testing.T) {
+	tests := []struct {
+		name  string
+		trace string
+		err   string
+	}{
+		{
+			name: "valid trace",
+			trace: `{
+				"workers": [{"name": "w", "count": 2, "maxRuns": 4, "actions": ["a"], "leaveAt": "1m"}],
+				"rateLimits": [{"key": "api", "limit": 10, "window": "1s"}],
+				"concurrencyStrategies": [{"name": "per-user", "maxRuns": 1}],
+				"tasks": [{
+					"at": "0s", "count": 10, "interval": "100ms", "action": "a", "duration": "1s",
+					"rateLimits": {"api": 1}, "concurrency": {"strategy": "per-user", "key": "u1"}
+				}]
+			}`,
+		},
+		{
+			name:  "unknown field",
+			trace: `{"workers": [], "tasks": [], "extra": true}`,
+			err:   "unknown field",
+		},
+		{
+			name:  "invalid duration",
+			trace: `{"workers": [], "tasks": [{"at": 10}]}`,
+			err:   "duration must be a string",
+		},
+		{
+			name:  "no worker for action",
+			trace: `{"workers": [{"maxRuns": 1, "actions": ["a"]}], "tasks": [{"at": "0s", "action": "b", "duration": "1s"}]}`,
+			err:   `no worker registers action "b"`,
+		},
+		{
+			name:  "undefined rate limit",
+			trace: `{"workers": [{"maxRuns": 1, "actions": ["a"]}], "tasks": [{"at": "0s", "action": "a", "duration": "1s", "rateLimits": {"api": 1}}]}`,
+			err:   `rate limit "api" is not defined`,
+		},
+		{
+			name:  "undefined concurrency strategy",
+			trace: `{"workers": [{"maxRuns": 1, "actions": ["a"]}], "tasks": [{"at": "0s", "action": "a", "duration": "1s", "concurrency": {"strategy": "s", "key": "k"}}]}`,
+			err:   `concurrency strategy "s" is not defined`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTrace(strings.NewReader(tt.trace))
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestRunConcurrencyStrategy(t *testing.T) {
+	trace := &Trace{
+		Workers:               []TraceWorker{{MaxRuns: 10, Actions: []string{"a"}}},
+		ConcurrencyStrategies: []TraceConcurrencyStrategy{{Name: "per-user", MaxRuns: 1}},
+		Tasks: []TraceTask{
+			{Action: "a", Count: 3, Concurrency: &TraceConcurrency{Strategy: "per-user", Key: "u1"}},
+			{Action: "a", Count: 2, Concurrency: &TraceConcurrency{Strategy: "per-user", Key: "u2"}},
+		},
+	}
+
+	repo := newMemoryRepository(trace, newClock(1))
+
+	for _, task := range repo.tasks {
+		repo.queue(task)
+	}
+
+	strategy := repo.strategies[0]
+
+	res, err := repo.RunConcurrencyStrategy(context.Background(), repo.tenantId, strategy)
+	require.NoError(t, err)
+
+	// one task per key is queued, and the rest wait for a running task to complete
+	require.Len(t, res.Queued, 2)
+	assert.Equal(t, int64(1), res.Queued[0].Id)
+	assert.Equal(t, int64(4), res.Queued[1].Id)
+
+	res, err = repo.RunConcurrencyStrategy(context.Background(), repo.tenantId, strategy)
+	require.NoError(t, err)
+	assert.Empty(t, res.Queued)
+
+	repo.getTask(1).state = taskStateRunning
+	repo.complete(1)
+
+	res, err = repo.RunConcurrencyStrategy(context.Background(), repo.tenantId, strategy)
+	require.NoError(t, err)
+	require.Len(t, res.Queued, 1)
+	assert.Equal(t, int64(2), res.Queued[0].Id)
+}
+
+func TestUpdateRateLimits(t *testing.T) {
+	trace := &Trace{
+		Workers:    []TraceWorker{{MaxRuns: 1, Actions: []string{"a"}}},
+		RateLimits: []TraceRateLimit{{Key: "api", Limit: 10, Window: Duration(time.Hour)}},
+		Tasks:      []TraceTask{{Action: "a"}},
+	}
+
+	repo := newMemoryRepository(trace, newClock(1))
+
+	res, _, err := repo.UpdateRateLimits(context.Background(), repo.tenantId, map[string]int{"api": 4})
+	require.NoError(t, err)
+	assert.Equal(t, 6, res["api"])
+
+	res, _, err = repo.UpdateRateLimits(context.Background(), repo.tenantId, map[string]int{"api": 4})
+	require.NoError(t, err)
+	assert.Equal(t, 2, res["api"])
+}
+
+func TestSimulatorRun(t *testing.T) {
+	trace := &Trace{
+		Workers: []TraceWorker{{Name: "w", Count: 2, MaxRuns: 2, Actions: []string{"a", "b"}}},
+		Tasks: []TraceTask{
+			{Action: "a", Count: 6, Interval: Duration(10 * time.Millisecond), Duration: Duration(100 * time.Millisecond)},
+			{Action: "b", Count: 2, Duration: Duration(100 * time.Millisecond), DesiredLabels: []TraceDesiredLabel{
+				{Key: "gpu", Value: "true", Required: true, Comparator: sqlcv1.WorkerLabelComparatorEQUAL},
+			}, ScheduleTimeout: Duration(2 * time.Second)},
+		},
+	}
+
+	l := zerolog.Nop()
+
+	sim, err := New(trace, 1, &l)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	report, err := sim.Run(ctx)
+	require.NoError(t, err)
+
+	assert.False(t, report.Incomplete)
+	assert.Equal(t, 8, report.Tasks)
+	assert.Equal(t, 6, report.Assigned)
+	assert.Equal(t, 6, report.Completed)
+
+	// no worker has the required label, so these tasks time out
+	assert.Equal(t, 2, report.SchedulingTimedOut)
+
+	assert.Equal(t, 6, report.QueueLatency.Count)
+	assert.Equal(t, 6, report.Actions["a"].Count)
+	assert.LessOrEqual(t, report.QueueLatency.P50, report.QueueLatency.P99)
+}
@@ -0,0 +1,250 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// Duration is a time.Duration which is encoded in JSON as a duration string, like "1.5s" or "10m".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"1.5s\": %w", err)
+	}
+
+	parsed, err := time.ParseDuration(s)
+
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+
+	return nil
+}
+
+// Trace is a recorded workload which is replayed against the scheduler. All times are relative to the start
+// of the simulation.
+type Trace struct {
+	Workers               []TraceWorker              `json:"workers"`
+	RateLimits            []TraceRateLimit           `json:"rateLimits,omitempty"`
+	ConcurrencyStrategies []TraceConcurrencyStrategy `json:"concurrencyStrategies,omitempty"`
+	Tasks                 []TraceTask                `json:"tasks"`
+}
+
+// TraceWorker is a group of identical workers.
+type TraceWorker struct {
+	// Name identifies the worker group in errors
+	Name string `json:"name"`
+
+	// Count is the number of workers in the group, defaults to 1
+	Count int `json:"count,omitempty"`
+
+	// MaxRuns is the number of slots on each worker
+	MaxRuns int `json:"maxRuns"`
+
+	// Actions are the actions which each worker has registered
+	Actions []string `json:"actions"`
+
+	// Labels are the worker labels. Values which are integers can also be matched by integer comparators.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Resources are the named resource capacities of each worker
+	Resources map[string]int32 `json:"resources,omitempty"`
+
+	// JoinAt is when the workers become active
+	JoinAt Duration `json:"joinAt,omitempty"`
+
+	// LeaveAt is when the workers stop accepting new tasks. Tasks which are already running are completed.
+	LeaveAt *Duration `json:"leaveAt,omitempty"`
+}
+
+// TraceRateLimit is a rate limit which is refilled to its limit at the start of every window.
+type TraceRateLimit struct {
+	Key   string `json:"key"`
+	Limit int    `json:"limit"`
+
+	// Window defaults to 1s
+	Window Duration `json:"window,omitempty"`
+}
+
+// TraceConcurrencyStrategy is a GROUP_ROUND_ROBIN concurrency strategy, which limits the number of queued
+// and running tasks for each concurrency key.
+type TraceConcurrencyStrategy struct {
+	Name    string `json:"name"`
+	MaxRuns int32  `json:"maxRuns"`
+}
+
+// TraceDesiredLabel is a worker label which a task prefers or requires.
+type TraceDesiredLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+
+	// Comparator defaults to EQUAL
+	Comparator sqlcv1.WorkerLabelComparator `json:"comparator,omitempty"`
+	Required   bool                         `json:"required,omitempty"`
+	Weight     int32                        `json:"weight,omitempty"`
+}
+
+// TraceConcurrency assigns a task to a concurrency key of a concurrency strategy.
+type TraceConcurrency struct {
+	Strategy string `json:"strategy"`
+	Key      string `json:"key"`
+}
+
+// TraceTask is a group of identical tasks which are queued at a fixed interval.
+type TraceTask struct {
+	// At is when the first task in the group is queued
+	At Duration `json:"at"`
+
+	// Count is the number of tasks in the group, defaults to 1
+	Count int `json:"count,omitempty"`
+
+	// Interval is the time between tasks in the group
+	Interval Duration `json:"interval,omitempty"`
+
+	Action string `json:"action"`
+
+	// Queue defaults to "default"
+	Queue string `json:"queue,omitempty"`
+
+	// Duration is how long each task runs once it is assigned to a worker
+	Duration Duration `json:"duration"`
+
+	// Priority defaults to 1
+	Priority int32 `json:"priority,omitempty"`
+
+	// ScheduleTimeout defaults to 5m
+	ScheduleTimeout Duration `json:"scheduleTimeout,omitempty"`
+
+	SlotWeight        int32               `json:"slotWeight,omitempty"`
+	Resources         map[string]int32    `json:"resources,omitempty"`
+	RateLimits        map[string]int32    `json:"rateLimits,omitempty"`
+	DesiredLabels     []TraceDesiredLabel `json:"desiredLabels,omitempty"`
+	FairnessKey       string              `json:"fairnessKey,omitempty"`
+	PlacementStrategy string              `json:"placementStrategy,omitempty"`
+	Concurrency       *TraceConcurrency   `json:"concurrency,omitempty"`
+}
+
+// ReadTrace reads a JSON trace from a file.
+func ReadTrace(path string) (*Trace, error) {
+	f, err := os.Open(path) // nolint: gosec
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ParseTrace(f)
+}
+
+// ParseTrace parses and validates a JSON trace.
+func ParseTrace(r io.Reader) (*Trace, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	trace := &Trace{}
+
+	if err := dec.Decode(trace); err != nil {
+		return nil, fmt.Errorf("could not decode trace: %w", err)
+	}
+
+	if err := trace.Validate(); err != nil {
+		return nil, err
+	}
+
+	return trace, nil
+}
+
+// Validate checks that the trace is well-formed, and that every action, rate limit and concurrency strategy
+// referenced by a task is defined.
+func (t *Trace) Validate() error {
+	if len(t.Tasks) == 0 {
+		return fmt.Errorf("trace has no tasks")
+	}
+
+	actions := make(map[string]bool)
+
+	for i, w := range t.Workers {
+		if w.MaxRuns <= 0 {
+			return fmt.Errorf("worker %d (%s): maxRuns must be positive", i, w.Name)
+		}
+
+		if w.Count < 0 {
+			return fmt.Errorf("worker %d (%s): count must not be negative", i, w.Name)
+		}
+
+		if len(w.Actions) == 0 {
+			return fmt.Errorf("worker %d (%s): at least one action is required", i, w.Name)
+		}
+
+		if w.LeaveAt != nil && *w.LeaveAt <= w.JoinAt {
+			return fmt.Errorf("worker %d (%s): leaveAt must be after joinAt", i, w.Name)
+		}
+
+		for _, action := range w.Actions {
+			actions[action] = true
+		}
+	}
+
+	rateLimits := make(map[string]bool)
+
+	for _, rl := range t.RateLimits {
+		if rl.Key == "" || rl.Limit <= 0 {
+			return fmt.Errorf("rate limit %q: key is required and limit must be positive", rl.Key)
+		}
+
+		rateLimits[rl.Key] = true
+	}
+
+	strategies := make(map[string]bool)
+
+	for _, s := range t.ConcurrencyStrategies {
+		if s.Name == "" || s.MaxRuns <= 0 {
+			return fmt.Errorf("concurrency strategy %q: name is required and maxRuns must be positive", s.Name)
+		}
+
+		strategies[s.Name] = true
+	}
+
+	for i, task := range t.Tasks {
+		if !actions[task.Action] {
+			return fmt.Errorf("task %d: no worker registers action %q", i, task.Action)
+		}
+
+		if task.Count < 0 || task.Duration < 0 || task.Interval < 0 || task.SlotWeight < 0 {
+			return fmt.Errorf("task %d: count, duration, interval and slotWeight must not be negative", i)
+		}
+
+		for key := range task.RateLimits {
+			if !rateLimits[key] {
+				return fmt.Errorf("task %d: rate limit %q is not defined", i, key)
+			}
+		}
+
+		if task.Concurrency != nil && !strategies[task.Concurrency.Strategy] {
+			return fmt.Errorf("task %d: concurrency strategy %q is not defined", i, task.Concurrency.Strategy)
+		}
+
+		switch task.PlacementStrategy {
+		case "", "SPREAD", "PACK", "LEAST_LOADED":
+		default:
+			return fmt.Errorf("task %d: invalid placement strategy %q", i, task.PlacementStrategy)
+		}
+	}
+
+	return nil
+}
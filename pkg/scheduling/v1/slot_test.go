@@ -150,3 +150,86 @@ func TestGetRankedSlots(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSlotGroup(t *testing.T) {
+	worker1 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+	worker2 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId2}}
+
+	t.Run("reserves all slots on a single worker", func(t *testing.T) {
+		slots := []*slot{
+			newSlot(worker1, []string{}),
+			newSlot(worker2, []string{}),
+			newSlot(worker2, []string{}),
+			newSlot(worker1, []string{}),
+		}
+
+		group := findSlotGroup(slots, 2, nil, func() {}, func() {})
+
+		assert.Len(t, group, 2)
+
+		for _, s := range group {
+			assert.Equal(t, stableWorkerId1, s.getWorkerId())
+			assert.True(t, s.isUsed())
+		}
+
+		assert.False(t, slots[1].isUsed())
+		assert.False(t, slots[2].isUsed())
+	})
+
+	t.Run("skips workers without enough active slots", func(t *testing.T) {
+		slots := []*slot{
+			newSlot(worker1, []string{}),
+			newSlot(worker1, []string{}),
+			newSlot(worker2, []string{}),
+			newSlot(worker2, []string{}),
+			newSlot(worker2, []string{}),
+		}
+
+		slots[0].use(nil, nil)
+
+		group := findSlotGroup(slots, 2, nil, func() {}, func() {})
+
+		assert.Len(t, group, 2)
+
+		for _, s := range group {
+			assert.Equal(t, stableWorkerId2, s.getWorkerId())
+		}
+
+		assert.False(t, slots[1].isUsed())
+	})
+
+	t.Run("returns nil when no worker has enough slots", func(t *testing.T) {
+		slots := []*slot{
+			newSlot(worker1, []string{}),
+			newSlot(worker2, []string{}),
+		}
+
+		group := findSlotGroup(slots, 2, nil, func() {}, func() {})
+
+		assert.Nil(t, group)
+
+		for _, s := range slots {
+			assert.False(t, s.isUsed())
+		}
+	})
+
+	t.Run("calls rate limit callbacks once per group", func(t *testing.T) {
+		slots := []*slot{
+			newSlot(worker1, []string{}),
+			newSlot(worker1, []string{}),
+			newSlot(worker1, []string{}),
+		}
+
+		nacks := 0
+
+		group := findSlotGroup(slots, 3, nil, func() {}, func() { nacks++ })
+
+		assert.Len(t, group, 3)
+
+		for _, s := range group {
+			s.nack()
+		}
+
+		assert.Equal(t, 1, nacks)
+	})
+}
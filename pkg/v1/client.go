@@ -38,6 +38,7 @@ type HatchetClient interface {
 	Schedules() features.SchedulesClient
 	Events() v0Client.EventClient
 	Filters() features.FiltersClient
+	Concurrency() features.ConcurrencyClient
 
 	// TODO Run, RunNoWait, bulk
 }
@@ -46,15 +47,16 @@ type HatchetClient interface {
 type v1HatchetClientImpl struct {
 	v0 v0Client.Client
 
-	metrics    features.MetricsClient
-	rateLimits features.RateLimitsClient
-	runs       features.RunsClient
-	workers    features.WorkersClient
-	workflows  features.WorkflowsClient
-	cel        features.CELClient
-	crons      features.CronsClient
-	schedules  features.SchedulesClient
-	filters    features.FiltersClient
+	metrics     features.MetricsClient
+	rateLimits  features.RateLimitsClient
+	runs        features.RunsClient
+	workers     features.WorkersClient
+	workflows   features.WorkflowsClient
+	cel         features.CELClient
+	crons       features.CronsClient
+	schedules   features.SchedulesClient
+	filters     features.FiltersClient
+	concurrency features.ConcurrencyClient
 }
 
 // NewHatchetClient creates a new V1 Hatchet client with the provided configuration.
@@ -185,3 +187,12 @@ func (c *v1HatchetClientImpl) CEL() features.CELClient {
 	}
 	return c.cel
 }
+
+func (c *v1HatchetClientImpl) Concurrency() features.ConcurrencyClient {
+	if c.concurrency == nil {
+		api := c.V0().API()
+		tenantId := c.V0().TenantId()
+		c.concurrency = features.NewConcurrencyClient(api, &tenantId)
+	}
+	return c.concurrency
+}
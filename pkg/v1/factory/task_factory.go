@@ -37,14 +37,16 @@ func NewTask[I, O any](opts create.StandaloneTask, fn func(ctx worker.HatchetCon
 
 	// Create a workflow with the same name as the task
 	workflowOpts := create.WorkflowCreateOpts[I]{
-		Name:            opts.Name,
-		Version:         opts.Version,
-		Description:     opts.Description,
-		OnEvents:        opts.OnEvents,
-		OnCron:          opts.OnCron,
-		OutputKey:       &opts.Name,
-		DefaultPriority: opts.DefaultPriority,
-		DefaultFilters:  opts.DefaultFilters,
+		Name:                 opts.Name,
+		Version:              opts.Version,
+		Description:          opts.Description,
+		OnEvents:             opts.OnEvents,
+		OnCron:               opts.OnCron,
+		OutputKey:            &opts.Name,
+		DefaultPriority:      opts.DefaultPriority,
+		DefaultFilters:       opts.DefaultFilters,
+		PriorityAgingSeconds: opts.PriorityAgingSeconds,
+		Debounce:             opts.Debounce,
 	}
 
 	// Create the workflow
@@ -55,12 +57,19 @@ func NewTask[I, O any](opts create.StandaloneTask, fn func(ctx worker.HatchetCon
 		Name:                   opts.Name,
 		ExecutionTimeout:       opts.ExecutionTimeout,
 		ScheduleTimeout:        opts.ScheduleTimeout,
+		TotalTimeout:           opts.TotalTimeout,
 		Retries:                opts.Retries,
 		RetryBackoffFactor:     opts.RetryBackoffFactor,
 		RetryMaxBackoffSeconds: opts.RetryMaxBackoffSeconds,
+		RetryPolicy:            opts.RetryPolicy,
 		RateLimits:             opts.RateLimits,
 		WorkerLabels:           opts.WorkerLabels,
 		Concurrency:            opts.Concurrency,
+		SlotWeight:             opts.SlotWeight,
+		ResourceRequests:       opts.ResourceRequests,
+		FairnessKey:            opts.FairnessKey,
+		Preemptible:            opts.Preemptible,
+		PlacementStrategy:      opts.PlacementStrategy,
 		DefaultPriority:        opts.DefaultPriority,
 	}
 
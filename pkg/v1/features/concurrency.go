@@ -0,0 +1,124 @@
+package features
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+)
+
+// ConcurrencyClient provides an interface for inspecting the concurrency slots of a tenant and managing its
+// per-key concurrency limits, which override the max runs of GROUP_ROUND_ROBIN strategies without registering a
+// new workflow version.
+type ConcurrencyClient interface {
+	// List retrieves the per-key concurrency limits of the tenant.
+	List(ctx context.Context) (*rest.V1ConcurrencyKeyLimitList, error)
+
+	// Set sets the maximum number of concurrent runs of a workflow for a single concurrency key. Runs which are
+	// already running are not cancelled when a limit is lowered.
+	Set(ctx context.Context, workflowName, key string, maxRuns int) (*rest.V1ConcurrencyKeyLimit, error)
+
+	// Delete deletes a per-key concurrency limit, so the key uses the max runs of the workflow version again.
+	Delete(ctx context.Context, workflowName, key string) error
+
+	// ListSlots retrieves the active keys of the workflow and step concurrency strategies of the tenant, with the
+	// number of running and queued runs and the oldest queued run per key (optional params).
+	ListSlots(ctx context.Context, opts *rest.V1ConcurrencySlotListParams) (*rest.V1ConcurrencySlotKeyList, error)
+}
+
+type concurrencyClientImpl struct {
+	api      *rest.ClientWithResponses
+	tenantId uuid.UUID
+}
+
+func NewConcurrencyClient(
+	api *rest.ClientWithResponses,
+	tenantId *string,
+) ConcurrencyClient {
+	tenantIdUUID := uuid.MustParse(*tenantId)
+
+	return &concurrencyClientImpl{
+		api:      api,
+		tenantId: tenantIdUUID,
+	}
+}
+
+// List retrieves the per-key concurrency limits of the tenant.
+func (c *concurrencyClientImpl) List(ctx context.Context) (*rest.V1ConcurrencyKeyLimitList, error) {
+	resp, err := c.api.V1ConcurrencyLimitListWithResponse(
+		ctx,
+		c.tenantId,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// Set sets the maximum number of concurrent runs of a workflow for a single concurrency key.
+func (c *concurrencyClientImpl) Set(ctx context.Context, workflowName, key string, maxRuns int) (*rest.V1ConcurrencyKeyLimit, error) {
+	resp, err := c.api.V1ConcurrencyLimitSetWithResponse(
+		ctx,
+		c.tenantId,
+		rest.V1SetConcurrencyKeyLimitRequest{
+			WorkflowName: workflowName,
+			Key:          key,
+			MaxRuns:      maxRuns,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("could not set concurrency limit for key %s: %s", key, resp.Status())
+	}
+
+	return resp.JSON200, nil
+}
+
+// Delete deletes a per-key concurrency limit.
+func (c *concurrencyClientImpl) Delete(ctx context.Context, workflowName, key string) error {
+	resp, err := c.api.V1ConcurrencyLimitDeleteWithResponse(
+		ctx,
+		c.tenantId,
+		rest.V1DeleteConcurrencyKeyLimitRequest{
+			WorkflowName: workflowName,
+			Key:          key,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("could not delete concurrency limit for key %s: %s", key, resp.Status())
+	}
+
+	return nil
+}
+
+// ListSlots retrieves the active keys of the concurrency strategies of the tenant.
+func (c *concurrencyClientImpl) ListSlots(ctx context.Context, opts *rest.V1ConcurrencySlotListParams) (*rest.V1ConcurrencySlotKeyList, error) {
+	resp, err := c.api.V1ConcurrencySlotListWithResponse(
+		ctx,
+		c.tenantId,
+		opts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("could not list concurrency slots: %s", resp.Status())
+	}
+
+	return resp.JSON200, nil
+}
@@ -3,6 +3,8 @@ package features
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	v0Client "github.com/hatchet-dev/hatchet/pkg/client"
@@ -18,6 +20,14 @@ type CreateRatelimitOpts struct {
 	Limit int
 	// duration specifies the time period for the rate limit
 	Duration types.RateLimitDuration
+	// algorithm specifies how the limit is enforced, defaults to a fixed window. semaphores hold units until
+	// the task which acquired them finishes, rather than refilling over the duration.
+	Algorithm types.RateLimitAlgorithm
+	// burst is the capacity of a token bucket rate limit (optional, defaults to limit)
+	Burst *int
+	// refillRate is the number of tokens added to a token bucket rate limit per second (optional, defaults to
+	// limit per duration)
+	RefillRate *float64
 }
 
 // rateLimitsClient provides an interface for managing rate limits.
@@ -27,6 +37,26 @@ type RateLimitsClient interface {
 
 	// list retrieves rate limits based on the provided parameters (optional).
 	List(ctx context.Context, opts *rest.RateLimitListParams) (*rest.RateLimitListResponse, error)
+
+	// usage retrieves a time series of the units consumed from a rate limit key and the tasks which were rate
+	// limited by it. defaults to the last 24 hours if opts is nil.
+	Usage(ctx context.Context, key string, opts *rest.V1RateLimitGetUsageParams) (*rest.V1RateLimitUsage, error)
+
+	// delete deletes a rate limit. rate limits which are used by a workflow step or by tasks waiting to be
+	// scheduled can't be deleted.
+	Delete(ctx context.Context, key string) error
+
+	// deleteByPrefix deletes all rate limits whose key starts with the prefix and returns the deleted keys. rate
+	// limits which are used by a workflow step or by tasks waiting to be scheduled are skipped.
+	DeleteByPrefix(ctx context.Context, prefix string) ([]string, error)
+
+	// reset refills a rate limit to its limit.
+	Reset(ctx context.Context, key string) (*rest.RateLimit, error)
+
+	// deleteUnused deletes the rate limits created from dynamic key expressions which haven't been used for the
+	// duration and returns the deleted keys. dynamic keys which were created before unused keys were tracked are
+	// only detected if the tasks which evaluated them are still retained.
+	DeleteUnused(ctx context.Context, unusedFor time.Duration) ([]string, error)
 }
 
 // rlClientImpl implements the rateLimitsClient interface.
@@ -54,8 +84,11 @@ func NewRateLimitsClient(
 // upsert creates or updates a rate limit with the provided options.
 func (c *rlClientImpl) Upsert(opts CreateRatelimitOpts) error {
 	return (*c.admin).PutRateLimit(opts.Key, &types.RateLimitOpts{
-		Max:      opts.Limit,
-		Duration: opts.Duration,
+		Max:        opts.Limit,
+		Duration:   opts.Duration,
+		Algorithm:  opts.Algorithm,
+		Burst:      opts.Burst,
+		RefillRate: opts.RefillRate,
 	})
 }
 
@@ -67,3 +100,107 @@ func (c *rlClientImpl) List(ctx context.Context, opts *rest.RateLimitListParams)
 		opts,
 	)
 }
+
+// usage retrieves a time series of the units consumed from a rate limit key and the tasks which were rate
+// limited by it.
+func (c *rlClientImpl) Usage(ctx context.Context, key string, opts *rest.V1RateLimitGetUsageParams) (*rest.V1RateLimitUsage, error) {
+	resp, err := c.api.V1RateLimitGetUsageWithResponse(
+		ctx,
+		c.tenantId,
+		key,
+		opts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("could not get usage of rate limit %s: %s", key, resp.Status())
+	}
+
+	return resp.JSON200, nil
+}
+
+// delete deletes a rate limit. rate limits which are used by a workflow step or by tasks waiting to be
+// scheduled can't be deleted.
+func (c *rlClientImpl) Delete(ctx context.Context, key string) error {
+	resp, err := c.api.V1RateLimitDeleteWithResponse(
+		ctx,
+		c.tenantId,
+		key,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("could not delete rate limit %s: %s", key, resp.Status())
+	}
+
+	return nil
+}
+
+// deleteByPrefix deletes all rate limits whose key starts with the prefix and returns the deleted keys. rate
+// limits which are used by a workflow step or by tasks waiting to be scheduled are skipped.
+func (c *rlClientImpl) DeleteByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	resp, err := c.api.V1RateLimitDeleteByPrefixWithResponse(
+		ctx,
+		c.tenantId,
+		rest.V1DeleteRateLimitsRequest{
+			Prefix: prefix,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("could not delete rate limits with prefix %s: %s", prefix, resp.Status())
+	}
+
+	return resp.JSON200.Keys, nil
+}
+
+// reset refills a rate limit to its limit.
+func (c *rlClientImpl) Reset(ctx context.Context, key string) (*rest.RateLimit, error) {
+	resp, err := c.api.V1RateLimitResetWithResponse(
+		ctx,
+		c.tenantId,
+		key,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("could not reset rate limit %s: %s", key, resp.Status())
+	}
+
+	return resp.JSON200, nil
+}
+
+// deleteUnused deletes the rate limits created from dynamic key expressions which haven't been used for the
+// duration and returns the deleted keys.
+func (c *rlClientImpl) DeleteUnused(ctx context.Context, unusedFor time.Duration) ([]string, error) {
+	resp, err := c.api.V1RateLimitDeleteUnusedWithResponse(
+		ctx,
+		c.tenantId,
+		rest.V1DeleteUnusedRateLimitsRequest{
+			UnusedForSeconds: int(unusedFor.Seconds()),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("could not delete unused rate limits: %s", resp.Status())
+	}
+
+	return resp.JSON200.Keys, nil
+}
@@ -33,8 +33,16 @@ type RunsClient interface {
 	// Cancel requests cancellation of a specific task within a workflow run.
 	Cancel(ctx context.Context, opts rest.V1CancelTaskRequest) (*rest.V1TaskCancelResponse, error)
 
+	// Signal sends a signal to a task, or to each task of a workflow run. The signal is delivered to durable
+	// tasks which wait on it with WaitForSignal, and is stored until the task waits on it.
+	Signal(ctx context.Context, opts rest.V1SignalTaskRequest) (*rest.V1TaskSignalResponse, error)
+
 	// SubscribeToStream subscribes to streaming events for a specific workflow run.
 	SubscribeToStream(ctx context.Context, workflowRunId string) (<-chan string, error)
+
+	// GetSchedulingExplanation explains why a task has not started running, for example because no worker
+	// matches its labels or it is rate limited.
+	GetSchedulingExplanation(ctx context.Context, taskId string) (*rest.V1TaskGetSchedulingExplanationResponse, error)
 }
 
 // runsClientImpl implements the RunsClient interface.
@@ -96,6 +104,14 @@ func (r *runsClientImpl) List(ctx context.Context, opts rest.V1WorkflowRunListPa
 	)
 }
 
+// GetSchedulingExplanation explains why a task has not started running.
+func (r *runsClientImpl) GetSchedulingExplanation(ctx context.Context, taskId string) (*rest.V1TaskGetSchedulingExplanationResponse, error) {
+	return r.api.V1TaskGetSchedulingExplanationWithResponse(
+		ctx,
+		uuid.MustParse(taskId),
+	)
+}
+
 // Replay requests a task to be replayed within a workflow run.
 func (r *runsClientImpl) Replay(ctx context.Context, opts rest.V1ReplayTaskRequest) (*rest.V1TaskReplayResponse, error) {
 	json, err := json.Marshal(opts)
@@ -126,6 +142,21 @@ func (r *runsClientImpl) Cancel(ctx context.Context, opts rest.V1CancelTaskReque
 	)
 }
 
+// Signal sends a signal to a task, or to each task of a workflow run.
+func (r *runsClientImpl) Signal(ctx context.Context, opts rest.V1SignalTaskRequest) (*rest.V1TaskSignalResponse, error) {
+	json, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.api.V1TaskSignalWithBodyWithResponse(
+		ctx,
+		r.tenantId,
+		"application/json",
+		bytes.NewReader(json),
+	)
+}
+
 // SubscribeToStream subscribes to streaming events for a specific workflow run.
 func (r *runsClientImpl) SubscribeToStream(ctx context.Context, workflowRunId string) (<-chan string, error) {
 	ch := make(chan string)
@@ -24,6 +24,12 @@ type WorkersClient interface {
 
 	// Unpause unpauses a worker.
 	Unpause(ctx context.Context, workerId string) (*rest.Worker, error)
+
+	// Drain stops new tasks from being assigned to a worker and returns the tasks still running on it.
+	Drain(ctx context.Context, workerId string) (*rest.WorkerDrainStatus, error)
+
+	// GetDrainStatus returns whether a worker is draining and the tasks still running on it.
+	GetDrainStatus(ctx context.Context, workerId string) (*rest.WorkerDrainStatus, error)
 }
 
 // workersClientImpl implements the WorkersClient interface.
@@ -141,3 +147,39 @@ func (w *workersClientImpl) Unpause(ctx context.Context, workerId string) (*rest
 
 	return resp.JSON200, nil
 }
+
+// Drain stops new tasks from being assigned to a worker and returns the tasks still running on it.
+func (w *workersClientImpl) Drain(ctx context.Context, workerId string) (*rest.WorkerDrainStatus, error) {
+	workerIdUUID, err := uuid.Parse(workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.api.WorkerDrainWithResponse(
+		ctx,
+		workerIdUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// GetDrainStatus returns whether a worker is draining and the tasks still running on it.
+func (w *workersClientImpl) GetDrainStatus(ctx context.Context, workerId string) (*rest.WorkerDrainStatus, error) {
+	workerIdUUID, err := uuid.Parse(workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.api.WorkerDrainStatusWithResponse(
+		ctx,
+		workerIdUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -26,6 +26,9 @@ type TaskShared struct {
 	// ScheduleTimeout specifies the maximum time a task can wait to be scheduled
 	ScheduleTimeout *time.Duration
 
+	// TotalTimeout specifies the maximum wall-clock time of the task across all of its attempts
+	TotalTimeout *time.Duration
+
 	// Retries defines the number of times to retry a failed task
 	Retries *int32
 
@@ -35,6 +38,9 @@ type TaskShared struct {
 	// RetryMaxBackoffSeconds is the maximum backoff duration in seconds between retries
 	RetryMaxBackoffSeconds *int32
 
+	// RetryPolicy decides whether and when the task is retried based on the error code of a failure
+	RetryPolicy *types.RetryPolicy
+
 	// RateLimits define constraints on how frequently the task can be executed
 	RateLimits []*types.RateLimit
 
@@ -44,6 +50,27 @@ type TaskShared struct {
 	// Concurrency defines constraints on how many instances of this task can run simultaneously
 	Concurrency []*types.Concurrency
 
+	// SlotWeight is the number of worker slots the task consumes while running
+	SlotWeight *int32
+
+	// ResourceRequests are the named worker resources the task consumes while running
+	ResourceRequests map[string]int32
+
+	// FairnessKey is a CEL expression for the task's fairness key
+	FairnessKey *string
+
+	// Preemptible allows the task to be cancelled and requeued to make room for higher-priority tasks
+	Preemptible bool
+
+	// Gang is the name of a gang of sibling tasks which are only assigned when all of them can start together
+	Gang *string
+
+	// GangTimeout is the maximum time to wait for every task in the gang to be assigned
+	GangTimeout *time.Duration
+
+	// PlacementStrategy determines how the task is placed across workers
+	PlacementStrategy *types.PlacementStrategy
+
 	// The function to execute when the task runs
 	// must be a function that takes an input and a Hatchet context and returns an output and an error
 	Fn interface{}
@@ -165,6 +192,8 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 		concurrencyOpts := &contracts.Concurrency{
 			Expression: concurrency.Expression,
 			MaxRuns:    concurrency.MaxRuns,
+			MaxQueued:  concurrency.MaxQueued,
+			Group:      concurrency.Group,
 		}
 
 		if concurrency.LimitStrategy != nil {
@@ -174,18 +203,29 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 			concurrencyOpts.LimitStrategy = &strategyEnum
 		}
 
+		if concurrency.QueueOverflow != nil {
+			overflowInt := contracts.ConcurrencyQueueOverflow_value[string(*concurrency.QueueOverflow)]
+			overflowEnum := contracts.ConcurrencyQueueOverflow(overflowInt)
+			concurrencyOpts.QueueOverflow = &overflowEnum
+		}
+
 		taskOpts.Concurrency[j] = concurrencyOpts
 	}
 
 	if t.ExecutionTimeout != nil {
-		taskOpts.Timeout = durationToSeconds(*t.ExecutionTimeout)
+		taskOpts.Timeout = DurationToSeconds(*t.ExecutionTimeout)
 	}
 
 	if t.ScheduleTimeout != nil {
-		scheduleTimeout := durationToSeconds(*t.ScheduleTimeout)
+		scheduleTimeout := DurationToSeconds(*t.ScheduleTimeout)
 		taskOpts.ScheduleTimeout = &scheduleTimeout
 	}
 
+	if t.TotalTimeout != nil {
+		totalTimeout := DurationToSeconds(*t.TotalTimeout)
+		taskOpts.TotalTimeout = &totalTimeout
+	}
+
 	// Only set Retries if it's not nil
 	if t.Retries != nil {
 		taskOpts.Retries = *t.Retries
@@ -199,6 +239,43 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 		taskOpts.BackoffMaxSeconds = t.RetryMaxBackoffSeconds
 	}
 
+	if t.RetryPolicy != nil {
+		taskOpts.RetryErrorCodes = t.RetryPolicy.ErrorCodes
+		taskOpts.RetryJitter = t.RetryPolicy.Jitter
+		taskOpts.RetryMinBackoffSeconds = t.RetryPolicy.MinBackoffSeconds
+	}
+
+	if t.SlotWeight != nil {
+		taskOpts.SlotWeight = t.SlotWeight
+	}
+
+	if len(t.ResourceRequests) > 0 {
+		taskOpts.ResourceRequests = t.ResourceRequests
+	}
+
+	if t.FairnessKey != nil {
+		taskOpts.FairnessKey = t.FairnessKey
+	}
+
+	if t.Preemptible {
+		preemptible := true
+		taskOpts.Preemptible = &preemptible
+	}
+
+	if t.Gang != nil {
+		taskOpts.Gang = t.Gang
+
+		if t.GangTimeout != nil {
+			gangTimeout := DurationToSeconds(*t.GangTimeout)
+			taskOpts.GangTimeout = &gangTimeout
+		}
+	}
+
+	if t.PlacementStrategy != nil {
+		placementStrategy := contracts.PlacementStrategy(*t.PlacementStrategy)
+		taskOpts.PlacementStrategy = &placementStrategy
+	}
+
 	// Apply workflow task defaults if they are not set
 	if taskDefaults != nil {
 		if t.Retries == nil && taskDefaults.Retries != 0 {
@@ -206,11 +283,11 @@ func makeContractTaskOpts(t *TaskShared, taskDefaults *create.TaskDefaults) *con
 		}
 
 		if t.ExecutionTimeout == nil && taskDefaults.ExecutionTimeout != 0 {
-			taskOpts.Timeout = durationToSeconds(taskDefaults.ExecutionTimeout)
+			taskOpts.Timeout = DurationToSeconds(taskDefaults.ExecutionTimeout)
 		}
 
 		if t.ScheduleTimeout == nil && taskDefaults.ScheduleTimeout != 0 {
-			scheduleTimeout := durationToSeconds(taskDefaults.ScheduleTimeout)
+			scheduleTimeout := DurationToSeconds(taskDefaults.ScheduleTimeout)
 			taskOpts.ScheduleTimeout = &scheduleTimeout
 		}
 
@@ -237,6 +314,7 @@ func (t *TaskDeclaration[I]) Dump(workflowName string, taskDefaults *create.Task
 	sleepConditions := make([]*contracts.SleepMatchCondition, 0)
 	userEventConditions := make([]*contracts.UserEventMatchCondition, 0)
 	parentOverrideConditions := make([]*contracts.ParentOverrideMatchCondition, 0)
+	approvalConditions := make([]*contracts.ApprovalMatchCondition, 0)
 
 	if t.WaitFor != nil {
 		cs := t.WaitFor.ToPB(contracts.Action_QUEUE)
@@ -244,6 +322,7 @@ func (t *TaskDeclaration[I]) Dump(workflowName string, taskDefaults *create.Task
 		sleepConditions = append(sleepConditions, cs.SleepConditions...)
 		userEventConditions = append(userEventConditions, cs.UserEventConditions...)
 		parentOverrideConditions = append(parentOverrideConditions, cs.ParentConditions...)
+		approvalConditions = append(approvalConditions, cs.ApprovalConditions...)
 	}
 
 	if t.SkipIf != nil {
@@ -252,6 +331,7 @@ func (t *TaskDeclaration[I]) Dump(workflowName string, taskDefaults *create.Task
 		sleepConditions = append(sleepConditions, cs.SleepConditions...)
 		userEventConditions = append(userEventConditions, cs.UserEventConditions...)
 		parentOverrideConditions = append(parentOverrideConditions, cs.ParentConditions...)
+		approvalConditions = append(approvalConditions, cs.ApprovalConditions...)
 	}
 
 	if t.CancelIf != nil {
@@ -260,20 +340,24 @@ func (t *TaskDeclaration[I]) Dump(workflowName string, taskDefaults *create.Task
 		sleepConditions = append(sleepConditions, cs.SleepConditions...)
 		userEventConditions = append(userEventConditions, cs.UserEventConditions...)
 		parentOverrideConditions = append(parentOverrideConditions, cs.ParentConditions...)
+		approvalConditions = append(approvalConditions, cs.ApprovalConditions...)
 	}
 
 	base.Conditions = &contracts.TaskConditions{
 		SleepConditions:          sleepConditions,
 		UserEventConditions:      userEventConditions,
 		ParentOverrideConditions: parentOverrideConditions,
+		ApprovalConditions:       approvalConditions,
 	}
 
 	return base
 }
 
-func durationToSeconds(d time.Duration) string {
-	if d == 0 {
-		return "0s"
+// DurationToSeconds formats a duration for the engine in whole seconds, or in milliseconds if the duration is
+// not a whole number of seconds.
+func DurationToSeconds(d time.Duration) string {
+	if d%time.Second != 0 {
+		return fmt.Sprintf("%dms", d.Milliseconds())
 	}
 
 	return fmt.Sprintf("%ds", int(d.Seconds()))
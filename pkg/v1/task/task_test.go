@@ -0,0 +1,18 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationToSeconds(t *testing.T) {
+	assert.Equal(t, "0s", DurationToSeconds(0))
+	assert.Equal(t, "5s", DurationToSeconds(5*time.Second))
+	assert.Equal(t, "120s", DurationToSeconds(2*time.Minute))
+	assert.Equal(t, "500ms", DurationToSeconds(500*time.Millisecond))
+	assert.Equal(t, "1500ms", DurationToSeconds(1500*time.Millisecond))
+}
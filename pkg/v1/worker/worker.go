@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	v0Client "github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/pkg/v1/features"
@@ -35,6 +36,10 @@ type Worker interface {
 
 	// Unpause resumes all paused worker instances
 	Unpause(ctx context.Context) error
+
+	// Drain stops new tasks from being assigned to all worker instances and blocks until their running
+	// tasks have finished or the context is done
+	Drain(ctx context.Context) error
 }
 
 // WorkerLabels represents a map of labels that can be assigned to a worker
@@ -49,7 +54,9 @@ type WorkerOpts struct {
 	// (optional) a list of workflows to register on the worker. If not provided, the worker will not run any workflows.
 	Workflows []workflow.WorkflowBase
 
-	// (optional) maximum number of concurrent runs on this worker, defaults to 100
+	// (optional) the slot capacity of this worker, defaults to 100. Each running task consumes a number of
+	// slots equal to its SlotWeight (1 by default), so this is the maximum number of concurrent runs when
+	// all tasks use the default weight.
 	Slots int
 
 	// (optional) labels to set on the worker
@@ -63,6 +70,15 @@ type WorkerOpts struct {
 
 	// (optional) maximum number of concurrent runs for durable tasks, defaults to 1000
 	DurableSlots int
+
+	// (optional) named resource capacities for this worker (i.e. gpu_mem, db_connections). Tasks which set
+	// ResourceRequests are only assigned to this worker while all of their requests fit.
+	Resources map[string]int32
+
+	// (optional) how long to wait for running tasks to finish when the worker is stopped. When set, the worker
+	// is drained before it shuts down: no new tasks are assigned to it, and the cleanup function blocks until
+	// running tasks complete or the timeout elapses. Defaults to 0, which stops the worker immediately.
+	DrainTimeout time.Duration
 }
 
 // WorkerImpl is the concrete implementation of the Worker interface.
@@ -99,6 +115,12 @@ type WorkerImpl struct {
 
 	// labels are the labels assigned to this worker
 	labels WorkerLabels
+
+	// resources are the named resource capacities of this worker
+	resources map[string]int32
+
+	// drainTimeout is how long to wait for running tasks to finish on shutdown
+	drainTimeout time.Duration
 }
 
 // NewWorker creates and configures a new Worker with the provided client and options.
@@ -112,7 +134,10 @@ func NewWorker(workersClient features.WorkersClient, v0 v0Client.Client, opts Wo
 		logLevel:  opts.LogLevel,
 		logger:    opts.Logger,
 		labels:    opts.Labels,
+		resources: opts.Resources,
 		workflows: opts.Workflows,
+
+		drainTimeout: opts.DrainTimeout,
 	}
 
 	if opts.Slots == 0 {
@@ -166,6 +191,7 @@ func (w *WorkerImpl) RegisterWorkflows(workflows ...workflow.WorkflowBase) error
 				worker.WithMaxRuns(w.slots),
 				worker.WithLogLevel(w.logLevel),
 				worker.WithLabels(w.labels),
+				worker.WithResources(w.resources),
 			}
 
 			if w.logger != nil {
@@ -295,6 +321,16 @@ func (w *WorkerImpl) Start() (func() error, error) {
 
 	// Return a combined cleanup function that also uses errgroup for concurrent cleanup
 	return func() error {
+		if w.drainTimeout > 0 {
+			ctx, cancel := context.WithTimeout(context.Background(), w.drainTimeout)
+			defer cancel()
+
+			// the workers are still stopped if they could not be drained in time
+			if err := w.Drain(ctx); err != nil && len(workers) > 0 {
+				workers[0].Logger().Warn().Err(err).Msg("could not drain worker before stopping")
+			}
+		}
+
 		g := new(errgroup.Group)
 
 		for _, cleanup := range cleanupFuncs {
@@ -409,3 +445,27 @@ func (w *WorkerImpl) Unpause(ctx context.Context) error {
 
 	return nil
 }
+
+// Drain stops new tasks from being assigned to all worker instances and blocks until their running tasks have
+// finished or the context is done
+func (w *WorkerImpl) Drain(ctx context.Context) error {
+	var workers []*worker.Worker
+	if w.nonDurableWorker != nil {
+		workers = append(workers, w.nonDurableWorker)
+	}
+	if w.durableWorker != nil {
+		workers = append(workers, w.durableWorker)
+	}
+
+	// Drain workers concurrently so the durable worker's tasks don't wait on the non-durable worker's
+	g := new(errgroup.Group)
+
+	for i := range workers {
+		worker := workers[i]
+		g.Go(func() error {
+			return worker.Drain(ctx)
+		})
+	}
+
+	return g.Wait()
+}
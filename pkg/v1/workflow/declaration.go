@@ -136,6 +136,8 @@ type workflowDeclarationImpl[I any, O any] struct {
 	OnFailureTask  *task.OnFailureTaskDeclaration[I]
 	StickyStrategy *types.StickyStrategy
 
+	PlacementStrategy *types.PlacementStrategy
+
 	TaskDefaults *create.TaskDefaults
 
 	tasks        []*task.TaskDeclaration[I]
@@ -148,8 +150,11 @@ type workflowDeclarationImpl[I any, O any] struct {
 	// Map to store task output setters
 	outputSetters map[string]func(*O, interface{})
 
-	DefaultPriority *int32
-	DefaultFilters  []types.DefaultFilter
+	DefaultPriority      *int32
+	PriorityAgingSeconds *int32
+	DefaultFilters       []types.DefaultFilter
+	Debounce             *types.Debounce
+	RunTimeout           time.Duration
 }
 
 // NewWorkflowDeclaration creates a new workflow declaration with the specified options and client.
@@ -195,16 +200,20 @@ func NewWorkflowDeclaration[I any, O any](opts create.WorkflowCreateOpts[I], v0
 		OnCron:      opts.OnCron,
 		Concurrency: opts.Concurrency,
 		// OnFailureTask:    opts.OnFailureTask, // TODO: add this back in
-		StickyStrategy:   opts.StickyStrategy,
-		TaskDefaults:     opts.TaskDefaults,
-		outputKey:        opts.OutputKey,
-		tasks:            []*task.TaskDeclaration[I]{},
-		taskFuncs:        make(map[string]interface{}),
-		durableTasks:     []*task.DurableTaskDeclaration[I]{},
-		durableTaskFuncs: make(map[string]interface{}),
-		outputSetters:    make(map[string]func(*O, interface{})),
-		DefaultPriority:  opts.DefaultPriority,
-		DefaultFilters:   opts.DefaultFilters,
+		StickyStrategy:       opts.StickyStrategy,
+		PlacementStrategy:    opts.PlacementStrategy,
+		TaskDefaults:         opts.TaskDefaults,
+		outputKey:            opts.OutputKey,
+		tasks:                []*task.TaskDeclaration[I]{},
+		taskFuncs:            make(map[string]interface{}),
+		durableTasks:         []*task.DurableTaskDeclaration[I]{},
+		durableTaskFuncs:     make(map[string]interface{}),
+		outputSetters:        make(map[string]func(*O, interface{})),
+		DefaultPriority:      opts.DefaultPriority,
+		DefaultFilters:       opts.DefaultFilters,
+		PriorityAgingSeconds: opts.PriorityAgingSeconds,
+		Debounce:             opts.Debounce,
+		RunTimeout:           opts.RunTimeout,
 	}
 
 	if opts.Version != "" {
@@ -279,7 +288,12 @@ func (w *workflowDeclarationImpl[I, O]) Task(opts create.WorkflowTask[I, O], fn
 	var retryMaxBackoffSeconds *int32
 	var executionTimeout *time.Duration
 	var scheduleTimeout *time.Duration
+	var totalTimeout *time.Duration
 	var retries *int32
+	var slotWeight *int32
+	var fairnessKey *string
+	var gang *string
+	var gangTimeout *time.Duration
 
 	if opts.RetryBackoffFactor != 0 {
 		retryBackoffFactor = &opts.RetryBackoffFactor
@@ -293,9 +307,24 @@ func (w *workflowDeclarationImpl[I, O]) Task(opts create.WorkflowTask[I, O], fn
 	if opts.ScheduleTimeout != 0 {
 		scheduleTimeout = &opts.ScheduleTimeout
 	}
+	if opts.TotalTimeout != 0 {
+		totalTimeout = &opts.TotalTimeout
+	}
 	if opts.Retries != 0 {
 		retries = &opts.Retries
 	}
+	if opts.SlotWeight != 0 {
+		slotWeight = &opts.SlotWeight
+	}
+	if opts.FairnessKey != "" {
+		fairnessKey = &opts.FairnessKey
+	}
+	if opts.Gang != "" {
+		gang = &opts.Gang
+	}
+	if opts.GangTimeout != 0 {
+		gangTimeout = &opts.GangTimeout
+	}
 
 	// Convert parent task declarations to parent task names
 	parentNames := make([]string, len(opts.Parents))
@@ -313,12 +342,21 @@ func (w *workflowDeclarationImpl[I, O]) Task(opts create.WorkflowTask[I, O], fn
 		TaskShared: task.TaskShared{
 			ExecutionTimeout:       executionTimeout,
 			ScheduleTimeout:        scheduleTimeout,
+			TotalTimeout:           totalTimeout,
 			Retries:                retries,
 			RetryBackoffFactor:     retryBackoffFactor,
 			RetryMaxBackoffSeconds: retryMaxBackoffSeconds,
+			RetryPolicy:            opts.RetryPolicy,
 			RateLimits:             opts.RateLimits,
 			WorkerLabels:           opts.WorkerLabels,
 			Concurrency:            opts.Concurrency,
+			SlotWeight:             slotWeight,
+			ResourceRequests:       opts.ResourceRequests,
+			FairnessKey:            fairnessKey,
+			Preemptible:            opts.Preemptible,
+			Gang:                   gang,
+			GangTimeout:            gangTimeout,
+			PlacementStrategy:      opts.PlacementStrategy,
 		},
 	}
 
@@ -374,7 +412,12 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 	var retryMaxBackoffSeconds *int32
 	var executionTimeout *time.Duration
 	var scheduleTimeout *time.Duration
+	var totalTimeout *time.Duration
 	var retries *int32
+	var slotWeight *int32
+	var fairnessKey *string
+	var gang *string
+	var gangTimeout *time.Duration
 
 	if opts.RetryBackoffFactor != 0 {
 		retryBackoffFactor = &opts.RetryBackoffFactor
@@ -388,9 +431,24 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 	if opts.ScheduleTimeout != 0 {
 		scheduleTimeout = &opts.ScheduleTimeout
 	}
+	if opts.TotalTimeout != 0 {
+		totalTimeout = &opts.TotalTimeout
+	}
 	if opts.Retries != 0 {
 		retries = &opts.Retries
 	}
+	if opts.SlotWeight != 0 {
+		slotWeight = &opts.SlotWeight
+	}
+	if opts.FairnessKey != "" {
+		fairnessKey = &opts.FairnessKey
+	}
+	if opts.Gang != "" {
+		gang = &opts.Gang
+	}
+	if opts.GangTimeout != 0 {
+		gangTimeout = &opts.GangTimeout
+	}
 
 	// Convert parent task declarations to parent task names
 	parentNames := make([]string, len(opts.Parents))
@@ -408,12 +466,21 @@ func (w *workflowDeclarationImpl[I, O]) DurableTask(opts create.WorkflowTask[I,
 		TaskShared: task.TaskShared{
 			ExecutionTimeout:       executionTimeout,
 			ScheduleTimeout:        scheduleTimeout,
+			TotalTimeout:           totalTimeout,
 			Retries:                retries,
 			RetryBackoffFactor:     retryBackoffFactor,
 			RetryMaxBackoffSeconds: retryMaxBackoffSeconds,
+			RetryPolicy:            opts.RetryPolicy,
 			RateLimits:             opts.RateLimits,
 			WorkerLabels:           opts.WorkerLabels,
 			Concurrency:            opts.Concurrency,
+			SlotWeight:             slotWeight,
+			ResourceRequests:       opts.ResourceRequests,
+			FairnessKey:            fairnessKey,
+			Preemptible:            opts.Preemptible,
+			Gang:                   gang,
+			GangTimeout:            gangTimeout,
+			PlacementStrategy:      opts.PlacementStrategy,
 		},
 	}
 
@@ -457,7 +524,10 @@ func (w *workflowDeclarationImpl[I, O]) OnFailure(opts create.WorkflowOnFailureT
 	var retryMaxBackoffSeconds *int32
 	var executionTimeout *time.Duration
 	var scheduleTimeout *time.Duration
+	var totalTimeout *time.Duration
 	var retries *int32
+	var slotWeight *int32
+	var fairnessKey *string
 
 	if opts.RetryBackoffFactor != 0 {
 		retryBackoffFactor = &opts.RetryBackoffFactor
@@ -471,21 +541,37 @@ func (w *workflowDeclarationImpl[I, O]) OnFailure(opts create.WorkflowOnFailureT
 	if opts.ScheduleTimeout != 0 {
 		scheduleTimeout = &opts.ScheduleTimeout
 	}
+	if opts.TotalTimeout != 0 {
+		totalTimeout = &opts.TotalTimeout
+	}
 	if opts.Retries != 0 {
 		retries = &opts.Retries
 	}
+	if opts.SlotWeight != 0 {
+		slotWeight = &opts.SlotWeight
+	}
+	if opts.FairnessKey != "" {
+		fairnessKey = &opts.FairnessKey
+	}
 
 	taskDecl := &task.OnFailureTaskDeclaration[I]{
 		Fn: genericFn,
 		TaskShared: task.TaskShared{
 			ExecutionTimeout:       executionTimeout,
 			ScheduleTimeout:        scheduleTimeout,
+			TotalTimeout:           totalTimeout,
 			Retries:                retries,
 			RetryBackoffFactor:     retryBackoffFactor,
 			RetryMaxBackoffSeconds: retryMaxBackoffSeconds,
+			RetryPolicy:            opts.RetryPolicy,
 			RateLimits:             opts.RateLimits,
 			WorkerLabels:           opts.WorkerLabels,
 			Concurrency:            opts.Concurrency,
+			SlotWeight:             slotWeight,
+			ResourceRequests:       opts.ResourceRequests,
+			FairnessKey:            fairnessKey,
+			Preemptible:            opts.Preemptible,
+			PlacementStrategy:      opts.PlacementStrategy,
 		},
 	}
 
@@ -747,11 +833,12 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 	tasksToRegister := append(taskOpts, durableOpts...)
 
 	req := &contracts.CreateWorkflowVersionRequest{
-		Tasks:           tasksToRegister,
-		Name:            w.Name,
-		EventTriggers:   w.OnEvents,
-		CronTriggers:    w.OnCron,
-		DefaultPriority: w.DefaultPriority,
+		Tasks:                tasksToRegister,
+		Name:                 w.Name,
+		EventTriggers:        w.OnEvents,
+		CronTriggers:         w.OnCron,
+		DefaultPriority:      w.DefaultPriority,
+		PriorityAgingSeconds: w.PriorityAgingSeconds,
 	}
 
 	if w.Version != nil {
@@ -762,10 +849,24 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 		req.Description = *w.Description
 	}
 
+	if w.Debounce != nil {
+		req.Debounce = &contracts.Debounce{
+			Expression: w.Debounce.Expression,
+			Window:     task.DurationToSeconds(w.Debounce.Window),
+		}
+	}
+
+	if w.RunTimeout != 0 {
+		runTimeout := task.DurationToSeconds(w.RunTimeout)
+		req.ExecutionTimeout = &runTimeout
+	}
+
 	for _, concurrency := range w.Concurrency {
 		c := contracts.Concurrency{
 			Expression: concurrency.Expression,
 			MaxRuns:    concurrency.MaxRuns,
+			MaxQueued:  concurrency.MaxQueued,
+			Group:      concurrency.Group,
 		}
 
 		if concurrency.LimitStrategy != nil {
@@ -775,6 +876,12 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 			c.LimitStrategy = &strategyEnum
 		}
 
+		if concurrency.QueueOverflow != nil {
+			overflowInt := contracts.ConcurrencyQueueOverflow_value[string(*concurrency.QueueOverflow)]
+			overflowEnum := contracts.ConcurrencyQueueOverflow(overflowInt)
+			c.QueueOverflow = &overflowEnum
+		}
+
 		req.ConcurrencyArr = append(req.ConcurrencyArr, &c)
 	}
 
@@ -787,6 +894,11 @@ func (w *workflowDeclarationImpl[I, O]) Dump() (*contracts.CreateWorkflowVersion
 		req.Sticky = &stickyStrategy
 	}
 
+	if w.PlacementStrategy != nil {
+		placementStrategy := contracts.PlacementStrategy(*w.PlacementStrategy)
+		req.PlacementStrategy = &placementStrategy
+	}
+
 	// Create named function objects for regular tasks
 	regularNamedFns := make([]NamedFunction, len(w.tasks))
 	for i, task := range w.tasks {
@@ -10,7 +10,7 @@ import (
 
 // Condition represents a set of conditions to either trigger a task or satisfy a wait condition.
 // Callers should not use Condition directly. Instead, you should use a condition wrapper, such
-// as Conditions, SleepCondition, UserEventCondition, ParentCondition, Or.
+// as Conditions, SleepCondition, UserEventCondition, ParentCondition, ApprovalCondition, SignalCondition, Or.
 type Condition interface {
 	ToPB(action contracts.Action) *ConditionMulti
 }
@@ -19,6 +19,8 @@ type ConditionMulti struct {
 	SleepConditions     []*contracts.SleepMatchCondition
 	UserEventConditions []*contracts.UserEventMatchCondition
 	ParentConditions    []*contracts.ParentOverrideMatchCondition
+	ApprovalConditions  []*contracts.ApprovalMatchCondition
+	SignalConditions    []*contracts.SignalMatchCondition
 }
 
 type baseCondition struct {
@@ -41,6 +43,8 @@ func (b *baseCondition) ToPB(action contracts.Action) *ConditionMulti {
 		SleepConditions:     nil,
 		UserEventConditions: nil,
 		ParentConditions:    nil,
+		ApprovalConditions:  nil,
+		SignalConditions:    nil,
 	}
 }
 
@@ -74,6 +78,8 @@ func (s *sleepCondition) ToPB(action contracts.Action) *ConditionMulti {
 		SleepConditions:     []*contracts.SleepMatchCondition{sleep},
 		UserEventConditions: nil,
 		ParentConditions:    nil,
+		ApprovalConditions:  nil,
+		SignalConditions:    nil,
 	}
 }
 
@@ -107,6 +113,8 @@ func (u *userEventCondition) ToPB(action contracts.Action) *ConditionMulti {
 		SleepConditions:     nil,
 		UserEventConditions: []*contracts.UserEventMatchCondition{userEvent},
 		ParentConditions:    nil,
+		ApprovalConditions:  nil,
+		SignalConditions:    nil,
 	}
 }
 
@@ -144,6 +152,87 @@ func (p *parentCondition) ToPB(action contracts.Action) *ConditionMulti {
 		SleepConditions:     nil,
 		UserEventConditions: nil,
 		ParentConditions:    []*contracts.ParentOverrideMatchCondition{parent},
+		ApprovalConditions:  nil,
+		SignalConditions:    nil,
+	}
+}
+
+type approvalCondition struct {
+	baseCondition
+
+	expiresIn    time.Duration
+	skipOnExpiry bool
+}
+
+// ApprovalCondition creates a new condition that waits for a user to approve the task through the
+// Hatchet API or dashboard. The key is the readable data key under which the approval decision is made
+// available to the task. If the task is rejected, it fails without being run.
+func ApprovalCondition(key string) *approvalCondition {
+	return &approvalCondition{
+		baseCondition: baseCondition{
+			readableDataKey: key,
+		},
+	}
+}
+
+// ExpiresIn sets a duration after which a pending approval expires. By default, the task fails
+// when the approval expires. If skipOnExpiry is true, the task is skipped instead.
+func (a *approvalCondition) ExpiresIn(duration time.Duration, skipOnExpiry bool) *approvalCondition {
+	a.expiresIn = duration
+	a.skipOnExpiry = skipOnExpiry
+	return a
+}
+
+func (a *approvalCondition) ToPB(action contracts.Action) *ConditionMulti {
+	approval := &contracts.ApprovalMatchCondition{
+		Base:         a.baseCondition.baseCondition(action),
+		SkipOnExpiry: a.skipOnExpiry,
+	}
+
+	if a.expiresIn > 0 {
+		approval.ExpiresIn = a.expiresIn.String()
+	}
+
+	return &ConditionMulti{
+		SleepConditions:     nil,
+		UserEventConditions: nil,
+		ParentConditions:    nil,
+		ApprovalConditions:  []*contracts.ApprovalMatchCondition{approval},
+		SignalConditions:    nil,
+	}
+}
+
+type signalCondition struct {
+	baseCondition
+
+	name string
+}
+
+// SignalCondition creates a new condition that waits for a signal with the given name to be sent to the
+// task through the Hatchet API. Signals which are sent before the task waits on them are stored, so the
+// condition is satisfied immediately if a signal was already sent. Signal conditions can only be used in
+// durable tasks.
+func SignalCondition(name string) *signalCondition {
+	return &signalCondition{
+		baseCondition: baseCondition{
+			readableDataKey: name,
+		},
+		name: name,
+	}
+}
+
+func (s *signalCondition) ToPB(action contracts.Action) *ConditionMulti {
+	signal := &contracts.SignalMatchCondition{
+		Base:       s.baseCondition.baseCondition(action),
+		SignalName: s.name,
+	}
+
+	return &ConditionMulti{
+		SleepConditions:     nil,
+		UserEventConditions: nil,
+		ParentConditions:    nil,
+		ApprovalConditions:  nil,
+		SignalConditions:    []*contracts.SignalMatchCondition{signal},
 	}
 }
 
@@ -166,6 +255,8 @@ func (o *orGroup) ToPB(action contracts.Action) *ConditionMulti {
 	sleepConditions := make([]*contracts.SleepMatchCondition, 0)
 	userEventConditions := make([]*contracts.UserEventMatchCondition, 0)
 	parentConditions := make([]*contracts.ParentOverrideMatchCondition, 0)
+	approvalConditions := make([]*contracts.ApprovalMatchCondition, 0)
+	signalConditions := make([]*contracts.SignalMatchCondition, 0)
 
 	for _, condition := range o.conditions {
 		c := condition.ToPB(action)
@@ -184,12 +275,24 @@ func (o *orGroup) ToPB(action contracts.Action) *ConditionMulti {
 			parentCondition.Base.OrGroupId = o.orGroupID.String()
 			parentConditions = append(parentConditions, parentCondition)
 		}
+
+		for _, approvalCondition := range c.ApprovalConditions {
+			approvalCondition.Base.OrGroupId = o.orGroupID.String()
+			approvalConditions = append(approvalConditions, approvalCondition)
+		}
+
+		for _, signalCondition := range c.SignalConditions {
+			signalCondition.Base.OrGroupId = o.orGroupID.String()
+			signalConditions = append(signalConditions, signalCondition)
+		}
 	}
 
 	return &ConditionMulti{
 		SleepConditions:     sleepConditions,
 		UserEventConditions: userEventConditions,
 		ParentConditions:    parentConditions,
+		ApprovalConditions:  approvalConditions,
+		SignalConditions:    signalConditions,
 	}
 }
 
@@ -208,17 +311,23 @@ func (c *conditions) ToPB(action contracts.Action) *ConditionMulti {
 	sleepConditions := make([]*contracts.SleepMatchCondition, 0)
 	userEventConditions := make([]*contracts.UserEventMatchCondition, 0)
 	parentConditions := make([]*contracts.ParentOverrideMatchCondition, 0)
+	approvalConditions := make([]*contracts.ApprovalMatchCondition, 0)
+	signalConditions := make([]*contracts.SignalMatchCondition, 0)
 
 	for _, condition := range c.conditions {
 		c := condition.ToPB(action)
 		sleepConditions = append(sleepConditions, c.SleepConditions...)
 		userEventConditions = append(userEventConditions, c.UserEventConditions...)
 		parentConditions = append(parentConditions, c.ParentConditions...)
+		approvalConditions = append(approvalConditions, c.ApprovalConditions...)
+		signalConditions = append(signalConditions, c.SignalConditions...)
 	}
 
 	return &ConditionMulti{
 		SleepConditions:     sleepConditions,
 		UserEventConditions: userEventConditions,
 		ParentConditions:    parentConditions,
+		ApprovalConditions:  approvalConditions,
+		SignalConditions:    signalConditions,
 	}
 }
@@ -49,6 +49,8 @@ type HatchetContext interface {
 
 	StepRunErrors() map[string]string
 
+	StepRunErrorDetails() map[string]StructuredError
+
 	TriggeredByEvent() bool
 
 	WorkflowInput(target interface{}) error
@@ -114,13 +116,14 @@ type JobRunLookupData struct {
 }
 
 type StepRunData struct {
-	Input              map[string]interface{}            `json:"input"`
-	TriggeredBy        TriggeredBy                       `json:"triggered_by"`
-	Parents            map[string]StepData               `json:"parents"`
-	Triggers           map[string]map[string]interface{} `json:"triggers,omitempty"`
-	AdditionalMetadata map[string]string                 `json:"additional_metadata"`
-	UserData           map[string]interface{}            `json:"user_data"`
-	StepRunErrors      map[string]string                 `json:"step_run_errors,omitempty"`
+	Input               map[string]interface{}            `json:"input"`
+	TriggeredBy         TriggeredBy                       `json:"triggered_by"`
+	Parents             map[string]StepData               `json:"parents"`
+	Triggers            map[string]map[string]interface{} `json:"triggers,omitempty"`
+	AdditionalMetadata  map[string]string                 `json:"additional_metadata"`
+	UserData            map[string]interface{}            `json:"user_data"`
+	StepRunErrors       map[string]string                 `json:"step_run_errors,omitempty"`
+	StepRunErrorDetails map[string]StructuredError        `json:"step_run_error_details,omitempty"`
 }
 
 type StepData map[string]interface{}
@@ -260,6 +263,12 @@ func (h *hatchetContext) StepRunErrors() map[string]string {
 	return errors
 }
 
+// StepRunErrorDetails returns the structured errors of the failed upstream steps, keyed by step name. It is
+// intended to be run in an on-failure step.
+func (h *hatchetContext) StepRunErrorDetails() map[string]StructuredError {
+	return h.stepData.StepRunErrorDetails
+}
+
 func (h *hatchetContext) UserData(target interface{}) error {
 	return toTarget(h.stepData.UserData, target)
 }
@@ -699,6 +708,11 @@ type DurableHatchetContext interface {
 	// TODO: docs
 	WaitForEvent(eventKey, expression string) (*SingleWaitResult, error)
 
+	// WaitForSignal pauses execution until a signal with the given name is sent to the task, and returns
+	// the payload of the signal. Signals are sent by task or workflow run id through the Hatchet API, and
+	// signals which were sent before the task started waiting are delivered immediately.
+	WaitForSignal(name string) (*SingleWaitResult, error)
+
 	// WaitFor pauses execution until the specified conditions are met.
 	// Conditions are "global" meaning they will wait in real time regardless of transient failures
 	// like worker restarts.
@@ -744,6 +758,17 @@ func (d *durableHatchetContext) WaitForEvent(eventKey, expression string) (*Sing
 	return newSingleWaitResult(eventKey, wr), nil
 }
 
+// WaitForSignal implements the DurableHatchetContext.WaitForSignal method.
+func (d *durableHatchetContext) WaitForSignal(name string) (*SingleWaitResult, error) {
+	wr, err := d.WaitFor(condition.SignalCondition(name))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newSingleWaitResult(name, wr), nil
+}
+
 // WaitFor implements the DurableHatchetContext.WaitFor method.
 func (d *durableHatchetContext) WaitFor(conditions condition.Condition) (*WaitResult, error) {
 	// Increment wait key to ensure unique keys for multiple wait operations
@@ -761,6 +786,11 @@ func (d *durableHatchetContext) WaitFor(conditions condition.Condition) (*WaitRe
 
 	// compose the durable event to listen for
 	c := conditions.ToPB(v1.Action_CREATE)
+
+	if len(c.ApprovalConditions) > 0 {
+		return nil, fmt.Errorf("approval conditions are not supported in durable waits")
+	}
+
 	signalKey := fmt.Sprintf("signal-%d", count)
 
 	_, err = d.client().Dispatcher().RegisterDurableEvent(d, &v1.RegisterDurableEventRequest{
@@ -769,6 +799,7 @@ func (d *durableHatchetContext) WaitFor(conditions condition.Condition) (*WaitRe
 		Conditions: &v1.DurableEventListenerConditions{
 			SleepConditions:     c.SleepConditions,
 			UserEventConditions: c.UserEventConditions,
+			SignalConditions:    c.SignalConditions,
 		},
 	})
 
@@ -1,6 +1,9 @@
 package worker
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 type NonRetryableError struct {
 	e error
@@ -10,6 +13,10 @@ func (e *NonRetryableError) Error() string {
 	return e.e.Error()
 }
 
+func (e *NonRetryableError) Unwrap() error {
+	return e.e
+}
+
 func NewNonRetryableError(err error) error {
 	return &NonRetryableError{e: err}
 }
@@ -18,3 +25,142 @@ func IsNonRetryableError(err error) bool {
 	e := &NonRetryableError{}
 	return errors.As(err, &e)
 }
+
+// ErrorWithCode attaches an error code to an error. The code is sent along with the failure and matched
+// against the retry policy of the task, for example to never retry a VALIDATION error.
+type ErrorWithCode struct {
+	code string
+	e    error
+}
+
+func (e *ErrorWithCode) Error() string {
+	return e.e.Error()
+}
+
+func (e *ErrorWithCode) Unwrap() error {
+	return e.e
+}
+
+func (e *ErrorWithCode) Code() string {
+	return e.code
+}
+
+func NewErrorWithCode(code string, err error) error {
+	return &ErrorWithCode{code: code, e: err}
+}
+
+// GetErrorCode returns the error code of the first ErrorWithCode in the error chain.
+func GetErrorCode(err error) (string, bool) {
+	e := &ErrorWithCode{}
+
+	if errors.As(err, &e) {
+		return e.code, true
+	}
+
+	return "", false
+}
+
+// ErrorWithDetails attaches arbitrary JSON-serializable details to an error. The details are sent along with
+// the structured failure payload of the task.
+type ErrorWithDetails struct {
+	details map[string]interface{}
+	e       error
+}
+
+func (e *ErrorWithDetails) Error() string {
+	return e.e.Error()
+}
+
+func (e *ErrorWithDetails) Unwrap() error {
+	return e.e
+}
+
+func (e *ErrorWithDetails) Details() map[string]interface{} {
+	return e.details
+}
+
+func NewErrorWithDetails(err error, details map[string]interface{}) error {
+	return &ErrorWithDetails{details: details, e: err}
+}
+
+// GetErrorDetails returns the details of the first ErrorWithDetails in the error chain.
+func GetErrorDetails(err error) (map[string]interface{}, bool) {
+	e := &ErrorWithDetails{}
+
+	if errors.As(err, &e) {
+		return e.details, true
+	}
+
+	return nil, false
+}
+
+// panicError is the error of a recovered panic, which keeps the stack trace of the panic.
+type panicError struct {
+	stack []byte
+	e     error
+}
+
+func (e *panicError) Error() string {
+	return e.e.Error()
+}
+
+func (e *panicError) Unwrap() error {
+	return e.e
+}
+
+// StructuredError is the structured failure payload which is sent to the engine when a task fails.
+type StructuredError struct {
+	// the type of the error, either the value of an `ErrorType() string` method or the Go type of the error
+	Type string `json:"type"`
+
+	Message string `json:"message"`
+
+	StackTrace string `json:"stack_trace,omitempty"`
+
+	Retryable bool `json:"retryable"`
+
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// NewStructuredError builds the structured failure payload of an error.
+func NewStructuredError(err error) *StructuredError {
+	res := &StructuredError{
+		Type:      getErrorType(err),
+		Message:   err.Error(),
+		Retryable: !IsNonRetryableError(err),
+	}
+
+	if details, ok := GetErrorDetails(err); ok {
+		res.Details = details
+	}
+
+	p := &panicError{}
+
+	if errors.As(err, &p) {
+		res.StackTrace = string(p.stack)
+	}
+
+	return res
+}
+
+// getErrorType returns the type of the error, skipping the wrappers of this package.
+func getErrorType(err error) string {
+	for {
+		if t, ok := err.(interface{ ErrorType() string }); ok {
+			return t.ErrorType()
+		}
+
+		switch e := err.(type) {
+		case *NonRetryableError:
+			err = e.e
+		case *ErrorWithCode:
+			err = e.e
+		case *ErrorWithDetails:
+			err = e.e
+		case *panicError:
+			return "panic"
+		default:
+			return fmt.Sprintf("%T", err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+//go:build !e2e && !load && !rampup && !integration
+
+package worker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type paymentError struct{}
+
+func (e *paymentError) Error() string {
+	return "card declined"
+}
+
+func (e *paymentError) ErrorType() string {
+	return "PaymentError"
+}
+
+func TestNewStructuredError(t *testing.T) {
+	err := NewNonRetryableError(NewErrorWithDetails(errors.New("invalid input"), map[string]interface{}{
+		"field": "email",
+	}))
+
+	res := NewStructuredError(err)
+
+	assert.Equal(t, "*errors.errorString", res.Type)
+	assert.Equal(t, "invalid input", res.Message)
+	assert.False(t, res.Retryable)
+	assert.Equal(t, "", res.StackTrace)
+	assert.Equal(t, map[string]interface{}{"field": "email"}, res.Details)
+}
+
+func TestNewStructuredErrorType(t *testing.T) {
+	res := NewStructuredError(NewErrorWithCode("CARD_DECLINED", &paymentError{}))
+
+	assert.Equal(t, "PaymentError", res.Type)
+	assert.True(t, res.Retryable)
+	assert.Nil(t, res.Details)
+}
+
+func TestNewStructuredErrorPanic(t *testing.T) {
+	res := NewStructuredError(&panicError{stack: []byte("goroutine 1"), e: errors.New("recovered from panic")})
+
+	assert.Equal(t, "panic", res.Type)
+	assert.Equal(t, "goroutine 1", res.StackTrace)
+}
@@ -54,7 +54,12 @@ func (w *Worker) panicMiddleware(ctx HatchetContext, next func(HatchetContext) e
 					err = fmt.Errorf("%v", r)
 				}
 
-				innerErr := w.sendFailureEvent(ctx, fmt.Errorf("recovered from panic: %w. Stack trace:\n%s", err, string(debug.Stack())))
+				stack := debug.Stack()
+
+				innerErr := w.sendFailureEvent(ctx, &panicError{
+					stack: stack,
+					e:     fmt.Errorf("recovered from panic: %w. Stack trace:\n%s", err, string(stack)),
+				})
 
 				if innerErr != nil {
 					w.l.Error().Err(innerErr).Msg("could not send failure event")
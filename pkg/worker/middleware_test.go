@@ -55,6 +55,10 @@ func (c *testHatchetContext) StepRunErrors() map[string]string {
 	return make(map[string]string)
 }
 
+func (c *testHatchetContext) StepRunErrorDetails() map[string]StructuredError {
+	return make(map[string]StructuredError)
+}
+
 func (c *testHatchetContext) AdditionalMetadata() map[string]string {
 	return nil
 }
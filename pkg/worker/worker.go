@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/pkg/client/compute"
@@ -79,6 +82,8 @@ func (j *actionImpl) Compute() *compute.Compute {
 
 type ActionRegistry map[string]Action
 
+const drainPollInterval = time.Second
+
 type Worker struct {
 	client client.Client
 
@@ -106,6 +111,8 @@ type Worker struct {
 
 	labels map[string]interface{}
 
+	resources map[string]int32
+
 	id *string
 }
 
@@ -123,6 +130,8 @@ type WorkerOpts struct {
 	actions []string
 
 	labels map[string]interface{}
+
+	resources map[string]int32
 }
 
 func defaultWorkerOpts() *WorkerOpts {
@@ -176,6 +185,14 @@ func WithLabels(labels map[string]interface{}) WorkerOpt {
 	}
 }
 
+// WithResources sets the named resource capacities of the worker (i.e. gpu_mem, db_connections). Tasks
+// which request these resources are only assigned to the worker while their requests fit.
+func WithResources(resources map[string]int32) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.resources = resources
+	}
+}
+
 func WithLogger(l *zerolog.Logger) WorkerOpt {
 	return func(opts *WorkerOpts) {
 		if opts.l != nil {
@@ -240,6 +257,7 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 		maxRuns:              opts.maxRuns,
 		initActionNames:      opts.actions,
 		labels:               opts.labels,
+		resources:            opts.resources,
 		registered_workflows: map[string]bool{},
 	}
 
@@ -430,6 +448,44 @@ func (w *Worker) ID() *string {
 	return w.id
 }
 
+// Drain stops new tasks from being assigned to the worker and blocks until the tasks which are running on
+// the worker have finished, or the context is done. The worker keeps listening for actions while draining,
+// so cancellations are still delivered to running tasks.
+func (w *Worker) Drain(ctx context.Context) error {
+	if w.id == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		inFlight, err := w.client.Dispatcher().DrainWorker(ctx, *w.id)
+
+		if err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				w.l.Warn().Msgf("worker %s could not be drained: draining is not supported by the engine", w.name)
+				return nil
+			}
+
+			return fmt.Errorf("could not drain worker: %w", err)
+		}
+
+		if len(inFlight) == 0 {
+			w.l.Debug().Msgf("worker %s is drained", w.name)
+			return nil
+		}
+
+		w.l.Debug().Msgf("worker %s is draining, waiting on %d tasks", w.name, len(inFlight))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("worker %s did not drain, %d tasks still running: %w", w.name, len(inFlight), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 func (w *Worker) startBlocking(ctx context.Context) error {
 	actionNames := []string{}
 
@@ -452,6 +508,7 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 		Actions:    actionNames,
 		MaxRuns:    w.maxRuns,
 		Labels:     w.labels,
+		Resources:  w.resources,
 	})
 
 	w.id = id
@@ -766,6 +823,17 @@ func (w *Worker) sendFailureEvent(ctx HatchetContext, err error) error {
 		failureEvent.ShouldNotRetry = &shouldNotRetry
 	}
 
+	if code, ok := GetErrorCode(err); ok {
+		failureEvent.ErrorCode = &code
+	}
+
+	if errorDetails, marshalErr := json.Marshal(NewStructuredError(err)); marshalErr == nil {
+		errorDetailsStr := string(errorDetails)
+		failureEvent.ErrorDetails = &errorDetailsStr
+	} else {
+		w.l.Warn().Err(marshalErr).Msg("could not marshal structured error")
+	}
+
 	innerCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -55,9 +55,13 @@ CREATE TYPE "StepExpressionKind" AS ENUM (
     'DYNAMIC_RATE_LIMIT_KEY',
     'DYNAMIC_RATE_LIMIT_VALUE',
     'DYNAMIC_RATE_LIMIT_UNITS',
-    'DYNAMIC_RATE_LIMIT_WINDOW'
+    'DYNAMIC_RATE_LIMIT_WINDOW',
+    'FAIRNESS_KEY'
 );
 
+-- CreateEnum
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'SLIDING_WINDOW', 'TOKEN_BUCKET', 'SEMAPHORE');
+
 -- CreateEnum
 CREATE TYPE "StepRateLimitKind" AS ENUM ('STATIC', 'DYNAMIC');
 
@@ -362,7 +366,18 @@ CREATE TABLE "RateLimit" (
     "limitValue" INTEGER NOT NULL,
     "value" INTEGER NOT NULL,
     "window" TEXT NOT NULL,
-    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW',
+    -- the capacity of a token bucket, defaults to limitValue
+    "burst" INTEGER,
+    -- the tokens added to a token bucket per second, defaults to limitValue per window
+    "refillRate" DOUBLE PRECISION,
+    -- the units used in the previous window of a sliding window
+    "previousUsage" INTEGER NOT NULL DEFAULT 0,
+    -- whether the rate limit was created from a dynamic key expression rather than through the API
+    "isDynamic" BOOLEAN NOT NULL DEFAULT false,
+    -- the last time units were consumed from the rate limit or a task referencing it was created
+    "lastUsedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
 -- CreateTable
@@ -450,6 +465,18 @@ CREATE TABLE "Step" (
     -- the maximum amount of time in seconds to wait between retries
     "retryMaxBackoff" INTEGER,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    -- the number of worker slots a single run of this step consumes
+    "slotWeight" INTEGER NOT NULL DEFAULT 1,
+    -- whether runs of this step can be cancelled and requeued to make room for higher-priority runs
+    "preemptible" BOOLEAN NOT NULL DEFAULT false,
+    -- the name of the gang this step belongs to. steps in the same gang are only assigned when all of them can start together
+    "gangName" TEXT,
+    -- the maximum amount of time to wait for all members of the gang to be assigned
+    "gangTimeout" TEXT,
+    -- how runs of this step are placed across workers: SPREAD, PACK or LEAST_LOADED. defaults to SPREAD when null
+    "placementStrategy" TEXT,
+    -- the maximum wall-clock time of a run of this step across all of its attempts, including retries and backoff
+    "totalTimeout" TEXT,
 
     CONSTRAINT "Step_pkey" PRIMARY KEY ("id")
 );
@@ -613,6 +640,9 @@ CREATE TABLE "Tenant" (
     "dataRetentionPeriod" TEXT NOT NULL DEFAULT '720h',
     "schedulerPartitionId" TEXT,
     "canUpgradeV1" BOOLEAN NOT NULL DEFAULT true,
+    -- the number of seconds a queued task waits before its effective priority is raised by 1. aging
+    -- is disabled when this is NULL.
+    "priorityAgingSeconds" INTEGER,
 
     CONSTRAINT "Tenant_pkey" PRIMARY KEY ("id")
 );
@@ -855,6 +885,7 @@ CREATE TABLE "Worker" (
     "os" TEXT,
     "runtimeExtra" TEXT,
     "sdkVersion" TEXT,
+    "drainingAt" TIMESTAMP(3),
 
     CONSTRAINT "Worker_pkey" PRIMARY KEY ("id")
 );
@@ -1074,6 +1105,13 @@ CREATE TABLE
         "kind" "WorkflowKind" NOT NULL DEFAULT 'DAG',
         "defaultPriority" INTEGER,
         "createWorkflowVersionOpts" JSONB,
+        "priorityAgingSeconds" INTEGER,
+        -- triggers with the same debounce key are coalesced into a single run, which starts once no new
+        -- triggers have arrived for the debounce window
+        "debounceExpression" TEXT,
+        "debounceWindow" TEXT,
+        -- the maximum wall-clock time of a run of this workflow version, including retries and backoff
+        "executionTimeout" TEXT,
         CONSTRAINT "WorkflowVersion_pkey" PRIMARY KEY ("id")
     );
 
@@ -121,7 +121,10 @@ CREATE TYPE v1_task_initial_state AS ENUM ('QUEUED', 'CANCELLED', 'SKIPPED', 'FA
 
 -- We need a NONE strategy to allow for tasks which were previously using a concurrency strategy to
 -- enqueue if the strategy is removed.
-CREATE TYPE v1_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST');
+CREATE TYPE v1_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST', 'QUEUE_NEWEST');
+
+-- Which run a QUEUE_NEWEST strategy cancels once the per-key queue is full
+CREATE TYPE v1_concurrency_queue_overflow AS ENUM ('DROP_OLDEST', 'REJECT_NEWEST');
 
 CREATE TABLE v1_workflow_concurrency (
     -- We need an id used for stable ordering to prevent deadlocks. We must process all concurrency
@@ -136,9 +139,17 @@ CREATE TABLE v1_workflow_concurrency (
     expression TEXT NOT NULL,
     tenant_id UUID NOT NULL,
     max_concurrency INTEGER NOT NULL,
+    -- The maximum number of queued runs per key for QUEUE_NEWEST, unbounded if NULL
+    max_queued INTEGER,
+    queue_overflow v1_concurrency_queue_overflow NOT NULL DEFAULT 'DROP_OLDEST',
+    -- The name of a tenant-level concurrency group. GROUP_ROUND_ROBIN strategies with the same group share
+    -- their slots across workflows.
+    concurrency_group TEXT,
     CONSTRAINT v1_workflow_concurrency_pkey PRIMARY KEY (workflow_id, workflow_version_id, id)
 );
 
+CREATE INDEX v1_workflow_concurrency_group_idx ON v1_workflow_concurrency (tenant_id, concurrency_group) WHERE concurrency_group IS NOT NULL;
+
 CREATE TABLE v1_step_concurrency (
     -- We need an id used for stable ordering to prevent deadlocks. We must process all concurrency
     -- strategies on a step in the same order.
@@ -154,9 +165,28 @@ CREATE TABLE v1_step_concurrency (
     expression TEXT NOT NULL,
     tenant_id UUID NOT NULL,
     max_concurrency INTEGER NOT NULL,
+    -- The maximum number of queued runs per key for QUEUE_NEWEST, unbounded if NULL
+    max_queued INTEGER,
+    queue_overflow v1_concurrency_queue_overflow NOT NULL DEFAULT 'DROP_OLDEST',
+    -- The name of a tenant-level concurrency group, copied from the parent strategy if there is one
+    concurrency_group TEXT,
     CONSTRAINT v1_step_concurrency_pkey PRIMARY KEY (workflow_id, workflow_version_id, step_id, id)
 );
 
+CREATE INDEX v1_step_concurrency_group_idx ON v1_step_concurrency (tenant_id, concurrency_group) WHERE concurrency_group IS NOT NULL;
+
+-- Overrides the max runs of GROUP_ROUND_ROBIN strategies for a single concurrency key of a workflow, so limits
+-- can be changed without registering a new workflow version
+CREATE TABLE v1_concurrency_key_limit (
+    tenant_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    max_runs INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, workflow_id, key)
+);
+
 CREATE OR REPLACE FUNCTION create_v1_step_concurrency()
 RETURNS trigger AS $$
 DECLARE
@@ -282,6 +312,11 @@ CREATE TABLE v1_task (
     concurrency_keys TEXT[],
     retry_backoff_factor DOUBLE PRECISION,
     retry_max_backoff INTEGER,
+    -- the evaluated fairness key, used to share worker slots fairly between keys on the same queue
+    fairness_key TEXT,
+    -- set by the retry policy of the step on an application failure, the time after which the next retry is queued.
+    -- overrides the backoff computed from retry_backoff_factor.
+    retry_after TIMESTAMPTZ,
     CONSTRAINT v1_task_pkey PRIMARY KEY (id, inserted_at)
 ) PARTITION BY RANGE(inserted_at);
 
@@ -340,6 +375,16 @@ CREATE TABLE v1_task_expression_eval (
     CONSTRAINT v1_task_expression_eval_pkey PRIMARY KEY (task_id, task_inserted_at, kind, key)
 );
 
+CREATE TYPE v1_scheduling_reason AS ENUM (
+    'NO_WORKERS',
+    'NO_SLOTS',
+    'LABEL_MISMATCH',
+    'STICKY_WORKER_UNAVAILABLE',
+    'RATE_LIMITED',
+    'RESOURCES_UNAVAILABLE',
+    'GANG_WAITING'
+);
+
 -- CreateTable
 CREATE TABLE v1_queue_item (
     id bigint GENERATED ALWAYS AS IDENTITY,
@@ -358,6 +403,12 @@ CREATE TABLE v1_queue_item (
     sticky v1_sticky_strategy NOT NULL,
     desired_worker_id UUID,
     retry_count INTEGER NOT NULL DEFAULT 0,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    fairness_key TEXT,
+    -- the reason that the scheduler last failed to assign this queue item, if it has been evaluated
+    scheduling_reason v1_scheduling_reason,
+    scheduling_message TEXT,
+    scheduling_evaluated_at TIMESTAMPTZ,
     CONSTRAINT v1_queue_item_pkey PRIMARY KEY (id)
 );
 
@@ -377,6 +428,14 @@ CREATE INDEX v1_queue_item_list_idx ON v1_queue_item (
     id ASC
 );
 
+CREATE INDEX v1_queue_item_fairness_idx ON v1_queue_item (
+    tenant_id ASC,
+    queue ASC,
+    fairness_key ASC,
+    priority DESC,
+    id ASC
+) WHERE fairness_key IS NOT NULL;
+
 CREATE INDEX v1_queue_item_task_idx ON v1_queue_item (
     task_id ASC,
     task_inserted_at ASC,
@@ -391,6 +450,10 @@ CREATE TABLE v1_task_runtime (
     worker_id UUID,
     tenant_id UUID NOT NULL,
     timeout_at TIMESTAMP(3) NOT NULL,
+    -- the number of worker slots held by this runtime
+    slot_weight INTEGER NOT NULL DEFAULT 1,
+    -- whether this runtime can be preempted by a higher-priority task
+    preemptible BOOLEAN NOT NULL DEFAULT false,
 
     CONSTRAINT v1_task_runtime_pkey PRIMARY KEY (task_id, task_inserted_at, retry_count)
 );
@@ -399,6 +462,8 @@ CREATE INDEX v1_task_runtime_tenantId_workerId_idx ON v1_task_runtime (tenant_id
 
 CREATE INDEX v1_task_runtime_tenantId_timeoutAt_idx ON v1_task_runtime (tenant_id ASC, timeout_at ASC);
 
+CREATE INDEX v1_task_runtime_tenantId_preemptible_idx ON v1_task_runtime (tenant_id ASC, worker_id ASC) WHERE preemptible;
+
 alter table v1_task_runtime set (
     autovacuum_vacuum_scale_factor = '0.1',
     autovacuum_analyze_scale_factor='0.05',
@@ -408,6 +473,74 @@ alter table v1_task_runtime set (
     autovacuum_vacuum_cost_limit='1000'
 );
 
+-- v1_worker_resource stores the named resource capacities which a worker registers with (i.e. gpu_mem)
+CREATE TABLE v1_worker_resource (
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    capacity INTEGER NOT NULL,
+
+    CONSTRAINT v1_worker_resource_pkey PRIMARY KEY (worker_id, key),
+    CONSTRAINT v1_worker_resource_worker_id_fkey FOREIGN KEY (worker_id) REFERENCES "Worker" ("id") ON DELETE CASCADE
+);
+
+-- v1_step_resource_request stores the named worker resources which a single run of a step consumes
+CREATE TABLE v1_step_resource_request (
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+
+    CONSTRAINT v1_step_resource_request_pkey PRIMARY KEY (step_id, key)
+);
+
+-- v1_step_retry_policy stores the declarative retry policy of a step, which decides whether and when an
+-- application failure with an error code is retried
+CREATE TABLE v1_step_retry_policy (
+    tenant_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    -- error_codes and error_code_max_retries are parallel arrays. a max retries of 0 means failures with the
+    -- error code are never retried, failures with other error codes use the retries of the step.
+    error_codes TEXT[] NOT NULL DEFAULT '{}',
+    error_code_max_retries INTEGER[] NOT NULL DEFAULT '{}',
+    -- randomizes the retry delay by up to this fraction of the delay
+    jitter DOUBLE PRECISION NOT NULL DEFAULT 0,
+    min_backoff_seconds INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT v1_step_retry_policy_pkey PRIMARY KEY (step_id)
+);
+
+-- v1_task_runtime_resource stores the resources held by a v1_task_runtime. rows are deleted when the
+-- runtime is released.
+CREATE TABLE v1_task_runtime_resource (
+    task_id bigint NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    tenant_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+
+    CONSTRAINT v1_task_runtime_resource_pkey PRIMARY KEY (task_id, task_inserted_at, retry_count, key)
+);
+
+CREATE INDEX v1_task_runtime_resource_tenantId_workerId_idx ON v1_task_runtime_resource (tenant_id ASC, worker_id ASC);
+
+-- v1_task_runtime_semaphore stores the units of SEMAPHORE rate limits held by a v1_task_runtime. rows are
+-- deleted and the units are returned to the rate limit when the runtime is released.
+CREATE TABLE v1_task_runtime_semaphore (
+    task_id bigint NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    units INTEGER NOT NULL,
+
+    CONSTRAINT v1_task_runtime_semaphore_pkey PRIMARY KEY (task_id, task_inserted_at, retry_count, key)
+);
+
+CREATE INDEX v1_task_runtime_semaphore_tenantId_key_idx ON v1_task_runtime_semaphore (tenant_id ASC, key ASC);
+
 CREATE TYPE v1_match_kind AS ENUM ('TRIGGER', 'SIGNAL');
 
 CREATE TABLE v1_match (
@@ -450,7 +583,7 @@ CREATE TYPE v1_event_type AS ENUM ('USER', 'INTERNAL');
 -- negative conditions from positive conditions. For example, if a task is waiting for a set of
 -- tasks to fail, the success of all tasks would be a CANCEL condition, and the failure of any
 -- task would be a QUEUE condition. Different actions are implicitly different groups of conditions.
-CREATE TYPE v1_match_condition_action AS ENUM ('CREATE', 'QUEUE', 'CANCEL', 'SKIP', 'CREATE_MATCH');
+CREATE TYPE v1_match_condition_action AS ENUM ('CREATE', 'QUEUE', 'CANCEL', 'SKIP', 'CREATE_MATCH', 'FAIL');
 
 CREATE TABLE v1_match_condition (
     v1_match_id bigint NOT NULL,
@@ -818,6 +951,23 @@ CREATE TABLE v1_retry_queue_item (
 
 CREATE INDEX v1_retry_queue_item_tenant_id_retry_after_idx ON v1_retry_queue_item (tenant_id ASC, retry_after ASC);
 
+-- the deadline for the total wall-clock time of a task across all of its attempts, including retries, backoff
+-- and durable sleeps. rows are removed once the deadline is processed, whether or not the task has finished.
+CREATE TABLE v1_task_execution_timeout (
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    tenant_id UUID NOT NULL,
+    timeout_at TIMESTAMPTZ NOT NULL,
+    -- the timeout which sets the deadline, used for the event message
+    timeout TEXT NOT NULL,
+    -- whether the deadline comes from the execution timeout of the workflow run rather than the total timeout of the task
+    is_workflow_timeout BOOLEAN NOT NULL DEFAULT FALSE,
+
+    CONSTRAINT v1_task_execution_timeout_pkey PRIMARY KEY (task_id, task_inserted_at)
+);
+
+CREATE INDEX v1_task_execution_timeout_tenant_id_timeout_at_idx ON v1_task_execution_timeout (tenant_id ASC, timeout_at ASC);
+
 CREATE OR REPLACE FUNCTION v1_task_insert_function()
 RETURNS TRIGGER AS $$
 DECLARE
@@ -908,7 +1058,8 @@ BEGIN
         priority,
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     )
     SELECT
         tenant_id,
@@ -925,7 +1076,8 @@ BEGIN
         COALESCE(priority, 1),
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     FROM new_table
     WHERE initial_state = 'QUEUED' AND concurrency_strategy_ids[1] IS NULL;
 
@@ -989,12 +1141,16 @@ BEGIN
             nt.inserted_at,
             nt.retry_count,
             nt.tenant_id,
-            -- Convert the retry_after based on min(retry_backoff_factor ^ retry_count, retry_max_backoff)
-            NOW() + (LEAST(nt.retry_max_backoff, POWER(nt.retry_backoff_factor, nt.app_retry_count)) * interval '1 second') AS retry_after
+            -- Use the retry_after decided by the retry policy, otherwise convert the retry_after based on
+            -- min(retry_backoff_factor ^ retry_count, retry_max_backoff)
+            COALESCE(
+                nt.retry_after,
+                NOW() + (LEAST(nt.retry_max_backoff, POWER(nt.retry_backoff_factor, nt.app_retry_count)) * interval '1 second')
+            ) AS retry_after
         FROM new_table nt
         JOIN old_table ot ON ot.id = nt.id
         WHERE nt.initial_state = 'QUEUED'
-            AND nt.retry_backoff_factor IS NOT NULL
+            AND (nt.retry_backoff_factor IS NOT NULL OR nt.retry_after IS NOT NULL)
             AND ot.app_retry_count IS DISTINCT FROM nt.app_retry_count
             AND nt.app_retry_count != 0
     )
@@ -1045,7 +1201,7 @@ BEGIN
         WHERE nt.initial_state = 'QUEUED'
             -- Concurrency strategy id should never be null
             AND nt.concurrency_strategy_ids[1] IS NOT NULL
-            AND (nt.retry_backoff_factor IS NULL OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
+            AND ((nt.retry_backoff_factor IS NULL AND nt.retry_after IS NULL) OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
             AND ot.retry_count IS DISTINCT FROM nt.retry_count
     ), updated_slot AS (
         UPDATE
@@ -1127,7 +1283,8 @@ BEGIN
         priority,
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     )
     SELECT
         nt.tenant_id,
@@ -1144,12 +1301,13 @@ BEGIN
         4,
         nt.sticky,
         nt.desired_worker_id,
-        nt.retry_count
+        nt.retry_count,
+        nt.fairness_key
     FROM new_table nt
     JOIN old_table ot ON ot.id = nt.id
     WHERE nt.initial_state = 'QUEUED'
         AND nt.concurrency_strategy_ids[1] IS NULL
-        AND (nt.retry_backoff_factor IS NULL OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
+        AND ((nt.retry_backoff_factor IS NULL AND nt.retry_after IS NULL) OR ot.app_retry_count IS NOT DISTINCT FROM nt.app_retry_count OR nt.app_retry_count = 0)
         AND ot.retry_count IS DISTINCT FROM nt.retry_count;
 
     RETURN NULL;
@@ -1268,7 +1426,8 @@ BEGIN
         priority,
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     )
     SELECT
         tenant_id,
@@ -1285,7 +1444,8 @@ BEGIN
         4,
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     FROM tasks;
 
     RETURN NULL;
@@ -1407,7 +1567,8 @@ BEGIN
         priority,
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     )
     SELECT
         tenant_id,
@@ -1424,7 +1585,8 @@ BEGIN
         COALESCE(priority, 1),
         sticky,
         desired_worker_id,
-        retry_count
+        retry_count,
+        fairness_key
     FROM tasks;
 
     RETURN NULL;
@@ -1483,7 +1645,7 @@ CREATE TABLE v1_log_line (
     PRIMARY KEY (task_id, task_inserted_at, id)
 ) PARTITION BY RANGE(task_inserted_at);
 
-CREATE TYPE v1_step_match_condition_kind AS ENUM ('PARENT_OVERRIDE', 'USER_EVENT', 'SLEEP');
+CREATE TYPE v1_step_match_condition_kind AS ENUM ('PARENT_OVERRIDE', 'USER_EVENT', 'SLEEP', 'APPROVAL');
 
 CREATE TABLE v1_step_match_condition (
     id BIGINT GENERATED ALWAYS AS IDENTITY,
@@ -1500,6 +1662,10 @@ CREATE TABLE v1_step_match_condition (
     event_key TEXT,
     -- If this is a PARENT_OVERRIDE condition, this will be set to the parent readable_id
     parent_readable_id TEXT,
+    -- If this is an APPROVAL condition, this will be set to the (optional) duration after which the approval expires
+    approval_expires_in TEXT,
+    -- If this is an APPROVAL condition with an expiry, this will be set to the action to take on expiry (SKIP or FAIL)
+    approval_expiry_action v1_match_condition_action,
     PRIMARY KEY (step_id, id)
 );
 
@@ -1510,3 +1676,91 @@ CREATE TABLE v1_durable_sleep (
     sleep_duration TEXT NOT NULL,
     PRIMARY KEY (tenant_id, sleep_until, id)
 );
+
+CREATE TYPE v1_approval_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'EXPIRED');
+
+-- A manual approval which a task with an APPROVAL match condition is waiting on. The decision is delivered to
+-- the task's match conditions as an internal event with the key approval-<id>.
+CREATE TABLE v1_approval (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    external_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    task_external_id UUID NOT NULL,
+    workflow_run_id UUID NOT NULL,
+    step_id UUID NOT NULL,
+    readable_data_key TEXT NOT NULL,
+    status v1_approval_status NOT NULL DEFAULT 'PENDING',
+    expires_at TIMESTAMPTZ,
+    decided_at TIMESTAMPTZ,
+    -- the id and email of the user who approved or rejected the task
+    decided_by UUID,
+    decided_by_email TEXT,
+    comment TEXT,
+    payload JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE UNIQUE INDEX v1_approval_tenant_external_id_idx ON v1_approval (tenant_id, external_id);
+
+CREATE INDEX v1_approval_tenant_status_idx ON v1_approval (tenant_id, status, created_at);
+
+-- A pending run for a debounced workflow. New triggers with the same key replace the input and push back
+-- fire_at, and the run is created by the sleep emitter once fire_at has passed.
+CREATE TABLE v1_debounced_run (
+    tenant_id UUID NOT NULL,
+    workflow_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    workflow_version_id UUID NOT NULL,
+    -- the external id of the first trigger, which is returned to every coalesced trigger and becomes the id of the run
+    -- (a trigger_count of 0 means the id was claimed, but no trigger has arrived yet)
+    external_id UUID NOT NULL,
+    input JSONB NOT NULL,
+    additional_metadata JSONB,
+    priority INTEGER,
+    trigger_count INTEGER NOT NULL DEFAULT 1,
+    first_triggered_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    fire_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, workflow_id, key)
+);
+
+CREATE INDEX v1_debounced_run_fire_at_idx ON v1_debounced_run (tenant_id ASC, fire_at ASC);
+
+-- An idempotency key for workflow triggers and events. The key is claimed with an external id when the trigger
+-- is received, and triggered_at is set once the run is created, so duplicate triggers return the original
+-- external id and redelivered triggers are skipped. Expired keys can be claimed again.
+CREATE TABLE v1_idempotency_key (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    external_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    triggered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, key)
+);
+
+CREATE INDEX v1_idempotency_key_expires_at_idx ON v1_idempotency_key (expires_at ASC);
+
+-- A signal sent to a task from outside of the task. Signals are stored until a durable task waits on them:
+-- a pending signal is assigned to the first match condition which waits on its name, and is delivered to the
+-- condition as an internal event with the key durable-signal-<name>. Signals which aren't delivered within a day
+-- are removed.
+CREATE TABLE v1_durable_signal (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    task_external_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}'::JSONB,
+    -- the match condition which the signal was delivered to
+    v1_match_id BIGINT,
+    match_condition_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX v1_durable_signal_pending_idx ON v1_durable_signal (tenant_id, task_external_id, name, id) WHERE match_condition_id IS NULL;
+
+CREATE INDEX v1_durable_signal_delivered_idx ON v1_durable_signal (tenant_id, task_external_id, name) WHERE match_condition_id IS NOT NULL;
+
+CREATE INDEX v1_durable_signal_created_at_idx ON v1_durable_signal (created_at) WHERE match_condition_id IS NULL;
@@ -270,7 +270,8 @@ CREATE TYPE v1_event_type_olap AS ENUM (
     'CANCELLED',
     'TIMED_OUT',
     'RATE_LIMIT_ERROR',
-    'SKIPPED'
+    'SKIPPED',
+    'PREEMPTED'
 );
 
 -- this is a hash-partitioned table on the task_id, so that we can process batches of events in parallel
@@ -330,6 +331,8 @@ CREATE TABLE v1_task_events_olap (
     worker_id UUID,
     additional__event_data TEXT,
     additional__event_message TEXT,
+    -- the structured error of a FAILED event (type, message, stack trace, retryable flag and details)
+    error_details JSONB,
 
     PRIMARY KEY (task_id, task_inserted_at, id)
 );
@@ -463,6 +466,34 @@ CREATE TABLE v1_event_to_run_olap (
     PRIMARY KEY (event_id, event_seen_at, run_id, run_inserted_at)
 ) PARTITION BY RANGE(event_seen_at);
 
+-- v1_rate_limit_usage_olap stores the units consumed from each rate limit key and the number of tasks which
+-- were rate limited by the key, bucketed by minute
+CREATE TABLE v1_rate_limit_usage_olap (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    bucket TIMESTAMPTZ NOT NULL,
+    units_consumed BIGINT NOT NULL DEFAULT 0,
+    -- the number of distinct tasks which were first rate limited by the key in the bucket, see v1_rate_limited_task_olap
+    tasks_rate_limited BIGINT NOT NULL DEFAULT 0,
+    -- the number of times a task could not be assigned because the rate limit was exhausted
+    rate_limited_attempts BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (tenant_id, key, bucket)
+) PARTITION BY RANGE(bucket);
+
+-- v1_rate_limited_task_olap stores the tasks which were rate limited by each rate limit key, so that a task which
+-- is rate limited on every scheduling attempt is only counted once per key and day in v1_rate_limit_usage_olap
+CREATE TABLE v1_rate_limited_task_olap (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    rate_limited_on DATE NOT NULL,
+    -- the first time the task was rate limited by the key on rate_limited_on
+    rate_limited_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (tenant_id, key, task_id, task_inserted_at, rate_limited_on)
+) PARTITION BY RANGE(rate_limited_on);
 
 -- TRIGGERS TO LINK TASKS, DAGS AND EVENTS --
 CREATE OR REPLACE FUNCTION v1_tasks_olap_insert_function()